@@ -27,7 +27,6 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/retry"
@@ -46,21 +45,43 @@ const (
 type Client struct {
 	config config.Evm
 	client.Core
-	logger  *log.Entry
-	chainId uint64
+	logger       *log.Entry
+	chainId      uint64
+	logsProvider logsProvider
+	// Bounds the in-flight requests to the endpoint. Nil disables the limit
+	limiter *callLimiter
 }
 
 // NewClient creates new instance of an EVM client
 func NewClient(c config.Evm, chainId uint64) *Client {
+	client, err := newClient(c, chainId)
+	if err != nil {
+		config.GetLoggerFor("EVM Client").Fatalf("Failed to initialize Client with Chain Id [%v]. Error [%s]", chainId, err)
+	}
+	return client
+}
+
+// newClient creates new instance of an EVM client, failing if its logs provider can not be built
+func newClient(c config.Evm, chainId uint64) (*Client, error) {
 	logger := config.GetLoggerFor(fmt.Sprintf("EVM Client"))
 	if c.BlockConfirmations < 1 {
 		logger.Fatalf("BlockConfirmations should be a positive number")
 	}
 
 	var client client.Core
-	client, err := ethclient.Dial(c.NodeUrl)
+	var caller rpcCaller
+	limiter := newCallLimiter(c.MaxConcurrentCalls)
+	ethClient, err := dialLimited(c.NodeUrl, c.NodeHeaders, limiter)
 	if err != nil {
 		logger.Warnf("Failed to initialize Client with Chain Id [%v]. Error [%s]", chainId, err)
+	} else {
+		client = ethClient
+		caller = ethClient.Client()
+	}
+
+	provider, err := newLogsProvider(c.LogsProvider, client, caller)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize logs provider: %w", err)
 	}
 
 	return &Client{
@@ -68,7 +89,9 @@ func NewClient(c config.Evm, chainId uint64) *Client {
 		client,
 		logger,
 		chainId,
-	}
+		provider,
+		limiter,
+	}, nil
 }
 func (ec *Client) GetChainID() uint64 {
 	return ec.chainId
@@ -206,28 +229,51 @@ func (ec Client) RetryBlockNumber() (uint64, error) {
 }
 
 // RetryFilterLogs returns the logs from the input query
-// Uses a retry mechanism in case the filter query is stuck
+// Uses the configured logs provider, following its continuation cursor until all pages are retrieved.
+// Every page uses a retry mechanism in case the filter query is stuck
 func (ec Client) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
-	filterLogsFunc := func(ctx context.Context) retry.Result {
-		logs, err := ec.FilterLogs(ctx, query)
-		return retry.Result{
-			Value: logs,
-			Error: err,
-		}
+	provider := ec.logsProvider
+	if provider == nil {
+		provider = &rangeLogsProvider{core: ec.Core}
 	}
 
-	result, err := service.Retry(filterLogsFunc, executionRetries)
-	if err != nil {
-		ec.logger.Warnf("Error in [RetryFilterLogs] Retry [%s]", err)
-		return nil, err
-	}
+	var logs []types.Log
+	cursor := ""
+	for {
+		pageCursor := cursor
+		filterLogsFunc := func(ctx context.Context) retry.Result {
+			page, next, err := provider.FilterLogs(ctx, query, pageCursor)
+			return retry.Result{
+				Value: logsPage{logs: page, next: next},
+				Error: err,
+			}
+		}
 
-	logs, ok := result.([]types.Log)
-	if !ok {
-		return nil, fmt.Errorf("failed to cast logs [%v]", result)
+		result, err := service.Retry(filterLogsFunc, executionRetries)
+		if err != nil {
+			ec.logger.Warnf("Error in [RetryFilterLogs] Retry [%s]", err)
+			return nil, err
+		}
+
+		page, ok := result.(logsPage)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast logs [%v]", result)
+		}
+
+		logs = append(logs, page.logs...)
+		if page.next == "" {
+			return logs, nil
+		}
+		if page.next == cursor {
+			return nil, fmt.Errorf("logs provider returned the same cursor [%s] twice", cursor)
+		}
+		cursor = page.next
 	}
+}
 
-	return logs, nil
+type logsPage struct {
+	logs []types.Log
+	next string
 }
 
 func (ec *Client) WaitForConfirmations(raw types.Log) error {
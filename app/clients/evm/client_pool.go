@@ -20,15 +20,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"strings"
+	"net/http"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 )
@@ -37,11 +35,15 @@ type ClientPool struct {
 	clients        []client.EVM
 	clientsConfigs []config.Evm
 	retries        int
-	logger         *log.Entry
+	// Consecutive failures of the clients, used to prefer the healthy ones
+	health *endpointHealth
+	// The concurrency limiters of the clients, in the order of the pool. Nil entries are not limited
+	limiters []*callLimiter
+	logger   *log.Entry
 }
 
-func validateWebsocketUrl(wsUrl string, logger *log.Entry) error {
-	client, err := ethclient.Dial(wsUrl)
+func validateWebsocketUrl(wsUrl string, headers map[string]string, logger *log.Entry) error {
+	client, err := Dial(wsUrl, headers)
 	if err != nil {
 		logger.WithFields(log.Fields{
 			"nodeUrl": wsUrl,
@@ -59,12 +61,12 @@ func validateWebsocketUrl(wsUrl string, logger *log.Entry) error {
 	return nil
 }
 
-func checkIfNodeURLIsValid(nodeURL string) error {
+func checkIfNodeURLIsValid(nodeURL string, headers map[string]string) error {
 	logger := config.GetLoggerFor("EVM Client Pool")
-	if strings.HasPrefix(nodeURL, "wss://") || strings.HasPrefix(nodeURL, "ws://") {
-		return validateWebsocketUrl(nodeURL, logger)
+	if isWebsocketUrl(nodeURL) {
+		return validateWebsocketUrl(nodeURL, headers, logger)
 	}
-	client, err := rpc.DialHTTP(nodeURL)
+	client, err := dialRpc(nodeURL, headers, http.DefaultTransport)
 	if err != nil {
 		logger.WithFields(log.Fields{
 			"nodeUrl": nodeURL,
@@ -84,9 +86,13 @@ func checkIfNodeURLIsValid(nodeURL string) error {
 
 func NewClientPool(c config.EvmPool, chainId uint64) (*ClientPool, error) {
 	logger := config.GetLoggerFor("EVM Client Pool")
+	if err := validateLogsProvider(c.LogsProvider); err != nil {
+		return nil, fmt.Errorf("evm client pool creation failed: %w", err)
+	}
 	nodeURLs := c.NodeUrls
 	clients := make([]client.EVM, 0, len(nodeURLs))
 	clientsConfigs := make([]config.Evm, 0, len(nodeURLs))
+	limiters := make([]*callLimiter, 0, len(nodeURLs))
 	invalidUrls := 0
 	for _, nodeURL := range nodeURLs {
 		configEvm := config.Evm{
@@ -96,28 +102,46 @@ func NewClientPool(c config.EvmPool, chainId uint64) (*ClientPool, error) {
 			StartBlock:         c.StartBlock,
 			PollingInterval:    c.PollingInterval,
 			MaxLogsBlocks:      c.MaxLogsBlocks,
+			LogsProvider:       c.LogsProvider,
+			NodeHeaders:        c.NodeHeaders[nodeURL],
+			MaxConcurrentCalls: c.MaxConcurrentCalls,
+		}
+		evmClient, err := newClient(configEvm, chainId)
+		if err != nil {
+			logger.Errorf("Skipping endpoint [%s] of Chain Id [%v]. Error [%s]", nodeURL, chainId, err)
+			continue
 		}
-		err := checkIfNodeURLIsValid(nodeURL)
+		err = checkIfNodeURLIsValid(nodeURL, configEvm.NodeHeaders)
 		if err == nil {
-			clients = append([]client.EVM{NewClient(configEvm, chainId)}, clients...)
+			clients = append([]client.EVM{evmClient}, clients...)
 			clientsConfigs = append([]config.Evm{configEvm}, clientsConfigs...)
+			limiters = append([]*callLimiter{evmClient.limiter}, limiters...)
 		} else {
 			invalidUrls++
-			clients = append(clients, NewClient(configEvm, chainId))
+			clients = append(clients, evmClient)
 			clientsConfigs = append(clientsConfigs, configEvm)
+			limiters = append(limiters, evmClient.limiter)
 		}
 	}
 
-	if invalidUrls == len(nodeURLs) {
+	if invalidUrls == len(clients) {
 		return nil, fmt.Errorf("evm client pool creation failed: no working urls found in nodeURLs")
 	}
 
 	retry := len(clients) * 3
 
+	// The unreachable urls are at the end of the pool and start demoted
+	health := newEndpointHealth(len(clients))
+	for i := len(clients) - invalidUrls; i < len(clients); i++ {
+		health.failed(i)
+	}
+
 	return &ClientPool{
 		clients:        clients,
 		clientsConfigs: clientsConfigs,
 		retries:        retry,
+		health:         health,
+		limiters:       limiters,
 		logger:         logger,
 	}, nil
 }
@@ -128,15 +152,21 @@ func (cp *ClientPool) getClient(idx int) (client.EVM, config.Evm) {
 	return cp.clients[clientIndex], cp.clientsConfigs[configIndex]
 }
 
+// retryOperation tries the operation on the clients of the pool, preferring the healthy ones,
+// and fails over to the next client on error
 func (cp *ClientPool) retryOperation(operation func(client.EVM) (interface{}, error)) (interface{}, error) {
+	order := cp.health.order()
 	var err error
 	for i := 0; i < cp.retries; i++ {
-		client, clientConfig := cp.getClient(i)
+		idx := order[i%len(order)]
+		client, clientConfig := cp.getClient(idx)
 		result, e := operation(client)
 		if e == nil {
+			cp.health.succeeded(idx)
 			return result, nil
 		}
 
+		cp.health.failed(idx)
 		cp.logger.WithFields(log.Fields{
 			"nodeUrl": clientConfig.NodeUrl,
 			"retries": i,
@@ -147,6 +177,31 @@ func (cp *ClientPool) retryOperation(operation func(client.EVM) (interface{}, er
 	return nil, err
 }
 
+// VerifyChainID checks that every endpoint of the pool serves the expected chain, so that an endpoint of
+// the wrong network (e.g. a testnet endpoint in a mainnet configuration) fails the startup instead of
+// being failed over to. Unreachable endpoints are demoted and skipped, but at least one endpoint must confirm the chain.
+func (cp *ClientPool) VerifyChainID(ctx context.Context, expected uint64) error {
+	confirmed := 0
+	for i, c := range cp.clients {
+		host := endpointHost(cp.clientsConfigs[i].NodeUrl)
+		actual, err := c.ChainID(ctx)
+		if err != nil {
+			cp.logger.Warnf("Failed to retrieve chain ID of endpoint [%s]. Error: [%s]", host, err)
+			cp.health.failed(i)
+			continue
+		}
+		if actual.Uint64() != expected {
+			return fmt.Errorf("endpoint [%s] serves chain [%d] instead of the configured chain [%d]", host, actual, expected)
+		}
+		confirmed++
+	}
+
+	if confirmed == 0 {
+		return fmt.Errorf("no endpoint confirmed the configured chain [%d]", expected)
+	}
+	return nil
+}
+
 func (cp *ClientPool) ChainID(ctx context.Context) (*big.Int, error) {
 	operation := func(c client.EVM) (interface{}, error) {
 		return c.ChainID(ctx)
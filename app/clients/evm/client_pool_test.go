@@ -17,6 +17,9 @@ package evm
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
@@ -55,6 +58,7 @@ func setupCP() {
 		clientsConfigs: clientConfigs,
 		logger:         config.GetLoggerFor("client_pool_test_logger"),
 		retries:        retries,
+		health:         newEndpointHealth(len(evmList)),
 	}
 }
 
@@ -119,6 +123,45 @@ func TestNewClientPool_PanicsWhenNoWorkingURLIsFound(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestNewClientPool_SkipsClientWithoutLogsProvider(t *testing.T) {
+	// Answers every request with an empty block
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id json.RawMessage `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.Id, "result": map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	// The raw RPC client of an unsupported scheme can not be dialed
+	nodeUrls := []string{"ftp://localhost:8546", server.URL}
+	configEvmPool := config.EvmPool{
+		BlockConfirmations: 3,
+		NodeUrls:           nodeUrls,
+		PrivateKey:         "0x000000000",
+		LogsProvider:       LogsProviderBlockHash,
+	}
+
+	clientPool, err := NewClientPool(configEvmPool, 256)
+	assert.NoError(t, err)
+	assert.Len(t, clientPool.clients, 1)
+	assert.Equal(t, server.URL, clientPool.clientsConfigs[0].NodeUrl)
+}
+
+func TestNewClientPool_FailsWithoutUsableClient(t *testing.T) {
+	configEvmPool := config.EvmPool{
+		BlockConfirmations: 3,
+		NodeUrls:           []string{"ftp://localhost:8546"},
+		PrivateKey:         "0x000000000",
+		LogsProvider:       LogsProviderCursor,
+	}
+
+	_, err := NewClientPool(configEvmPool, 256)
+	assert.Error(t, err)
+}
+
 func TestClientPool_SetChainID(t *testing.T) {
 	setupCP()
 	cp.SetChainID(chainId)
@@ -176,27 +219,27 @@ func TestClientPool_ValidateContractDeployedAt_NotASmartContract(t *testing.T) {
 }
 
 func TestClientPool_ValidateWebsocketUrl_Valid(t *testing.T) {
-	result := checkIfNodeURLIsValid("wss://ethereum-rpc.publicnode.com")
+	result := checkIfNodeURLIsValid("wss://ethereum-rpc.publicnode.com", nil)
 	assert.NoError(t, result)
 }
 
 func TestClientPool_ValidateWebsocketUrl_Invalid(t *testing.T) {
-	result := checkIfNodeURLIsValid("wss://publicnode.com/")
+	result := checkIfNodeURLIsValid("wss://publicnode.com/", nil)
 	assert.Error(t, result)
 }
 
 func TestClientPool_CheckIfNodeURLIsValid_Valid(t *testing.T) {
-	result := checkIfNodeURLIsValid("https://ethereum-holesky-rpc.publicnode.com")
+	result := checkIfNodeURLIsValid("https://ethereum-holesky-rpc.publicnode.com", nil)
 	assert.NoError(t, result)
 }
 
 func TestClientPool_CheckIfNodeURLIsValid_Invalid(t *testing.T) {
-	result := checkIfNodeURLIsValid("//google.com")
+	result := checkIfNodeURLIsValid("//google.com", nil)
 	assert.Error(t, result)
 }
 
 func TestClientPool_CheckIfNodeURLIsValid_Invalid_404(t *testing.T) {
-	result := checkIfNodeURLIsValid("https://rpc.ankr.com/eth/404")
+	result := checkIfNodeURLIsValid("https://rpc.ankr.com/eth/404", nil)
 	assert.Error(t, result)
 }
 
@@ -509,3 +552,112 @@ func TestClientPool_CallContract(t *testing.T) {
 	assert.Equal(t, expectedResult, actualResult)
 	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "CallContract", 3)
 }
+
+func setupFailoverCP() {
+	setupCP()
+	cp.clients = append(cp.clients, mocks.MEVMClient)
+	cp.clientsConfigs = []config.Evm{{NodeUrl: "https://primary.example.com/key"}, {NodeUrl: "https://secondary.example.com/key"}}
+	cp.health = newEndpointHealth(len(cp.clients))
+}
+
+func TestClientPool_FailsOverToHealthyEndpoint(t *testing.T) {
+	setupFailoverCP()
+	ctx := context.TODO()
+	mocks.MEVMCoreClient.On("BlockNumber", ctx).Return(nil, errors.New("connection refused"))
+	mocks.MEVMClient.On("BlockNumber", ctx).Return(uint64(5), nil)
+
+	blockNumber, err := cp.BlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), blockNumber)
+
+	// The failing endpoint is demoted, so the healthy one is tried first
+	blockNumber, err = cp.BlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), blockNumber)
+	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "BlockNumber", 1)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "BlockNumber", 2)
+
+	assert.Equal(t, []client.EvmEndpoint{
+		{Host: "primary.example.com", Failures: 1},
+		{Host: "secondary.example.com", Failures: 0},
+	}, cp.Endpoints())
+}
+
+func TestClientPool_Endpoints_QueueWait(t *testing.T) {
+	setupFailoverCP()
+	limiter := newCallLimiter(1)
+	limiter.waited = 3 * time.Second
+	limiter.calls = 4
+	cp.limiters = []*callLimiter{nil, limiter}
+
+	assert.Equal(t, []client.EvmEndpoint{
+		{Host: "primary.example.com"},
+		{Host: "secondary.example.com", QueueWait: 3 * time.Second, LimitedCalls: 4},
+	}, cp.Endpoints())
+}
+
+func TestClientPool_RetriesDemotedEndpointAfterCooldown(t *testing.T) {
+	setupFailoverCP()
+	ctx := context.TODO()
+	now := time.Now()
+	cp.health.now = func() time.Time { return now }
+	cp.health.failed(0)
+	mocks.MEVMCoreClient.On("BlockNumber", ctx).Return(uint64(6), nil)
+	mocks.MEVMClient.On("BlockNumber", ctx).Return(uint64(5), nil)
+
+	blockNumber, err := cp.BlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), blockNumber)
+
+	now = now.Add(endpointCooldown)
+	blockNumber, err = cp.BlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), blockNumber)
+	assert.Equal(t, 0, cp.Endpoints()[0].Failures)
+}
+
+func TestClientPool_AllEndpointsFail(t *testing.T) {
+	setupFailoverCP()
+	ctx := context.TODO()
+	mocks.MEVMCoreClient.On("BlockNumber", ctx).Return(nil, errors.New("connection refused"))
+	mocks.MEVMClient.On("BlockNumber", ctx).Return(uint64(0), errors.New("connection refused"))
+
+	_, err := cp.BlockNumber(ctx)
+	assert.Error(t, err)
+	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "BlockNumber", 2)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "BlockNumber", 1)
+}
+
+func TestClientPool_VerifyChainID_Matching(t *testing.T) {
+	setupFailoverCP()
+	mocks.MEVMCoreClient.On("ChainID", context.Background()).Return(big.NewInt(1), nil)
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(1), nil)
+
+	assert.Nil(t, cp.VerifyChainID(context.Background(), 1))
+}
+
+func TestClientPool_VerifyChainID_Mismatching(t *testing.T) {
+	setupFailoverCP()
+	mocks.MEVMCoreClient.On("ChainID", context.Background()).Return(big.NewInt(1), nil)
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(5), nil)
+
+	err := cp.VerifyChainID(context.Background(), 1)
+
+	assert.EqualError(t, err, "endpoint [secondary.example.com] serves chain [5] instead of the configured chain [1]")
+}
+
+func TestClientPool_VerifyChainID_SkipsUnreachable(t *testing.T) {
+	setupFailoverCP()
+	mocks.MEVMCoreClient.On("ChainID", context.Background()).Return(nil, errors.New("some-error"))
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(1), nil)
+
+	assert.Nil(t, cp.VerifyChainID(context.Background(), 1))
+	assert.Equal(t, []int{1, 0}, cp.health.order())
+}
+
+func TestClientPool_VerifyChainID_NoneReachable(t *testing.T) {
+	setupCP()
+	mocks.MEVMCoreClient.On("ChainID", context.Background()).Return(nil, errors.New("some-error"))
+
+	assert.Error(t, cp.VerifyChainID(context.Background(), 1))
+}
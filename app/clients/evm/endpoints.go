@@ -0,0 +1,112 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+)
+
+// The period after its last failure, during which a failing endpoint is demoted.
+// Afterwards, it is tried in its configured position again, so that recovered endpoints are detected
+const endpointCooldown = time.Minute
+
+// endpointHealth tracks the consecutive failures of the endpoints of a client pool, by their position in the pool
+type endpointHealth struct {
+	mutex       sync.Mutex
+	failures    []int
+	lastFailure []time.Time
+	now         func() time.Time
+}
+
+func newEndpointHealth(endpoints int) *endpointHealth {
+	return &endpointHealth{
+		failures:    make([]int, endpoints),
+		lastFailure: make([]time.Time, endpoints),
+		now:         time.Now,
+	}
+}
+
+// order returns the positions of the endpoints in the order they are tried.
+// Healthy endpoints come first, followed by the demoted ones with the fewest consecutive failures
+func (h *endpointHealth) order() []int {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	now := h.now()
+	demotion := make([]int, len(h.failures))
+	order := make([]int, len(h.failures))
+	for i := range h.failures {
+		order[i] = i
+		if now.Sub(h.lastFailure[i]) < endpointCooldown {
+			demotion[i] = h.failures[i]
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return demotion[order[i]] < demotion[order[j]]
+	})
+
+	return order
+}
+
+func (h *endpointHealth) succeeded(idx int) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.failures[idx] = 0
+}
+
+func (h *endpointHealth) failed(idx int) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.failures[idx]++
+	h.lastFailure[idx] = h.now()
+}
+
+// Endpoints returns the health of the endpoints of the pool, in the order of the pool
+func (cp *ClientPool) Endpoints() []client.EvmEndpoint {
+	cp.health.mutex.Lock()
+	defer cp.health.mutex.Unlock()
+
+	endpoints := make([]client.EvmEndpoint, len(cp.clientsConfigs))
+	for i, clientConfig := range cp.clientsConfigs {
+		var limiter *callLimiter
+		if i < len(cp.limiters) {
+			limiter = cp.limiters[i]
+		}
+		queueWait, calls := limiter.queueWait()
+		endpoints[i] = client.EvmEndpoint{
+			Host:         endpointHost(clientConfig.NodeUrl),
+			Failures:     cp.health.failures[i],
+			QueueWait:    queueWait,
+			LimitedCalls: calls,
+		}
+	}
+	return endpoints
+}
+
+// endpointHost returns the host of the given node URL, omitting any API key in its path or query
+func endpointHost(nodeUrl string) string {
+	parsed, err := url.Parse(nodeUrl)
+	if err != nil || parsed.Host == "" {
+		return "unknown"
+	}
+	return parsed.Host
+}
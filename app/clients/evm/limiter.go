@@ -0,0 +1,108 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// callLimiter bounds the in-flight requests to an endpoint, queuing the rest until a slot frees up
+type callLimiter struct {
+	slots chan struct{}
+	now   func() time.Time
+	mutex sync.Mutex
+	// The total time the requests spent queued and the amount of requests, which went through the limiter
+	waited time.Duration
+	calls  uint64
+}
+
+// newCallLimiter creates a limiter of at most limit in-flight requests. Returns nil, disabling the limit, if it is not positive
+func newCallLimiter(limit int) *callLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &callLimiter{
+		slots: make(chan struct{}, limit),
+		now:   time.Now,
+	}
+}
+
+// acquire waits for a free slot, unless the given request is cancelled first
+func (l *callLimiter) acquire(req *http.Request) error {
+	start := l.now()
+	select {
+	case l.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.waited += l.now().Sub(start)
+	l.calls++
+	return nil
+}
+
+func (l *callLimiter) release() {
+	<-l.slots
+}
+
+// queueWait returns the total time the requests spent queued and the amount of requests, which went through the limiter
+func (l *callLimiter) queueWait() (time.Duration, uint64) {
+	if l == nil {
+		return 0, 0
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.waited, l.calls
+}
+
+// limitTransport holds a slot of the limiter from sending a request until its response body is closed
+type limitTransport struct {
+	limiter *callLimiter
+	base    http.RoundTripper
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.acquire(req); err != nil {
+		return nil, err
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.limiter.release()
+		return nil, err
+	}
+	res.Body = &limitedBody{ReadCloser: res.Body, release: t.limiter.release}
+	return res, nil
+}
+
+// limitedBody releases the slot of its request once closed
+type limitedBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *limitedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
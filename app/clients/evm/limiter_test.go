@@ -0,0 +1,147 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingTransport holds every request until released and records the peak of the in-flight requests
+type blockingTransport struct {
+	inFlight int32
+	peak     int32
+	release  chan struct{}
+}
+
+func (t *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	current := atomic.AddInt32(&t.inFlight, 1)
+	for {
+		peak := atomic.LoadInt32(&t.peak)
+		if current <= peak || atomic.CompareAndSwapInt32(&t.peak, peak, current) {
+			break
+		}
+	}
+	<-t.release
+	atomic.AddInt32(&t.inFlight, -1)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func Test_NewCallLimiter_Disabled(t *testing.T) {
+	assert.Nil(t, newCallLimiter(0))
+	assert.Nil(t, newCallLimiter(-1))
+
+	var limiter *callLimiter
+	queueWait, calls := limiter.queueWait()
+	assert.Zero(t, queueWait)
+	assert.Zero(t, calls)
+}
+
+func Test_LimitTransport_ConcurrencyCeiling(t *testing.T) {
+	base := &blockingTransport{release: make(chan struct{})}
+	transport := &limitTransport{limiter: newCallLimiter(2), base: base}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPost, "https://rpc.example.com", nil)
+			res, err := transport.RoundTrip(req)
+			assert.Nil(t, err)
+			res.Body.Close()
+		}()
+	}
+
+	// Release the requests one by one, while the rest are queued
+	for i := 0; i < 6; i++ {
+		time.Sleep(10 * time.Millisecond)
+		assert.LessOrEqual(t, atomic.LoadInt32(&base.inFlight), int32(2))
+		base.release <- struct{}{}
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&base.peak))
+	_, calls := transport.limiter.queueWait()
+	assert.Equal(t, uint64(6), calls)
+}
+
+func Test_LimitTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	stub := &stubTransport{result: `"0x1"`}
+	transport := &limitTransport{limiter: newCallLimiter(1), base: stub}
+	req, _ := http.NewRequest(http.MethodPost, "https://rpc.example.com", nil)
+
+	res, err := transport.RoundTrip(req)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	queued, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://rpc.example.com", nil)
+	_, err = transport.RoundTrip(queued)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Nil(t, res.Body.Close())
+	assert.Nil(t, res.Body.Close())
+	_, err = transport.RoundTrip(req)
+	assert.Nil(t, err)
+	assert.Len(t, stub.requests, 2)
+}
+
+func Test_CallLimiter_RecordsQueueWait(t *testing.T) {
+	limiter := newCallLimiter(1)
+	now := time.Unix(100, 0)
+	limiter.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	req, _ := http.NewRequest(http.MethodPost, "https://rpc.example.com", nil)
+
+	assert.Nil(t, limiter.acquire(req))
+	limiter.release()
+	assert.Nil(t, limiter.acquire(req))
+
+	queueWait, calls := limiter.queueWait()
+	assert.Equal(t, 2*time.Second, queueWait)
+	assert.Equal(t, uint64(2), calls)
+}
+
+func Test_LimitTransport_ReleasesSlotAfterRpcCall(t *testing.T) {
+	limiter := newCallLimiter(1)
+	stub := &stubTransport{result: `"0x1"`}
+	rpcClient, err := dialRpc("https://rpc.example.com", nil, &limitTransport{limiter: limiter, base: stub})
+	assert.Nil(t, err)
+
+	var result string
+	assert.Nil(t, rpcClient.Call(&result, "eth_chainId"))
+	assert.Nil(t, rpcClient.Call(&result, "eth_chainId"))
+
+	assert.Equal(t, "0x1", result)
+	_, calls := limiter.queueWait()
+	assert.Equal(t, uint64(2), calls)
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+)
+
+// Supported values for the `logs_provider` EVM client configuration
+const (
+	LogsProviderRange     = "range"
+	LogsProviderBlockHash = "block_hash"
+	LogsProviderCursor    = "cursor"
+)
+
+// The number of blocks, queried by a single page of the block hash provider
+const blockHashPageBlocks = 50
+
+// logsProvider abstracts the query style used for eth_getLogs against a given RPC provider.
+// FilterLogs returns a single page of logs for the query, starting from the given cursor,
+// and the cursor of the next page. An empty next cursor means that there are no more pages.
+type logsProvider interface {
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery, cursor string) ([]types.Log, string, error)
+}
+
+// rpcCaller is the subset of the raw RPC client, used for provider specific and batched requests
+type rpcCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// validateLogsProvider checks that the logs provider with the given name is supported
+func validateLogsProvider(name string) error {
+	switch name {
+	case "", LogsProviderRange, LogsProviderBlockHash, LogsProviderCursor:
+		return nil
+	default:
+		return fmt.Errorf("unsupported logs provider [%s]", name)
+	}
+}
+
+func newLogsProvider(name string, core client.Core, caller rpcCaller) (logsProvider, error) {
+	if err := validateLogsProvider(name); err != nil {
+		return nil, err
+	}
+	if name == "" || name == LogsProviderRange {
+		return &rangeLogsProvider{core: core}, nil
+	}
+	if caller == nil {
+		return nil, fmt.Errorf("logs provider [%s] requires a raw RPC client", name)
+	}
+	if name == LogsProviderCursor {
+		return &cursorLogsProvider{caller: caller}, nil
+	}
+	return &blockHashLogsProvider{caller: caller}, nil
+}
+
+// rangeLogsProvider queries the whole block range at once. Supported by every provider.
+type rangeLogsProvider struct {
+	core client.Core
+}
+
+func (p *rangeLogsProvider) FilterLogs(ctx context.Context, query ethereum.FilterQuery, _ string) ([]types.Log, string, error) {
+	logs, err := p.core.FilterLogs(ctx, query)
+	return logs, "", err
+}
+
+// blockHashLogsProvider splits the block range into `blockHash` scoped queries, for providers which limit
+// the results of range queries. A page covers up to blockHashPageBlocks blocks in two batched requests -
+// one for the headers of the blocks and one for their logs. The cursor is the number of the next block to be queried.
+type blockHashLogsProvider struct {
+	caller rpcCaller
+}
+
+func (p *blockHashLogsProvider) FilterLogs(ctx context.Context, query ethereum.FilterQuery, cursor string) ([]types.Log, string, error) {
+	if query.FromBlock == nil || query.ToBlock == nil {
+		return nil, "", fmt.Errorf("block hash scoped queries require both FromBlock and ToBlock")
+	}
+
+	from := new(big.Int).Set(query.FromBlock)
+	if cursor != "" {
+		if _, ok := from.SetString(cursor, 10); !ok {
+			return nil, "", fmt.Errorf("invalid block cursor [%s]", cursor)
+		}
+	}
+	to := new(big.Int).Add(from, big.NewInt(blockHashPageBlocks-1))
+	if to.Cmp(query.ToBlock) > 0 {
+		to = query.ToBlock
+	}
+
+	headers, err := p.headers(ctx, from, to)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logs, err := p.logs(ctx, headers, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if to.Cmp(query.ToBlock) >= 0 {
+		return logs, "", nil
+	}
+	return logs, new(big.Int).Add(to, big.NewInt(1)).String(), nil
+}
+
+// headers retrieves the headers of the blocks in the given inclusive range in a single batch
+func (p *blockHashLogsProvider) headers(ctx context.Context, from, to *big.Int) ([]*types.Header, error) {
+	count := new(big.Int).Sub(to, from).Int64() + 1
+	headers := make([]*types.Header, count)
+	batch := make([]rpc.BatchElem, count)
+	for i := range batch {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeBig(new(big.Int).Add(from, big.NewInt(int64(i)))), false},
+			Result: &headers[i],
+		}
+	}
+
+	if err := p.caller.BatchCallContext(ctx, batch); err != nil {
+		return nil, err
+	}
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+		if headers[i] == nil {
+			return nil, fmt.Errorf("block [%s] not found", elem.Args[0])
+		}
+	}
+
+	return headers, nil
+}
+
+// logs retrieves the logs of the blocks with the given headers, matching the query, in a single batch
+func (p *blockHashLogsProvider) logs(ctx context.Context, headers []*types.Header, query ethereum.FilterQuery) ([]types.Log, error) {
+	pages := make([][]types.Log, len(headers))
+	batch := make([]rpc.BatchElem, len(headers))
+	for i, header := range headers {
+		arg := map[string]interface{}{"blockHash": header.Hash()}
+		if len(query.Addresses) > 0 {
+			arg["address"] = query.Addresses
+		}
+		if len(query.Topics) > 0 {
+			arg["topics"] = query.Topics
+		}
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getLogs",
+			Args:   []interface{}{arg},
+			Result: &pages[i],
+		}
+	}
+
+	if err := p.caller.BatchCallContext(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	var logs []types.Log
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+		logs = append(logs, pages[i]...)
+	}
+
+	return logs, nil
+}
+
+// cursorLogsProvider uses eth_getLogs with a continuation cursor, for providers which paginate
+// dense ranges instead of failing the whole query. A plain array of logs in the response,
+// returned by providers which do not paginate, is the whole result.
+type cursorLogsProvider struct {
+	caller rpcCaller
+}
+
+type cursorLogsArg struct {
+	FromBlock string           `json:"fromBlock"`
+	ToBlock   string           `json:"toBlock"`
+	Address   []common.Address `json:"address,omitempty"`
+	Topics    [][]common.Hash  `json:"topics,omitempty"`
+	Cursor    string           `json:"cursor,omitempty"`
+}
+
+type cursorLogsResult struct {
+	Logs   []types.Log `json:"logs"`
+	Cursor string      `json:"cursor"`
+}
+
+func (p *cursorLogsProvider) FilterLogs(ctx context.Context, query ethereum.FilterQuery, cursor string) ([]types.Log, string, error) {
+	if query.FromBlock == nil || query.ToBlock == nil {
+		return nil, "", fmt.Errorf("cursor queries require both FromBlock and ToBlock")
+	}
+
+	arg := cursorLogsArg{
+		FromBlock: hexutil.EncodeBig(query.FromBlock),
+		ToBlock:   hexutil.EncodeBig(query.ToBlock),
+		Address:   query.Addresses,
+		Topics:    query.Topics,
+		Cursor:    cursor,
+	}
+
+	var raw json.RawMessage
+	if err := p.caller.CallContext(ctx, &raw, "eth_getLogs", arg); err != nil {
+		return nil, "", err
+	}
+
+	var logs []types.Log
+	if err := json.Unmarshal(raw, &logs); err == nil {
+		return logs, "", nil
+	}
+
+	var result cursorLogsResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, "", fmt.Errorf("failed to decode paginated logs: %w", err)
+	}
+	return result.Logs, result.Cursor, nil
+}
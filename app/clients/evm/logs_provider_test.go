@@ -0,0 +1,225 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	logsQuery = ethereum.FilterQuery{
+		FromBlock: big.NewInt(10),
+		ToBlock:   big.NewInt(11),
+		Addresses: []common.Address{common.HexToAddress(address)},
+	}
+)
+
+// stubLogsProvider returns one log per page and a continuation cursor until all pages are served
+type stubLogsProvider struct {
+	pages   map[string]string
+	cursors []string
+	err     error
+}
+
+func (s *stubLogsProvider) FilterLogs(_ context.Context, _ ethereum.FilterQuery, cursor string) ([]types.Log, string, error) {
+	s.cursors = append(s.cursors, cursor)
+	if s.err != nil {
+		return nil, "", s.err
+	}
+	return []types.Log{{Index: uint(len(s.cursors))}}, s.pages[cursor], nil
+}
+
+// stubRpcCaller serves batches of block headers and block hash scoped logs, one log per block,
+// and the given responses of cursor queries, keyed by the requested cursor
+type stubRpcCaller struct {
+	batches [][]rpc.BatchElem
+	args    []cursorLogsArg
+	pages   map[string]string
+}
+
+func (s *stubRpcCaller) CallContext(_ context.Context, result interface{}, _ string, args ...interface{}) error {
+	arg := args[0].(cursorLogsArg)
+	s.args = append(s.args, arg)
+	*result.(*json.RawMessage) = json.RawMessage(s.pages[arg.Cursor])
+	return nil
+}
+
+func (s *stubRpcCaller) BatchCallContext(_ context.Context, b []rpc.BatchElem) error {
+	s.batches = append(s.batches, b)
+	for _, elem := range b {
+		switch elem.Method {
+		case "eth_getBlockByNumber":
+			number, _ := hexutil.DecodeBig(elem.Args[0].(string))
+			*elem.Result.(**types.Header) = &types.Header{Number: number}
+		case "eth_getLogs":
+			hash := elem.Args[0].(map[string]interface{})["blockHash"].(common.Hash)
+			*elem.Result.(*[]types.Log) = []types.Log{{BlockHash: hash}}
+		}
+	}
+	return nil
+}
+
+func Test_RetryFilterLogs_FollowsContinuationCursor(t *testing.T) {
+	setup()
+	provider := &stubLogsProvider{pages: map[string]string{"": "page-2", "page-2": "page-3"}}
+	c.logsProvider = provider
+
+	logs, err := c.RetryFilterLogs(logsQuery)
+
+	assert.Nil(t, err)
+	assert.Len(t, logs, 3)
+	assert.Equal(t, []string{"", "page-2", "page-3"}, provider.cursors)
+}
+
+func Test_RetryFilterLogs_ProviderFails(t *testing.T) {
+	setup()
+	c.logsProvider = &stubLogsProvider{err: errors.New("some-error")}
+
+	logs, err := c.RetryFilterLogs(logsQuery)
+
+	assert.Error(t, err)
+	assert.Nil(t, logs)
+}
+
+func Test_RetryFilterLogs_RepeatedCursor(t *testing.T) {
+	setup()
+	c.logsProvider = &stubLogsProvider{pages: map[string]string{"": "page-2", "page-2": "page-2"}}
+
+	logs, err := c.RetryFilterLogs(logsQuery)
+
+	assert.Error(t, err)
+	assert.Nil(t, logs)
+}
+
+func Test_RetryFilterLogs_DefaultsToRange(t *testing.T) {
+	setup()
+	expected := []types.Log{{Index: 1}}
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, logsQuery).Return(expected, nil)
+
+	logs, err := c.RetryFilterLogs(logsQuery)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, logs)
+}
+
+func Test_BlockHashLogsProvider(t *testing.T) {
+	setup()
+	caller := &stubRpcCaller{}
+	provider, err := newLogsProvider(LogsProviderBlockHash, mocks.MEVMCoreClient, caller)
+	assert.Nil(t, err)
+	c.logsProvider = provider
+
+	logs, err := c.RetryFilterLogs(logsQuery)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Log{
+		{BlockHash: (&types.Header{Number: big.NewInt(10)}).Hash()},
+		{BlockHash: (&types.Header{Number: big.NewInt(11)}).Hash()},
+	}, logs)
+	// A single batch of headers and a single batch of logs
+	assert.Len(t, caller.batches, 2)
+	assert.Equal(t, logsQuery.Addresses, caller.batches[1][0].Args[0].(map[string]interface{})["address"])
+}
+
+func Test_BlockHashLogsProvider_Pages(t *testing.T) {
+	setup()
+	caller := &stubRpcCaller{}
+	provider, err := newLogsProvider(LogsProviderBlockHash, mocks.MEVMCoreClient, caller)
+	assert.Nil(t, err)
+	c.logsProvider = provider
+	query := ethereum.FilterQuery{FromBlock: big.NewInt(1), ToBlock: big.NewInt(blockHashPageBlocks + 10)}
+
+	logs, err := c.RetryFilterLogs(query)
+
+	assert.Nil(t, err)
+	assert.Len(t, logs, blockHashPageBlocks+10)
+	assert.Len(t, caller.batches, 4)
+	assert.Len(t, caller.batches[0], blockHashPageBlocks)
+	assert.Len(t, caller.batches[2], 10)
+}
+
+func Test_CursorLogsProvider(t *testing.T) {
+	setup()
+	caller := &stubRpcCaller{pages: map[string]string{
+		"":          `{"logs":[],"cursor":"next-page"}`,
+		"next-page": `{"logs":[],"cursor":""}`,
+	}}
+	provider, err := newLogsProvider(LogsProviderCursor, mocks.MEVMCoreClient, caller)
+	assert.Nil(t, err)
+	c.logsProvider = provider
+
+	logs, err := c.RetryFilterLogs(logsQuery)
+
+	assert.Nil(t, err)
+	assert.Empty(t, logs)
+	assert.Len(t, caller.args, 2)
+	assert.Equal(t, hexutil.EncodeBig(logsQuery.FromBlock), caller.args[0].FromBlock)
+	assert.Equal(t, hexutil.EncodeBig(logsQuery.ToBlock), caller.args[0].ToBlock)
+	assert.Equal(t, logsQuery.Addresses, caller.args[0].Address)
+	assert.Equal(t, "next-page", caller.args[1].Cursor)
+}
+
+func Test_CursorLogsProvider_NotPaginated(t *testing.T) {
+	setup()
+	caller := &stubRpcCaller{pages: map[string]string{"": `[]`}}
+	provider, err := newLogsProvider(LogsProviderCursor, mocks.MEVMCoreClient, caller)
+	assert.Nil(t, err)
+
+	logs, next, err := provider.FilterLogs(context.Background(), logsQuery, "")
+
+	assert.Nil(t, err)
+	assert.Empty(t, logs)
+	assert.Empty(t, next)
+	assert.Len(t, caller.args, 1)
+}
+
+func Test_CursorLogsProvider_InvalidResponse(t *testing.T) {
+	setup()
+	caller := &stubRpcCaller{pages: map[string]string{"": `"unexpected"`}}
+	provider, err := newLogsProvider(LogsProviderCursor, mocks.MEVMCoreClient, caller)
+	assert.Nil(t, err)
+
+	logs, _, err := provider.FilterLogs(context.Background(), logsQuery, "")
+
+	assert.Error(t, err)
+	assert.Nil(t, logs)
+}
+
+func Test_NewLogsProvider_Fails(t *testing.T) {
+	setup()
+	_, err := newLogsProvider("unknown", mocks.MEVMCoreClient, nil)
+	assert.Error(t, err)
+
+	_, err = newLogsProvider(LogsProviderBlockHash, mocks.MEVMCoreClient, nil)
+	assert.Error(t, err)
+
+	_, err = newLogsProvider(LogsProviderCursor, mocks.MEVMCoreClient, nil)
+	assert.Error(t, err)
+}
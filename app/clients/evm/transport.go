@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// headerTransport attaches the configured headers of an endpoint to every request sent to it
+type headerTransport struct {
+	headers http.Header
+	base    http.RoundTripper
+}
+
+func newHeaderTransport(headers map[string]string, base http.RoundTripper) *headerTransport {
+	httpHeaders := make(http.Header, len(headers))
+	for key, value := range headers {
+		httpHeaders.Set(key, value)
+	}
+	return &headerTransport{
+		headers: httpHeaders,
+		base:    base,
+	}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Round trippers must not modify the given request
+	req = req.Clone(req.Context())
+	for key, values := range t.headers {
+		req.Header[key] = values
+	}
+	return t.base.RoundTrip(req)
+}
+
+func isWebsocketUrl(nodeUrl string) bool {
+	return strings.HasPrefix(nodeUrl, "wss://") || strings.HasPrefix(nodeUrl, "ws://")
+}
+
+// dialRpc connects to the given node URL, sending the given headers with every request.
+// HTTP requests go through the given base transport
+func dialRpc(nodeUrl string, headers map[string]string, base http.RoundTripper) (*rpc.Client, error) {
+	if isWebsocketUrl(nodeUrl) {
+		if len(headers) == 0 {
+			return rpc.DialContext(context.Background(), nodeUrl)
+		}
+		// The websocket handshake does not go through the HTTP client
+		return rpc.DialOptions(context.Background(), nodeUrl, rpc.WithHeaders(newHeaderTransport(headers, base).headers))
+	}
+
+	transport := base
+	if len(headers) > 0 {
+		transport = newHeaderTransport(headers, base)
+	}
+	return rpc.DialOptions(context.Background(), nodeUrl, rpc.WithHTTPClient(&http.Client{Transport: transport}))
+}
+
+// Dial connects to the given node URL, sending the given headers with every request
+func Dial(nodeUrl string, headers map[string]string) (*ethclient.Client, error) {
+	return dialLimited(nodeUrl, headers, nil)
+}
+
+// dialLimited connects to the given node URL, sending the given headers with every request.
+// The HTTP requests are bounded by the given limiter. Nil disables the limit.
+// Websocket connections multiplex their requests over a single connection and are not limited
+func dialLimited(nodeUrl string, headers map[string]string, limiter *callLimiter) (*ethclient.Client, error) {
+	var base http.RoundTripper = http.DefaultTransport
+	if limiter != nil {
+		base = &limitTransport{limiter: limiter, base: base}
+	}
+
+	rpcClient, err := dialRpc(nodeUrl, headers, base)
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTransport records the requests sent through it and answers every one of them with the given JSON-RPC result
+type stubTransport struct {
+	requests []*http.Request
+	result   string
+}
+
+func (t *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"jsonrpc":"2.0","id":1,"result":` + t.result + `}`)),
+		Request:    req,
+	}, nil
+}
+
+func Test_HeaderTransport_AttachesHeaders(t *testing.T) {
+	stub := &stubTransport{result: `"0x1"`}
+	headers := map[string]string{
+		"Authorization": "Bearer some-token",
+		"x-api-key":     "some-key",
+	}
+
+	rpcClient, err := dialRpc("https://rpc.example.com", headers, stub)
+	assert.Nil(t, err)
+
+	var result string
+	err = rpcClient.Call(&result, "eth_chainId")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "0x1", result)
+	assert.Len(t, stub.requests, 1)
+	assert.Equal(t, "Bearer some-token", stub.requests[0].Header.Get("Authorization"))
+	assert.Equal(t, "some-key", stub.requests[0].Header.Get("X-Api-Key"))
+	assert.Equal(t, "application/json", stub.requests[0].Header.Get("Content-Type"))
+}
+
+func Test_HeaderTransport_DoesNotModifyOriginalRequest(t *testing.T) {
+	stub := &stubTransport{}
+	transport := newHeaderTransport(map[string]string{"Authorization": "Bearer some-token"}, stub)
+	req, _ := http.NewRequest(http.MethodPost, "https://rpc.example.com", nil)
+
+	_, err := transport.RoundTrip(req)
+
+	assert.Nil(t, err)
+	assert.Empty(t, req.Header.Get("Authorization"))
+	assert.Equal(t, "Bearer some-token", stub.requests[0].Header.Get("Authorization"))
+}
+
+func Test_HeaderTransport_OverridesExistingHeader(t *testing.T) {
+	stub := &stubTransport{}
+	transport := newHeaderTransport(map[string]string{"User-Agent": "validator"}, stub)
+	req, _ := http.NewRequest(http.MethodPost, "https://rpc.example.com", nil)
+	req.Header.Set("User-Agent", "Go-http-client")
+
+	_, err := transport.RoundTrip(req)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"validator"}, stub.requests[0].Header.Values("User-Agent"))
+}
+
+func Test_IsWebsocketUrl(t *testing.T) {
+	assert.True(t, isWebsocketUrl("wss://rpc.example.com"))
+	assert.True(t, isWebsocketUrl("ws://localhost:8546"))
+	assert.False(t, isWebsocketUrl("https://rpc.example.com"))
+}
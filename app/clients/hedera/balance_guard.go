@@ -0,0 +1,149 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// BalanceGuard wraps a Hedera node client and holds back all submissions, while the balance
+// of the operator account is below the configured minimum, until the account is topped up
+type BalanceGuard struct {
+	client.HederaNode
+	mirrorNode        client.MirrorNode
+	operator          string
+	minimum           int64
+	interval          time.Duration
+	prometheusService service.Prometheus
+	now               func() time.Time
+	sleep             func(time.Duration)
+	mutex             sync.Mutex
+	checkedAt         time.Time
+	balance           int64
+	low               bool
+	logger            *log.Entry
+}
+
+// NewBalanceGuard creates a guard of the given node client, refreshing the operator balance from the mirror node at most once per interval
+func NewBalanceGuard(node client.HederaNode, mirrorNode client.MirrorNode, operator string, minimum int64, interval time.Duration, prometheusService service.Prometheus) *BalanceGuard {
+	return &BalanceGuard{
+		HederaNode:        node,
+		mirrorNode:        mirrorNode,
+		operator:          operator,
+		minimum:           minimum,
+		interval:          interval,
+		prometheusService: prometheusService,
+		now:               time.Now,
+		sleep:             time.Sleep,
+		logger:            config.GetLoggerFor("Hedera Operator Balance Guard"),
+	}
+}
+
+// await blocks while the operator balance is below the minimum, refreshing it once per interval,
+// until the account is topped up. Submissions are not held back when the balance cannot be retrieved.
+func (bg *BalanceGuard) await() {
+	for {
+		bg.mutex.Lock()
+		now := bg.now()
+		if bg.checkedAt.IsZero() || now.Sub(bg.checkedAt) >= bg.interval {
+			bg.refresh(now)
+		}
+		low := bg.low
+		bg.mutex.Unlock()
+
+		if !low {
+			return
+		}
+		bg.sleep(bg.interval)
+	}
+}
+
+func (bg *BalanceGuard) refresh(now time.Time) {
+	account, err := bg.mirrorNode.GetAccount(bg.operator)
+	if err != nil {
+		bg.logger.Errorf("Failed to retrieve the balance of operator account [%s]. Error: [%s]", bg.operator, err)
+		return
+	}
+	bg.checkedAt = now
+	bg.balance = int64(account.Balance.Balance)
+
+	low := bg.balance < bg.minimum
+	if low && !bg.low {
+		bg.logger.Errorf("Operator account [%s] balance [%d] fell below the minimum [%d]. Holding back Hedera submissions until it is topped up.", bg.operator, bg.balance, bg.minimum)
+	} else if !low && bg.low {
+		bg.logger.Infof("Operator account [%s] balance [%d] is above the minimum [%d]. Resuming Hedera submissions.", bg.operator, bg.balance, bg.minimum)
+	}
+	bg.low = low
+	metrics.SetOperatorBalanceLow(low, bg.prometheusService)
+}
+
+// SubmitTopicConsensusMessage submits the message, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitTopicConsensusMessage(topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error) {
+	bg.await()
+	return bg.HederaNode.SubmitTopicConsensusMessage(topicId, message)
+}
+
+// SubmitScheduledTokenTransferTransaction submits the scheduled token transfer, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitScheduledTokenTransferTransaction(tokenID hedera.TokenID, transfers []transfer.Hedera, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	bg.await()
+	return bg.HederaNode.SubmitScheduledTokenTransferTransaction(tokenID, transfers, payerAccountID, memo)
+}
+
+// SubmitScheduledHbarTransferTransaction submits the scheduled hbar transfer, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitScheduledHbarTransferTransaction(transfers []transfer.Hedera, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	bg.await()
+	return bg.HederaNode.SubmitScheduledHbarTransferTransaction(transfers, payerAccountID, memo)
+}
+
+// SubmitScheduledNftTransferTransaction submits the scheduled nft transfer, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitScheduledNftTransferTransaction(nftID hedera.NftID, payerAccount hedera.AccountID, sender hedera.AccountID, receiving hedera.AccountID, memo string, approved bool) (*hedera.TransactionResponse, error) {
+	bg.await()
+	return bg.HederaNode.SubmitScheduledNftTransferTransaction(nftID, payerAccount, sender, receiving, memo, approved)
+}
+
+// SubmitScheduledNftApproveTransaction submits the scheduled nft allowance, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitScheduledNftApproveTransaction(payer hedera.AccountID, memo string, nftId hedera.NftID, owner, spender hedera.AccountID) (*hedera.TransactionResponse, error) {
+	bg.await()
+	return bg.HederaNode.SubmitScheduledNftApproveTransaction(payer, memo, nftId, owner, spender)
+}
+
+// SubmitScheduleSign submits the schedule sign, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitScheduleSign(scheduleID hedera.ScheduleID) (*hedera.TransactionResponse, error) {
+	bg.await()
+	return bg.HederaNode.SubmitScheduleSign(scheduleID)
+}
+
+// SubmitScheduledTokenMintTransaction submits the scheduled token mint, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitScheduledTokenMintTransaction(tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	bg.await()
+	return bg.HederaNode.SubmitScheduledTokenMintTransaction(tokenID, amount, payerAccountID, memo)
+}
+
+// SubmitScheduledTokenBurnTransaction submits the scheduled token burn, once the operator balance is above the minimum
+func (bg *BalanceGuard) SubmitScheduledTokenBurnTransaction(id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	bg.await()
+	return bg.HederaNode.SubmitScheduledTokenBurnTransaction(id, amount, account, memo)
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	operator   = "0.0.111"
+	topicID    = hedera.TopicID{Topic: 222}
+	topicMsg   = []byte("message")
+	minBalance = int64(1000)
+	checkEvery = time.Minute
+)
+
+func setupBalanceGuard() (*BalanceGuard, *[]time.Duration) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	now := time.Unix(100, 0)
+	var slept []time.Duration
+	bg := NewBalanceGuard(mocks.MHederaNodeClient, mocks.MHederaMirrorClient, operator, minBalance, checkEvery, mocks.MPrometheusService)
+	bg.now = func() time.Time { return now }
+	bg.sleep = func(d time.Duration) {
+		slept = append(slept, d)
+		now = now.Add(d)
+	}
+	return bg, &slept
+}
+
+func mockBalance(balance int) {
+	mocks.MHederaMirrorClient.On("GetAccount", operator).
+		Return(&account.AccountsResponse{Account: operator, Balance: account.Balance{Balance: balance}}, nil).Once()
+}
+
+func Test_BalanceGuard_AboveMinimum(t *testing.T) {
+	bg, slept := setupBalanceGuard()
+	mockBalance(2000)
+	txID := hedera.TransactionID{}
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicID, topicMsg).Return(&txID, nil)
+
+	actual, err := bg.SubmitTopicConsensusMessage(topicID, topicMsg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &txID, actual)
+	assert.Empty(t, *slept)
+	mocks.MHederaNodeClient.AssertCalled(t, "SubmitTopicConsensusMessage", topicID, topicMsg)
+}
+
+func Test_BalanceGuard_BelowMinimum_WaitsUntilToppedUp(t *testing.T) {
+	bg, slept := setupBalanceGuard()
+	mockBalance(999)
+	mockBalance(999)
+	mockBalance(5000)
+	txID := hedera.TransactionID{}
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicID, topicMsg).Return(&txID, nil)
+
+	actual, err := bg.SubmitTopicConsensusMessage(topicID, topicMsg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &txID, actual)
+	assert.Equal(t, []time.Duration{checkEvery, checkEvery}, *slept)
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetAccount", 3)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+}
+
+func Test_BalanceGuard_RefreshesOncePerInterval(t *testing.T) {
+	bg, slept := setupBalanceGuard()
+	mockBalance(5000)
+	scheduleID := hedera.ScheduleID{Schedule: 1}
+	mocks.MHederaNodeClient.On("SubmitScheduleSign", scheduleID).Return(&hedera.TransactionResponse{}, nil)
+
+	_, err := bg.SubmitScheduleSign(scheduleID)
+	assert.Nil(t, err)
+	_, err = bg.SubmitScheduleSign(scheduleID)
+	assert.Nil(t, err)
+
+	assert.Empty(t, *slept)
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetAccount", 1)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitScheduleSign", 2)
+}
+
+func Test_BalanceGuard_BalanceUnavailable(t *testing.T) {
+	bg, slept := setupBalanceGuard()
+	mocks.MHederaMirrorClient.On("GetAccount", operator).Return((*account.AccountsResponse)(nil), errors.New("some-error"))
+	txID := hedera.TransactionID{}
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicID, topicMsg).Return(&txID, nil)
+
+	_, err := bg.SubmitTopicConsensusMessage(topicID, topicMsg)
+
+	assert.Nil(t, err)
+	assert.Empty(t, *slept)
+}
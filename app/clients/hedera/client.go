@@ -225,6 +225,13 @@ func (hc Node) TransactionReceiptQuery(transactionID hedera.TransactionID, nodeA
 		Execute(hc.GetClient())
 }
 
+func (hc Node) TokenInfoQuery(tokenID hedera.TokenID) (hedera.TokenInfo, error) {
+	return hedera.NewTokenInfoQuery().
+		SetTokenID(tokenID).
+		SetMaxRetry(hc.maxRetry).
+		Execute(hc.GetClient())
+}
+
 func (hc Node) SubmitScheduledNftApproveTransaction(
 	payer hedera.AccountID,
 	memo string,
@@ -533,10 +533,29 @@ func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
 // WaitForScheduledTransaction Polls the transaction at intervals. Depending on the
 // result, the corresponding `onSuccess` and `onFailure` functions are called
 func (c Client) WaitForScheduledTransaction(txId string, onSuccess, onFailure func()) {
+	c.waitForScheduledTransaction(txId, time.Time{}, onSuccess, onFailure, nil)
+}
+
+// WaitForScheduledTransactionWithTimeout Polls the transaction at intervals. Depending on the
+// result, the corresponding `onSuccess` and `onFailure` functions are called. If the scheduled
+// transaction is not executed within the given timeout, polling stops and `onTimeout` is called
+func (c Client) WaitForScheduledTransactionWithTimeout(txId string, timeout time.Duration, onSuccess, onFailure, onTimeout func()) {
+	c.waitForScheduledTransaction(txId, time.Now().Add(timeout), onSuccess, onFailure, onTimeout)
+}
+
+// waitForScheduledTransaction polls the scheduled transaction until it is executed or until the deadline, if set
+func (c Client) waitForScheduledTransaction(txId string, deadline time.Time, onSuccess, onFailure, onTimeout func()) {
 	c.logger.Debugf("Added new Scheduled TX [%s] for monitoring", txId)
 	for {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			c.logger.Debugf("Scheduled TX [%s] was not executed before the deadline", txId)
+			onTimeout()
+			return
+		}
+
 		response, err := c.GetTransaction(txId)
 		if response != nil && response.IsNotFound() {
+			time.Sleep(c.pollingInterval * time.Second)
 			continue
 		}
 		if err != nil {
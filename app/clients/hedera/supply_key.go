@@ -0,0 +1,56 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+)
+
+// VerifySupplyKeys checks that the given public key is part of the supply key of every given token,
+// so that the mint transactions, scheduled by the validator, can be executed.
+// Returns an error, listing the tokens whose supply key does not include the key or whose info could not be queried
+func VerifySupplyKeys(node client.HederaNode, publicKey hedera.PublicKey, tokens []hedera.TokenID) error {
+	var failures []string
+	for _, token := range tokens {
+		info, err := node.TokenInfoQuery(token)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("[%s] - failed to query token info: [%s]", token, err))
+			continue
+		}
+		if !supplyKeyIncludes(info.SupplyKey, publicKey) {
+			failures = append(failures, fmt.Sprintf("[%s] - supply key does not include the validator key", token))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("supply key verification failed for [%d] out of [%d] tokens: %s", len(failures), len(tokens), strings.Join(failures, ", "))
+	}
+	return nil
+}
+
+// supplyKeyIncludes checks whether the given public key is the supply key or a member of its (threshold) key list.
+// The SDK does not expose the members of a key list, but its string representation lists the ones of all of its nested keys
+func supplyKeyIncludes(supplyKey hedera.Key, publicKey hedera.PublicKey) bool {
+	if supplyKey == nil {
+		return false
+	}
+	return strings.Contains(supplyKey.String(), publicKey.String())
+}
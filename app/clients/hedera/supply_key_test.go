@@ -0,0 +1,103 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	wrappedToken = hedera.TokenID{Token: 333}
+	otherToken   = hedera.TokenID{Token: 444}
+)
+
+func generatePublicKey(t *testing.T) hedera.PublicKey {
+	privateKey, err := hedera.PrivateKeyGenerateEd25519()
+	assert.Nil(t, err)
+	return privateKey.PublicKey()
+}
+
+func Test_VerifySupplyKeys_KeyPresent(t *testing.T) {
+	mocks.Setup()
+	validatorKey := generatePublicKey(t)
+	supplyKey := hedera.KeyListWithThreshold(2).AddAllPublicKeys([]hedera.PublicKey{generatePublicKey(t), validatorKey, generatePublicKey(t)})
+	mocks.MHederaNodeClient.On("TokenInfoQuery", wrappedToken).Return(hedera.TokenInfo{SupplyKey: supplyKey}, nil)
+
+	err := VerifySupplyKeys(mocks.MHederaNodeClient, validatorKey, []hedera.TokenID{wrappedToken})
+
+	assert.Nil(t, err)
+}
+
+func Test_VerifySupplyKeys_KeyPresentInNestedList(t *testing.T) {
+	mocks.Setup()
+	validatorKey := generatePublicKey(t)
+	nested := hedera.KeyListWithThreshold(1).AddAllPublicKeys([]hedera.PublicKey{validatorKey})
+	supplyKey := hedera.KeyListWithThreshold(1).Add(generatePublicKey(t)).Add(nested)
+	mocks.MHederaNodeClient.On("TokenInfoQuery", wrappedToken).Return(hedera.TokenInfo{SupplyKey: supplyKey}, nil)
+
+	err := VerifySupplyKeys(mocks.MHederaNodeClient, validatorKey, []hedera.TokenID{wrappedToken})
+
+	assert.Nil(t, err)
+}
+
+func Test_VerifySupplyKeys_SingleKey(t *testing.T) {
+	mocks.Setup()
+	validatorKey := generatePublicKey(t)
+	mocks.MHederaNodeClient.On("TokenInfoQuery", wrappedToken).Return(hedera.TokenInfo{SupplyKey: validatorKey}, nil)
+
+	err := VerifySupplyKeys(mocks.MHederaNodeClient, validatorKey, []hedera.TokenID{wrappedToken})
+
+	assert.Nil(t, err)
+}
+
+func Test_VerifySupplyKeys_KeyAbsent(t *testing.T) {
+	mocks.Setup()
+	validatorKey := generatePublicKey(t)
+	supplyKey := hedera.KeyListWithThreshold(1).AddAllPublicKeys([]hedera.PublicKey{generatePublicKey(t), generatePublicKey(t)})
+	mocks.MHederaNodeClient.On("TokenInfoQuery", wrappedToken).Return(hedera.TokenInfo{SupplyKey: supplyKey}, nil)
+	mocks.MHederaNodeClient.On("TokenInfoQuery", otherToken).Return(hedera.TokenInfo{SupplyKey: validatorKey}, nil)
+
+	err := VerifySupplyKeys(mocks.MHederaNodeClient, validatorKey, []hedera.TokenID{wrappedToken, otherToken})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), wrappedToken.String())
+	assert.NotContains(t, err.Error(), otherToken.String())
+}
+
+func Test_VerifySupplyKeys_NoSupplyKey(t *testing.T) {
+	mocks.Setup()
+	mocks.MHederaNodeClient.On("TokenInfoQuery", wrappedToken).Return(hedera.TokenInfo{}, nil)
+
+	err := VerifySupplyKeys(mocks.MHederaNodeClient, generatePublicKey(t), []hedera.TokenID{wrappedToken})
+
+	assert.Error(t, err)
+}
+
+func Test_VerifySupplyKeys_QueryFails(t *testing.T) {
+	mocks.Setup()
+	mocks.MHederaNodeClient.On("TokenInfoQuery", wrappedToken).Return(hedera.TokenInfo{}, errors.New("some-error"))
+
+	err := VerifySupplyKeys(mocks.MHederaNodeClient, generatePublicKey(t), []hedera.TokenID{wrappedToken})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "some-error")
+}
@@ -0,0 +1,264 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bounded
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/persistent"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Supported values for the `queue_overflow_policy` node configuration
+const (
+	// OverflowBlock blocks the producer until a message is dispatched
+	OverflowBlock = "block"
+	// OverflowDropOldestReadOnly spills the oldest read-only message to the store to make room for the pushed one.
+	// Blocks the producer if no read-only message can be spilled
+	OverflowDropOldestReadOnly = "drop-oldest-read-only"
+	// OverflowReject spills the pushed message to the store, if it is read-only. Blocks the producer otherwise, since
+	// the producer is not told about the rejection and moves on, which would lose the transfer
+	OverflowReject = "reject"
+)
+
+// The prefix of the topics of messages, which only record transfers, processed by the other validators
+const readOnlyTopicPrefix = "READ_ONLY_"
+
+// Queue holds up to a capacity of messages in memory and applies its overflow policy, when pushed to while full.
+// Read-only messages, dropped by the policy, are spilled to the store and enqueued again once there is room,
+// since non-validator nodes record transfers only from them
+type Queue struct {
+	channel           chan *queue.Message
+	capacity          int
+	policy            string
+	store             repository.QueueMessage
+	prometheusService service.Prometheus
+	mutex             sync.Mutex
+	cond              *sync.Cond
+	// The number of dequeued messages, which are being pushed to the channel
+	sending  int
+	messages []*queue.Message
+	// The number of messages in the store, waiting for room in the queue
+	spilled int
+	logger  *log.Entry
+}
+
+// NewQueue creates a queue with the given capacity and overflow policy and starts dispatching to its channel.
+// An empty policy defaults to OverflowBlock. Messages, spilled by a previous run, are enqueued again
+func NewQueue(capacity int, policy string, store repository.QueueMessage, prometheusService service.Prometheus) (*Queue, error) {
+	q, err := newQueue(capacity, policy, store, prometheusService)
+	if err != nil {
+		return nil, err
+	}
+
+	go q.dispatch()
+
+	return q, nil
+}
+
+func newQueue(capacity int, policy string, store repository.QueueMessage, prometheusService service.Prometheus) (*Queue, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("non-positive queue capacity [%d]", capacity)
+	}
+
+	switch policy {
+	case "":
+		policy = OverflowBlock
+	case OverflowBlock, OverflowDropOldestReadOnly, OverflowReject:
+	default:
+		return nil, fmt.Errorf("unsupported queue overflow policy [%s]", policy)
+	}
+
+	spilled := 0
+	if policy != OverflowBlock {
+		if store == nil {
+			return nil, fmt.Errorf("queue overflow policy [%s] requires a message store", policy)
+		}
+		records, err := store.GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve spilled queue messages: %w", err)
+		}
+		spilled = len(records)
+	}
+
+	q := &Queue{
+		channel:           make(chan *queue.Message),
+		capacity:          capacity,
+		policy:            policy,
+		store:             store,
+		prometheusService: prometheusService,
+		spilled:           spilled,
+		logger:            config.GetLoggerFor("Bounded Queue"),
+	}
+	q.cond = sync.NewCond(&q.mutex)
+
+	return q, nil
+}
+
+// Push enqueues the message, applying the overflow policy if the queue is full
+func (q *Queue) Push(message *queue.Message) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.messages) >= q.capacity {
+		metrics.IncrementQueueFullEvents(q.policy, q.prometheusService)
+		if !q.overflow(message) {
+			return
+		}
+	}
+
+	q.messages = append(q.messages, message)
+	q.cond.Broadcast()
+}
+
+// Ack is a no-op, as messages are not kept after they are dispatched
+func (q *Queue) Ack(message *queue.Message) {}
+
+func (q *Queue) Channel() chan *queue.Message {
+	return q.channel
+}
+
+// Pending returns the number of enqueued messages, together with the ones being pushed to the channel
+func (q *Queue) Pending() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.messages) + q.sending
+}
+
+// overflow applies the overflow policy to the full queue and returns whether the pushed message is to be enqueued.
+// Must be called with the mutex held
+func (q *Queue) overflow(message *queue.Message) bool {
+	switch q.policy {
+	case OverflowReject:
+		if isReadOnly(message) && q.spill(message) {
+			q.logger.Warnf("Queue is full. Spilled read-only message with topic [%s] to the store.", message.Topic)
+			return false
+		}
+	case OverflowDropOldestReadOnly:
+		for i, m := range q.messages {
+			if isReadOnly(m) && q.spill(m) {
+				q.messages = append(q.messages[:i], q.messages[i+1:]...)
+				q.logger.Warnf("Queue is full. Spilled the oldest read-only message with topic [%s] to the store.", m.Topic)
+				return true
+			}
+		}
+		if isReadOnly(message) && q.spill(message) {
+			q.logger.Warnf("Queue is full. Spilled read-only message with topic [%s] to the store.", message.Topic)
+			return false
+		}
+	}
+
+	for len(q.messages) >= q.capacity {
+		q.cond.Wait()
+	}
+	return true
+}
+
+// spill stores the message, until there is room for it in the queue. Returns whether it was stored.
+// Must be called with the mutex held
+func (q *Queue) spill(message *queue.Message) bool {
+	record, err := persistent.Encode(message)
+	if err != nil {
+		q.logger.Errorf("[%s] - Failed to encode queue message. It will not be spilled. Error: [%s]", message.Topic, err)
+		return false
+	}
+	if err = q.store.Create(record); err != nil {
+		q.logger.Errorf("[%s] - Failed to spill queue message. Error: [%s]", message.Topic, err)
+		return false
+	}
+
+	q.spilled++
+	return true
+}
+
+// restore enqueues the spilled messages again, in the order they were spilled, while there is room for them
+func (q *Queue) restore() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.spilled == 0 || len(q.messages) >= q.capacity {
+		return
+	}
+
+	records, err := q.store.GetAll()
+	if err != nil {
+		q.logger.Errorf("Failed to retrieve spilled queue messages. Error: [%s]", err)
+		return
+	}
+	q.spilled = len(records)
+
+	for _, record := range records {
+		if len(q.messages) >= q.capacity {
+			break
+		}
+		message, err := persistent.Decode(record)
+		if err != nil {
+			q.logger.Errorf("[%s] - Failed to decode spilled queue message [%d]. Skipping. Error: [%s]", record.Topic, record.ID, err)
+		}
+		// Deleted before it is enqueued, so that it is not enqueued twice
+		if err := q.store.Delete(record.ID); err != nil {
+			q.logger.Errorf("[%s] - Failed to delete spilled queue message [%d]. Error: [%s]", record.Topic, record.ID, err)
+			return
+		}
+		q.spilled--
+		if message != nil {
+			q.logger.Infof("[%s] - Restored spilled queue message [%d].", record.Topic, record.ID)
+			q.messages = append(q.messages, message)
+		}
+	}
+	q.cond.Broadcast()
+}
+
+// dispatch pushes the enqueued messages to the channel, in the order they were enqueued
+func (q *Queue) dispatch() {
+	for {
+		q.restore()
+		q.channel <- q.next()
+
+		q.mutex.Lock()
+		q.sending--
+		q.mutex.Unlock()
+	}
+}
+
+// next waits for an enqueued message and dequeues it
+func (q *Queue) next() *queue.Message {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for len(q.messages) == 0 {
+		q.cond.Wait()
+	}
+
+	message := q.messages[0]
+	q.messages = q.messages[1:]
+	q.sending++
+	q.cond.Broadcast()
+
+	return message
+}
+
+func isReadOnly(message *queue.Message) bool {
+	return strings.HasPrefix(message.Topic, readOnlyTopicPrefix)
+}
@@ -0,0 +1,303 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bounded
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/persistent"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubStore keeps the spilled queue messages in memory
+type stubStore struct {
+	messages map[uint64]*entity.QueueMessage
+	lastId   uint64
+	err      error
+}
+
+func newStubStore() *stubStore {
+	return &stubStore{messages: make(map[uint64]*entity.QueueMessage)}
+}
+
+func (s *stubStore) Create(entity *entity.QueueMessage) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.lastId++
+	entity.ID = s.lastId
+	s.messages[entity.ID] = entity
+	return nil
+}
+
+func (s *stubStore) Delete(id uint64) error {
+	delete(s.messages, id)
+	return nil
+}
+
+func (s *stubStore) GetAll() ([]*entity.QueueMessage, error) {
+	var result []*entity.QueueMessage
+	for _, m := range s.messages {
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+var transfer = payload.New("0xtx-1", 1, 296, 1, "0.0.2", "0xsource", "0.0.3", "0xsource", "100")
+
+func setup(t *testing.T, policy string) (*Queue, prometheus.Counter) {
+	mocks.Setup()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: constants.QueueFullEventsCounterName})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", prometheus.CounterOpts{
+		Name:        constants.QueueFullEventsCounterName,
+		Help:        constants.QueueFullEventsCounterHelp,
+		ConstLabels: prometheus.Labels{constants.QueuePolicyMetricLabelKey: policy},
+	}).Return(counter)
+
+	q, err := newQueue(2, policy, newStubStore(), mocks.MPrometheusService)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q, counter
+}
+
+func spilled(q *Queue) []string {
+	records, _ := q.store.GetAll()
+	var res []string
+	for _, r := range records {
+		res = append(res, r.Topic)
+	}
+	return res
+}
+
+func topics(messages []*queue.Message) []string {
+	var res []string
+	for _, m := range messages {
+		res = append(res, m.Topic)
+	}
+	return res
+}
+
+func Test_NewQueue_InvalidConfig(t *testing.T) {
+	_, err := NewQueue(0, OverflowBlock, nil, mocks.MPrometheusService)
+	assert.Error(t, err)
+
+	_, err = NewQueue(1, "some-policy", nil, mocks.MPrometheusService)
+	assert.Error(t, err)
+
+	_, err = NewQueue(1, OverflowReject, nil, mocks.MPrometheusService)
+	assert.Error(t, err)
+}
+
+func Test_NewQueue_DefaultsToBlock(t *testing.T) {
+	q, err := newQueue(1, "", nil, mocks.MPrometheusService)
+
+	assert.Nil(t, err)
+	assert.Equal(t, OverflowBlock, q.policy)
+}
+
+func Test_Push_Block(t *testing.T) {
+	q, counter := setup(t, OverflowBlock)
+	q.Push(&queue.Message{Topic: "first"})
+	q.Push(&queue.Message{Topic: "second"})
+
+	pushed := make(chan bool)
+	go func() {
+		q.Push(&queue.Message{Topic: "third"})
+		pushed <- true
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("push to a full queue did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.Equal(t, "first", q.next().Topic)
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Fatal("push was not unblocked")
+	}
+	assert.Equal(t, []string{"second", "third"}, topics(q.messages))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_Push_Reject(t *testing.T) {
+	q, counter := setup(t, OverflowReject)
+	q.Push(&queue.Message{Topic: constants.HederaMintHtsTransfer})
+	q.Push(&queue.Message{Topic: constants.TopicMessageSubmission})
+
+	q.Push(&queue.Message{Topic: constants.ReadOnlyTransferSave, Payload: transfer})
+
+	assert.Equal(t, []string{constants.HederaMintHtsTransfer, constants.TopicMessageSubmission}, topics(q.messages))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+	assert.Equal(t, []string{constants.ReadOnlyTransferSave}, spilled(q))
+}
+
+func Test_Push_Reject_RecoversSpilledTransfer(t *testing.T) {
+	q, _ := setup(t, OverflowReject)
+	q.Push(&queue.Message{Topic: constants.HederaMintHtsTransfer})
+	q.Push(&queue.Message{Topic: constants.TopicMessageSubmission})
+	q.Push(&queue.Message{Topic: constants.ReadOnlyTransferSave, Payload: transfer})
+
+	// There is no room, until a message is dequeued
+	q.restore()
+	assert.Len(t, q.messages, 2)
+
+	q.next()
+	q.restore()
+
+	assert.Equal(t, []string{constants.TopicMessageSubmission, constants.ReadOnlyTransferSave}, topics(q.messages))
+	assert.Equal(t, transfer, q.messages[1].Payload)
+	assert.Empty(t, spilled(q))
+	assert.Equal(t, 0, q.spilled)
+}
+
+func Test_Push_Reject_BlocksWhenSpillFails(t *testing.T) {
+	q, _ := setup(t, OverflowReject)
+	q.store.(*stubStore).err = errors.New("some-error")
+	q.Push(&queue.Message{Topic: "first"})
+	q.Push(&queue.Message{Topic: "second"})
+
+	pushed := make(chan bool)
+	go func() {
+		q.Push(&queue.Message{Topic: constants.ReadOnlyTransferSave, Payload: transfer})
+		pushed <- true
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("push of a read-only message, which could not be spilled, to a full queue did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.Equal(t, "first", q.next().Topic)
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Fatal("push was not unblocked")
+	}
+	assert.Equal(t, []string{"second", constants.ReadOnlyTransferSave}, topics(q.messages))
+}
+
+func Test_Push_Reject_BlocksNonReadOnly(t *testing.T) {
+	q, _ := setup(t, OverflowReject)
+	q.Push(&queue.Message{Topic: "first"})
+	q.Push(&queue.Message{Topic: "second"})
+
+	pushed := make(chan bool)
+	go func() {
+		q.Push(&queue.Message{Topic: "third"})
+		pushed <- true
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("push of a message, which is not read-only, to a full queue did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.Equal(t, "first", q.next().Topic)
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Fatal("push was not unblocked")
+	}
+	assert.Equal(t, []string{"second", "third"}, topics(q.messages))
+}
+
+func Test_Push_DropOldestReadOnly(t *testing.T) {
+	q, counter := setup(t, OverflowDropOldestReadOnly)
+	q.Push(&queue.Message{Topic: constants.HederaMintHtsTransfer})
+	q.Push(&queue.Message{Topic: constants.ReadOnlyHederaMintHtsTransfer, Payload: transfer})
+
+	q.Push(&queue.Message{Topic: constants.TopicMessageSubmission})
+
+	assert.Equal(t, []string{constants.HederaMintHtsTransfer, constants.TopicMessageSubmission}, topics(q.messages))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+	assert.Equal(t, []string{constants.ReadOnlyHederaMintHtsTransfer}, spilled(q))
+}
+
+func Test_Push_DropOldestReadOnly_DropsPushedReadOnly(t *testing.T) {
+	q, _ := setup(t, OverflowDropOldestReadOnly)
+	q.Push(&queue.Message{Topic: constants.HederaMintHtsTransfer})
+	q.Push(&queue.Message{Topic: constants.TopicMessageSubmission})
+
+	q.Push(&queue.Message{Topic: constants.ReadOnlyTransferSave, Payload: transfer})
+
+	assert.Equal(t, []string{constants.HederaMintHtsTransfer, constants.TopicMessageSubmission}, topics(q.messages))
+	assert.Equal(t, []string{constants.ReadOnlyTransferSave}, spilled(q))
+}
+
+func Test_Channel_DispatchesInOrder(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	q, err := NewQueue(2, OverflowBlock, nil, mocks.MPrometheusService)
+	assert.Nil(t, err)
+
+	q.Push(&queue.Message{Topic: "first"})
+	q.Push(&queue.Message{Topic: "second"})
+
+	assert.Equal(t, "first", (<-q.Channel()).Topic)
+	assert.Equal(t, "second", (<-q.Channel()).Topic)
+}
+
+func Test_Pending_CountsUntilTaken(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	q, err := NewQueue(2, OverflowBlock, nil, mocks.MPrometheusService)
+	assert.Nil(t, err)
+
+	q.Push(&queue.Message{Topic: "first"})
+	q.Push(&queue.Message{Topic: "second"})
+	assert.Equal(t, 2, q.Pending())
+
+	<-q.Channel()
+	assert.Eventually(t, func() bool { return q.Pending() == 1 }, time.Second, time.Millisecond)
+	<-q.Channel()
+	assert.Eventually(t, func() bool { return q.Pending() == 0 }, time.Second, time.Millisecond)
+}
+
+func Test_NewQueue_RestoresPreviousRun(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	store := newStubStore()
+	record, err := persistent.Encode(&queue.Message{Topic: constants.ReadOnlyTransferSave, Payload: transfer})
+	assert.Nil(t, err)
+	assert.Nil(t, store.Create(record))
+
+	q, err := NewQueue(2, OverflowReject, store, mocks.MPrometheusService)
+	assert.Nil(t, err)
+
+	restored := <-q.Channel()
+	assert.Equal(t, constants.ReadOnlyTransferSave, restored.Topic)
+	assert.Equal(t, transfer, restored.Payload)
+	assert.Empty(t, store.messages)
+}
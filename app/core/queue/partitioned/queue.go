@@ -0,0 +1,200 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package partitioned
+
+import (
+	"sync"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+)
+
+const (
+	// The weight of partitions, which are not configured explicitly
+	defaultWeight = 1
+	// The number of messages a partition holds, before pushing to it blocks
+	maxPartitionDepth = 100
+)
+
+// Queue dispatches the messages of its partitions to the target queue in weighted round-robin order.
+// In every round, each partition dispatches up to its weight of messages, so that a busy partition
+// cannot starve the others.
+type Queue struct {
+	target            qi.Queue
+	weights           map[string]int
+	prometheusService service.Prometheus
+	mutex             sync.Mutex
+	cond              *sync.Cond
+	partitions        map[string]*partition
+	order             []*partition
+	// The number of messages of the current round, which are being pushed to the target queue
+	sending int
+}
+
+type partition struct {
+	name     string
+	weight   int
+	messages []*queue.Message
+}
+
+// partitionQueue is the queue of a single partition, handed to its watchers
+type partitionQueue struct {
+	parent    *Queue
+	partition *partition
+}
+
+// NewQueue creates a partitioned queue in front of the given target queue and starts dispatching to it.
+// Partitions, missing from the weights, are dispatched with a weight of 1
+func NewQueue(target qi.Queue, weights map[string]int, prometheusService service.Prometheus) *Queue {
+	q := &Queue{
+		target:            target,
+		weights:           weights,
+		prometheusService: prometheusService,
+		partitions:        make(map[string]*partition),
+	}
+	q.cond = sync.NewCond(&q.mutex)
+
+	go q.dispatch()
+
+	return q
+}
+
+// Partition returns the queue of the partition with the given name, creating the partition if needed
+func (q *Queue) Partition(name string) qi.Queue {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	p, ok := q.partitions[name]
+	if !ok {
+		weight := q.weights[name]
+		if weight <= 0 {
+			weight = defaultWeight
+		}
+		p = &partition{name: name, weight: weight}
+		q.partitions[name] = p
+		q.order = append(q.order, p)
+	}
+
+	return &partitionQueue{parent: q, partition: p}
+}
+
+// Push pushes directly to the target queue. Used by watchers, which have not been assigned a partition
+func (q *Queue) Push(message *queue.Message) {
+	q.target.Push(message)
+}
+
+func (q *Queue) Ack(message *queue.Message) {
+	q.target.Ack(message)
+}
+
+func (q *Queue) Channel() chan *queue.Message {
+	return q.target.Channel()
+}
+
+// Pending returns the number of messages, held by the partitions or being pushed to the target queue,
+// together with the pending messages of the target queue, if it buffers them
+func (q *Queue) Pending() int {
+	q.mutex.Lock()
+	pending := q.pending() + q.sending
+	q.mutex.Unlock()
+
+	if buffered, ok := q.target.(qi.Buffered); ok {
+		pending += buffered.Pending()
+	}
+	return pending
+}
+
+func (q *Queue) push(p *partition, message *queue.Message) {
+	q.mutex.Lock()
+	for len(p.messages) >= maxPartitionDepth {
+		q.cond.Wait()
+	}
+	p.messages = append(p.messages, message)
+	depth := len(p.messages)
+	q.cond.Broadcast()
+	q.mutex.Unlock()
+
+	metrics.SetQueuePartitionDepth(p.name, depth, q.prometheusService)
+}
+
+// dispatch pushes the messages of every partition to the target queue, one round at a time
+func (q *Queue) dispatch() {
+	for {
+		for _, message := range q.nextRound() {
+			q.target.Push(message)
+
+			q.mutex.Lock()
+			q.sending--
+			q.mutex.Unlock()
+		}
+	}
+}
+
+// nextRound waits for pending messages and takes up to the weight of messages from every partition
+func (q *Queue) nextRound() []*queue.Message {
+	q.mutex.Lock()
+	for q.pending() == 0 {
+		q.cond.Wait()
+	}
+
+	var round []*queue.Message
+	depths := make(map[string]int)
+	for _, p := range q.order {
+		if len(p.messages) == 0 {
+			continue
+		}
+		n := p.weight
+		if n > len(p.messages) {
+			n = len(p.messages)
+		}
+		round = append(round, p.messages[:n]...)
+		p.messages = p.messages[n:]
+		depths[p.name] = len(p.messages)
+	}
+	q.sending = len(round)
+	q.cond.Broadcast()
+	q.mutex.Unlock()
+
+	for name, depth := range depths {
+		metrics.SetQueuePartitionDepth(name, depth, q.prometheusService)
+	}
+
+	return round
+}
+
+func (q *Queue) pending() int {
+	pending := 0
+	for _, p := range q.order {
+		pending += len(p.messages)
+	}
+	return pending
+}
+
+// Push blocks while the partition holds the maximum number of messages
+func (pq *partitionQueue) Push(message *queue.Message) {
+	pq.parent.push(pq.partition, message)
+}
+
+func (pq *partitionQueue) Ack(message *queue.Message) {
+	pq.parent.target.Ack(message)
+}
+
+func (pq *partitionQueue) Channel() chan *queue.Message {
+	return pq.parent.target.Channel()
+}
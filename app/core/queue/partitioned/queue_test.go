@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package partitioned
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func setup(weights map[string]int) *Queue {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+
+	q := &Queue{
+		target:            queue.NewQueue(),
+		weights:           weights,
+		prometheusService: mocks.MPrometheusService,
+		partitions:        make(map[string]*partition),
+	}
+	q.cond = sync.NewCond(&q.mutex)
+	return q
+}
+
+func pushAll(q *Queue, name string, count int) {
+	p := q.Partition(name)
+	for i := 0; i < count; i++ {
+		p.Push(&queue.Message{Topic: fmt.Sprintf("%s-%d", name, i)})
+	}
+}
+
+func topics(messages []*queue.Message) []string {
+	var res []string
+	for _, m := range messages {
+		res = append(res, m.Topic)
+	}
+	return res
+}
+
+func Test_NextRound_FairUnderSkewedLoad(t *testing.T) {
+	q := setup(nil)
+	pushAll(q, "busy", 50)
+	pushAll(q, "quiet", 2)
+
+	var dispatched []*queue.Message
+	for i := 0; i < 3; i++ {
+		dispatched = append(dispatched, q.nextRound()...)
+	}
+
+	assert.Equal(t, []string{"busy-0", "quiet-0", "busy-1", "quiet-1", "busy-2"}, topics(dispatched))
+	assert.Equal(t, 47, q.pending())
+}
+
+func Test_NextRound_Weighted(t *testing.T) {
+	q := setup(map[string]int{"busy": 3})
+	pushAll(q, "busy", 10)
+	pushAll(q, "quiet", 10)
+
+	round := q.nextRound()
+
+	assert.Equal(t, []string{"busy-0", "busy-1", "busy-2", "quiet-0"}, topics(round))
+}
+
+func Test_Partition_ReusesPartition(t *testing.T) {
+	q := setup(map[string]int{"busy": 0})
+
+	q.Partition("busy")
+	q.Partition("busy")
+
+	assert.Len(t, q.order, 1)
+	assert.Equal(t, defaultWeight, q.partitions["busy"].weight)
+}
+
+func Test_Dispatch(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	target := queue.NewQueue()
+	q := NewQueue(target, nil, mocks.MPrometheusService)
+	busy := q.Partition("busy")
+	quiet := q.Partition("quiet")
+
+	for i := 0; i < 20; i++ {
+		busy.Push(&queue.Message{Topic: "busy"})
+	}
+	quiet.Push(&queue.Message{Topic: "quiet"})
+
+	// at most a round with a single busy message is in flight before the quiet one is pushed,
+	// after that the partitions are dispatched in turns
+	var received []string
+	for len(received) < 21 {
+		received = append(received, (<-q.Channel()).Topic)
+	}
+
+	assert.Contains(t, received[:3], "quiet")
+}
+
+func Test_Pending(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	q := NewQueue(queue.NewQueue(), nil, mocks.MPrometheusService)
+	busy := q.Partition("busy")
+
+	for i := 0; i < 3; i++ {
+		busy.Push(&queue.Message{Topic: "busy"})
+	}
+	assert.Equal(t, 3, q.Pending())
+
+	for i := 0; i < 3; i++ {
+		<-q.Channel()
+	}
+	assert.Eventually(t, func() bool { return q.Pending() == 0 }, time.Second, time.Millisecond)
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persistent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// Payload types of the stored messages
+const (
+	payloadTypeTransfer     = "transfer"
+	payloadTypeTopicMessage = "topic_message"
+)
+
+// topicMessage is the stored representation of a message.Message
+type topicMessage struct {
+	Data                 []byte `json:"data"`
+	TransactionTimestamp int64  `json:"transactionTimestamp"`
+}
+
+// Queue stores every pushed message until it is acknowledged, so that
+// in-flight messages are delivered again after a restart
+type Queue struct {
+	channel    chan *queue.Message
+	repository repository.QueueMessage
+	mutex      sync.Mutex
+	stored     map[*queue.Message]uint64
+	logger     *log.Entry
+}
+
+// NewQueue creates a queue, backed by the given repository. Messages, left
+// unacknowledged by a previous run, are pushed again to the channel.
+func NewQueue(repository repository.QueueMessage) *Queue {
+	q := &Queue{
+		channel:    make(chan *queue.Message),
+		repository: repository,
+		stored:     make(map[*queue.Message]uint64),
+		logger:     config.GetLoggerFor("Persistent Queue"),
+	}
+
+	pending, err := repository.GetAll()
+	if err != nil {
+		q.logger.Fatalf("Failed to retrieve pending queue messages. Error: [%s]", err)
+	}
+	go q.replay(pending)
+
+	return q
+}
+
+// Push stores the message and pushes it to the channel
+func (q *Queue) Push(message *queue.Message) {
+	record, err := Encode(message)
+	if err != nil {
+		q.logger.Errorf("[%s] - Failed to encode queue message. It will not survive a restart. Error: [%s]", message.Topic, err)
+	} else if err = q.repository.Create(record); err != nil {
+		q.logger.Errorf("[%s] - Failed to store queue message. It will not survive a restart. Error: [%s]", message.Topic, err)
+	} else {
+		q.track(message, record.ID)
+	}
+
+	q.channel <- message
+}
+
+// Ack removes the stored message, once it has been handled
+func (q *Queue) Ack(message *queue.Message) {
+	q.mutex.Lock()
+	id, ok := q.stored[message]
+	delete(q.stored, message)
+	q.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	err := q.repository.Delete(id)
+	if err != nil {
+		q.logger.Errorf("[%s] - Failed to delete handled queue message [%d]. Error: [%s]", message.Topic, id, err)
+	}
+}
+
+func (q *Queue) Channel() chan *queue.Message {
+	return q.channel
+}
+
+func (q *Queue) track(message *queue.Message, id uint64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.stored[message] = id
+}
+
+func (q *Queue) replay(pending []*entity.QueueMessage) {
+	for _, record := range pending {
+		message, err := Decode(record)
+		if err != nil {
+			q.logger.Errorf("[%s] - Failed to decode stored queue message [%d]. Skipping. Error: [%s]", record.Topic, record.ID, err)
+			continue
+		}
+		q.logger.Infof("[%s] - Resuming stored queue message [%d].", record.Topic, record.ID)
+		q.track(message, record.ID)
+		q.channel <- message
+	}
+}
+
+// Encode converts the message to its stored representation
+func Encode(m *queue.Message) (*entity.QueueMessage, error) {
+	var payloadType string
+	var data []byte
+	var err error
+
+	switch p := m.Payload.(type) {
+	case *payload.Transfer:
+		payloadType = payloadTypeTransfer
+		data, err = json.Marshal(p)
+	case *message.Message:
+		payloadType = payloadTypeTopicMessage
+		var msgBytes []byte
+		msgBytes, err = proto.Marshal(p.TopicMessage)
+		if err != nil {
+			return nil, err
+		}
+		data, err = json.Marshal(topicMessage{Data: msgBytes, TransactionTimestamp: p.TransactionTimestamp})
+	default:
+		return nil, fmt.Errorf("unsupported payload type [%T]", m.Payload)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.QueueMessage{
+		Topic:       m.Topic,
+		PayloadType: payloadType,
+		Payload:     data,
+	}, nil
+}
+
+// Decode restores the message from its stored representation
+func Decode(record *entity.QueueMessage) (*queue.Message, error) {
+	var p interface{}
+
+	switch record.PayloadType {
+	case payloadTypeTransfer:
+		transfer := &payload.Transfer{}
+		err := json.Unmarshal(record.Payload, transfer)
+		if err != nil {
+			return nil, err
+		}
+		p = transfer
+	case payloadTypeTopicMessage:
+		stored := topicMessage{}
+		err := json.Unmarshal(record.Payload, &stored)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := message.FromBytesWithTS(stored.Data, stored.TransactionTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		p = msg
+	default:
+		return nil, fmt.Errorf("unsupported payload type [%s]", record.PayloadType)
+	}
+
+	return &queue.Message{Payload: p, Topic: record.Topic}, nil
+}
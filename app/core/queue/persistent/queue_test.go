@@ -0,0 +1,164 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persistent
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	model "github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	transferPayload = payload.New("0xtx-1", 1, 296, 1, "0.0.2", "0xsource", "0.0.3", "0xsource", "100")
+	topicPayload    = &message.Message{
+		TopicMessage: &model.TopicMessage{
+			Message: &model.TopicMessage_FungibleSignatureMessage{
+				FungibleSignatureMessage: &model.TopicEthSignatureMessage{
+					SourceChainId: 1,
+					TargetChainId: 296,
+					TransferID:    "0xtx-1",
+					Asset:         "0.0.3",
+					Recipient:     "0.0.2",
+					Amount:        "100",
+					Signature:     "signature",
+				},
+			},
+		},
+		TransactionTimestamp: 123,
+	}
+)
+
+// stubStore keeps the queue messages in memory, so that it can outlive a queue instance
+type stubStore struct {
+	messages map[uint64]*entity.QueueMessage
+	lastId   uint64
+	err      error
+}
+
+func newStubStore() *stubStore {
+	return &stubStore{messages: make(map[uint64]*entity.QueueMessage)}
+}
+
+func (s *stubStore) Create(entity *entity.QueueMessage) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.lastId++
+	entity.ID = s.lastId
+	s.messages[entity.ID] = entity
+	return nil
+}
+
+func (s *stubStore) Delete(id uint64) error {
+	delete(s.messages, id)
+	return nil
+}
+
+func (s *stubStore) GetAll() ([]*entity.QueueMessage, error) {
+	var result []*entity.QueueMessage
+	for _, m := range s.messages {
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func push(q *Queue, m *queue.Message) *queue.Message {
+	go q.Push(m)
+	return <-q.Channel()
+}
+
+func Test_PersistentQueue_RedeliversAfterRestart(t *testing.T) {
+	store := newStubStore()
+	pq := NewQueue(store)
+
+	handled := push(pq, &queue.Message{Payload: transferPayload, Topic: "transfer-topic"})
+	push(pq, &queue.Message{Payload: topicPayload, Topic: "message-topic"})
+	pq.Ack(handled)
+
+	restarted := NewQueue(store)
+	resumed := <-restarted.Channel()
+
+	assert.Equal(t, "message-topic", resumed.Topic)
+	resumedMsg, ok := resumed.Payload.(*message.Message)
+	assert.True(t, ok)
+	assert.Equal(t, topicPayload.TransactionTimestamp, resumedMsg.TransactionTimestamp)
+	assert.Equal(t, topicPayload.GetFungibleSignatureMessage().TransferID, resumedMsg.GetFungibleSignatureMessage().TransferID)
+	assert.Equal(t, topicPayload.GetFungibleSignatureMessage().Signature, resumedMsg.GetFungibleSignatureMessage().Signature)
+
+	restarted.Ack(resumed)
+	assert.Empty(t, store.messages)
+}
+
+func Test_PersistentQueue_RedeliversTransfer(t *testing.T) {
+	store := newStubStore()
+	pq := NewQueue(store)
+	push(pq, &queue.Message{Payload: transferPayload, Topic: "transfer-topic"})
+
+	restarted := NewQueue(store)
+	resumed := <-restarted.Channel()
+
+	assert.Equal(t, "transfer-topic", resumed.Topic)
+	assert.Equal(t, transferPayload, resumed.Payload)
+}
+
+func Test_PersistentQueue_Ack(t *testing.T) {
+	store := newStubStore()
+	pq := NewQueue(store)
+
+	received := push(pq, &queue.Message{Payload: transferPayload, Topic: "transfer-topic"})
+	assert.Len(t, store.messages, 1)
+
+	pq.Ack(received)
+	assert.Empty(t, store.messages)
+	assert.Empty(t, pq.stored)
+}
+
+func Test_PersistentQueue_StoreFails(t *testing.T) {
+	store := newStubStore()
+	store.err = errors.New("some-error")
+	pq := NewQueue(store)
+
+	received := push(pq, &queue.Message{Payload: transferPayload, Topic: "transfer-topic"})
+
+	assert.Equal(t, transferPayload, received.Payload)
+	assert.Empty(t, pq.stored)
+	assert.Empty(t, store.messages)
+}
+
+func Test_PersistentQueue_UnsupportedPayload(t *testing.T) {
+	store := newStubStore()
+	pq := NewQueue(store)
+
+	received := push(pq, &queue.Message{Payload: "unsupported", Topic: "topic"})
+
+	assert.Equal(t, "unsupported", received.Payload)
+	assert.Empty(t, store.messages)
+}
+
+func Test_Decode_UnsupportedPayloadType(t *testing.T) {
+	_, err := Decode(&entity.QueueMessage{ID: 1, Topic: "topic", PayloadType: "unknown"})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package priority
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+// The priority of high-value transfers, when not configured
+const defaultHighValuePriority = 1
+
+// NewPrioritizer derives the priority of the transfer messages from the configured priority of their corridor
+// and from their amount. High-value transfers get at least the high-value priority. Other messages get the lowest priority
+func NewPrioritizer(cfg config.QueuePriority, pricingService service.Pricing, assetsService service.Assets) Prioritizer {
+	highValuePriority := cfg.HighValuePriority
+	if highValuePriority == 0 {
+		highValuePriority = defaultHighValuePriority
+	}
+
+	return func(message *queue.Message) int {
+		transfer, ok := message.Payload.(*payload.Transfer)
+		if !ok {
+			return 0
+		}
+
+		priority := cfg.Corridors[corridor(transfer.SourceChainId, transfer.TargetChainId)]
+		if highValuePriority > priority && isHighValue(transfer, cfg.HighValueMultiplier, pricingService, assetsService) {
+			priority = highValuePriority
+		}
+		return priority
+	}
+}
+
+// corridor formats the key of the corridor between the given chains, as configured in `queue_priority.corridors`
+func corridor(sourceChainId, targetChainId uint64) string {
+	return fmt.Sprintf("%d-%d", sourceChainId, targetChainId)
+}
+
+// isHighValue checks whether the amount of the fungible transfer is at least its minimum amount times the multiplier.
+// The amount of the transfer is in the decimals of the target asset, so it is converted to the decimals of the native asset,
+// in which the minimum amount is
+func isHighValue(transfer *payload.Transfer, multiplier uint64, pricingService service.Pricing, assetsService service.Assets) bool {
+	if multiplier == 0 || transfer.IsNft {
+		return false
+	}
+
+	targetAmount, ok := new(big.Int).SetString(transfer.Amount, 10)
+	if !ok {
+		return false
+	}
+
+	nativeAssetInfo, exist := assetsService.FungibleAssetInfo(transfer.NativeChainId, transfer.NativeAsset)
+	if !exist {
+		return false
+	}
+	targetAssetInfo, exist := assetsService.FungibleAssetInfo(transfer.TargetChainId, transfer.TargetAsset)
+	if !exist {
+		return false
+	}
+	amount, err := decimal.AdjustDecimals(targetAmount, targetAssetInfo.Decimals, nativeAssetInfo.Decimals)
+	if err != nil {
+		return false
+	}
+
+	priceInfo, exist := pricingService.GetTokenPriceInfo(transfer.NativeChainId, transfer.NativeAsset)
+	if !exist || priceInfo.MinAmountWithFee == nil {
+		return false
+	}
+
+	threshold := new(big.Int).Mul(priceInfo.MinAmountWithFee, new(big.Int).SetUint64(multiplier))
+	return amount.Cmp(threshold) >= 0
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package priority
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func transferMessage(sourceChainId, targetChainId uint64, amount string) *queue.Message {
+	return &queue.Message{Payload: &payload.Transfer{
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: sourceChainId,
+		NativeAsset:   "0xasset",
+		TargetAsset:   "0xtarget",
+		Amount:        amount,
+	}}
+}
+
+func Test_NewPrioritizer_Corridor(t *testing.T) {
+	mocks.Setup()
+	prioritizer := NewPrioritizer(config.QueuePriority{Corridors: map[string]int{"1-296": 3}}, mocks.MPricingService, mocks.MAssetsService)
+
+	assert.Equal(t, 3, prioritizer(transferMessage(1, 296, "10")))
+	assert.Equal(t, 0, prioritizer(transferMessage(296, 1, "10")))
+	assert.Equal(t, 0, prioritizer(&queue.Message{Payload: "signature"}))
+}
+
+// setupAssets sets up the native asset with 8 decimals and its target assets with 18 decimals
+func setupAssets() {
+	mocks.MAssetsService.On("FungibleAssetInfo", uint64(1), "0xasset").Return(&asset.FungibleAssetInfo{Decimals: 8}, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", mock.Anything, "0xtarget").Return(&asset.FungibleAssetInfo{Decimals: 18}, true)
+}
+
+func Test_NewPrioritizer_HighValue(t *testing.T) {
+	mocks.Setup()
+	setupAssets()
+	mocks.MPricingService.On("GetTokenPriceInfo", uint64(1), "0xasset").Return(pricing.TokenPriceInfo{MinAmountWithFee: big.NewInt(10)}, true)
+	prioritizer := NewPrioritizer(config.QueuePriority{
+		Corridors:           map[string]int{"1-137": 5},
+		HighValueMultiplier: 100,
+	}, mocks.MPricingService, mocks.MAssetsService)
+
+	// 1000 in the 8 decimals of the native asset
+	assert.Equal(t, defaultHighValuePriority, prioritizer(transferMessage(1, 296, "10000000000000")))
+	assert.Equal(t, 0, prioritizer(transferMessage(1, 296, "9999999999999")))
+	assert.Equal(t, 0, prioritizer(transferMessage(1, 296, "1000")))
+	assert.Equal(t, 0, prioritizer(transferMessage(1, 296, "invalid")))
+	// The higher corridor priority is kept
+	assert.Equal(t, 5, prioritizer(transferMessage(1, 137, "10000000000000")))
+}
+
+func Test_NewPrioritizer_HighValueWithoutAssetInfo(t *testing.T) {
+	mocks.Setup()
+	mocks.MPricingService.On("GetTokenPriceInfo", uint64(1), "0xasset").Return(pricing.TokenPriceInfo{MinAmountWithFee: big.NewInt(10)}, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", uint64(1), "0xasset").Return(&asset.FungibleAssetInfo{Decimals: 8}, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", uint64(296), "0xtarget").Return((*asset.FungibleAssetInfo)(nil), false)
+	prioritizer := NewPrioritizer(config.QueuePriority{HighValueMultiplier: 100, HighValuePriority: 2}, mocks.MPricingService, mocks.MAssetsService)
+
+	assert.Equal(t, 0, prioritizer(transferMessage(1, 296, "10000000000000")))
+}
+
+func Test_NewPrioritizer_HighValueWithoutPrice(t *testing.T) {
+	mocks.Setup()
+	setupAssets()
+	mocks.MPricingService.On("GetTokenPriceInfo", uint64(1), "0xasset").Return(pricing.TokenPriceInfo{}, false)
+	prioritizer := NewPrioritizer(config.QueuePriority{HighValueMultiplier: 100, HighValuePriority: 2}, mocks.MPricingService, mocks.MAssetsService)
+
+	assert.Equal(t, 0, prioritizer(transferMessage(1, 296, "1000")))
+}
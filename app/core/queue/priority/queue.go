@@ -0,0 +1,165 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package priority
+
+import (
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+)
+
+// The interval, by which a waiting message is raised by one priority, when not configured
+const defaultAgingInterval = 60 * time.Second
+
+// Prioritizer derives the priority of a message. Higher priorities are dispatched first
+type Prioritizer func(message *queue.Message) int
+
+// Queue holds its messages in memory and dispatches the message of the highest priority first.
+// Messages of the same priority are dispatched in the order they were pushed. Every aging interval
+// a message waits, its priority is raised by one, so that low-priority messages are not starved during a backlog
+type Queue struct {
+	channel           chan *queue.Message
+	capacity          int
+	prioritizer       Prioritizer
+	agingInterval     time.Duration
+	prometheusService service.Prometheus
+	mutex             sync.Mutex
+	cond              *sync.Cond
+	messages          []*entry
+	// The number of dequeued messages, which are being pushed to the channel
+	sending int
+	// The number of held messages per priority
+	depths map[int]int
+	now    func() time.Time
+}
+
+type entry struct {
+	message *queue.Message
+	pushed  time.Time
+}
+
+// NewQueue creates a priority queue and starts dispatching to its channel. Pushing to a queue, which holds
+// its capacity of messages, blocks. A non-positive capacity leaves the queue unbounded.
+// The prioritizer sets the priority of the pushed messages, which have none. A zero aging interval defaults to 60 seconds
+func NewQueue(capacity int, prioritizer Prioritizer, agingInterval time.Duration, prometheusService service.Prometheus) *Queue {
+	q := newQueue(capacity, prioritizer, agingInterval, prometheusService)
+
+	go q.dispatch()
+
+	return q
+}
+
+func newQueue(capacity int, prioritizer Prioritizer, agingInterval time.Duration, prometheusService service.Prometheus) *Queue {
+	if agingInterval <= 0 {
+		agingInterval = defaultAgingInterval
+	}
+
+	q := &Queue{
+		channel:           make(chan *queue.Message),
+		capacity:          capacity,
+		prioritizer:       prioritizer,
+		agingInterval:     agingInterval,
+		prometheusService: prometheusService,
+		depths:            make(map[int]int),
+		now:               time.Now,
+	}
+	q.cond = sync.NewCond(&q.mutex)
+
+	return q
+}
+
+// Push enqueues the message, blocking while the queue is full
+func (q *Queue) Push(message *queue.Message) {
+	if message.Priority == 0 && q.prioritizer != nil {
+		message.Priority = q.prioritizer(message)
+	}
+
+	q.mutex.Lock()
+	for q.capacity > 0 && len(q.messages) >= q.capacity {
+		q.cond.Wait()
+	}
+	q.messages = append(q.messages, &entry{message: message, pushed: q.now()})
+	q.depths[message.Priority]++
+	depth := q.depths[message.Priority]
+	q.cond.Broadcast()
+	q.mutex.Unlock()
+
+	metrics.SetQueuePriorityDepth(message.Priority, depth, q.prometheusService)
+}
+
+// Ack is a no-op, as messages are not kept after they are dispatched
+func (q *Queue) Ack(message *queue.Message) {}
+
+func (q *Queue) Channel() chan *queue.Message {
+	return q.channel
+}
+
+// Pending returns the number of enqueued messages, together with the ones being pushed to the channel
+func (q *Queue) Pending() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.messages) + q.sending
+}
+
+// dispatch pushes the enqueued messages to the channel, the highest priority first
+func (q *Queue) dispatch() {
+	for {
+		q.channel <- q.next()
+
+		q.mutex.Lock()
+		q.sending--
+		q.mutex.Unlock()
+	}
+}
+
+// next waits for an enqueued message and dequeues the one of the highest aged priority
+func (q *Queue) next() *queue.Message {
+	q.mutex.Lock()
+	for len(q.messages) == 0 {
+		q.cond.Wait()
+	}
+
+	now := q.now()
+	selected := 0
+	highest := q.agedPriority(q.messages[0], now)
+	for i := 1; i < len(q.messages); i++ {
+		// Strictly higher only, so that messages of the same priority keep their order
+		if priority := q.agedPriority(q.messages[i], now); priority > highest {
+			selected, highest = i, priority
+		}
+	}
+
+	message := q.messages[selected].message
+	q.messages = append(q.messages[:selected], q.messages[selected+1:]...)
+	q.depths[message.Priority]--
+	depth := q.depths[message.Priority]
+	q.sending++
+	q.cond.Broadcast()
+	q.mutex.Unlock()
+
+	metrics.SetQueuePriorityDepth(message.Priority, depth, q.prometheusService)
+
+	return message
+}
+
+// agedPriority raises the priority of the message by one for every aging interval it waited
+func (q *Queue) agedPriority(e *entry, now time.Time) int {
+	return e.message.Priority + int(now.Sub(e.pushed)/q.agingInterval)
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package priority
+
+import (
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// setup creates a queue with a controllable clock, prioritizing the messages by their topic
+func setup(capacity int, priorities map[string]int) (*Queue, *time.Time) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	prioritizer := func(message *queue.Message) int {
+		return priorities[message.Topic]
+	}
+	q := newQueue(capacity, prioritizer, time.Minute, mocks.MPrometheusService)
+	now := time.Unix(0, 0)
+	q.now = func() time.Time {
+		return now
+	}
+	return q, &now
+}
+
+func Test_NewQueue_DefaultsAgingInterval(t *testing.T) {
+	q := newQueue(0, nil, 0, mocks.MPrometheusService)
+
+	assert.Equal(t, defaultAgingInterval, q.agingInterval)
+}
+
+func Test_Next_MixedPriorities(t *testing.T) {
+	q, _ := setup(0, map[string]int{"high": 2, "medium": 1})
+	q.Push(&queue.Message{Topic: "dust-1"})
+	q.Push(&queue.Message{Topic: "medium"})
+	q.Push(&queue.Message{Topic: "dust-2"})
+	q.Push(&queue.Message{Topic: "high"})
+
+	var order []string
+	for i := 0; i < 4; i++ {
+		order = append(order, q.next().Topic)
+	}
+
+	assert.Equal(t, []string{"high", "medium", "dust-1", "dust-2"}, order)
+}
+
+func Test_Push_KeepsExplicitPriority(t *testing.T) {
+	q, _ := setup(0, map[string]int{"explicit": 1})
+	q.Push(&queue.Message{Topic: "explicit", Priority: 3})
+
+	assert.Equal(t, 3, q.next().Priority)
+}
+
+func Test_Next_AgingPreventsStarvation(t *testing.T) {
+	q, now := setup(0, map[string]int{"high": 2})
+	q.Push(&queue.Message{Topic: "dust"})
+	q.Push(&queue.Message{Topic: "high"})
+
+	// The dust waited less than two aging intervals and is still below the high-priority messages
+	*now = now.Add(90 * time.Second)
+	assert.Equal(t, "high", q.next().Topic)
+
+	// Raised to the aged priority of the newly pushed high-priority message, the older dust is dispatched first
+	q.Push(&queue.Message{Topic: "high"})
+	*now = now.Add(90 * time.Second)
+	assert.Equal(t, "dust", q.next().Topic)
+	assert.Equal(t, "high", q.next().Topic)
+}
+
+func Test_Push_BlocksWhenFull(t *testing.T) {
+	q, _ := setup(1, nil)
+	q.Push(&queue.Message{Topic: "first"})
+
+	pushed := make(chan bool)
+	go func() {
+		q.Push(&queue.Message{Topic: "second"})
+		pushed <- true
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("push to a full queue did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.Equal(t, "first", q.next().Topic)
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Fatal("push was not unblocked")
+	}
+}
+
+func Test_Pending(t *testing.T) {
+	q, _ := setup(0, nil)
+	go q.dispatch()
+	q.Push(&queue.Message{Topic: "first"})
+	q.Push(&queue.Message{Topic: "second"})
+
+	assert.Equal(t, 2, q.Pending())
+
+	<-q.Channel()
+	<-q.Channel()
+	assert.Eventually(t, func() bool {
+		return q.Pending() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func Test_PriorityDepth(t *testing.T) {
+	q, _ := setup(0, map[string]int{"high": 2})
+	mocks.MPrometheusService.ExpectedCalls = nil
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	gauges := map[string]prometheus.Gauge{
+		"0": prometheus.NewGauge(prometheus.GaugeOpts{Name: "priority_0"}),
+		"2": prometheus.NewGauge(prometheus.GaugeOpts{Name: "priority_2"}),
+	}
+	for priority, gauge := range gauges {
+		priority := priority
+		mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+			return opts.Name == constants.QueuePriorityDepthGaugeNamePrefix+priority && opts.ConstLabels[constants.QueuePriorityMetricLabelKey] == priority
+		})).Return(gauge)
+	}
+
+	q.Push(&queue.Message{Topic: "dust"})
+	q.Push(&queue.Message{Topic: "dust"})
+	q.Push(&queue.Message{Topic: "high"})
+	assert.Equal(t, float64(2), testutil.ToFloat64(gauges["0"]))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauges["2"]))
+
+	q.next()
+	assert.Equal(t, float64(2), testutil.ToFloat64(gauges["0"]))
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauges["2"]))
+}
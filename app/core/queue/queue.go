@@ -19,8 +19,19 @@ package queue
 type Message struct {
 	Payload interface{}
 	Topic   string
+	// Trace carries the tracing context of the message from its watcher to its handler. It is not persisted
+	Trace map[string]string
+	// Priority of the message, used by the priority queue. Higher priorities are dispatched first. It is not persisted
+	Priority int
 }
 
+// Supported values for the `queue` node configuration
+const (
+	TypeMemory     = "memory"
+	TypePersistent = "persistent"
+	TypePriority   = "priority"
+)
+
 // Queue is a wrapper of a go channel, particularly to restrict actions on the channel itself
 type Queue struct {
 	channel chan *Message
@@ -31,6 +42,9 @@ func (q *Queue) Push(message *Message) {
 	q.channel <- message
 }
 
+// Ack is a no-op, as messages are not kept after they are pushed
+func (q *Queue) Ack(message *Message) {}
+
 func (q *Queue) Channel() chan *Message {
 	return q.channel
 }
@@ -17,18 +17,43 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
 	"github.com/go-chi/chi"
 	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
-	"net/http"
 )
 
 type Watcher interface {
 	Watch(queue queue.Queue)
 }
 
+// PartitionedWatcher is a Watcher, whose messages are pushed to their own partition of the queue
+type PartitionedWatcher interface {
+	Watcher
+	// Partition returns the name of the queue partition of the watcher
+	Partition() string
+}
+
+// The queue partition of watchers, which do not name their own
+const defaultPartition = "default"
+
+// The interval, in which the queue is checked for buffered messages on shutdown
+const drainPollInterval = 10 * time.Millisecond
+
 type Handler interface {
 	Handle(interface{})
 }
@@ -38,13 +63,46 @@ type Server struct {
 	watchers []Watcher
 	handlers map[string]Handler
 	queue    queue.Queue
+	// Number of workers, handling the messages of the given topic in parallel.
+	// The messages of topics without workers are each handled in their own goroutine
+	handlerWorkers    map[string]int
+	pools             map[string]*workerPool
+	prometheusService service.Prometheus
+	// The time, given to the messages in flight to be handled on shutdown
+	shutdownTimeout time.Duration
+	stop            chan struct{}
+	stopOnce        sync.Once
+	// Closed, once the server has stopped dispatching messages
+	stopped  chan struct{}
+	inFlight sync.WaitGroup
+	// Guards watchersStopped, so that no push of the watchers starts once they are stopped
+	pushMutex       sync.Mutex
+	watchersStopped bool
+	pushing         sync.WaitGroup
+	// Bounds the messages, taken from the queue and not yet handled, so that the backlog is held by the queue.
+	// Nil, when the messages in flight are unbounded
+	intake chan struct{}
 }
 
-func NewServer() *Server {
+// NewServer creates a server, dispatching the messages of the queue to the handlers.
+// At most maxInFlight messages are taken from the queue and not yet handled at a time. Zero leaves them unbounded
+func NewServer(queue queue.Queue, handlerWorkers map[string]int, maxInFlight int, shutdownTimeout time.Duration, prometheusService service.Prometheus) *Server {
+	var intake chan struct{}
+	if maxInFlight > 0 {
+		intake = make(chan struct{}, maxInFlight)
+	}
+
 	return &Server{
-		logger:   config.GetLoggerFor("Server"),
-		handlers: make(map[string]Handler),
-		queue:    q.NewQueue(),
+		logger:            config.GetLoggerFor("Server"),
+		handlers:          make(map[string]Handler),
+		queue:             queue,
+		handlerWorkers:    handlerWorkers,
+		pools:             make(map[string]*workerPool),
+		intake:            intake,
+		prometheusService: prometheusService,
+		shutdownTimeout:   shutdownTimeout,
+		stop:              make(chan struct{}),
+		stopped:           make(chan struct{}),
 	}
 }
 
@@ -56,17 +114,244 @@ func (s *Server) AddHandler(topic string, handler Handler) {
 	s.handlers[topic] = handler
 }
 
-// Run starts every handler and watcher, serving the chi.Mux on a given port
+// Run starts every handler and watcher, serving the chi.Mux on a given port.
+// Returns after shutting down gracefully on SIGINT or SIGTERM
 func (s *Server) Run(chi *chi.Mux, port string) {
+	s.startWorkerPools()
+	go s.dispatchAll()
+
+	for _, watcher := range s.watchers {
+		go watcher.Watch(&stoppableQueue{Queue: s.watcherQueue(watcher), server: s})
+	}
+
+	httpServer := &http.Server{Addr: port, Handler: chi}
 	go func() {
-		for message := range s.queue.Channel() {
-			go s.handlers[message.Topic].Handle(message.Payload)
+		s.logger.Infof("Listening on port [%s]", port)
+		err := httpServer.ListenAndServe()
+		if !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Fatal(err)
 		}
 	}()
 
-	for _, watcher := range s.watchers {
-		go watcher.Watch(s.queue)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	received := <-signals
+	s.logger.Infof("Received [%s]. Shutting down gracefully within [%s].", received, s.shutdownTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+	err := httpServer.Shutdown(ctx)
+	if err != nil {
+		s.logger.Errorf("Failed to shut down the HTTP server. Error: [%s]", err)
+	}
+	err = s.Shutdown(ctx)
+	if err != nil {
+		s.logger.Errorf("Failed to drain the handlers. Error: [%s]", err)
+		return
+	}
+	s.logger.Infof("Shut down gracefully")
+}
+
+// Shutdown stops the watchers and dispatches the messages, they have already pushed, to the handlers.
+// Then it stops dispatching and waits for the messages in flight to be handled, together with the background work
+// they started, e.g. awaiting their scheduled transactions, until the context is done.
+// Stopped watchers block on their next push, so that they do not advance their checkpoints past it
+func (s *Server) Shutdown(ctx context.Context) error {
+	err := s.stopWatchers(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.awaitBuffered(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+
+	select {
+	case <-s.stopped:
+	case <-ctx.Done():
+		return fmt.Errorf("dispatching did not stop: %w", ctx.Err())
+	}
+
+	err = await(ctx, s.inFlight.Wait)
+	if err != nil {
+		return fmt.Errorf("messages in flight were not handled: %w", err)
+	}
+
+	err = syncHelper.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("background work of handled messages did not complete: %w", err)
+	}
+	return nil
+}
+
+// stopWatchers blocks every further push of the watchers and waits for the pushes in progress to complete
+func (s *Server) stopWatchers(ctx context.Context) error {
+	s.pushMutex.Lock()
+	s.watchersStopped = true
+	s.pushMutex.Unlock()
+
+	err := await(ctx, s.pushing.Wait)
+	if err != nil {
+		return fmt.Errorf("pushes of the watchers did not complete: %w", err)
+	}
+	return nil
+}
+
+// awaitBuffered waits for the messages, buffered by the queue, to be dispatched
+func (s *Server) awaitBuffered(ctx context.Context) error {
+	buffered, ok := s.queue.(queue.Buffered)
+	if !ok {
+		return nil
+	}
+
+	for buffered.Pending() > 0 {
+		select {
+		case <-time.After(drainPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("buffered messages were not dispatched: %w", ctx.Err())
+		}
+	}
+	return nil
+}
+
+// startPush returns whether a push of the watchers is allowed to start, tracking it until it completes
+func (s *Server) startPush() bool {
+	s.pushMutex.Lock()
+	defer s.pushMutex.Unlock()
+
+	if s.watchersStopped {
+		return false
 	}
-	s.logger.Infof("Listening on port [%s]", port)
-	s.logger.Fatal(http.ListenAndServe(port, chi))
+	s.pushing.Add(1)
+	return true
+}
+
+// await runs the given wait function, until it returns or the context is done
+func await(ctx context.Context, wait func()) error {
+	done := make(chan struct{})
+	go func() {
+		wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dispatchAll dispatches the messages of the queue to the handlers, until the server is shut down.
+// A message is taken from the queue only once there is room for it in flight
+func (s *Server) dispatchAll() {
+	defer close(s.stopped)
+	for {
+		if !s.acquire() {
+			return
+		}
+		select {
+		case <-s.stop:
+			s.release()
+			return
+		case message := <-s.queue.Channel():
+			s.dispatch(message)
+		}
+	}
+}
+
+// acquire waits for room for another message in flight. Returns false, if the server is shut down meanwhile
+func (s *Server) acquire() bool {
+	if s.intake == nil {
+		return true
+	}
+	select {
+	case <-s.stop:
+		return false
+	case s.intake <- struct{}{}:
+		return true
+	}
+}
+
+// release frees the room of a message, which is no longer in flight
+func (s *Server) release() {
+	if s.intake != nil {
+		<-s.intake
+	}
+}
+
+// startWorkerPools starts the workers of every topic with a handler and configured workers
+func (s *Server) startWorkerPools() {
+	for topic, workers := range s.handlerWorkers {
+		if workers <= 0 {
+			continue
+		}
+		if _, ok := s.handlers[topic]; !ok {
+			s.logger.Warnf("No handler for topic [%s], configured with [%d] workers", topic, workers)
+			continue
+		}
+		s.pools[topic] = newWorkerPool(topic, workers, s.handleInFlight, s.prometheusService)
+		s.logger.Infof("Handling topic [%s] with [%d] workers", topic, workers)
+	}
+}
+
+// dispatch hands the message to the workers of its topic, or handles it in its own goroutine, if the topic has no workers
+func (s *Server) dispatch(message *q.Message) {
+	s.inFlight.Add(1)
+	if pool, ok := s.pools[message.Topic]; ok {
+		pool.dispatch(message)
+		return
+	}
+	go s.handleInFlight(message)
+}
+
+// handleInFlight handles a dispatched message, marking it as no longer in flight afterwards
+func (s *Server) handleInFlight(message *q.Message) {
+	defer s.inFlight.Done()
+	defer s.release()
+	s.handle(message)
+}
+
+// handle processes the message and acknowledges it to the queue afterwards
+func (s *Server) handle(message *q.Message) {
+	_, span := tracing.Start(tracing.Extract(message.Trace), "handle "+message.Topic, tracing.TransferId(message.Payload))
+	defer span.End()
+
+	s.handlers[message.Topic].Handle(message.Payload)
+	s.queue.Ack(message)
+}
+
+// watcherQueue returns the partition of the watcher, when the queue is partitioned
+func (s *Server) watcherQueue(watcher Watcher) queue.Queue {
+	partitioned, ok := s.queue.(queue.Partitioned)
+	if !ok {
+		return s.queue
+	}
+
+	name := defaultPartition
+	if pw, ok := watcher.(PartitionedWatcher); ok {
+		name = pw.Partition()
+	}
+	return partitioned.Partition(name)
+}
+
+// stoppableQueue is the queue, handed to a watcher, whose pushes block forever once the server stops the watchers
+type stoppableQueue struct {
+	queue.Queue
+	server *Server
+}
+
+func (sq *stoppableQueue) Push(message *q.Message) {
+	if !sq.server.startPush() {
+		sq.server.logger.Debugf("[%s] - Watchers are stopped. Holding back the message until exit.", message.Topic)
+		select {}
+	}
+	defer sq.server.pushing.Done()
+
+	sq.Queue.Push(message)
 }
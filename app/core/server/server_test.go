@@ -17,13 +17,26 @@
 package server
 
 import (
+	"context"
+
 	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/bounded"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/priority"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"sync"
 	"testing"
+	"time"
 )
 
 var (
@@ -36,11 +49,25 @@ var (
 func Test_NewServer(t *testing.T) {
 	setup()
 
-	actualServer := NewServer()
+	actualServer := NewServer(queueInstance, server.handlerWorkers, 0, server.shutdownTimeout, mocks.MPrometheusService)
 
 	assert.Equal(t, server.logger, actualServer.logger)
 	assert.Equal(t, server.handlers, actualServer.handlers)
 	assert.Equal(t, server.watchers, actualServer.watchers)
+	assert.Equal(t, server.queue, actualServer.queue)
+	assert.Equal(t, server.handlerWorkers, actualServer.handlerWorkers)
+	assert.Equal(t, server.pools, actualServer.pools)
+	assert.Equal(t, server.prometheusService, actualServer.prometheusService)
+	assert.Equal(t, server.shutdownTimeout, actualServer.shutdownTimeout)
+	assert.Nil(t, actualServer.intake)
+}
+
+func Test_NewServer_BoundsInFlight(t *testing.T) {
+	setup()
+
+	actualServer := NewServer(queueInstance, server.handlerWorkers, 5, server.shutdownTimeout, mocks.MPrometheusService)
+
+	assert.Equal(t, 5, cap(actualServer.intake))
 }
 
 func Test_AddWatcher(t *testing.T) {
@@ -66,8 +93,317 @@ func setup() {
 	queueInstance = q.NewQueue()
 
 	server = &Server{
-		logger:   config.GetLoggerFor("Server"),
-		handlers: make(map[string]Handler),
-		queue:    queueInstance,
+		logger:            config.GetLoggerFor("Server"),
+		handlers:          make(map[string]Handler),
+		queue:             queueInstance,
+		handlerWorkers:    map[string]int{handlerTopic: 2},
+		pools:             make(map[string]*workerPool),
+		prometheusService: mocks.MPrometheusService,
+		shutdownTimeout:   time.Second,
+		stop:              make(chan struct{}),
+		stopped:           make(chan struct{}),
 	}
 }
+
+func Test_Handle_AcksMessage(t *testing.T) {
+	setup()
+	server.queue = mocks.MQueue
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	message := &q.Message{Payload: "payload", Topic: handlerTopic}
+	mocks.MHandler.On("Handle", message.Payload).Return()
+	mocks.MQueue.On("Ack", message).Return()
+
+	server.handle(message)
+
+	mocks.MHandler.AssertCalled(t, "Handle", message.Payload)
+	mocks.MQueue.AssertCalled(t, "Ack", message)
+}
+
+func Test_Handle_ContinuesTrace(t *testing.T) {
+	setup()
+	recorder := tracetest.NewSpanRecorder()
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	}()
+	server.queue = mocks.MQueue
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	ctx, dispatch := tracing.Start(context.Background(), "evm.dispatch", "0.0.123-1-1")
+	message := &q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-1-1"}, Topic: handlerTopic, Trace: tracing.Inject(ctx)}
+	dispatch.End()
+	mocks.MHandler.On("Handle", message.Payload).Return()
+	mocks.MQueue.On("Ack", message).Return()
+
+	server.handle(message)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "handle "+handlerTopic, spans[1].Name())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+	assert.Contains(t, spans[1].Attributes(), tracing.TransferIdKey.String("0.0.123-1-1"))
+}
+
+type stubPartitioned struct {
+	*q.Queue
+	partitions []string
+}
+
+func (s *stubPartitioned) Partition(name string) queue.Queue {
+	s.partitions = append(s.partitions, name)
+	return s.Queue
+}
+
+type stubPartitionedWatcher struct{}
+
+func (w stubPartitionedWatcher) Watch(queue queue.Queue) {}
+
+func (w stubPartitionedWatcher) Partition() string {
+	return "80001"
+}
+
+func Test_WatcherQueue_NotPartitioned(t *testing.T) {
+	setup()
+
+	assert.Equal(t, queueInstance, server.watcherQueue(mocks.MWatcher))
+}
+
+func Test_WatcherQueue_Partitioned(t *testing.T) {
+	setup()
+	partitioned := &stubPartitioned{Queue: q.NewQueue()}
+	server.queue = partitioned
+
+	server.watcherQueue(stubPartitionedWatcher{})
+	server.watcherQueue(mocks.MWatcher)
+
+	assert.Equal(t, []string{"80001", defaultPartition}, partitioned.partitions)
+}
+
+// ackingQueue records the acknowledged messages
+type ackingQueue struct {
+	*q.Queue
+	mutex sync.Mutex
+	acked []*q.Message
+}
+
+func (a *ackingQueue) Ack(message *q.Message) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.acked = append(a.acked, message)
+}
+
+func (a *ackingQueue) ackedCount() int {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return len(a.acked)
+}
+
+// statusHandler blocks until released and persists the completed status of the transfer afterwards
+type statusHandler struct {
+	started chan struct{}
+	release chan struct{}
+	mutex   sync.Mutex
+	status  map[string]string
+}
+
+func (h *statusHandler) Handle(p interface{}) {
+	transfer := p.(*payload.Transfer)
+	h.setStatus(transfer.TransactionId, "IN_PROGRESS")
+	h.started <- struct{}{}
+	<-h.release
+	h.setStatus(transfer.TransactionId, "COMPLETED")
+}
+
+func (h *statusHandler) setStatus(transactionId, status string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.status[transactionId] = status
+}
+
+func (h *statusHandler) statusOf(transactionId string) string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.status[transactionId]
+}
+
+func setupShutdown() (*ackingQueue, *statusHandler) {
+	setup()
+	acking := &ackingQueue{Queue: q.NewQueue()}
+	handler := &statusHandler{started: make(chan struct{}, 1), release: make(chan struct{}), status: make(map[string]string)}
+	server.queue = acking
+	server.AddHandler(handlerTopic, handler)
+	go server.dispatchAll()
+	return acking, handler
+}
+
+func Test_Shutdown_DrainsInFlightTransfer(t *testing.T) {
+	acking, handler := setupShutdown()
+	transactionId := "0.0.123-1-1"
+	acking.Push(&q.Message{Payload: &payload.Transfer{TransactionId: transactionId}, Topic: handlerTopic})
+	<-handler.started
+
+	shutdown := make(chan error)
+	go func() {
+		shutdown <- server.Shutdown(context.Background())
+	}()
+
+	// Shutdown waits for the transfer in flight
+	select {
+	case <-shutdown:
+		t.Fatal("shutdown did not wait for the transfer in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, "IN_PROGRESS", handler.statusOf(transactionId))
+
+	close(handler.release)
+	assert.Nil(t, <-shutdown)
+	assert.Equal(t, "COMPLETED", handler.statusOf(transactionId))
+	assert.Equal(t, 1, acking.ackedCount())
+}
+
+func Test_Shutdown_StopsDispatching(t *testing.T) {
+	acking, handler := setupShutdown()
+	close(handler.release)
+
+	assert.Nil(t, server.Shutdown(context.Background()))
+
+	pushed := make(chan struct{})
+	go func() {
+		acking.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-1-1"}, Topic: handlerTopic})
+		close(pushed)
+	}()
+	select {
+	case <-pushed:
+		t.Fatal("message was dispatched after shutdown")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, 0, acking.ackedCount())
+}
+
+func Test_Shutdown_Timeout(t *testing.T) {
+	acking, handler := setupShutdown()
+	acking.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-1-1"}, Topic: handlerTopic})
+	<-handler.started
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := server.Shutdown(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, acking.ackedCount())
+	close(handler.release)
+}
+
+func Test_Shutdown_StopsWatchers(t *testing.T) {
+	acking, handler := setupShutdown()
+	close(handler.release)
+	watcherQueue := &stoppableQueue{Queue: acking, server: server}
+
+	assert.Nil(t, server.Shutdown(context.Background()))
+
+	pushed := make(chan struct{})
+	go func() {
+		watcherQueue.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-1-1"}, Topic: handlerTopic})
+		close(pushed)
+	}()
+	select {
+	case <-pushed:
+		t.Fatal("watcher pushed after shutdown")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, 0, acking.ackedCount())
+}
+
+func Test_Shutdown_DispatchesBufferedMessages(t *testing.T) {
+	setup()
+	boundedQueue, err := bounded.NewQueue(10, bounded.OverflowBlock, nil, mocks.MPrometheusService)
+	assert.Nil(t, err)
+	handler := newRecordingHandler()
+	close(handler.release)
+	server.queue = boundedQueue
+	server.AddHandler(handlerTopic, handler)
+	watcherQueue := &stoppableQueue{Queue: boundedQueue, server: server}
+	for _, id := range []string{"0.0.123-1-1", "0.0.123-2-2", "0.0.123-3-3"} {
+		watcherQueue.Push(&q.Message{Payload: &payload.Transfer{TransactionId: id}, Topic: handlerTopic})
+	}
+	go server.dispatchAll()
+
+	assert.Nil(t, server.Shutdown(context.Background()))
+
+	assert.Equal(t, 0, boundedQueue.Pending())
+	for _, id := range []string{"0.0.123-1-1", "0.0.123-2-2", "0.0.123-3-3"} {
+		assert.Len(t, handler.handledOf(id), 1)
+	}
+}
+
+// backgroundHandler starts background work, which completes the transfer once released
+type backgroundHandler struct {
+	release chan struct{}
+	mutex   sync.Mutex
+	status  string
+}
+
+func (h *backgroundHandler) Handle(p interface{}) {
+	syncHelper.Go(func() {
+		<-h.release
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		h.status = "COMPLETED"
+	})
+}
+
+func Test_Shutdown_AwaitsBackgroundWork(t *testing.T) {
+	setup()
+	acking := &ackingQueue{Queue: q.NewQueue()}
+	handler := &backgroundHandler{release: make(chan struct{})}
+	server.queue = acking
+	server.AddHandler(handlerTopic, handler)
+	go server.dispatchAll()
+	acking.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-1-1"}, Topic: handlerTopic})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, server.Shutdown(ctx), context.DeadlineExceeded)
+
+	close(handler.release)
+	assert.Nil(t, syncHelper.Wait(context.Background()))
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	assert.Equal(t, "COMPLETED", handler.status)
+}
+
+func Test_DispatchAll_BoundedInFlight_DispatchesBacklogByPriority(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	prioritizer := func(message *q.Message) int {
+		if message.Payload.(*payload.Transfer).Amount == "high" {
+			return 1
+		}
+		return 0
+	}
+	priorityQueue := priority.NewQueue(0, prioritizer, time.Minute, mocks.MPrometheusService)
+	handler := newRecordingHandler()
+	server.queue = priorityQueue
+	server.intake = make(chan struct{}, 1)
+	server.AddHandler(handlerTopic, handler)
+	go server.dispatchAll()
+
+	priorityQueue.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-1-1", Amount: "low"}, Topic: handlerTopic})
+	assert.Equal(t, []string{"0.0.123-1-1"}, handler.awaitStarted(1))
+	priorityQueue.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-2-2", Amount: "low"}, Topic: handlerTopic})
+	priorityQueue.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-3-3", Amount: "low"}, Topic: handlerTopic})
+	priorityQueue.Push(&q.Message{Payload: &payload.Transfer{TransactionId: "0.0.123-4-4", Amount: "high"}, Topic: handlerTopic})
+
+	// Nothing else is taken from the queue, while the message is in flight
+	assert.Empty(t, handler.awaitStarted(1))
+	assert.Equal(t, 3, priorityQueue.Pending())
+
+	close(handler.release)
+	started := handler.awaitStarted(3)
+	assert.Len(t, started, 3)
+	// The first low-priority message may already be taken by the dispatching of the queue, before the higher priority is pushed
+	assert.Equal(t, "0.0.123-3-3", started[2])
+	assert.Nil(t, server.Shutdown(context.Background()))
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sync"
+	"sync/atomic"
+
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/shard"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+)
+
+// workerPool handles the messages of a single topic with a fixed number of workers, running in parallel.
+// The messages of a transfer are always handled by the same worker, so that they are handled in order
+type workerPool struct {
+	topic             string
+	workers           []*worker
+	handle            func(message *q.Message)
+	next              uint64
+	active            int64
+	prometheusService service.Prometheus
+}
+
+// worker handles its pending messages one at a time, in the order they were dispatched to it
+type worker struct {
+	mutex   sync.Mutex
+	pending []*q.Message
+	signal  chan struct{}
+}
+
+// newWorkerPool starts the given number of workers, handling the messages of the topic with the given function
+func newWorkerPool(topic string, count int, handle func(message *q.Message), prometheusService service.Prometheus) *workerPool {
+	pool := &workerPool{
+		topic:             topic,
+		workers:           make([]*worker, count),
+		handle:            handle,
+		prometheusService: prometheusService,
+	}
+	for i := range pool.workers {
+		pool.workers[i] = &worker{signal: make(chan struct{}, 1)}
+		go pool.run(pool.workers[i])
+	}
+	return pool
+}
+
+// dispatch queues the message to the worker of its transfer. Messages, which do not refer to a transfer, are spread among the workers
+func (p *workerPool) dispatch(message *q.Message) {
+	p.workers[p.workerIndex(tracing.TransferId(message.Payload))].push(message)
+}
+
+func (p *workerPool) workerIndex(transferId string) uint64 {
+	count := uint64(len(p.workers))
+	if transferId == "" {
+		return atomic.AddUint64(&p.next, 1) % count
+	}
+	return shard.Of(transferId, count)
+}
+
+func (p *workerPool) run(w *worker) {
+	for range w.signal {
+		for message := w.pop(); message != nil; message = w.pop() {
+			metrics.SetHandlerActiveWorkers(p.topic, atomic.AddInt64(&p.active, 1), p.prometheusService)
+			p.handle(message)
+			metrics.SetHandlerActiveWorkers(p.topic, atomic.AddInt64(&p.active, -1), p.prometheusService)
+		}
+	}
+}
+
+func (w *worker) push(message *q.Message) {
+	w.mutex.Lock()
+	w.pending = append(w.pending, message)
+	w.mutex.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (w *worker) pop() *q.Message {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if len(w.pending) == 0 {
+		return nil
+	}
+	message := w.pending[0]
+	w.pending[0] = nil
+	w.pending = w.pending[1:]
+	return message
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// recordingHandler records the handled payloads and blocks every call until release is closed
+type recordingHandler struct {
+	mutex   sync.Mutex
+	handled []string
+	started chan string
+	release chan struct{}
+}
+
+func newRecordingHandler() *recordingHandler {
+	return &recordingHandler{
+		started: make(chan string, 100),
+		release: make(chan struct{}),
+	}
+}
+
+func (h *recordingHandler) Handle(p interface{}) {
+	transfer := p.(*payload.Transfer)
+	h.started <- transfer.TransactionId
+	<-h.release
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.handled = append(h.handled, transfer.TransactionId+"/"+transfer.Amount)
+}
+
+func (h *recordingHandler) handledOf(transactionId string) []string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var result []string
+	for _, handled := range h.handled {
+		if strings.HasPrefix(handled, transactionId+"/") {
+			result = append(result, handled)
+		}
+	}
+	return result
+}
+
+// awaitStarted returns the ids of the transfers, whose handling started within the timeout
+func (h *recordingHandler) awaitStarted(count int) []string {
+	var started []string
+	for len(started) < count {
+		select {
+		case id := <-h.started:
+			started = append(started, id)
+		case <-time.After(time.Second):
+			return started
+		}
+	}
+	return started
+}
+
+func transferMessage(transactionId, amount string) *q.Message {
+	return &q.Message{Payload: &payload.Transfer{TransactionId: transactionId, Amount: amount}, Topic: handlerTopic}
+}
+
+func setupWorkers(handler Handler) prometheus.Gauge {
+	setup()
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "active_workers"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+		return opts.Name == constants.HandlerActiveWorkersGaugeNamePrefix+"topic_msg_submission"
+	})).Return(gauge)
+	server.AddHandler(handlerTopic, handler)
+	server.startWorkerPools()
+	return gauge
+}
+
+func Test_StartWorkerPools(t *testing.T) {
+	setupWorkers(mocks.MHandler)
+	server.handlerWorkers["unhandled"] = 2
+
+	assert.Len(t, server.pools, 1)
+	assert.Len(t, server.pools[handlerTopic].workers, 2)
+}
+
+func Test_Dispatch_Concurrent(t *testing.T) {
+	handler := newRecordingHandler()
+	gauge := setupWorkers(handler)
+	// Transfers, which are handled by different workers
+	first, second := "0.0.1-1-1", "0.0.1-1-2"
+	pool := server.pools[handlerTopic]
+	for pool.workerIndex(first) == pool.workerIndex(second) {
+		second += "0"
+	}
+
+	server.dispatch(transferMessage(first, "1"))
+	server.dispatch(transferMessage(second, "1"))
+
+	assert.ElementsMatch(t, []string{first, second}, handler.awaitStarted(2))
+	assert.Equal(t, float64(2), testutil.ToFloat64(gauge))
+
+	close(handler.release)
+	assert.Eventually(t, func() bool {
+		return len(handler.handledOf(first)) == 1 && len(handler.handledOf(second)) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(gauge) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func Test_Dispatch_TransferOrder(t *testing.T) {
+	handler := newRecordingHandler()
+	setupWorkers(handler)
+	transactionId := "0.0.1-1-1"
+
+	server.dispatch(transferMessage(transactionId, "1"))
+	server.dispatch(transferMessage(transactionId, "2"))
+	server.dispatch(transferMessage(transactionId, "3"))
+
+	// The messages of the transfer are handled one at a time
+	assert.Len(t, handler.awaitStarted(2), 1)
+
+	close(handler.release)
+	assert.Eventually(t, func() bool {
+		return len(handler.handledOf(transactionId)) == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{transactionId + "/1", transactionId + "/2", transactionId + "/3"}, handler.handledOf(transactionId))
+}
+
+func Test_Dispatch_WithoutWorkers(t *testing.T) {
+	handler := newRecordingHandler()
+	setup()
+	server.handlerWorkers = nil
+	server.AddHandler(handlerTopic, handler)
+	server.startWorkerPools()
+	transactionId := "0.0.1-1-1"
+
+	server.dispatch(transferMessage(transactionId, "1"))
+	server.dispatch(transferMessage(transactionId, "2"))
+
+	// Without workers, every message is handled in its own goroutine
+	assert.Len(t, handler.awaitStarted(2), 2)
+	close(handler.release)
+}
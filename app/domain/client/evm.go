@@ -19,6 +19,7 @@ package client
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -56,3 +57,21 @@ type EVM interface {
 
 	RetryTransactionByHash(hash common.Hash) (*types.Transaction, error)
 }
+
+// EvmEndpoint describes the health of a single RPC endpoint of an EVM client
+type EvmEndpoint struct {
+	// The host of the endpoint. The full URL is omitted, as it may contain an API key
+	Host string
+	// The consecutive failed requests to the endpoint. Zero marks the endpoint as healthy
+	Failures int
+	// The total time the requests to the endpoint spent queued, because of its concurrency limit
+	QueueWait time.Duration
+	// The amount of requests to the endpoint, which went through its concurrency limit
+	LimitedCalls uint64
+}
+
+// EvmEndpoints is implemented by the EVM clients, which fail over across multiple RPC endpoints
+type EvmEndpoints interface {
+	// Endpoints returns the health of the RPC endpoints of the client
+	Endpoints() []EvmEndpoint
+}
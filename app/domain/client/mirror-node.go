@@ -17,6 +17,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
@@ -83,6 +85,10 @@ type MirrorNode interface {
 	// WaitForScheduledTransaction Polls the transaction at intervals. Depending on the
 	// result, the corresponding `onSuccess` and `onFailure` functions are called
 	WaitForScheduledTransaction(txId string, onSuccess, onFailure func())
+	// WaitForScheduledTransactionWithTimeout Polls the transaction at intervals. Depending on the
+	// result, the corresponding `onSuccess` and `onFailure` functions are called. If the scheduled
+	// transaction is not executed within the given timeout, `onTimeout` is called
+	WaitForScheduledTransactionWithTimeout(txId string, timeout time.Duration, onSuccess, onFailure, onTimeout func())
 	// GetHBARUsdPrice Returns USD price for HBAR
 	GetHBARUsdPrice() (price decimal.Decimal, err error)
 	// QueryDefaultLimit returns the default records limit per query
@@ -43,4 +43,6 @@ type HederaNode interface {
 	SubmitScheduledTokenBurnTransaction(id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 	// TransactionReceiptQuery returns the receipt for a given transaction ID
 	TransactionReceiptQuery(transactionID hedera.TransactionID, nodeAccIds []hedera.AccountID) (hedera.TransactionReceipt, error)
+	// TokenInfoQuery returns the info of a given token, including its keys
+	TokenInfoQuery(tokenID hedera.TokenID) (hedera.TokenInfo, error)
 }
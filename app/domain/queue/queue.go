@@ -22,5 +22,22 @@ import (
 
 type Queue interface {
 	Push(message *queue.Message)
+	// Ack marks the message as handled
+	Ack(message *queue.Message)
 	Channel() chan *queue.Message
 }
+
+// Partitioned is a Queue, which dispatches the messages of its partitions fairly,
+// so that a busy partition cannot starve the others
+type Partitioned interface {
+	Queue
+	// Partition returns the queue of the partition with the given name
+	Partition(name string) Queue
+}
+
+// Buffered is a Queue, which holds the pushed messages in memory, before they are taken from its channel
+type Buffered interface {
+	Queue
+	// Pending returns the number of pushed messages, which have not been taken from the channel yet
+	Pending() int
+}
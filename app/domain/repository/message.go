@@ -17,12 +17,27 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 )
 
 type Message interface {
 	Create(message *entity.Message) error
 	Exist(transferID, signature, hash string) (bool, error)
-	Get(transferID string) ([]entity.Message, error)
+	// Get returns the signature messages of the transfer from the given source chain, ordered by consensus timestamp
+	Get(sourceChainId uint64, transferID string) ([]entity.Message, error)
 	GetMessageWith(transferID, signature, hash string) (*entity.Message, error)
+	// PruneMessagesBefore deletes the messages of terminal transfers, which reached consensus before the cutoff,
+	// except the ones of completed transfers to EVM networks, which are still needed to claim them
+	PruneMessagesBefore(cutoff time.Time) (int64, error)
+	// CreateOrphan stores a signature message, received before the transfer it signs
+	CreateOrphan(orphan *entity.OrphanedSignature) error
+	// ResolveOrphans deletes and returns the orphaned signature messages of the given transfer from the given source chain,
+	// ordered by consensus timestamp
+	ResolveOrphans(sourceChainId uint64, transferID string) ([]entity.OrphanedSignature, error)
+	// PruneOrphansBefore deletes the orphaned signature messages, stored before the cutoff
+	PruneOrphansBefore(cutoff time.Time) (int64, error)
+	// CountOrphans returns the number of stored orphaned signature messages
+	CountOrphans() (int64, error)
 }
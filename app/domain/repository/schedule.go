@@ -24,6 +24,7 @@ type Schedule interface {
 	Create(entity *entity.Schedule) error
 	UpdateStatusCompleted(txId string) error
 	UpdateStatusFailed(txId string) error
-	GetReceiverTransferByTransactionID(id string) (*entity.Schedule, error)
+	UpdateStatusExpired(txId string) error
+	GetReceiverTransferByTransactionID(sourceChainId uint64, id string) (*entity.Schedule, error)
 	GetAllSubmittedIds() ([]*entity.Schedule, error)
 }
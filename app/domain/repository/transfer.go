@@ -17,21 +17,61 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 )
 
 type Transfer interface {
-	// Returns Transfer. Returns nil if not found
-	GetByTransactionId(txId string) (*entity.Transfer, error)
-	// Returns Transfer with preloaded Fee table. Returns nil if not found
-	GetWithFee(txId string) (*entity.Transfer, error)
-	GetWithPreloads(txId string) (*entity.Transfer, error)
-	UpdateFee(txId string, fee string) error
+	// Returns the Transfer with the given transaction id, originating from the given source chain. Returns nil if not found
+	GetByTransactionId(sourceChainId uint64, txId string) (*entity.Transfer, error)
+	// Returns the Transfer with the given transaction id, if it exists on a single source chain. Returns service.ErrAmbiguousTransfer
+	// if it exists on more than one source chain and nil if not found
+	GetByTransactionIdOnAnyChain(txId string) (*entity.Transfer, error)
+	// Returns Transfer with preloaded Fee table, originating from the given source chain. Returns nil if not found
+	GetWithFee(sourceChainId uint64, txId string) (*entity.Transfer, error)
+	// Returns Transfer with preloaded Fee and Message tables, originating from the given source chain. Returns nil if not found
+	GetWithPreloads(sourceChainId uint64, txId string) (*entity.Transfer, error)
+	UpdateFee(sourceChainId uint64, txId string, fee string) error
 
 	Create(ct *payload.Transfer) (*entity.Transfer, error)
-	UpdateStatusCompleted(txId string) error
-	UpdateStatusFailed(txId string) error
+	// Stores the transfer as Failed, unless it is stored already. Returns whether it was stored
+	CreateFailedIfAbsent(ct *payload.Transfer) (bool, error)
+	UpdateStatusCompleted(sourceChainId uint64, txId string) error
+	UpdateStatusFailed(sourceChainId uint64, txId string) error
+	UpdateSignatureMsgStatus(sourceChainId uint64, txId string, status string) error
+	// Returns the Initial transfers, for which the signature submission is pending or has failed
+	GetPendingSignatureSubmissions() ([]*entity.Transfer, error)
+	// Returns the Initial transfers, which were stored before the given time
+	GetInitialBefore(before time.Time) ([]*entity.Transfer, error)
+	// Returns the Initial transfers to EVM networks, which lack a signature message of the given signer
+	GetAwaitingSignatureFrom(signer string) ([]*entity.Transfer, error)
+	// Fails the transfer, unless it has left the Initial status in the meantime. Returns whether it was failed
+	UpdateStatusFailedIfInitial(sourceChainId uint64, txId string) (bool, error)
 	Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error)
+	// Returns the number of transfers per status, including statuses without any transfers
+	CountByStatus() (map[string]int64, error)
+	// Rewrites the legacy Hedera chain ids of transfers to the given one. Returns the number of updated rows
+	MigrateLegacyChainIds(hederaNetworkId uint64) (int64, error)
+	// Stamps the transfers without a creation time with the current time. Returns the number of updated rows
+	MigrateCreationTimes() (int64, error)
+	// Returns the distinct target chains and assets of the fungible transfers without decimals
+	GetTargetAssetsWithoutDecimals() ([]*entity.Transfer, error)
+	// Backfills the decimals of the fungible transfers to the given target asset. Returns the number of updated rows
+	MigrateDecimals(targetChainId uint64, targetAsset string, decimals uint8) (int64, error)
+	// Makes the source chain id part of the primary key of transfers. Returns whether the table has been migrated
+	MigrateCompositeIdentity() (bool, error)
+	// Backfills the source chain of the messages, fees and schedules, which reference a transfer by its transaction id alone.
+	// Returns the number of updated rows
+	MigrateTransferSourceChains() (int64, error)
+	// References transfers by their transaction id and source chain id from the messages, fees and schedules.
+	// Returns whether any foreign key has been added
+	MigrateCompositeForeignKeys() (bool, error)
+
+	// CreateEventLog stores the raw on-chain log of a transfer, unless it is already stored
+	CreateEventLog(eventLog *entity.EventLog) error
+	// Returns the raw on-chain log of a transfer from the given source chain. Returns nil if not found
+	GetEventLog(sourceChainId uint64, txId string) (*entity.EventLog, error)
 }
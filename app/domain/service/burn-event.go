@@ -27,5 +27,5 @@ type BurnEvent interface {
 	ProcessEvent(transfer payload.Transfer)
 	// TransactionID returns the corresponding Scheduled Transaction paying out the
 	// fees to validators and the amount being bridged to the receiver address
-	TransactionID(id string) (string, error)
+	TransactionID(sourceChainId uint64, id string) (string, error)
 }
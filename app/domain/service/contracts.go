@@ -32,8 +32,10 @@ type Contracts interface {
 	Address() common.Address
 	// GetMembers returns the array of bridge members currently set in the Bridge contract
 	GetMembers() []string
-	// ReloadMembers triggers to fetch all the members from the Router Contract
-	ReloadMembers()
+	// ReloadMembers fetches all the members from the Router Contract. On failure the members are marked as stale
+	ReloadMembers() error
+	// MembersStale returns whether the last reload of the members has failed
+	MembersStale() bool
 	// GetClient returns the Contracts Service corresponding EVM Client
 	GetClient() client.Core
 	// IsMember returns true/false depending on whether the provided address is a Bridge member or not
@@ -25,3 +25,7 @@ var ErrNotFound = errors.New("not found")
 var ErrBadRequestTransferTargetNetworkNoSignaturesRequired = errors.New("transfer target network does not require signatures")
 var ErrWrongQuery = errors.New("wrong query parameter")
 var ErrTooManyRetires = fmt.Errorf("too many retries")
+var ErrNotMember = errors.New("validator is not a bridge member")
+var ErrTransferNotFound = errors.New("transfer not found")
+var ErrTransferFeeNotFound = errors.New("transfer fee not found")
+var ErrAmbiguousTransfer = errors.New("transfer exists on more than one source chain, sourceChainId is required")
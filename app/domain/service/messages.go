@@ -17,6 +17,9 @@
 package service
 
 import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
 )
@@ -28,10 +31,25 @@ type Messages interface {
 	// SanityCheckNftSignature performs any validation required prior handling the topic message
 	// (verifies input data against the corresponding Transaction record)
 	SanityCheckNftSignature(tm *proto.TopicEthNftSignatureMessage) (bool, error)
-	// ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
-	ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error
+	// ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB.
+	// The signature is verified against the EIP-191 authMsg or the EIP-712 typedDataMsg, depending on the configured schemes
+	ProcessSignature(transferID, signature string, sourceChainId, targetChainId uint64, timestamp int64, authMsg, typedDataMsg []byte) error
+	// CheckMembership returns ErrNotMember if the validator is not in the current member set of the target network,
+	// in which case it must neither sign the transfer, nor execute any of its Hedera transactions
+	CheckMembership(transferID string, targetChainId uint64) error
 	// SignFungibleMessage signs a Fungible message based on Transfer
 	SignFungibleMessage(transfer payload.Transfer) ([]byte, error)
 	// SignNftMessage signs an NFT messaged based on Transfer
 	SignNftMessage(transfer payload.Transfer) ([]byte, error)
+	// PendingSigners returns the members of the target network's router, which have not yet signed the given transfer
+	PendingSigners(sourceChainId uint64, transferID string) ([]string, error)
+	// GetTransfersAwaitingSignatureFrom returns the transfers, which still await the signature of the given member
+	GetTransfersAwaitingSignatureFrom(member string) ([]*entity.Transfer, error)
+	// AwaitsOwnSignature returns whether the signature of the validator is still missing for the given transfer
+	AwaitsOwnSignature(sourceChainId uint64, transferID string) (bool, error)
+	// SignerRank returns the position of the signature of the validator among the ones, recorded for the given transfer
+	// in consensus order, or -1 if it is not recorded
+	SignerRank(sourceChainId uint64, transferID string) (int, error)
+	// ReportPendingSigners publishes, per member, the number of transfers awaiting its signature for longer than the timeout
+	ReportPendingSigners(timeout time.Duration)
 }
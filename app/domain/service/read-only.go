@@ -25,7 +25,7 @@ import (
 
 type ReadOnly interface {
 	FindTransfer(transferID string, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
-	FindAssetTransfer(transferID string, asset string, transfers []model.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
+	FindAssetTransfer(sourceChainId uint64, transferID string, asset string, transfers []model.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
 	FindNftTransfer(transferID string, tokenID string, serialNum int64, sender string, receiver string,
 		save func(transactionID, scheduleID, status string) error)
 	FindScheduledNftAllowanceApprove(
@@ -42,11 +42,17 @@ type Transfers interface {
 	ProcessWrappedTransfer(tm payload.Transfer) error
 	// TransferData returns from the database the given transfer, its signatures and
 	// calculates if its messages have reached super majority
-	TransferData(txId string) (interface{}, error)
+	TransferData(sourceChainId uint64, txId string) (interface{}, error)
 	// Paged returns a paginated list of all transfers
 	Paged(filter *model.PagedRequest) (*model.Paged, error)
 	// UpdateTransferStatusCompleted updates the transfer status to completed
-	UpdateTransferStatusCompleted(txId string) error
+	UpdateTransferStatusCompleted(sourceChainId uint64, txId string) error
+	// SourceChain returns the source chain of the transfer with the given ID. Fails with ErrAmbiguousTransfer,
+	// if transfers with the ID exist on more than one source chain
+	SourceChain(txId string) (uint64, error)
+	// ResumePendingSubmissions re-submits the signatures of the Hedera-originated transfers,
+	// whose submission to the topic was pending or failed before the last shutdown
+	ResumePendingSubmissions()
 }
 
 type TransferData struct {
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+
+// Watchers interface is implemented by the Watchers Service
+// Controls the running state of the EVM watchers, identified by their database identifier,
+// and the runtime deny-list of assets, disabled by the router
+type Watchers interface {
+	// Pause stops the processing of new blocks by the given watcher
+	Pause(id string)
+	// Resume continues the processing of new blocks by the given watcher
+	Resume(id string)
+	// IsPaused returns whether the given watcher is paused
+	IsPaused(id string) bool
+	// Iterate runs the given iteration of the given watcher, unless it is paused. Returns whether the iteration was run
+	Iterate(id string, iteration func()) bool
+	// AwaitIteration blocks until the iteration of the given watcher in flight, if any, is finished
+	AwaitIteration(id string)
+	// RegisterSimulator registers the simulator of the given watcher
+	RegisterSimulator(id string, simulator Simulator)
+	// Simulate dry-runs the processing of the given block range by the given watcher
+	Simulate(id string, from, to int64) ([]*watcher.SimResult, error)
+	// RegisterCheckpointResetter registers the checkpoint resetter of the given watcher
+	RegisterCheckpointResetter(id string, resetter CheckpointResetter)
+	// ResetCheckpoint lets the given watcher drop its progress ahead of its overridden checkpoint
+	ResetCheckpoint(id string)
+	// DenyAsset adds the given asset on the given network to the runtime deny-list
+	DenyAsset(chainId uint64, asset string)
+	// AllowAsset removes the given asset on the given network from the runtime deny-list.
+	// Returns false if the asset was not denied
+	AllowAsset(chainId uint64, asset string) bool
+	// IsAssetDenied returns whether transfers of the given asset on the given network are denied
+	IsAssetDenied(chainId uint64, asset string) bool
+	// DeniedAssets returns the denied assets, grouped by network
+	DeniedAssets() map[uint64][]string
+}
+
+// Simulator is implemented by the watchers, which support a dry-run of their processing
+type Simulator interface {
+	// Simulate processes the events in the given block range, without any side effects
+	Simulate(from, to int64) ([]*watcher.SimResult, error)
+}
+
+// CheckpointResetter is implemented by the watchers, which keep progress ahead of their persisted checkpoint
+type CheckpointResetter interface {
+	// ResetCheckpoint drops the progress ahead of the persisted checkpoint, so that the watcher continues from it
+	ResetCheckpoint()
+}
@@ -17,6 +17,7 @@
 package big_numbers
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 )
@@ -38,3 +39,74 @@ func Max(x, y uint64) uint64 {
 	}
 	return x
 }
+
+// ParseAmount parses a non-negative integer amount, e.g. a stored transfer amount.
+// Leading zeros are accepted. Signs, whitespace, fractions and other bases are rejected
+func ParseAmount(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, errors.New("amount is empty")
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("amount [%s] is not a non-negative integer", value)
+		}
+	}
+
+	amount, _ := new(big.Int).SetString(value, 10)
+	return amount, nil
+}
+
+// CanonicalAmount returns the canonical form of the amount - its decimal digits without leading zeros
+func CanonicalAmount(value string) (string, error) {
+	amount, err := ParseAmount(value)
+	if err != nil {
+		return "", err
+	}
+
+	return amount.String(), nil
+}
+
+// CompareAmounts compares the amounts numerically. Returns -1, 0 or +1, the same as big.Int.Cmp
+func CompareAmounts(x, y string) (int, error) {
+	xAmount, err := ParseAmount(x)
+	if err != nil {
+		return 0, err
+	}
+	yAmount, err := ParseAmount(y)
+	if err != nil {
+		return 0, err
+	}
+
+	return xAmount.Cmp(yAmount), nil
+}
+
+// SumAmounts returns the sum of the amounts. Fails on the first invalid amount
+func SumAmounts(values ...string) (*big.Int, error) {
+	sum := new(big.Int)
+	for _, value := range values {
+		amount, err := ParseAmount(value)
+		if err != nil {
+			return nil, err
+		}
+		sum.Add(sum, amount)
+	}
+
+	return sum, nil
+}
+
+// SubtractAmounts returns the canonical form of x - y. Fails if y is greater than x
+func SubtractAmounts(x, y string) (string, error) {
+	xAmount, err := ParseAmount(x)
+	if err != nil {
+		return "", err
+	}
+	yAmount, err := ParseAmount(y)
+	if err != nil {
+		return "", err
+	}
+	if yAmount.Cmp(xAmount) > 0 {
+		return "", fmt.Errorf("amount [%s] is less than the subtracted [%s]", x, y)
+	}
+
+	return xAmount.Sub(xAmount, yAmount).String(), nil
+}
@@ -37,3 +37,79 @@ func Test_ToBigIntError(t *testing.T) {
 	_, err := ToBigInt(notValidNumber)
 	assert.Error(t, err)
 }
+
+func Test_ParseAmount(t *testing.T) {
+	large := "123456789012345678901234567890123456789"
+	expected, _ := new(big.Int).SetString(large, 10)
+
+	actual, err := ParseAmount(large)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+
+	actual, err = ParseAmount("000100")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), actual)
+
+	actual, err = ParseAmount("0")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, actual.Sign())
+}
+
+func Test_ParseAmount_Invalid(t *testing.T) {
+	for _, value := range []string{"", "-1", "+1", " 1", "1.5", "1e18", "0x10", "1_000", notValidNumber} {
+		actual, err := ParseAmount(value)
+		assert.Error(t, err, value)
+		assert.Nil(t, actual, value)
+	}
+}
+
+func Test_CanonicalAmount(t *testing.T) {
+	actual, err := CanonicalAmount("0000")
+	assert.Nil(t, err)
+	assert.Equal(t, "0", actual)
+
+	actual, err = CanonicalAmount("00012345678901234567890")
+	assert.Nil(t, err)
+	assert.Equal(t, "12345678901234567890", actual)
+
+	_, err = CanonicalAmount("12a")
+	assert.Error(t, err)
+}
+
+func Test_CompareAmounts(t *testing.T) {
+	actual, err := CompareAmounts("0100", "100")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, actual)
+
+	// compared as strings, "9" would be greater
+	actual, err = CompareAmounts("9", "10000000000000000000000")
+	assert.Nil(t, err)
+	assert.Equal(t, -1, actual)
+
+	_, err = CompareAmounts("1", "one")
+	assert.Error(t, err)
+}
+
+func Test_SumAmounts(t *testing.T) {
+	expected, _ := new(big.Int).SetString("18446744073709551616", 10)
+
+	actual, err := SumAmounts("18446744073709551615", "01")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+
+	actual, err = SumAmounts("1", "", "2")
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_SubtractAmounts(t *testing.T) {
+	actual, err := SubtractAmounts("100000000000000000000", "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "99999999999999999999", actual)
+
+	_, err = SubtractAmounts("1", "2")
+	assert.Error(t, err)
+
+	_, err = SubtractAmounts("1", "-1")
+	assert.Error(t, err)
+}
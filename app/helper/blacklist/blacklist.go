@@ -20,11 +20,14 @@ import (
 	"fmt"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 )
 
+// IsBlacklistedAccount checks whether the account is blacklisted.
+// Hedera accounts are compared in their normalized form.
 func IsBlacklistedAccount(blacklistedAccounts []string, account string) bool {
 	for _, blacklisted := range blacklistedAccounts {
-		if blacklisted == account {
+		if hederahelper.SameAccount(blacklisted, account) {
 			return true
 		}
 	}
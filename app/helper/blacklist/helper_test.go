@@ -30,6 +30,13 @@ func Test_IsBlacklistedAccount(t *testing.T) {
 	assert.False(t, IsBlacklistedAccount(blacklist, "0x000002"))
 }
 
+func Test_IsBlacklistedAccount_NormalizesHederaAccounts(t *testing.T) {
+	blacklist := []string{"0.0.1234-vfmkw"}
+	assert.True(t, IsBlacklistedAccount(blacklist, "0.0.1234"))
+	assert.True(t, IsBlacklistedAccount(blacklist, "0.0.01234"))
+	assert.False(t, IsBlacklistedAccount(blacklist, "0.1.1234"))
+}
+
 func Test_CheckNFTTxForBlacklistedAccounts(t *testing.T) {
 	tx := setupTX()
 	tx.NftTransfers = []transaction.NftTransfer{
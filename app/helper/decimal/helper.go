@@ -17,11 +17,29 @@
 package decimal
 
 import (
-	"github.com/shopspring/decimal"
-	"math"
+	"errors"
+	"fmt"
 	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// Rounding policies, applied when an amount is scaled down to fewer decimals
+const (
+	// RoundingTruncate truncates the digits, which are not representable with the target decimals
+	RoundingTruncate = "truncate"
+	// RoundingReject rejects amounts, whose truncated digits are not all zero
+	RoundingReject = "reject"
 )
 
+// ErrRoundingRemainder is returned, when scaling down with RoundingReject would lose a part of the amount
+var ErrRoundingRemainder = errors.New("amount is not representable without a remainder")
+
+// IsValidRounding checks whether the given rounding policy is supported. Empty defaults to RoundingTruncate
+func IsValidRounding(rounding string) bool {
+	return rounding == "" || rounding == RoundingTruncate || rounding == RoundingReject
+}
+
 // ToLowestDenomination decimal amount to the lowest denomination
 func ToLowestDenomination(amount decimal.Decimal, decimals uint8) *big.Int {
 
@@ -44,23 +62,52 @@ func ParseAmount(amount string) (result *decimal.Decimal, err error) {
 	return &newResult, err
 }
 
-// TargetAmount converts the provided source amount to a target amount,
-// based on the source & destination decimals
-// Example: sourceDecimals 8, targetDecimals 8, source amount 1 000 => target amount 1 000
-// Example: sourceDecimals 9, targetDecimals 8, source amount 1 000 => target amount 100
-// Example: sourceDecimals 8, targetDecimals 9, source amount 1 000 => target amount 10 000
-func TargetAmount(sourceDecimals uint8, targetDecimals uint8, sourceAmount *big.Int) *big.Int {
-	if sourceDecimals == targetDecimals {
-		return sourceAmount
-	} else if sourceDecimals > targetDecimals {
-		power := int(sourceDecimals - targetDecimals)
-		divider := big.NewInt(int64(math.Pow10(power)))
-
-		return new(big.Int).Div(sourceAmount, divider)
+// AdjustDecimals converts the provided amount from the given decimals to the given decimals.
+// Scaling down truncates the digits, which are not representable with the target decimals.
+// Returns an error if the adjusted amount is zero, so that amounts, rounding to zero, are not transferred.
+// Example: fromDecimals 8, toDecimals 8, amount 1 000 => 1 000
+// Example: fromDecimals 9, toDecimals 8, amount 1 000 => 100
+// Example: fromDecimals 8, toDecimals 9, amount 1 000 => 10 000
+// Example: fromDecimals 18, toDecimals 8, amount 1 000 => error
+func AdjustDecimals(amount *big.Int, fromDecimals, toDecimals uint8) (*big.Int, error) {
+	return AdjustDecimalsWithRounding(amount, fromDecimals, toDecimals, RoundingTruncate)
+}
+
+// AdjustDecimalsWithRounding converts the provided amount from the given decimals to the given decimals,
+// scaling down with the given rounding policy. With RoundingReject, an amount, which would lose a remainder
+// when scaled down, returns an error wrapping ErrRoundingRemainder.
+// Example: fromDecimals 9, toDecimals 8, amount 1 000, RoundingReject => 100
+// Example: fromDecimals 9, toDecimals 8, amount 1 001, RoundingReject => error
+func AdjustDecimalsWithRounding(amount *big.Int, fromDecimals, toDecimals uint8, rounding string) (*big.Int, error) {
+	adjusted := new(big.Int).Set(amount)
+	if fromDecimals > toDecimals {
+		remainder := new(big.Int)
+		adjusted.QuoRem(adjusted, pow10(fromDecimals-toDecimals), remainder)
+		if rounding == RoundingReject && remainder.Sign() != 0 {
+			return nil, fmt.Errorf("amount [%s] with [%d] decimals loses [%s] with [%d] decimals: %w", amount, fromDecimals, remainder, toDecimals, ErrRoundingRemainder)
+		}
+	} else if toDecimals > fromDecimals {
+		adjusted.Mul(adjusted, pow10(toDecimals-fromDecimals))
+	}
+
+	if adjusted.Sign() == 0 {
+		return nil, fmt.Errorf("amount [%s] with [%d] decimals is zero with [%d] decimals", amount, fromDecimals, toDecimals)
 	}
 
-	power := int(targetDecimals - sourceDecimals)
-	multiplier := big.NewInt(int64(math.Pow10(power)))
+	return adjusted, nil
+}
+
+// TruncatedRemainder returns the part of the provided amount, which is lost when it is truncated from the given decimals
+// to the given decimals. It is zero when the amount is not scaled down.
+// Example: fromDecimals 10, toDecimals 8, amount 1 999 => 99
+// Example: fromDecimals 8, toDecimals 10, amount 1 999 => 0
+func TruncatedRemainder(amount *big.Int, fromDecimals, toDecimals uint8) *big.Int {
+	if fromDecimals <= toDecimals {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Rem(amount, pow10(fromDecimals-toDecimals))
+}
 
-	return new(big.Int).Mul(sourceAmount, multiplier)
+func pow10(exponent uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
 }
@@ -64,33 +64,103 @@ func Test_ParseAmount_EmptyString(t *testing.T) {
 	assert.Equal(t, &zeroDecimalAmount, result)
 }
 
-func Test_TargetAmount_SourceMoreThanTargetDecimals(t *testing.T) {
+func Test_AdjustDecimals_ScaleDown(t *testing.T) {
 	amount := big.NewInt(1_000_000_000)
 	divider := big.NewInt(int64(math.Pow10(int(targetDecimals - sourceDecimals))))
 	expectedAmount := new(big.Int).Div(amount, divider)
 
-	result := TargetAmount(targetDecimals, sourceDecimals, amount)
+	result, err := AdjustDecimals(amount, targetDecimals, sourceDecimals)
 
+	assert.Nil(t, err)
 	assert.Equal(t, expectedAmount, result)
 }
 
-func Test_TargetAmount_SourceMoreThanTargetDecimals_EqualsZero(t *testing.T) {
-	result := TargetAmount(targetDecimals, sourceDecimals, amount)
+func Test_AdjustDecimals_ScaleDown_Truncates(t *testing.T) {
+	result, err := AdjustDecimals(big.NewInt(1_999), 10, 8)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(19), result)
+}
+
+func Test_AdjustDecimals_ScaleDown_RoundsToZero(t *testing.T) {
+	result, err := AdjustDecimals(amount, targetDecimals, sourceDecimals)
 
-	assert.Equal(t, big.NewInt(0), result)
+	assert.Error(t, err)
+	assert.Nil(t, result)
 }
 
-func Test_TargetAmount_EqualDecimals(t *testing.T) {
-	result := TargetAmount(targetDecimals, targetDecimals, amount)
+func Test_AdjustDecimals_EqualDecimals(t *testing.T) {
+	result, err := AdjustDecimals(amount, targetDecimals, targetDecimals)
 
+	assert.Nil(t, err)
 	assert.Equal(t, amount, result)
+	assert.NotSame(t, amount, result)
+}
+
+func Test_AdjustDecimals_ZeroAmount(t *testing.T) {
+	result, err := AdjustDecimals(big.NewInt(0), targetDecimals, targetDecimals)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
 }
 
-func Test_TargetAmount_SourceLessThanTargetDecimals(t *testing.T) {
+func Test_AdjustDecimals_ScaleUp(t *testing.T) {
 	multiplier := big.NewInt(int64(math.Pow10(int(targetDecimals - sourceDecimals))))
 	expected := new(big.Int).Mul(amount, multiplier)
 
-	result := TargetAmount(sourceDecimals, targetDecimals, amount)
+	result, err := AdjustDecimals(amount, sourceDecimals, targetDecimals)
 
+	assert.Nil(t, err)
 	assert.Equal(t, expected, result)
 }
+
+func Test_AdjustDecimals_ScaleUp_BeyondInt64(t *testing.T) {
+	expected, _ := new(big.Int).SetString("10000000000000000000000000", 10)
+
+	result, err := AdjustDecimals(amount, 0, 24)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func Test_AdjustDecimalsWithRounding_Truncate(t *testing.T) {
+	result, err := AdjustDecimalsWithRounding(big.NewInt(1_999), 10, 8, RoundingTruncate)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(19), result)
+}
+
+func Test_AdjustDecimalsWithRounding_Reject_Remainder(t *testing.T) {
+	result, err := AdjustDecimalsWithRounding(big.NewInt(1_999), 10, 8, RoundingReject)
+
+	assert.ErrorIs(t, err, ErrRoundingRemainder)
+	assert.Nil(t, result)
+}
+
+func Test_AdjustDecimalsWithRounding_Reject_NoRemainder(t *testing.T) {
+	result, err := AdjustDecimalsWithRounding(big.NewInt(1_900), 10, 8, RoundingReject)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(19), result)
+}
+
+func Test_AdjustDecimalsWithRounding_Reject_ScaleUp(t *testing.T) {
+	result, err := AdjustDecimalsWithRounding(big.NewInt(19), 8, 10, RoundingReject)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1_900), result)
+}
+
+func Test_TruncatedRemainder(t *testing.T) {
+	assert.Equal(t, big.NewInt(99), TruncatedRemainder(big.NewInt(1_999), 10, 8))
+	assert.Equal(t, big.NewInt(0), TruncatedRemainder(big.NewInt(1_900), 10, 8))
+	assert.Equal(t, big.NewInt(0), TruncatedRemainder(big.NewInt(1_999), 8, 8))
+	assert.Equal(t, big.NewInt(0), TruncatedRemainder(big.NewInt(1_999), 8, 10))
+}
+
+func Test_IsValidRounding(t *testing.T) {
+	assert.True(t, IsValidRounding(""))
+	assert.True(t, IsValidRounding(RoundingTruncate))
+	assert.True(t, IsValidRounding(RoundingReject))
+	assert.False(t, IsValidRounding("round-half-up"))
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"github.com/gookit/event"
 	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
+	transfer_event "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer-event"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
@@ -34,3 +35,38 @@ func GetBridgeCfgUpdateEventParams(e event.Event) (*bridge_config_event.Params,
 	}
 	return params, nil
 }
+
+// OnTransferEvent registers a listener for the given transfer lifecycle event.
+// Multiple listeners can be registered for the same event. Use "transfer.*" to listen for all of them.
+func OnTransferEvent(name string, listener func(params *transfer_event.Params) error) {
+	event.On(name, event.ListenerFunc(func(e event.Event) error {
+		params, err := GetTransferEventParams(e)
+		if err != nil {
+			return err
+		}
+		return listener(params)
+	}), constants.HandlerEventPriority)
+}
+
+// FireTransferEvent notifies the registered listeners of the given transfer lifecycle event.
+// Listener errors are logged and do not affect the caller.
+func FireTransferEvent(name string, sourceChainID uint64, transactionID, status string) {
+	err, _ := event.Fire(name, event.M{constants.TransferEventParamsKey: &transfer_event.Params{
+		SourceChainID: sourceChainID,
+		TransactionID: transactionID,
+		Status:        status,
+	}})
+	if err != nil {
+		log.Errorf("[%s] - Listener of event [%s] failed. Error: [%s]", transactionID, name, err)
+	}
+}
+
+func GetTransferEventParams(e event.Event) (*transfer_event.Params, error) {
+	params, ok := e.Get(constants.TransferEventParamsKey).(*transfer_event.Params)
+	if !ok {
+		errMsg := fmt.Sprintf("failed to cast params from event [%s]", e.Name())
+		log.Errorf(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return params, nil
+}
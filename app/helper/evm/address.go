@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NormalizeAddress returns the canonical EIP-55 checksummed form of the given EVM address,
+// so that the same address always compares equal, regardless of its casing. Surrounding whitespace is dropped
+// and the 0x prefix is added, if missing. Returns an error if the address is malformed.
+func NormalizeAddress(address string) (string, error) {
+	trimmed := strings.TrimSpace(address)
+	if !common.IsHexAddress(trimmed) {
+		return "", fmt.Errorf("invalid EVM address [%s]", address)
+	}
+
+	return common.HexToAddress(trimmed).String(), nil
+}
+
+// SameAddress checks whether the given addresses are equal, once normalized.
+// Falls back to comparing them as they are, if either of them is not a valid EVM address.
+func SameAddress(a, b string) bool {
+	normalizedA, err := NormalizeAddress(a)
+	if err != nil {
+		return a == b
+	}
+	normalizedB, err := NormalizeAddress(b)
+	if err != nil {
+		return a == b
+	}
+
+	return normalizedA == normalizedB
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const checksummedAddress = "0xb083879B1e10C8476802016CB12cd2F25a896691"
+
+func Test_NormalizeAddress(t *testing.T) {
+	for _, address := range []string{
+		checksummedAddress,
+		"0xb083879b1e10c8476802016cb12cd2f25a896691",
+		"0XB083879B1E10C8476802016CB12CD2F25A896691",
+		"b083879b1e10c8476802016cb12cd2f25a896691",
+		" 0xb083879b1e10c8476802016cb12cd2f25a896691 ",
+	} {
+		actual, err := NormalizeAddress(address)
+		assert.Nil(t, err, address)
+		assert.Equal(t, checksummedAddress, actual, address)
+	}
+}
+
+func Test_NormalizeAddress_Malformed(t *testing.T) {
+	for _, address := range []string{"", "0x1234", "0.0.1234", "0xb083879b1e10c8476802016cb12cd2f25a89669z"} {
+		_, err := NormalizeAddress(address)
+		assert.Error(t, err, address)
+	}
+}
+
+func Test_SameAddress(t *testing.T) {
+	assert.True(t, SameAddress(checksummedAddress, "0xb083879b1e10c8476802016cb12cd2f25a896691"))
+	assert.True(t, SameAddress("b083879b1e10c8476802016cb12cd2f25a896691", checksummedAddress))
+	assert.False(t, SameAddress(checksummedAddress, "0x0000000000000000000000000000000000000001"))
+	assert.True(t, SameAddress("0.0.1234", "0.0.1234"))
+	assert.False(t, SameAddress("0.0.1234", checksummedAddress))
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+)
+
+// NormalizeAccount returns the canonical `shard.realm.num` form of the given Hedera account,
+// so that the same account always compares equal. Surrounding whitespace and checksums are dropped.
+// Returns an error if the account is malformed.
+func NormalizeAccount(account string) (string, error) {
+	accountID, err := hedera.AccountIDFromString(strings.TrimSpace(account))
+	if err != nil {
+		return "", fmt.Errorf("invalid Hedera account [%s]: %w", account, err)
+	}
+
+	return accountID.String(), nil
+}
+
+// SameAccount checks whether the given accounts are equal, once normalized.
+// Falls back to comparing them as they are, if either of them is not a valid Hedera account.
+func SameAccount(a, b string) bool {
+	normalizedA, err := NormalizeAccount(a)
+	if err != nil {
+		return a == b
+	}
+	normalizedB, err := NormalizeAccount(b)
+	if err != nil {
+		return a == b
+	}
+
+	return normalizedA == normalizedB
+}
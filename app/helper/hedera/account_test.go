@@ -0,0 +1,52 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NormalizeAccount(t *testing.T) {
+	for _, account := range []string{"0.0.1234", " 0.0.1234 ", "0.0.01234", "0.0.1234-vfmkw"} {
+		actual, err := NormalizeAccount(account)
+		assert.Nil(t, err, account)
+		assert.Equal(t, "0.0.1234", actual, account)
+	}
+}
+
+func Test_NormalizeAccount_ShardAndRealm(t *testing.T) {
+	actual, err := NormalizeAccount("1.2.1234")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.2.1234", actual)
+}
+
+func Test_NormalizeAccount_Malformed(t *testing.T) {
+	for _, account := range []string{"", "1234", "0.01234", "0.0.abc", "0.0.-1", "0.0.1234.5", "0x1234"} {
+		_, err := NormalizeAccount(account)
+		assert.Error(t, err, account)
+	}
+}
+
+func Test_SameAccount(t *testing.T) {
+	assert.True(t, SameAccount("0.0.1234", "0.0.1234-vfmkw"))
+	assert.True(t, SameAccount("0.0.1234", "0.0.01234"))
+	assert.False(t, SameAccount("0.0.1234", "0.1.1234"))
+	assert.True(t, SameAccount("0x1234", "0x1234"))
+	assert.False(t, SameAccount("0x1234", "0.0.1234"))
+}
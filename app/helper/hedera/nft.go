@@ -30,6 +30,7 @@ func ScheduledNftTxExecutionCallbacks(
 	transferRepository repository.Transfer,
 	scheduleRepository repository.Schedule,
 	logger *log.Entry,
+	sourceChainId uint64,
 	id string,
 	hasReceiver bool,
 	statusResult *string,
@@ -50,6 +51,7 @@ func ScheduledNftTxExecutionCallbacks(
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			defer wg.Done()
@@ -73,13 +75,14 @@ func ScheduledNftTxExecutionCallbacks(
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			logger.Errorf("[%s] - Failed to update status failed. Error [%s].", id, err)
 			return
 		}
 
-		err = transferRepository.UpdateStatusFailed(id)
+		err = transferRepository.UpdateStatusFailed(sourceChainId, id)
 		if err != nil {
 			logger.Errorf("[%s] - Failed to update status failed. Error [%s].", id, err)
 			return
@@ -93,6 +96,7 @@ func ScheduledNftTxMinedCallbacks(
 	transferRepository repository.Transfer,
 	scheduleRepository repository.Schedule,
 	logger *log.Entry,
+	sourceChainId uint64,
 	id string,
 	status *string,
 	wg *sync.WaitGroup,
@@ -100,7 +104,7 @@ func ScheduledNftTxMinedCallbacks(
 	onSuccess = func(transactionID string) {
 		defer wg.Done()
 		logger.Debugf("[%s] - Scheduled TX execution successful.", id)
-		err := transferRepository.UpdateStatusCompleted(id)
+		err := transferRepository.UpdateStatusCompleted(sourceChainId, id)
 		if err != nil {
 			*status = syncHelper.FAIL
 			logger.Errorf("[%s] - Failed to update status completed. Error [%s].", id, err)
@@ -125,7 +129,7 @@ func ScheduledNftTxMinedCallbacks(
 			return
 		}
 
-		err = transferRepository.UpdateStatusFailed(id)
+		err = transferRepository.UpdateStatusFailed(sourceChainId, id)
 		if err != nil {
 			logger.Errorf("[%s] - Failed to update status failed. Error [%s].", transactionID, err)
 			return
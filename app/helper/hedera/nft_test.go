@@ -44,15 +44,16 @@ var (
 			String: transactionId,
 			Valid:  true,
 		},
+		TransferSourceChainID: sourceChainId,
 	}
 	createdScheduleOnError = *createdScheduleOnSuccess
-	error                  = errors.New("some-error")
+	someError              = errors.New("some-error")
 )
 
 func Test_ScheduledNftTxExecutionCallbacks(t *testing.T) {
 	setupNftTest(true)
 
-	onSuccess, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, true, statusResult, schedule.TRANSFER, wg)
+	onSuccess, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, true, statusResult, schedule.TRANSFER, wg)
 
 	onSuccess(transactionId, scheduleId)
 	onFail(transactionId)
@@ -61,9 +62,9 @@ func Test_ScheduledNftTxExecutionCallbacks(t *testing.T) {
 func Test_ScheduledNftTxExecutionCallbacks_ErrScheduleCreateOnSuccess(t *testing.T) {
 	setupNftTest(false)
 
-	mocks.MScheduleRepository.On("Create", createdScheduleOnSuccess).Return(error)
+	mocks.MScheduleRepository.On("Create", createdScheduleOnSuccess).Return(someError)
 
-	onSuccess, _ := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, true, statusResult, schedule.TRANSFER, wg)
+	onSuccess, _ := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, true, statusResult, schedule.TRANSFER, wg)
 
 	onSuccess(transactionId, scheduleId)
 }
@@ -71,9 +72,9 @@ func Test_ScheduledNftTxExecutionCallbacks_ErrScheduleCreateOnSuccess(t *testing
 func Test_ScheduledNftTxExecutionCallbacks_ErrScheduleCreateOnFail(t *testing.T) {
 	setupNftTest(false)
 	updateFieldsForCreatedScheduleOnError()
-	mocks.MScheduleRepository.On("Create", &createdScheduleOnError).Return(error)
+	mocks.MScheduleRepository.On("Create", &createdScheduleOnError).Return(someError)
 
-	_, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, true, statusResult, schedule.TRANSFER, wg)
+	_, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, true, statusResult, schedule.TRANSFER, wg)
 
 	onFail(transactionId)
 }
@@ -82,21 +83,21 @@ func Test_ScheduledNftTxExecutionCallbacks_ErrUpdateStatusFailedOnFail(t *testin
 	setupNftTest(false)
 	updateFieldsForCreatedScheduleOnError()
 	mocks.MScheduleRepository.On("Create", &createdScheduleOnError).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(error)
+	mocks.MTransferRepository.On("UpdateStatusFailed", sourceChainId, transactionId).Return(someError)
 
-	_, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, true, statusResult, schedule.TRANSFER, wg)
+	_, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, true, statusResult, schedule.TRANSFER, wg)
 
 	onFail(transactionId)
 }
 
 func Test_ScheduledNftTxMinedCallbacks(t *testing.T) {
 	setupNftTest(true)
-	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", sourceChainId, transactionId).Return(nil)
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", transactionId).Return(nil)
 	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(nil)
 	wg.Add(1)
 
-	onSuccess, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
+	onSuccess, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, statusResult, wg)
 
 	onSuccess(transactionId)
 	onFail(transactionId)
@@ -104,31 +105,31 @@ func Test_ScheduledNftTxMinedCallbacks(t *testing.T) {
 
 func Test_ScheduledNftTxMinedCallbacks_ErrTransferUpdateStatusCompletedOnSuccess(t *testing.T) {
 	setupNftTest(true)
-	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(error)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", sourceChainId, transactionId).Return(someError)
 	wg.Add(1)
 
-	onSuccess, _ := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
+	onSuccess, _ := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, statusResult, wg)
 
 	onSuccess(transactionId)
 }
 
 func Test_ScheduledNftTxMinedCallbacks_ErrScheduleUpdateStatusCompletedOnSuccess(t *testing.T) {
 	setupNftTest(true)
-	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(nil)
-	mocks.MScheduleRepository.On("UpdateStatusCompleted", transactionId).Return(error)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", sourceChainId, transactionId).Return(nil)
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", transactionId).Return(someError)
 	wg.Add(1)
 
-	onSuccess, _ := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
+	onSuccess, _ := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, statusResult, wg)
 
 	onSuccess(transactionId)
 }
 
 func Test_ScheduledNftTxMinedCallbacks_ErrScheduleUpdateStatusCompletedOnFail(t *testing.T) {
 	setupNftTest(true)
-	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(error)
+	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(someError)
 	wg.Add(1)
 
-	_, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
+	_, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, statusResult, wg)
 
 	onFail(transactionId)
 }
@@ -136,10 +137,10 @@ func Test_ScheduledNftTxMinedCallbacks_ErrScheduleUpdateStatusCompletedOnFail(t
 func Test_ScheduledNftTxMinedCallbacks_ErrTransferUpdateStatusCompletedOnFail(t *testing.T) {
 	setupNftTest(false)
 	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(error)
+	mocks.MTransferRepository.On("UpdateStatusFailed", sourceChainId, transactionId).Return(someError)
 	wg.Add(1)
 
-	_, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
+	_, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, sourceChainId, transactionId, statusResult, wg)
 
 	onFail(transactionId)
 }
@@ -155,7 +156,7 @@ func setupNftTest(withMocks bool) {
 		updateFieldsForCreatedScheduleOnError()
 		mocks.MScheduleRepository.On("Create", createdScheduleOnSuccess).Return(nil).Once()
 		mocks.MScheduleRepository.On("Create", &createdScheduleOnError).Return(nil).Once()
-		mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(nil)
+		mocks.MTransferRepository.On("UpdateStatusFailed", sourceChainId, transactionId).Return(nil)
 	}
 }
 
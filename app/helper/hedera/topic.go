@@ -0,0 +1,42 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/shard"
+)
+
+// TopicIDsFromStrings parses the given topic ids, failing on the first invalid one
+func TopicIDsFromStrings(topicIds []string) ([]hedera.TopicID, error) {
+	res := make([]hedera.TopicID, 0, len(topicIds))
+	for _, topicId := range topicIds {
+		topicID, err := hedera.TopicIDFromString(topicId)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, topicID)
+	}
+
+	return res, nil
+}
+
+// SignatureTopic returns the topic, to which the signatures of the given transfer are submitted.
+// Transfers are sharded across the given topics by their id, so that every validator picks the same topic for a transfer
+func SignatureTopic(transferId string, topics []hedera.TopicID) hedera.TopicID {
+	return topics[shard.Of(transferId, uint64(len(topics)))]
+}
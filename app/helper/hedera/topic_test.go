@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TopicIDsFromStrings(t *testing.T) {
+	res, err := TopicIDsFromStrings([]string{"0.0.1", "0.0.2"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []hedera.TopicID{{Topic: 1}, {Topic: 2}}, res)
+}
+
+func Test_TopicIDsFromStrings_Invalid(t *testing.T) {
+	res, err := TopicIDsFromStrings([]string{"0.0.1", "0.01"})
+
+	assert.Error(t, err)
+	assert.Nil(t, res)
+}
+
+func Test_SignatureTopic_SingleTopic(t *testing.T) {
+	topics := []hedera.TopicID{{Topic: 1}}
+
+	assert.Equal(t, topics[0], SignatureTopic("0x1-0", topics))
+	assert.Equal(t, topics[0], SignatureTopic("0x2-0", topics))
+}
+
+func Test_SignatureTopic_ShardsAcrossTopics(t *testing.T) {
+	topics := []hedera.TopicID{{Topic: 1}, {Topic: 2}, {Topic: 3}}
+	used := make(map[hedera.TopicID]bool)
+	for i := 0; i < 100; i++ {
+		transferId := fmt.Sprintf("0x%d-0", i)
+		topic := SignatureTopic(transferId, topics)
+		assert.Equal(t, topic, SignatureTopic(transferId, topics))
+		used[topic] = true
+	}
+
+	assert.Len(t, used, len(topics))
+}
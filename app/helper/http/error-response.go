@@ -32,7 +32,7 @@ func WriteErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
 	case service.ErrNotFound:
 		render.Status(r, http.StatusNotFound)
 		render.JSON(w, r, response.ErrorResponse(err))
-	case service.ErrWrongQuery:
+	case service.ErrWrongQuery, service.ErrAmbiguousTransfer:
 		render.Status(r, http.StatusBadRequest)
 		render.JSON(w, r, response.ErrorResponse(err))
 	default:
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+)
+
+// SourceChainId returns the source chain of the given transfer from the optional sourceChainId query parameter
+// of the request. The source chain is resolved from the transfer ID, when the parameter is omitted
+func SourceChainId(r *http.Request, transferID string, resolve func(transferID string) (uint64, error)) (uint64, error) {
+	s := r.URL.Query().Get("sourceChainId")
+	if s == "" {
+		return resolve(transferID)
+	}
+
+	sourceChainId, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, service.ErrWrongQuery
+	}
+	return sourceChainId, nil
+}
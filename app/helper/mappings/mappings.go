@@ -0,0 +1,103 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mappings
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+)
+
+// fungibleAsset holds the settings of a fungible asset, which affect whether and how its transfers are processed
+type fungibleAsset struct {
+	Decimals          uint8  `json:"decimals"`
+	FeePercentage     int64  `json:"feePercentage,omitempty"`
+	MinFeeAmountInUsd string `json:"minFeeAmountInUsd,omitempty"`
+	MinAmount         string `json:"minAmount,omitempty"`
+}
+
+// snapshot is the canonical form of the effective asset mappings. Maps are marshalled with sorted keys,
+// so equal mappings always produce equal JSON.
+type snapshot struct {
+	NativeToWrapped map[uint64]map[string]map[uint64]string `json:"nativeToWrapped"`
+	Fungible        map[uint64]map[string]fungibleAsset     `json:"fungible"`
+	NonFungible     map[uint64][]string                     `json:"nonFungible"`
+}
+
+// Hash returns the hex encoded SHA-256 hash of the effective asset mappings of the given assets service.
+// Validators with equal mappings, decimals, fee settings and configured min amounts produce equal hashes.
+// The min amounts derived from USD prices are left out, as they depend on the time of the last price fetch.
+// Asset addresses are compared case-insensitively.
+func Hash(assetsService service.Assets, pricingService service.Pricing) (string, error) {
+	s := snapshot{
+		NativeToWrapped: make(map[uint64]map[string]map[uint64]string),
+		Fungible:        make(map[uint64]map[string]fungibleAsset),
+		NonFungible:     make(map[uint64][]string),
+	}
+
+	for nativeChainId, assets := range assetsService.NativeToWrappedAssets() {
+		s.NativeToWrapped[nativeChainId] = make(map[string]map[uint64]string, len(assets))
+		for native, wrapped := range assets {
+			targets := make(map[uint64]string, len(wrapped))
+			for targetChainId, asset := range wrapped {
+				targets[targetChainId] = strings.ToLower(asset)
+			}
+			s.NativeToWrapped[nativeChainId][strings.ToLower(native)] = targets
+		}
+	}
+
+	for chainId, assets := range assetsService.FungibleNetworkAssets() {
+		s.Fungible[chainId] = make(map[string]fungibleAsset, len(assets))
+		for _, asset := range assets {
+			var entry fungibleAsset
+			if info, ok := assetsService.FungibleAssetInfo(chainId, asset); ok {
+				entry.Decimals = info.Decimals
+			}
+			if native := assetsService.FungibleNativeAsset(chainId, asset); native != nil {
+				entry.FeePercentage = native.FeePercentage
+				if native.MinFeeAmountInUsd != nil {
+					entry.MinFeeAmountInUsd = native.MinFeeAmountInUsd.String()
+				}
+			}
+			if priceInfo, ok := pricingService.GetTokenPriceInfo(chainId, asset); ok && priceInfo.DefaultMinAmount != nil {
+				entry.MinAmount = priceInfo.DefaultMinAmount.String()
+			}
+			s.Fungible[chainId][strings.ToLower(asset)] = entry
+		}
+	}
+
+	for chainId, assets := range assetsService.NonFungibleNetworkAssets() {
+		sorted := make([]string, len(assets))
+		for i, asset := range assets {
+			sorted[i] = strings.ToLower(asset)
+		}
+		sort.Strings(sorted)
+		s.NonFungible[chainId] = sorted
+	}
+
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mappings
+
+import (
+	"math/big"
+	"testing"
+
+	assetModel "github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	nativeChainId  = uint64(1)
+	wrappedChainId = uint64(296)
+	nativeAsset    = "0xAbC0000000000000000000000000000000000001"
+	wrappedAsset   = "0.0.1001"
+)
+
+// mockAssets mocks the assets and pricing services with a single fungible native asset, mapped to a single wrapped asset
+func mockAssets(native string, decimals uint8, minFeeAmountInUsd string, minAmount int64) {
+	mocks.Setup()
+	minFee := decimal.RequireFromString(minFeeAmountInUsd)
+
+	mocks.MAssetsService.On("NativeToWrappedAssets").Return(map[uint64]map[string]map[uint64]string{
+		nativeChainId: {native: {wrappedChainId: wrappedAsset}},
+	})
+	mocks.MAssetsService.On("FungibleNetworkAssets").Return(map[uint64][]string{
+		nativeChainId:  {native},
+		wrappedChainId: {wrappedAsset},
+	})
+	mocks.MAssetsService.On("NonFungibleNetworkAssets").Return(map[uint64][]string{})
+	mocks.MAssetsService.On("FungibleAssetInfo", nativeChainId, native).Return(&assetModel.FungibleAssetInfo{Decimals: decimals, IsNative: true}, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", wrappedChainId, wrappedAsset).Return(&assetModel.FungibleAssetInfo{Decimals: decimals}, true)
+	mocks.MAssetsService.On("FungibleNativeAsset", nativeChainId, native).Return(&assetModel.NativeAsset{
+		MinFeeAmountInUsd: &minFee,
+		ChainId:           nativeChainId,
+		Asset:             native,
+		FeePercentage:     10000,
+	})
+	mocks.MAssetsService.On("FungibleNativeAsset", wrappedChainId, wrappedAsset).Return((*assetModel.NativeAsset)(nil))
+	mocks.MPricingService.On("GetTokenPriceInfo", nativeChainId, native).Return(pricing.TokenPriceInfo{
+		MinAmountWithFee: big.NewInt(minAmount * 2),
+		DefaultMinAmount: big.NewInt(minAmount),
+	}, true)
+	mocks.MPricingService.On("GetTokenPriceInfo", wrappedChainId, wrappedAsset).Return(pricing.TokenPriceInfo{}, false)
+}
+
+func hash(t *testing.T) string {
+	actual, err := Hash(mocks.MAssetsService, mocks.MPricingService)
+	assert.Nil(t, err)
+	return actual
+}
+
+func Test_Hash_Matching(t *testing.T) {
+	mockAssets(nativeAsset, 18, "1.5", 100)
+	expected := hash(t)
+
+	mockAssets(nativeAsset, 18, "1.5", 100)
+	assert.Equal(t, expected, hash(t))
+
+	// Addresses are compared case-insensitively
+	mockAssets("0xabc0000000000000000000000000000000000001", 18, "1.5", 100)
+	assert.Equal(t, expected, hash(t))
+}
+
+func Test_Hash_Mismatching(t *testing.T) {
+	mockAssets(nativeAsset, 18, "1.5", 100)
+	expected := hash(t)
+
+	mockAssets(nativeAsset, 8, "1.5", 100)
+	assert.NotEqual(t, expected, hash(t))
+
+	mockAssets(nativeAsset, 18, "2", 100)
+	assert.NotEqual(t, expected, hash(t))
+
+	mockAssets(nativeAsset, 18, "1.5", 200)
+	assert.NotEqual(t, expected, hash(t))
+
+	mockAssets("0xAbC0000000000000000000000000000000000002", 18, "1.5", 100)
+	assert.NotEqual(t, expected, hash(t))
+}
@@ -26,6 +26,7 @@ import (
 	"math/big"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func PrepareValueForPrometheusMetricName(value string) string {
@@ -52,6 +53,54 @@ func ConstructNameForMetric(sourceNetworkId, targetNetworkId uint64, tokenType,
 	return fmt.Sprintf("%s_%s_to_%s_%s_%s", tokenType, sourceNetworkName, targetNetworkName, transactionId, metricTarget), nil
 }
 
+// SetGauge sets the value of the gauge with the given options, creating it if it does not exist.
+// Every metric helper goes through SetGauge, IncrementCounter, AddCounter or ObserveHistogram, so that no metric is created while monitoring is disabled
+func SetGauge(opts prometheus.GaugeOpts, value float64, prometheusService service.Prometheus) {
+	if !prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	gauge := prometheusService.CreateGaugeIfNotExists(opts)
+	if gauge == nil {
+		return
+	}
+
+	gauge.Set(value)
+}
+
+// IncrementCounter increments the counter with the given options, creating it if it does not exist
+func IncrementCounter(opts prometheus.CounterOpts, prometheusService service.Prometheus) {
+	AddCounter(opts, 1, prometheusService)
+}
+
+// AddCounter adds the given non-negative value to the counter with the given options, creating it if it does not exist
+func AddCounter(opts prometheus.CounterOpts, value float64, prometheusService service.Prometheus) {
+	if !prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	counter := prometheusService.CreateCounterIfNotExists(opts)
+	if counter == nil {
+		return
+	}
+
+	counter.Add(value)
+}
+
+// ObserveHistogram adds the given observation to the histogram with the given options, creating it if it does not exist
+func ObserveHistogram(opts prometheus.HistogramOpts, value float64, prometheusService service.Prometheus) {
+	if !prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	histogram := prometheusService.CreateHistogramIfNotExists(opts)
+	if histogram == nil {
+		return
+	}
+
+	histogram.Observe(value)
+}
+
 // Success Rate Metrics //
 
 func CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId uint64, asset string, transferID string, prometheusService service.Prometheus, logger *log.Entry) prometheus.Gauge {
@@ -156,6 +205,282 @@ func SetMajorityReached(sourceChainId, targetChainId uint64, asset string, trans
 	gauge.Set(1.0)
 }
 
+// ObserveSignatureToMajorityLatency records the time it took a transfer on the given corridor to reach signature majority
+func ObserveSignatureToMajorityLatency(sourceChainId, targetChainId uint64, seconds float64, prometheusService service.Prometheus) {
+	ObserveHistogram(prometheus.HistogramOpts{
+		Name: fmt.Sprintf("%s%d_%d", constants.SignatureToMajorityHistogramNamePrefix, sourceChainId, targetChainId),
+		Help: constants.SignatureToMajorityHistogramHelp,
+		ConstLabels: prometheus.Labels{
+			constants.SourceNetworkMetricLabelKey: strconv.FormatUint(sourceChainId, 10),
+			constants.TargetNetworkMetricLabelKey: strconv.FormatUint(targetChainId, 10),
+		},
+	}, seconds, prometheusService)
+}
+
+// IncrementQueuePushes increments the counter of messages pushed to the queue for the given topic
+func IncrementQueuePushes(topic string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: constants.QueuePushesCounterNamePrefix + strings.ToLower(topic),
+		Help: constants.QueuePushesCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.QueueTopicMetricLabelKey: topic,
+		},
+	}, prometheusService)
+}
+
+// IncrementSignatureTimeouts increments the counter of transfers, failed for not reaching signature majority in time
+func IncrementSignatureTimeouts(prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: constants.SignatureTimeoutsCounterName,
+		Help: constants.SignatureTimeoutsCounterHelp,
+	}, prometheusService)
+}
+
+// IncrementUnconfirmedScheduledTransactions increments the counter of executed scheduled transactions,
+// still missing on the mirror node after the confirmation retries
+func IncrementUnconfirmedScheduledTransactions(prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: constants.UnconfirmedScheduledTransactionsCounterName,
+		Help: constants.UnconfirmedScheduledTransactionsCounterHelp,
+	}, prometheusService)
+}
+
+// SetMappingMismatches sets the number of peer validators, whose hash of the asset mappings differs from the local one
+func SetMappingMismatches(mismatches int, prometheusService service.Prometheus) {
+	SetGauge(prometheus.GaugeOpts{
+		Name: constants.MappingMismatchesGaugeName,
+		Help: constants.MappingMismatchesGaugeHelp,
+	}, float64(mismatches), prometheusService)
+}
+
+// IncrementQueueFullEvents increments the counter of messages, pushed to the in-memory queue while it was full
+func IncrementQueueFullEvents(policy string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: constants.QueueFullEventsCounterName,
+		Help: constants.QueueFullEventsCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.QueuePolicyMetricLabelKey: policy,
+		},
+	}, prometheusService)
+}
+
+// SetQueuePartitionDepth sets the number of messages of the given queue partition, awaiting dispatch
+func SetQueuePartitionDepth(partition string, depth int, prometheusService service.Prometheus) {
+	SetGauge(prometheus.GaugeOpts{
+		Name: constants.QueuePartitionDepthGaugeNamePrefix + PrepareValueForPrometheusMetricName(strings.ToLower(partition)),
+		Help: constants.QueuePartitionDepthGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.QueuePartitionMetricLabelKey: partition,
+		},
+	}, float64(depth), prometheusService)
+}
+
+// SetQueuePriorityDepth sets the number of messages of the given priority, held by the priority queue
+func SetQueuePriorityDepth(priority int, depth int, prometheusService service.Prometheus) {
+	SetGauge(prometheus.GaugeOpts{
+		Name: constants.QueuePriorityDepthGaugeNamePrefix + strconv.Itoa(priority),
+		Help: constants.QueuePriorityDepthGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.QueuePriorityMetricLabelKey: strconv.Itoa(priority),
+		},
+	}, float64(depth), prometheusService)
+}
+
+// IncrementTopicDroppedMessages increments the counter of messages of the given topic, dropped by the topic watcher for the given reason
+func IncrementTopicDroppedMessages(topicId, reason string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%s_%s", constants.TopicDroppedMessagesCounterNamePrefix, reason, PrepareValueForPrometheusMetricName(topicId)),
+		Help: constants.TopicDroppedMessagesCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.TopicMetricLabelKey:  topicId,
+			constants.ReasonMetricLabelKey: reason,
+		},
+	}, prometheusService)
+}
+
+// SetHandlerActiveWorkers sets the number of workers of the given topic, currently handling a message
+func SetHandlerActiveWorkers(topic string, active int64, prometheusService service.Prometheus) {
+	SetGauge(prometheus.GaugeOpts{
+		Name: constants.HandlerActiveWorkersGaugeNamePrefix + PrepareValueForPrometheusMetricName(strings.ToLower(topic)),
+		Help: constants.HandlerActiveWorkersGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.QueueTopicMetricLabelKey: topic,
+		},
+	}, float64(active), prometheusService)
+}
+
+// SetNotMember sets the gauge, signaling whether the validator is not in the member set for the given network
+func SetNotMember(chainId uint64, isMember bool, prometheusService service.Prometheus) {
+	value := float64(1)
+	if isMember {
+		value = 0
+	}
+	SetGauge(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s%d", constants.NotMemberGaugeNamePrefix, chainId),
+		Help: constants.NotMemberGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.NetworkMetricLabelKey: strconv.FormatUint(chainId, 10),
+		},
+	}, value, prometheusService)
+}
+
+// SetMembersStale sets the gauge, signaling whether the router members for the given network are stale
+func SetMembersStale(chainId uint64, stale bool, prometheusService service.Prometheus) {
+	value := float64(0)
+	if stale {
+		value = 1
+	}
+	SetGauge(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s%d", constants.MembersStaleGaugeNamePrefix, chainId),
+		Help: constants.MembersStaleGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.NetworkMetricLabelKey: strconv.FormatUint(chainId, 10),
+		},
+	}, value, prometheusService)
+}
+
+// SetAssetDenied sets the gauge, signaling whether the given asset on the given network is on the runtime deny-list
+func SetAssetDenied(chainId uint64, asset string, denied bool, prometheusService service.Prometheus) {
+	value := float64(0)
+	if denied {
+		value = 1
+	}
+	SetGauge(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s%d_%s", constants.AssetDeniedGaugeNamePrefix, chainId, PrepareValueForPrometheusMetricName(strings.ToLower(asset))),
+		Help: constants.AssetDeniedGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.NetworkMetricLabelKey:      strconv.FormatUint(chainId, 10),
+			constants.AssetAddressMetricLabelKey: asset,
+		},
+	}, value, prometheusService)
+}
+
+// AddRoundingLoss adds the remainder, truncated when scaling an amount of the given asset down to fewer decimals, to the rounding loss of the asset
+func AddRoundingLoss(chainId uint64, asset string, remainder *big.Int, prometheusService service.Prometheus) {
+	value, _ := new(big.Float).SetInt(remainder).Float64()
+	AddCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%d_%s", constants.RoundingLossCounterNamePrefix, chainId, PrepareValueForPrometheusMetricName(strings.ToLower(asset))),
+		Help: constants.RoundingLossCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.NetworkMetricLabelKey:      strconv.FormatUint(chainId, 10),
+			constants.AssetAddressMetricLabelKey: asset,
+		},
+	}, value, prometheusService)
+}
+
+// SetPendingSignatures sets the number of transfers awaiting the signature of the given member for longer than the timeout
+func SetPendingSignatures(member string, count int, prometheusService service.Prometheus) {
+	SetGauge(prometheus.GaugeOpts{
+		Name: constants.PendingSignaturesGaugeNamePrefix + strings.ToLower(member),
+		Help: constants.PendingSignaturesGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.MemberMetricLabelKey: member,
+		},
+	}, float64(count), prometheusService)
+}
+
+// ObserveWatcherPhaseDuration records the time elapsed since start for the given processing phase of the watcher
+func ObserveWatcherPhaseDuration(dbIdentifier, phase string, start time.Time, prometheusService service.Prometheus) {
+	ObserveHistogram(prometheus.HistogramOpts{
+		Name: fmt.Sprintf("%s%s_%s", constants.WatcherPhaseDurationHistogramNamePrefix, phase, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.WatcherPhaseDurationHistogramHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+			constants.PhaseMetricLabelKey:   phase,
+		},
+	}, time.Since(start).Seconds(), prometheusService)
+}
+
+// IncrementDroppedEvents increments the counter of events, dropped by the watcher for the given reason
+func IncrementDroppedEvents(dbIdentifier, reason string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%s_%s", constants.DroppedEventsCounterNamePrefix, reason, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.DroppedEventsCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+			constants.ReasonMetricLabelKey:  reason,
+		},
+	}, prometheusService)
+}
+
+// IncrementCheckpointGaps increments the counter of the non-contiguous checkpoint updates of the given EVM watcher
+func IncrementCheckpointGaps(dbIdentifier string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%s", constants.CheckpointGapsCounterNamePrefix, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.CheckpointGapsCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+		},
+	}, prometheusService)
+}
+
+// SetSecondsSinceLastEvent sets the time elapsed since the given EVM watcher last dispatched an event
+func SetSecondsSinceLastEvent(dbIdentifier string, since time.Duration, prometheusService service.Prometheus) {
+	SetGauge(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s%s", constants.SinceLastEventGaugeNamePrefix, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.SinceLastEventGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+		},
+	}, since.Seconds(), prometheusService)
+}
+
+// SetDeferredEvents sets the number of events, whose dispatch the given EVM watcher defers to a later poll
+func SetDeferredEvents(dbIdentifier string, count int, prometheusService service.Prometheus) {
+	SetGauge(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s%s", constants.DeferredEventsGaugeNamePrefix, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.DeferredEventsGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+		},
+	}, float64(count), prometheusService)
+}
+
+// IncrementCrossVerificationFailures increments the counter of the high-value transfers of the given EVM watcher,
+// which could not be cross-verified and were deferred
+func IncrementCrossVerificationFailures(dbIdentifier string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%s", constants.CrossVerificationFailuresCounterNamePrefix, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.CrossVerificationFailuresCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+		},
+	}, prometheusService)
+}
+
+// IncrementRouterUpgrades increments the counter of the facet changes of the router, watched by the given EVM watcher
+func IncrementRouterUpgrades(dbIdentifier string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%s", constants.RouterUpgradesCounterNamePrefix, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.RouterUpgradesCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+		},
+	}, prometheusService)
+}
+
+// IncrementReorgs increments the counter of the reorgs, after which the given EVM watcher rewound its checkpoint
+func IncrementReorgs(dbIdentifier string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%s", constants.ReorgsCounterNamePrefix, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.ReorgsCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+		},
+	}, prometheusService)
+}
+
+// IncrementReorgHalts increments the counter of the reorgs, on which the given EVM watcher was paused
+func IncrementReorgHalts(dbIdentifier string, prometheusService service.Prometheus) {
+	IncrementCounter(prometheus.CounterOpts{
+		Name: fmt.Sprintf("%s%s", constants.ReorgHaltsCounterNamePrefix, PrepareValueForPrometheusMetricName(dbIdentifier)),
+		Help: constants.ReorgHaltsCounterHelp,
+		ConstLabels: prometheus.Labels{
+			constants.WatcherMetricLabelKey: dbIdentifier,
+		},
+	}, prometheusService)
+}
+
 func AssetAddressToMetricName(assetAddress string) string {
 	replace := PrepareValueForPrometheusMetricName(assetAddress)
 	result := fmt.Sprintf("%s%s", constants.AssetMetricsNamePrefix, replace)
@@ -178,3 +503,15 @@ func ConvertBasedOnDecimal(value *big.Int, decimal uint8) (*float64, error) {
 	res, _ := new(big.Float).Set(new(big.Float).Quo(new(big.Float).SetInt(value), big.NewFloat(parseValue))).Float64()
 	return &res, nil
 }
+
+// SetOperatorBalanceLow sets the gauge, signaling whether Hedera submissions are paused due to a low operator balance
+func SetOperatorBalanceLow(low bool, prometheusService service.Prometheus) {
+	value := float64(0)
+	if low {
+		value = 1
+	}
+	SetGauge(prometheus.GaugeOpts{
+		Name: constants.OperatorBalanceLowGaugeName,
+		Help: constants.OperatorBalanceLowGaugeHelp,
+	}, value, prometheusService)
+}
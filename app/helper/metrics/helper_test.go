@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	gaugeOpts     = prometheus.GaugeOpts{Name: "gauge", Help: "gauge"}
+	counterOpts   = prometheus.CounterOpts{Name: "counter", Help: "counter"}
+	histogramOpts = prometheus.HistogramOpts{Name: "histogram", Help: "histogram"}
+)
+
+func Test_SetGauge(t *testing.T) {
+	mocks.Setup()
+	gauge := prometheus.NewGauge(gaugeOpts)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", gaugeOpts).Return(gauge)
+
+	SetGauge(gaugeOpts, 42, mocks.MPrometheusService)
+
+	assert.Equal(t, float64(42), testutil.ToFloat64(gauge))
+}
+
+func Test_IncrementCounter(t *testing.T) {
+	mocks.Setup()
+	counter := prometheus.NewCounter(counterOpts)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", counterOpts).Return(counter)
+
+	IncrementCounter(counterOpts, mocks.MPrometheusService)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_AddCounter(t *testing.T) {
+	mocks.Setup()
+	counter := prometheus.NewCounter(counterOpts)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", counterOpts).Return(counter)
+
+	AddCounter(counterOpts, 5, mocks.MPrometheusService)
+	AddCounter(counterOpts, 7, mocks.MPrometheusService)
+
+	assert.Equal(t, float64(12), testutil.ToFloat64(counter))
+}
+
+func Test_ObserveHistogram(t *testing.T) {
+	mocks.Setup()
+	histogram := prometheus.NewHistogram(histogramOpts)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateHistogramIfNotExists", histogramOpts).Return(histogram)
+
+	ObserveHistogram(histogramOpts, 3, mocks.MPrometheusService)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(histogram))
+}
+
+func Test_MonitoringDisabled(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+
+	SetGauge(gaugeOpts, 42, mocks.MPrometheusService)
+	IncrementCounter(counterOpts, mocks.MPrometheusService)
+	AddCounter(counterOpts, 5, mocks.MPrometheusService)
+	ObserveHistogram(histogramOpts, 3, mocks.MPrometheusService)
+	IncrementDroppedEvents("identifier", "reason", mocks.MPrometheusService)
+	SetOperatorBalanceLow(true, mocks.MPrometheusService)
+	SetNotMember(1, false, mocks.MPrometheusService)
+
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateGaugeIfNotExists", mock.Anything)
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateCounterIfNotExists", mock.Anything)
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateHistogramIfNotExists", mock.Anything)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sampling
+
+import (
+	"math/big"
+	"sync/atomic"
+)
+
+// Sampler samples the routine per-transfer log lines of the watchers, logging 1 in every rate of them.
+// Only routine lines go through the Sampler, so warnings and errors are always logged.
+// The log lines of high-value transfers are always logged as well. A nil Sampler logs every line.
+type Sampler struct {
+	rate uint64
+	// Transfers with an amount of at least minimum amount * highValueMultiplier are always logged. Zero disables the exception
+	highValueMultiplier uint64
+	count               uint64
+}
+
+// New returns a Sampler, logging 1 in every rate routine log lines. Rates of zero and one log every line
+func New(rate, highValueMultiplier uint64) *Sampler {
+	return &Sampler{
+		rate:                rate,
+		highValueMultiplier: highValueMultiplier,
+	}
+}
+
+// Skip returns whether to skip the routine log line of a transfer with the given amount.
+// Nil amount or minimum amount mark lines, for which the value of the transfer is unknown.
+func (s *Sampler) Skip(amount, minAmount *big.Int) bool {
+	if s == nil || s.rate <= 1 || s.isHighValue(amount, minAmount) {
+		return false
+	}
+
+	return (atomic.AddUint64(&s.count, 1)-1)%s.rate != 0
+}
+
+func (s *Sampler) isHighValue(amount, minAmount *big.Int) bool {
+	if s.highValueMultiplier == 0 || amount == nil || minAmount == nil || minAmount.Sign() <= 0 {
+		return false
+	}
+
+	threshold := new(big.Int).Mul(minAmount, new(big.Int).SetUint64(s.highValueMultiplier))
+	return amount.Cmp(threshold) >= 0
+}
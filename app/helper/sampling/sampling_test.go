@@ -0,0 +1,65 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sampling
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// logged returns the indices of the lines, which are not skipped out of the given number of routine lines
+func logged(s *Sampler, lines int, amount, minAmount *big.Int) []int {
+	var indices []int
+	for i := 0; i < lines; i++ {
+		if !s.Skip(amount, minAmount) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+func Test_Skip_HonorsRate(t *testing.T) {
+	s := New(3, 0)
+
+	assert.Equal(t, []int{0, 3, 6}, logged(s, 9, nil, nil))
+}
+
+func Test_Skip_NeverSkipsHighValueTransfers(t *testing.T) {
+	s := New(10, 5)
+	minAmount := big.NewInt(100)
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, logged(s, 5, big.NewInt(500), minAmount))
+	assert.Equal(t, []int{0}, logged(s, 2, big.NewInt(499), minAmount))
+}
+
+func Test_Skip_UnknownValue(t *testing.T) {
+	s := New(2, 5)
+
+	assert.Equal(t, []int{0, 2}, logged(s, 4, big.NewInt(1000), nil))
+	assert.Equal(t, []int{0, 2}, logged(New(2, 5), 4, nil, big.NewInt(100)))
+	assert.Equal(t, []int{0, 2}, logged(New(2, 5), 4, big.NewInt(1000), big.NewInt(0)))
+}
+
+func Test_Skip_DisabledSampling(t *testing.T) {
+	var nilSampler *Sampler
+
+	assert.Equal(t, []int{0, 1, 2}, logged(nilSampler, 3, nil, nil))
+	assert.Equal(t, []int{0, 1, 2}, logged(New(0, 0), 3, nil, nil))
+	assert.Equal(t, []int{0, 1, 2}, logged(New(1, 0), 3, nil, nil))
+}
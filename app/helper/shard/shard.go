@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package shard
+
+import (
+	"hash/fnv"
+	"strings"
+)
+
+// Of returns the shard of the given receiver out of count shards.
+// Receivers are compared case-insensitively, so that checksum variants of an address fall in the same shard.
+func Of(receiver string, count uint64) uint64 {
+	if count == 0 {
+		return 0
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(strings.ToLower(receiver)))
+	return hash.Sum64() % count
+}
+
+// Owns checks whether the shard with the given index is assigned the given receiver.
+// A count of zero or one disables sharding, assigning every receiver to the single shard.
+func Owns(receiver string, index, count uint64) bool {
+	if count <= 1 {
+		return true
+	}
+	return Of(receiver, count) == index
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Of_Deterministic(t *testing.T) {
+	receiver := "0xaBcDef0123456789abCDef0123456789AbCdEf01"
+
+	assert.Equal(t, Of(receiver, 4), Of(receiver, 4))
+	assert.Equal(t, Of(receiver, 4), Of("0xabcdef0123456789abcdef0123456789abcdef01", 4))
+	assert.Less(t, Of(receiver, 4), uint64(4))
+}
+
+func Test_Of_ZeroCount(t *testing.T) {
+	assert.Equal(t, uint64(0), Of("0.0.123", 0))
+}
+
+func Test_Owns_Disabled(t *testing.T) {
+	assert.True(t, Owns("0.0.123", 0, 0))
+	assert.True(t, Owns("0.0.123", 0, 1))
+}
+
+func Test_Owns_ExactlyOneShard(t *testing.T) {
+	count := uint64(3)
+	for i := 0; i < 100; i++ {
+		receiver := fmt.Sprintf("0.0.%d", i)
+		owners := 0
+		for index := uint64(0); index < count; index++ {
+			if Owns(receiver, index, count) {
+				owners++
+			}
+		}
+		assert.Equal(t, 1, owners, receiver)
+	}
+}
+
+func Test_Owns_Distribution(t *testing.T) {
+	count := uint64(4)
+	assigned := make(map[uint64]int)
+	for i := 0; i < 1000; i++ {
+		assigned[Of(fmt.Sprintf("0.0.%d", i), count)]++
+	}
+
+	for index := uint64(0); index < count; index++ {
+		assert.Greater(t, assigned[index], 0)
+	}
+}
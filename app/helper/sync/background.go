@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import (
+	"context"
+	"sync"
+)
+
+// The number of functions, run in the background by Go, which have not returned yet
+var (
+	mutex   sync.Mutex
+	running int
+	idle    = sync.NewCond(&mutex)
+)
+
+// Go runs the given function in its own goroutine, so that Wait waits for it to return.
+// Used for the work, which outlives the handling of a message, e.g. awaiting its scheduled transactions
+func Go(f func()) {
+	mutex.Lock()
+	running++
+	mutex.Unlock()
+
+	go func() {
+		defer func() {
+			mutex.Lock()
+			running--
+			idle.Broadcast()
+			mutex.Unlock()
+		}()
+		f()
+	}()
+}
+
+// Wait waits for the functions, run by Go, to return, until the context is done
+func Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		mutex.Lock()
+		for running > 0 {
+			idle.Wait()
+		}
+		mutex.Unlock()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -64,3 +64,8 @@ func ToHumanReadable(timestampNanos int64) string {
 func FromNanos(nanos int64) time.Time {
 	return time.Unix(0, nanos).UTC()
 }
+
+// Expired checks whether the timestamp is older than maxAge, relative to now. A zero maxAge never expires
+func Expired(timestamp, now time.Time, maxAge time.Duration) bool {
+	return maxAge > 0 && now.Sub(timestamp) > maxAge
+}
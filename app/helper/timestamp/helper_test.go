@@ -18,6 +18,7 @@ package timestamp
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -64,3 +65,12 @@ func Test_ToHumanReadable(t *testing.T) {
 	expectedDate := "2020-09-01T01:44:35.082525Z"
 	assert.Equal(t, expectedDate, res)
 }
+
+func Test_Expired(t *testing.T) {
+	now := FromNanos(timestampInt64)
+	maxAge := time.Hour
+
+	assert.False(t, Expired(now.Add(-maxAge), now, maxAge))
+	assert.True(t, Expired(now.Add(-maxAge-time.Second), now, maxAge))
+	assert.False(t, Expired(now.Add(-maxAge-time.Second), now, 0))
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Standard OpenTelemetry environment variables, configuring the exporter
+const (
+	EnvEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+// Init registers a tracer provider, exporting the spans through OTLP/HTTP to the endpoint, configured through
+// the standard OpenTelemetry environment variables. Tracing stays disabled when no endpoint is configured.
+// The returned function flushes and stops the provider
+func Init() func(context.Context) error {
+	if !enabled() {
+		return func(context.Context) error { return nil }
+	}
+
+	exporter, err := otlptracehttp.New(context.Background())
+	if err != nil {
+		log.Errorf("Failed to create the OTLP trace exporter. Tracing is disabled. Error: [%s]", err)
+		return func(context.Context) error { return nil }
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.Default()),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider.Shutdown
+}
+
+// enabled returns whether an OTLP endpoint is configured
+func enabled() bool {
+	return os.Getenv(EnvTracesEndpoint) != "" || os.Getenv(EnvEndpoint) != ""
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the name of the tracer, which records the spans of the transfer pipeline
+	TracerName = "github.com/limechain/hedera-eth-bridge-validator"
+	// TransferIdKey is the span attribute, carrying the id of the traced transfer
+	TransferIdKey = attribute.Key("transfer.id")
+)
+
+// Start starts a span with the given name as a child of the span in the context, tagged with the transfer id
+func Start(ctx context.Context, name, transferId string) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(TransferIdKey.String(transferId)))
+}
+
+// Inject returns the carrier of the span context in ctx, ready to be attached to a queue message.
+// Nil when there is nothing to propagate
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Extract returns a context, carrying the span context, propagated through the given carrier
+func Extract(carrier map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(carrier))
+}
+
+// TransferId returns the id of the transfer, the payload of a queue message refers to. Empty if unknown
+func TransferId(msg interface{}) string {
+	switch p := msg.(type) {
+	case *payload.Transfer:
+		return p.TransactionId
+	case *message.Message:
+		if nft := p.GetNftSignatureMessage(); nft != nil {
+			return nft.GetTransferID()
+		}
+		if request := p.GetSignatureRequestMessage(); request != nil {
+			return request.GetTransferID()
+		}
+		return p.GetFungibleSignatureMessage().GetTransferID()
+	}
+	return ""
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	model "github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const transferId = "0.0.123-1-1"
+
+// setupRecorder registers a tracer provider, recording the ended spans, for the duration of the test
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+	return recorder
+}
+
+func Test_Start_SetsTransferId(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	_, span := Start(context.Background(), "dispatch", transferId)
+	span.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "dispatch", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), TransferIdKey.String(transferId))
+}
+
+func Test_InjectExtract_PropagatesParent(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	ctx, parent := Start(context.Background(), "dispatch", transferId)
+	carrier := Inject(ctx)
+	parent.End()
+	_, child := Start(Extract(carrier), "handle", transferId)
+	child.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
+
+func Test_Inject_NothingToPropagate(t *testing.T) {
+	assert.Nil(t, Inject(context.Background()))
+}
+
+func Test_TransferId(t *testing.T) {
+	fungible := message.NewFungibleSignature(&model.TopicEthSignatureMessage{TransferID: transferId})
+	nft := message.NewNftSignature(&model.TopicEthNftSignatureMessage{TransferID: transferId})
+
+	assert.Equal(t, transferId, TransferId(&payload.Transfer{TransactionId: transferId}))
+	assert.Equal(t, transferId, TransferId(fungible))
+	assert.Equal(t, transferId, TransferId(nft))
+	assert.Equal(t, transferId, TransferId(message.NewSignatureRequest(0, transferId)))
+	assert.Equal(t, "", TransferId("payload"))
+}
+
+func Test_Init_Disabled(t *testing.T) {
+	t.Setenv(EnvEndpoint, "")
+	t.Setenv(EnvTracesEndpoint, "")
+	provider := otel.GetTracerProvider()
+
+	shutdown := Init()
+
+	assert.Nil(t, shutdown(context.Background()))
+	assert.Equal(t, provider, otel.GetTracerProvider())
+}
+
+func Test_Init_ExportsSpans(t *testing.T) {
+	exported := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case exported <- r.URL.Path:
+		default:
+		}
+	}))
+	defer server.Close()
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+	t.Setenv(EnvEndpoint, server.URL)
+	t.Setenv(EnvTracesEndpoint, "")
+
+	shutdown := Init()
+	_, span := Start(context.Background(), "dispatch", transferId)
+	span.End()
+	err := shutdown(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "/v1/traces", <-exported)
+}
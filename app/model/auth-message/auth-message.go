@@ -19,11 +19,55 @@ package auth_message
 import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
 	"math/big"
 )
 
+// Supported schemes of the authorisation signatures
+const (
+	// SchemeEIP191 is the `eth_sign` personal message signature over the packed authorisation
+	SchemeEIP191 = "eip191"
+	// SchemeEIP712 is the typed data signature over the authorisation
+	SchemeEIP712 = "eip712"
+)
+
+// TypedDataDomain is the name and version of the EIP-712 domain of the authorisation signatures.
+// It must match the domain, verified by the router contracts. The chain id and verifying contract
+// of the domain are the target chain and its router
+type TypedDataDomain struct {
+	Name    string
+	Version string
+}
+
+var (
+	typedDataDomainType = []apitypes.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	}
+	fungibleTypedDataType = []apitypes.Type{
+		{Name: "sourceChainId", Type: "uint256"},
+		{Name: "targetChainId", Type: "uint256"},
+		{Name: "transactionId", Type: "bytes"},
+		{Name: "token", Type: "address"},
+		{Name: "receiver", Type: "address"},
+		{Name: "amount", Type: "uint256"},
+	}
+	nftTypedDataType = []apitypes.Type{
+		{Name: "sourceChainId", Type: "uint256"},
+		{Name: "targetChainId", Type: "uint256"},
+		{Name: "transactionId", Type: "bytes"},
+		{Name: "token", Type: "address"},
+		{Name: "tokenId", Type: "uint256"},
+		{Name: "metadata", Type: "string"},
+		{Name: "receiver", Type: "address"},
+	}
+)
+
 // EncodeFungibleBytesFrom returns the array of bytes representing an
 // authorisation ERC-20 Mint signature ready to be signed by EVM Private Key
 func EncodeFungibleBytesFrom(sourceChainId, targetChainId uint64, txId, asset, receiverEthAddress, amount string) ([]byte, error) {
@@ -71,6 +115,60 @@ func EncodeNftBytesFrom(sourceChainId, targetChainId uint64, txId, asset string,
 	return keccak(bytesToHash), nil
 }
 
+// EncodeFungibleTypedDataFrom returns the EIP-712 hash of an
+// authorisation ERC-20 Mint, verified by the router contract at the target chain
+func EncodeFungibleTypedDataFrom(sourceChainId, targetChainId uint64, txId, asset, receiverEthAddress, amount, routerAddress string, domain TypedDataDomain) ([]byte, error) {
+	amountBn, err := big_numbers.ToBigInt(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedDataHash("Mint", fungibleTypedDataType, domain, targetChainId, routerAddress, apitypes.TypedDataMessage{
+		"sourceChainId": math.NewHexOrDecimal256(int64(sourceChainId)),
+		"targetChainId": math.NewHexOrDecimal256(int64(targetChainId)),
+		"transactionId": []byte(txId),
+		"token":         common.HexToAddress(asset).String(),
+		"receiver":      common.HexToAddress(receiverEthAddress).String(),
+		"amount":        (*math.HexOrDecimal256)(amountBn),
+	})
+}
+
+// EncodeNftTypedDataFrom returns the EIP-712 hash of an
+// authorisation ERC-721 NFT Mint, verified by the router contract at the target chain
+func EncodeNftTypedDataFrom(sourceChainId, targetChainId uint64, txId, asset string, serialNum int64, metadata, receiverEthAddress, routerAddress string, domain TypedDataDomain) ([]byte, error) {
+	return typedDataHash("MintERC721", nftTypedDataType, domain, targetChainId, routerAddress, apitypes.TypedDataMessage{
+		"sourceChainId": math.NewHexOrDecimal256(int64(sourceChainId)),
+		"targetChainId": math.NewHexOrDecimal256(int64(targetChainId)),
+		"transactionId": []byte(txId),
+		"token":         common.HexToAddress(asset).String(),
+		"tokenId":       math.NewHexOrDecimal256(serialNum),
+		"metadata":      metadata,
+		"receiver":      common.HexToAddress(receiverEthAddress).String(),
+	})
+}
+
+func typedDataHash(primaryType string, primaryTypeFields []apitypes.Type, domain TypedDataDomain, chainId uint64, routerAddress string, message apitypes.TypedDataMessage) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": typedDataDomainType,
+			primaryType:    primaryTypeFields,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              domain.Name,
+			Version:           domain.Version,
+			ChainId:           math.NewHexOrDecimal256(int64(chainId)),
+			VerifyingContract: common.HexToAddress(routerAddress).String(),
+		},
+		Message: message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
+
 func generateNftArguments() (abi.Arguments, error) {
 	bytesType, err := abi.NewType("bytes", "", nil)
 	if err != nil {
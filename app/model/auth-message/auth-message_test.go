@@ -13,8 +13,11 @@ const (
 	receiverAddress = "0xsomeaddress"
 	invalidAmount   = "invalidamount"
 	amount          = "100"
+	routerAddress   = "0x0000000000000000000000000000000000000001"
 )
 
+var typedDataDomain = TypedDataDomain{Name: "Router", Version: "1"}
+
 func Test_EncodeFungibleBytesFromWithInvalidAmount(t *testing.T) {
 	actualResult, err := EncodeFungibleBytesFrom(
 		sourceChainId,
@@ -40,3 +43,45 @@ func Test_EncodeFungibleBytesFromWorks(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, actualResult)
 }
+
+func Test_EncodeFungibleTypedDataFromWorks(t *testing.T) {
+	actualResult, err := EncodeFungibleTypedDataFrom(
+		sourceChainId,
+		targetChainId,
+		txId,
+		asset,
+		receiverAddress,
+		amount,
+		routerAddress,
+		typedDataDomain)
+
+	assert.Nil(t, err)
+	assert.Len(t, actualResult, 32)
+
+	authMsg, _ := EncodeFungibleBytesFrom(sourceChainId, targetChainId, txId, asset, receiverAddress, amount)
+	assert.NotEqual(t, authMsg, actualResult)
+}
+
+func Test_EncodeNftTypedDataFromWorks(t *testing.T) {
+	actualResult, err := EncodeNftTypedDataFrom(
+		sourceChainId,
+		targetChainId,
+		txId,
+		asset,
+		1,
+		"metadata",
+		receiverAddress,
+		routerAddress,
+		typedDataDomain)
+
+	assert.Nil(t, err)
+	assert.Len(t, actualResult, 32)
+}
+
+func Test_EncodeFungibleTypedDataFrom_DependsOnDomain(t *testing.T) {
+	actualResult, err := EncodeFungibleTypedDataFrom(sourceChainId, targetChainId, txId, asset, receiverAddress, amount, routerAddress, TypedDataDomain{Name: "Router", Version: "2"})
+	assert.Nil(t, err)
+
+	expected, _ := EncodeFungibleTypedDataFrom(sourceChainId, targetChainId, txId, asset, receiverAddress, amount, routerAddress, typedDataDomain)
+	assert.NotEqual(t, expected, actualResult)
+}
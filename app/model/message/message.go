@@ -18,12 +18,17 @@ package message
 
 import (
 	"encoding/base64"
+	"errors"
+	"fmt"
 	"google.golang.org/protobuf/proto"
 	msgHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	model "github.com/limechain/hedera-eth-bridge-validator/proto"
 )
 
+// ErrPayloadTooLarge is returned, when the payload of a message exceeds the maximum size, before it is parsed
+var ErrPayloadTooLarge = errors.New("payload exceeds the maximum size")
+
 // Message serves as a model between Topic Message Watcher and Handler
 type Message struct {
 	*model.TopicMessage
@@ -46,6 +51,8 @@ func FromBytes(data []byte) (*Message, error) {
 		fungibleMsg := msg.GetFungibleSignatureMessage()
 		msgHelper.UpdateHederaChainIdOfFungibleMsg(fungibleMsg)
 		return &Message{TopicMessage: msg}, nil
+	case *model.TopicMessage_SignatureRequestMessage:
+		return &Message{TopicMessage: msg}, nil
 	default: // try to parse it to backward compatible type
 		oldFungibleMessage := &model.TopicEthSignatureMessage{}
 		err = proto.Unmarshal(data, oldFungibleMessage)
@@ -75,16 +82,30 @@ func FromBytesWithTS(data []byte, ts int64) (*Message, error) {
 
 // FromString instantiates new Topic Message protobuf from string `content` and `timestamp`
 func FromString(data, ts string) (*Message, error) {
+	return FromStringWithMaxSize(data, ts, 0)
+}
+
+// FromStringWithMaxSize instantiates new Topic Message protobuf from string `content` and `timestamp`.
+// Returns an error wrapping ErrPayloadTooLarge without parsing the payload, if it is larger than maxSize bytes. Zero disables the limit
+func FromStringWithMaxSize(data, ts string, maxSize int) (*Message, error) {
 	t, err := timestamp.FromString(ts)
 	if err != nil {
 		return nil, err
 	}
 
+	if maxSize > 0 && base64.StdEncoding.DecodedLen(len(data)) > maxSize+2 {
+		return nil, fmt.Errorf("encoded payload of [%d] bytes exceeds [%d] bytes: %w", len(data), maxSize, ErrPayloadTooLarge)
+	}
+
 	bytes, err := base64.StdEncoding.DecodeString(data)
 	if err != nil {
 		return nil, err
 	}
 
+	if maxSize > 0 && len(bytes) > maxSize {
+		return nil, fmt.Errorf("payload of [%d] bytes exceeds [%d] bytes: %w", len(bytes), maxSize, ErrPayloadTooLarge)
+	}
+
 	return FromBytesWithTS(bytes, t)
 }
 
@@ -98,6 +119,11 @@ func NewNftSignature(topicMsg *model.TopicEthNftSignatureMessage) *Message {
 	return &Message{TopicMessage: &model.TopicMessage{Message: &model.TopicMessage_NftSignatureMessage{NftSignatureMessage: topicMsg}}}
 }
 
+// NewSignatureRequest instantiates Signature Request Message struct ready for submission to the Bridge Topic
+func NewSignatureRequest(sourceChainId uint64, transferID string) *Message {
+	return &Message{TopicMessage: &model.TopicMessage{Message: &model.TopicMessage_SignatureRequestMessage{SignatureRequestMessage: &model.TopicSignatureRequestMessage{TransferID: transferID, SourceChainId: sourceChainId}}}}
+}
+
 // ToBytes marshals the underlying protobuf Message into bytes
 func (tm *Message) ToBytes() ([]byte, error) {
 	return proto.Marshal(tm.TopicMessage)
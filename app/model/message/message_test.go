@@ -79,6 +79,17 @@ func Test_NewSignatureWorks(t *testing.T) {
 	signatureEqualFields(t, expectedSignature(), actualSignature.TopicMessage.GetFungibleSignatureMessage())
 }
 
+func Test_NewSignatureRequest_RoundTrip(t *testing.T) {
+	bytes, err := NewSignatureRequest(80001, "0.0.123321-123321-420").ToBytes()
+	assert.Nil(t, err)
+
+	actual, err := FromBytes(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.0.123321-123321-420", actual.GetSignatureRequestMessage().TransferID)
+	assert.Equal(t, uint64(80001), actual.GetSignatureRequestMessage().SourceChainId)
+	assert.Nil(t, actual.GetFungibleSignatureMessage())
+}
+
 func Test_FromStringWithInvalidTS(t *testing.T) {
 	result, err := FromString(invalidStringData, invalidStringTs)
 	assert.Nil(t, result)
@@ -106,6 +117,34 @@ func Test_FromStringWorks(t *testing.T) {
 	signatureEqualFields(t, expected, result.TopicMessage.GetFungibleSignatureMessage())
 }
 
+func Test_FromStringWithMaxSize_AtLimit(t *testing.T) {
+	bytes, err := proto.Marshal(expectedSignature())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := FromStringWithMaxSize(base64.StdEncoding.EncodeToString(bytes), stringTs, len(bytes))
+	assert.Nil(t, err)
+	signatureEqualFields(t, expectedSignature(), result.TopicMessage.GetFungibleSignatureMessage())
+}
+
+func Test_FromStringWithMaxSize_OverLimit(t *testing.T) {
+	bytes, err := proto.Marshal(expectedSignature())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := FromStringWithMaxSize(base64.StdEncoding.EncodeToString(bytes), stringTs, len(bytes)-1)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrPayloadTooLarge)
+}
+
+func Test_FromStringWithMaxSize_FarOverLimit(t *testing.T) {
+	result, err := FromStringWithMaxSize(base64.StdEncoding.EncodeToString(make([]byte, 1024)), stringTs, 16)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrPayloadTooLarge)
+}
+
 //
 //func Test_ToBytes(t *testing.T) {
 //	expectedBytes, err := proto.Marshal(expectedSignature())
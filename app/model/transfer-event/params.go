@@ -0,0 +1,25 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_event
+
+// Params are the parameters passed to the listeners of the transfer lifecycle events
+type Params struct {
+	SourceChainID uint64
+	TransactionID string
+	// Status is the status of the transfer after the transition (empty for events which are not status updates)
+	Status string
+}
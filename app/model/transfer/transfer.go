@@ -42,6 +42,21 @@ type Transfer struct {
 	Status        string    `json:"status"`
 }
 
+// PublicTransfer is the response model of the public status API. It holds only non-sensitive fields
+type PublicTransfer struct {
+	TransactionId string    `json:"transactionId"`
+	SourceChainId uint64    `json:"sourceChainId"`
+	TargetChainId uint64    `json:"targetChainId"`
+	SourceAsset   string    `json:"sourceAsset"`
+	TargetAsset   string    `json:"targetAsset"`
+	Receiver      string    `json:"receiver"`
+	Amount        string    `json:"amount,omitempty"`
+	SerialNum     int64     `json:"serialNum,omitempty"`
+	IsNft         bool      `json:"isNft"`
+	Timestamp     time.Time `json:"timestamp"`
+	Status        string    `json:"status"`
+}
+
 type Paged struct {
 	Items      []*Transfer `json:"items"`
 	TotalCount int64       `json:"totalCount"`
@@ -58,6 +73,7 @@ type Filter struct {
 	TimestampQuery string `json:"timestamp"`
 	TokenId        string `json:"tokenId"`
 	TransactionId  string `json:"transactionId"`
+	Receiver       string `json:"receiver"`
 }
 
 type SanityCheckResult struct {
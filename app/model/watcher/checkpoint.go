@@ -0,0 +1,29 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watcher
+
+// Checkpoint represents the progress of a watcher against the head of its network
+type Checkpoint struct {
+	FromBlock   int64  `json:"fromBlock"`
+	LatestBlock uint64 `json:"latestBlock"`
+	Lag         int64  `json:"lag"`
+}
+
+// CheckpointUpdate is the request for manually setting the checkpoint of a watcher
+type CheckpointUpdate struct {
+	Block int64 `json:"block"`
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watcher
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+
+// Decisions of a simulated event
+const (
+	SimDecisionProcess  = "process"
+	SimDecisionReadOnly = "read_only"
+	SimDecisionSkip     = "skip"
+)
+
+// SimResult is the outcome of a dry-run of a single event by a watcher
+type SimResult struct {
+	EventType     string            `json:"eventType"`
+	TransactionId string            `json:"transactionId"`
+	Decision      string            `json:"decision"`
+	Reason        string            `json:"reason,omitempty"`
+	Topic         string            `json:"topic,omitempty"`
+	Payload       *payload.Transfer `json:"payload,omitempty"`
+}
@@ -52,8 +52,12 @@ func (db *Database) Migrate() {
 			entity.Transfer{},
 			entity.Fee{},
 			entity.Message{},
+			entity.OrphanedSignature{},
 			entity.Schedule{},
-			entity.Status{})
+			entity.Status{},
+			entity.EventLog{},
+			entity.QueueMessage{},
+			entity.DeniedAsset{})
 	if err != nil {
 		log.Fatal(err)
 	}
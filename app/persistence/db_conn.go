@@ -27,7 +27,17 @@ import (
 )
 
 type pgConnector struct {
-	connString string
+	connString      string
+	maxOpenConns    int
+	maxIdleConns    int
+	connMaxLifetime time.Duration
+}
+
+// poolConfigurer is the subset of sql.DB, used to tune the connection pool
+type poolConfigurer interface {
+	SetMaxOpenConns(n int)
+	SetMaxIdleConns(n int)
+	SetConnMaxLifetime(d time.Duration)
 }
 
 func NewPgConnector(cfg config.Database) *pgConnector {
@@ -35,7 +45,10 @@ func NewPgConnector(cfg config.Database) *pgConnector {
 		cfg.Host, cfg.Port, cfg.Username, cfg.Name, cfg.Password)
 
 	return &pgConnector{
-		connString,
+		connString:      connString,
+		maxOpenConns:    cfg.MaxOpenConns,
+		maxIdleConns:    cfg.MaxIdleConns,
+		connMaxLifetime: cfg.ConnMaxLifetime,
 	}
 }
 
@@ -44,6 +57,12 @@ func (c *pgConnector) Connect() *gorm.DB {
 	db := c.tryConnection()
 	log.Infoln("Successfully connected to Database")
 
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get the underlying database connection pool. Error: [%s]", err)
+	}
+	c.configurePool(sqlDB)
+
 	return db
 }
 
@@ -66,3 +85,16 @@ func (c *pgConnector) tryConnection() *gorm.DB {
 	}
 	return db
 }
+
+// configurePool applies the configured connection pool settings. Zero values keep the database/sql defaults.
+func (c *pgConnector) configurePool(pool poolConfigurer) {
+	if c.maxOpenConns > 0 {
+		pool.SetMaxOpenConns(c.maxOpenConns)
+	}
+	if c.maxIdleConns > 0 {
+		pool.SetMaxIdleConns(c.maxIdleConns)
+	}
+	if c.connMaxLifetime > 0 {
+		pool.SetConnMaxLifetime(c.connMaxLifetime)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 var (
@@ -28,15 +29,36 @@ var (
 	cfg       config.Database
 )
 
+type poolRecorder struct {
+	maxOpenConns    int
+	maxIdleConns    int
+	connMaxLifetime time.Duration
+}
+
+func (r *poolRecorder) SetMaxOpenConns(n int) {
+	r.maxOpenConns = n
+}
+
+func (r *poolRecorder) SetMaxIdleConns(n int) {
+	r.maxIdleConns = n
+}
+
+func (r *poolRecorder) SetConnMaxLifetime(d time.Duration) {
+	r.connMaxLifetime = d
+}
+
 func setupConnector() {
 	mocks.Setup()
 
 	cfg = config.Database{
-		Host:     "host",
-		Name:     "name",
-		Password: "password",
-		Port:     "4200",
-		Username: "username",
+		Host:            "host",
+		Name:            "name",
+		Password:        "password",
+		Port:            "4200",
+		Username:        "username",
+		MaxOpenConns:    10,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: time.Minute,
 	}
 
 	connector = NewPgConnector(cfg)
@@ -48,3 +70,21 @@ func Test_NewPgConnector(t *testing.T) {
 	actual := NewPgConnector(cfg)
 	assert.Equal(t, connector, actual)
 }
+
+func Test_ConfigurePool(t *testing.T) {
+	setupConnector()
+	recorder := &poolRecorder{}
+
+	connector.configurePool(recorder)
+
+	assert.Equal(t, &poolRecorder{maxOpenConns: 10, maxIdleConns: 5, connMaxLifetime: time.Minute}, recorder)
+}
+
+func Test_ConfigurePool_KeepsDefaults(t *testing.T) {
+	setupConnector()
+	recorder := &poolRecorder{maxOpenConns: 1, maxIdleConns: 2, connMaxLifetime: time.Second}
+
+	NewPgConnector(config.Database{}).configurePool(recorder)
+
+	assert.Equal(t, &poolRecorder{maxOpenConns: 1, maxIdleConns: 2, connMaxLifetime: time.Second}, recorder)
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package denied_asset
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db     *gorm.DB
+	logger *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db:     dbClient,
+		logger: config.GetLoggerFor("Denied Asset Repository"),
+	}
+}
+
+// Create stores the given asset as denied. Assets, which are already denied, are ignored
+func (r *Repository) Create(chainId uint64, asset string) error {
+	return r.db.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entity.DeniedAsset{ChainID: chainId, Asset: asset}).Error
+}
+
+func (r *Repository) Delete(chainId uint64, asset string) error {
+	return r.db.
+		Where("chain_id = ? and asset = ?", chainId, asset).
+		Delete(&entity.DeniedAsset{}).Error
+}
+
+func (r *Repository) GetAll() ([]*entity.DeniedAsset, error) {
+	var assets []*entity.DeniedAsset
+
+	err := r.db.Find(&assets).Error
+	return assets, err
+}
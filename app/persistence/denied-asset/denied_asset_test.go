@@ -0,0 +1,123 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package denied_asset
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository *Repository
+	dbConn     *gorm.DB
+	sqlMock    sqlmock.Sqlmock
+	chainId    = uint64(1)
+	asset      = "0x00000000000000000000000000000000000000AA"
+	expected   = &entity.DeniedAsset{
+		ChainID: chainId,
+		Asset:   asset,
+	}
+	rowArgs = []driver.Value{chainId, asset}
+	columns = []string{"chain_id", "asset"}
+
+	createQuery = regexp.QuoteMeta(`INSERT INTO "denied_assets" ("chain_id","asset") VALUES ($1,$2) ON CONFLICT DO NOTHING`)
+	deleteQuery = regexp.QuoteMeta(`DELETE FROM "denied_assets" WHERE chain_id = $1 and asset = $2`)
+	getAllQuery = regexp.QuoteMeta(`SELECT * FROM "denied_assets"`)
+)
+
+func setup() {
+	mocks.Setup()
+	dbConn, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db:     dbConn,
+		logger: config.GetLoggerFor("Denied Asset Repository"),
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+	actual := NewRepository(dbConn)
+	assert.Equal(t, repository, actual)
+}
+
+func Test_Create(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, createQuery, chainId, asset)
+
+	err := repository.Create(chainId, asset)
+
+	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Create_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, createQuery, chainId, asset)
+
+	err := repository.Create(chainId, asset)
+
+	assert.NotNil(t, err)
+}
+
+func Test_Delete(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, deleteQuery, chainId, asset)
+
+	err := repository.Delete(chainId, asset)
+
+	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Delete_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, deleteQuery, chainId, asset)
+
+	err := repository.Delete(chainId, asset)
+
+	assert.NotNil(t, err)
+}
+
+func Test_GetAll(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getAllQuery)
+
+	actual, err := repository.GetAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.DeniedAsset{expected}, actual)
+}
+
+func Test_GetAll_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getAllQuery)
+
+	actual, err := repository.GetAll()
+
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
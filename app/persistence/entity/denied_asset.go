@@ -0,0 +1,24 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// DeniedAsset is a db model used to persist the runtime deny-list of assets, disabled by the router,
+// so that they remain denied across restarts until explicitly re-enabled
+type DeniedAsset struct {
+	ChainID uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Asset   string `gorm:"primaryKey"`
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EventLog is a db model used to store the raw on-chain log, from which a given transfer originates.
+// Like transfers, it is identified by the transfer id and the source chain id
+type EventLog struct {
+	TransferID    string `gorm:"primaryKey"`
+	SourceChainID uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Address       string
+	BlockNumber   uint64
+	BlockHash     string
+	TxHash        string
+	LogIndex      uint
+	Topics        string // comma separated, hex encoded topics
+	Data          string // hex encoded data
+}
+
+// NewEventLog creates the db model of the given raw log, emitted at the given source chain
+func NewEventLog(transferID string, sourceChainId uint64, log types.Log) *EventLog {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Hex()
+	}
+
+	return &EventLog{
+		TransferID:    transferID,
+		SourceChainID: sourceChainId,
+		Address:       log.Address.Hex(),
+		BlockNumber:   log.BlockNumber,
+		BlockHash:     log.BlockHash.Hex(),
+		TxHash:        log.TxHash.Hex(),
+		LogIndex:      log.Index,
+		Topics:        strings.Join(topics, ","),
+		Data:          hexutil.Encode(log.Data),
+	}
+}
+
+// ToLog re-creates the raw log
+func (e *EventLog) ToLog() (types.Log, error) {
+	var topics []common.Hash
+	if e.Topics != "" {
+		for _, topic := range strings.Split(e.Topics, ",") {
+			topics = append(topics, common.HexToHash(topic))
+		}
+	}
+
+	data, err := hexutil.Decode(e.Data)
+	if err != nil {
+		return types.Log{}, err
+	}
+
+	return types.Log{
+		Address:     common.HexToAddress(e.Address),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: e.BlockNumber,
+		TxHash:      common.HexToHash(e.TxHash),
+		BlockHash:   common.HexToHash(e.BlockHash),
+		Index:       e.LogIndex,
+	}, nil
+}
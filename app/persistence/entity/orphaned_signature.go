@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import "time"
+
+// OrphanedSignature is a db model used to store a signature message, received before the transfer it signs.
+// It is handled again, once the transfer is created
+type OrphanedSignature struct {
+	ID                   uint64 `gorm:"primaryKey"`
+	TransferID           string `gorm:"index:idx_orphaned_signatures_transfer"`
+	SourceChainID        uint64 `gorm:"index:idx_orphaned_signatures_transfer"`
+	Payload              []byte // the marshalled topic message
+	TransactionTimestamp int64
+	CreatedAt            time.Time `gorm:"index"` // the time the message was stored
+}
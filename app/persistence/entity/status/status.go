@@ -28,4 +28,19 @@ const (
 	Failed = "FAILED"
 	// Submitted is set when a pending Fee/Schedule operation is created.
 	Submitted = "SUBMITTED"
+	// Expired is set once a Schedule has not been executed before its expiry and has been resubmitted.
+	// This is a terminal status
+	Expired = "EXPIRED"
+)
+
+// Signature Message Statuses of a Transfer
+const (
+	// SignaturePending is set once the validator starts submitting its signature to the topic
+	SignaturePending = "SIGNATURE_PENDING"
+	// SignatureSubmitted is set once the signature has been successfully submitted to the topic
+	SignatureSubmitted = "SIGNATURE_SUBMITTED"
+	// SignatureMined is set once the signature message has been confirmed on the topic by the mirror node
+	SignatureMined = "SIGNATURE_MINED"
+	// SignatureFailed is set once all submission attempts of the signature have failed
+	SignatureFailed = "SIGNATURE_FAILED"
 )
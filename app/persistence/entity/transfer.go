@@ -22,19 +22,24 @@ import (
 	"errors"
 	"time"
 
+	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
 	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 )
 
+// Transfer is identified by its transaction id together with its source chain id,
+// since transaction hashes may repeat across EVM networks
 type Transfer struct {
 	TransactionID string `gorm:"primaryKey"`
-	SourceChainID uint64
+	SourceChainID uint64 `gorm:"primaryKey;autoIncrement:false"`
 	TargetChainID uint64
 	NativeChainID uint64
 	SourceAsset   string
 	TargetAsset   string
 	NativeAsset   string
 	Receiver      string
-	Amount        string
+	Amount        string // canonical integer string, in the smallest denomination of the target asset
+	Decimals      uint8  // of the target asset, in which the amount is denominated
 	Fee           string
 	Status        string
 	SerialNumber  int64
@@ -42,9 +47,13 @@ type Transfer struct {
 	IsNft         bool     `gorm:"default:false"`
 	Timestamp     NanoTime `sql:"type:bigint" gorm:"index:,sort:desc"`
 	Originator    string
-	Messages      []Message  `gorm:"foreignKey:TransferID"`
-	Fees          []Fee      `gorm:"foreignKey:TransferID"`
-	Schedules     []Schedule `gorm:"foreignKey:TransferID"`
+	CreatedAt     time.Time  // the time the transfer was stored
+	Messages      []Message  `gorm:"foreignKey:TransferID,TransferSourceChainID;references:TransactionID,SourceChainID;constraint:-"`
+	Fees          []Fee      `gorm:"foreignKey:TransferID,TransferSourceChainID;references:TransactionID,SourceChainID;constraint:-"`
+	Schedules     []Schedule `gorm:"foreignKey:TransferID,TransferSourceChainID;references:TransactionID,SourceChainID;constraint:-"`
+
+	// SignatureMsgStatus tracks the submission of this validator's signature to the topic
+	SignatureMsgStatus string
 }
 
 func (t *Transfer) ToDto() *transferModel.Transfer {
@@ -68,14 +77,41 @@ func (t *Transfer) ToDto() *transferModel.Transfer {
 	}
 }
 
+// SignedAmount returns the amount, which the validators sign for the transfer. The fee of Hedera-native
+// transfers is paid out to the validators on Hedera, so only the amount net of the fee is bridged
+func (t *Transfer) SignedAmount() (string, error) {
+	if t.NativeChainID != constants.HederaNetworkId {
+		return t.Amount, nil
+	}
+	return big_numbers.SubtractAmounts(t.Amount, t.Fee)
+}
+
+// ToPublicDto returns only the non-sensitive fields of the transfer
+func (t *Transfer) ToPublicDto() *transferModel.PublicTransfer {
+	return &transferModel.PublicTransfer{
+		TransactionId: t.TransactionID,
+		SourceChainId: t.SourceChainID,
+		TargetChainId: t.TargetChainID,
+		SourceAsset:   t.SourceAsset,
+		TargetAsset:   t.TargetAsset,
+		Receiver:      t.Receiver,
+		Amount:        t.Amount,
+		SerialNum:     t.SerialNumber,
+		IsNft:         t.IsNft,
+		Timestamp:     t.Timestamp.Time,
+		Status:        t.Status,
+	}
+}
+
 // Message is a db model used to track the messages signed by validators for a given transfer
 type Message struct {
-	TransferID           string
-	Transfer             Transfer `gorm:"foreignKey:TransferID;references:TransactionID;"`
-	Hash                 string
-	Signature            string `gorm:"unique"`
-	Signer               string
-	TransactionTimestamp int64
+	TransferID            string
+	TransferSourceChainID uint64   // the source chain of the transfer
+	Transfer              Transfer `gorm:"foreignKey:TransferID,TransferSourceChainID;references:TransactionID,SourceChainID;constraint:-"`
+	Hash                  string
+	Signature             string `gorm:"unique"`
+	Signer                string
+	TransactionTimestamp  int64
 }
 
 // Fee is a db model used only to mark native Hedera transfer fees to validators
@@ -85,6 +121,8 @@ type Fee struct {
 	Amount        string
 	Status        string
 	TransferID    sql.NullString
+	// the source chain of the transfer
+	TransferSourceChainID uint64
 }
 
 // Schedule is a db model used to track scheduled transactions for a given transfer
@@ -95,6 +133,8 @@ type Schedule struct {
 	Operation     string // type of scheduled transaction (TokenMint, TokenBurn, CryptoTransfer)
 	Status        string
 	TransferID    sql.NullString // foreign key to the transfer ID
+	// the source chain of the transfer, which together with TransferID references it
+	TransferSourceChainID uint64
 }
 
 type NanoTime struct {
@@ -41,18 +41,20 @@ var (
 	amount        = "1"
 	someStatus    = entityStatus.Completed
 	transferId    = "transferId"
+	sourceChainId = uint64(80001)
 	expectedFee   = &entity.Fee{
-		TransactionID: transactionId,
-		ScheduleID:    scheduleId,
-		Amount:        amount,
-		Status:        someStatus,
-		TransferID:    sql.NullString{String: transferId, Valid: true},
+		TransactionID:         transactionId,
+		ScheduleID:            scheduleId,
+		Amount:                amount,
+		Status:                someStatus,
+		TransferID:            sql.NullString{String: transferId, Valid: true},
+		TransferSourceChainID: sourceChainId,
 	}
-	rowArgs = []driver.Value{transactionId, scheduleId, amount, someStatus, transferId}
-	columns = []string{"transaction_id", "schedule_id", "amount", "status", "transfer_id"}
+	rowArgs = []driver.Value{transactionId, scheduleId, amount, someStatus, transferId, sourceChainId}
+	columns = []string{"transaction_id", "schedule_id", "amount", "status", "transfer_id", "transfer_source_chain_id"}
 
 	feeQuery                = regexp.QuoteMeta(`SELECT * FROM "fees" WHERE transaction_id = $1 ORDER BY "fees"."transaction_id" LIMIT 1`)
-	createQuery             = regexp.QuoteMeta(`INSERT INTO "fees" ("transaction_id","schedule_id","amount","status","transfer_id") VALUES ($1,$2,$3,$4,$5)`)
+	createQuery             = regexp.QuoteMeta(`INSERT INTO "fees" ("transaction_id","schedule_id","amount","status","transfer_id","transfer_source_chain_id") VALUES ($1,$2,$3,$4,$5,$6)`)
 	updateStatusQuery       = regexp.QuoteMeta(`UPDATE "fees" SET "status"=$1 WHERE transaction_id = $2`)
 	getAllSubmittedIdsQuery = regexp.QuoteMeta(`SELECT "transaction_id" FROM "fees" WHERE status = $1`)
 )
@@ -98,7 +100,8 @@ func Test_Create(t *testing.T) {
 		expectedFee.ScheduleID,
 		expectedFee.Amount,
 		expectedFee.Status,
-		expectedFee.TransferID)
+		expectedFee.TransferID,
+		expectedFee.TransferSourceChainID)
 
 	err := repository.Create(expectedFee)
 	assert.Nil(t, err)
@@ -111,7 +114,8 @@ func Test_Create_Err(t *testing.T) {
 		expectedFee.ScheduleID,
 		expectedFee.Amount,
 		expectedFee.Status,
-		expectedFee.TransferID)
+		expectedFee.TransferID,
+		expectedFee.TransferSourceChainID)
 
 	err := repository.Create(expectedFee)
 	assert.NotNil(t, err)
@@ -18,9 +18,14 @@ package message
 
 import (
 	"errors"
+	"sort"
+	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -60,11 +65,13 @@ func (r *Repository) Create(message *entity.Message) error {
 	return r.db.Create(message).Error
 }
 
-func (r *Repository) Get(transferID string) ([]entity.Message, error) {
+// Get returns the signature messages of the transfer with the given transaction id from the given source chain,
+// ordered by consensus timestamp
+func (r *Repository) Get(sourceChainId uint64, transferID string) ([]entity.Message, error) {
 	var messages []entity.Message
 	err := r.db.
 		Preload("Transfer").
-		Where("transfer_id = ?", transferID).
+		Where("transfer_id = ? and transfer_source_chain_id = ?", transferID, sourceChainId).
 		Order("transaction_timestamp").
 		Find(&messages).
 		Error
@@ -73,3 +80,59 @@ func (r *Repository) Get(transferID string) ([]entity.Message, error) {
 	}
 	return messages, nil
 }
+
+// PruneMessagesBefore deletes the signature messages, which reached consensus before the given cutoff and whose
+// transfers are in a terminal state. Completed transfers to EVM networks are excluded, as their signatures are
+// needed by the users to claim on the router at any later time. The transfer records themselves are kept.
+// Returns the amount of deleted messages
+func (r *Repository) PruneMessagesBefore(cutoff time.Time) (int64, error) {
+	terminalTransfers := r.db.
+		Model(&entity.Transfer{}).
+		Select("transaction_id, source_chain_id").
+		Where("status = ? OR (status = ? AND target_chain_id = ?)", status.Failed, status.Completed, constants.HederaNetworkId)
+
+	result := r.db.
+		Where("transaction_timestamp < ? AND (transfer_id, transfer_source_chain_id) IN (?)", cutoff.UnixNano(), terminalTransfers).
+		Delete(&entity.Message{})
+	return result.RowsAffected, result.Error
+}
+
+// CreateOrphan stores a signature message, received before the transfer it signs
+func (r *Repository) CreateOrphan(orphan *entity.OrphanedSignature) error {
+	return r.db.Create(orphan).Error
+}
+
+// PruneOrphansBefore deletes the orphaned signature messages, stored before the cutoff. Messages, stored before
+// their storage time was recorded, are pruned as well
+func (r *Repository) PruneOrphansBefore(cutoff time.Time) (int64, error) {
+	result := r.db.
+		Where("created_at < ? or created_at is null", cutoff).
+		Delete(&entity.OrphanedSignature{})
+	return result.RowsAffected, result.Error
+}
+
+// CountOrphans returns the number of stored orphaned signature messages
+func (r *Repository) CountOrphans() (int64, error) {
+	var count int64
+	err := r.db.Model(&entity.OrphanedSignature{}).Count(&count).Error
+	return count, err
+}
+
+// ResolveOrphans deletes the orphaned signature messages of the given transfer from the given source chain and returns them,
+// ordered by consensus timestamp. Deleting and returning them in a single statement ensures each of them is resolved once
+func (r *Repository) ResolveOrphans(sourceChainId uint64, transferID string) ([]entity.OrphanedSignature, error) {
+	var orphans []entity.OrphanedSignature
+	err := r.db.
+		Clauses(clause.Returning{}).
+		Where("transfer_id = ? and source_chain_id = ?", transferID, sourceChainId).
+		Delete(&orphans).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(orphans, func(i, j int) bool {
+		return orphans[i].TransactionTimestamp < orphans[j].TransactionTimestamp
+	})
+	return orphans, nil
+}
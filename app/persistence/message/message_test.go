@@ -19,12 +19,15 @@ package message
 import (
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -37,26 +40,33 @@ var (
 	sqlMock      sqlmock.Sqlmock
 	db           *sql.DB
 
-	insertQuery                   = regexp.QuoteMeta(`INSERT INTO "messages" ("transfer_id","hash","signature","signer","transaction_timestamp") VALUES ($1,$2,$3,$4,$5)`)
+	insertQuery                   = regexp.QuoteMeta(`INSERT INTO "messages" ("transfer_id","transfer_source_chain_id","hash","signature","signer","transaction_timestamp") VALUES ($1,$2,$3,$4,$5,$6)`)
 	selectQuery                   = regexp.QuoteMeta(`SELECT * FROM "messages" WHERE transfer_id = $1 and signature = $2 and hash = $3 ORDER BY "messages"."transfer_id" LIMIT 1`)
-	selectByTransferIdQuery       = regexp.QuoteMeta(`SELECT * FROM "messages" WHERE transfer_id = $1 ORDER BY transaction_timestamp`)
-	selectTransferForeignKeyQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE "transfers"."transaction_id" = $1`)
+	selectByTransferIdQuery       = regexp.QuoteMeta(`SELECT * FROM "messages" WHERE transfer_id = $1 and transfer_source_chain_id = $2 ORDER BY transaction_timestamp`)
+	selectTransferForeignKeyQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE ("transfers"."transaction_id","transfers"."source_chain_id") IN (($1,$2))`)
+	pruneQuery                    = regexp.QuoteMeta(`DELETE FROM "messages" WHERE transaction_timestamp < $1 AND (transfer_id, transfer_source_chain_id) IN (SELECT transaction_id, source_chain_id FROM "transfers" WHERE status = $2 OR (status = $3 AND target_chain_id = $4))`)
+	insertOrphanQuery             = regexp.QuoteMeta(`INSERT INTO "orphaned_signatures" ("transfer_id","source_chain_id","payload","transaction_timestamp","created_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)
+	pruneOrphansQuery             = regexp.QuoteMeta(`DELETE FROM "orphaned_signatures" WHERE created_at < $1 or created_at is null`)
+	countOrphansQuery             = regexp.QuoteMeta(`SELECT count(*) FROM "orphaned_signatures"`)
+	resolveOrphansQuery           = regexp.QuoteMeta(`DELETE FROM "orphaned_signatures" WHERE transfer_id = $1 and source_chain_id = $2 RETURNING *`)
 
 	transferId           = "someTransferId"
+	sourceChainId        = uint64(80001)
 	transfer             = entity.Transfer{}
 	signature            = "someSignature"
 	hash                 = "someHash"
 	signer               = "someSigner"
 	transactionTimestamp = time.Now().UnixNano()
-	columns              = []string{"transfer_id", "hash", "signature", "signer", "transaction_timestamp"}
-	rowArgs              = []driver.Value{transferId, hash, signature, signer, transactionTimestamp}
+	columns              = []string{"transfer_id", "transfer_source_chain_id", "hash", "signature", "signer", "transaction_timestamp"}
+	rowArgs              = []driver.Value{transferId, sourceChainId, hash, signature, signer, transactionTimestamp}
 	expectedMsg          = &entity.Message{
-		TransferID:           transferId,
-		Transfer:             transfer,
-		Hash:                 hash,
-		Signature:            signature,
-		Signer:               signer,
-		TransactionTimestamp: transactionTimestamp,
+		TransferID:            transferId,
+		TransferSourceChainID: sourceChainId,
+		Transfer:              transfer,
+		Hash:                  hash,
+		Signature:             signature,
+		Signer:                signer,
+		TransactionTimestamp:  transactionTimestamp,
 	}
 )
 
@@ -71,7 +81,7 @@ func Test_NewRepository(t *testing.T) {
 func Test_Create(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareExec(sqlMock, insertQuery, transferId, hash, signature, signer, transactionTimestamp)
+	helper.SqlMockPrepareExec(sqlMock, insertQuery, transferId, sourceChainId, hash, signature, signer, transactionTimestamp)
 
 	err := repository.Create(expectedMsg)
 
@@ -81,7 +91,7 @@ func Test_Create(t *testing.T) {
 func Test_Create_Err(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, insertQuery, transferId, hash, signature, signer, transactionTimestamp)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, insertQuery, transferId, sourceChainId, hash, signature, signer, transactionTimestamp)
 
 	err := repository.Create(expectedMsg)
 
@@ -140,10 +150,10 @@ func Test_Exist_Err(t *testing.T) {
 func Test_Get(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, selectByTransferIdQuery, transferId)
-	sqlMock.ExpectQuery(selectTransferForeignKeyQuery).WithArgs(transferId).WillReturnRows(&sqlmock.Rows{})
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, selectByTransferIdQuery, transferId, sourceChainId)
+	sqlMock.ExpectQuery(selectTransferForeignKeyQuery).WithArgs(transferId, sourceChainId).WillReturnRows(&sqlmock.Rows{})
 
-	fetchedMessages, err := repository.Get(transferId)
+	fetchedMessages, err := repository.Get(sourceChainId, transferId)
 
 	assert.Nil(t, err)
 	assert.Len(t, fetchedMessages, 1)
@@ -153,14 +163,139 @@ func Test_Get(t *testing.T) {
 func Test_Get_Err(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	expectedErr := helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, selectByTransferIdQuery, transferId)
+	expectedErr := helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, selectByTransferIdQuery, transferId, sourceChainId)
 
-	fetchedMessages, err := repository.Get(transferId)
+	fetchedMessages, err := repository.Get(sourceChainId, transferId)
 
 	assert.Error(t, err, expectedErr)
 	assert.Len(t, fetchedMessages, 0)
 }
 
+func Test_PruneMessagesBefore(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	cutoff := time.Unix(1000, 0)
+	sqlMock.ExpectExec(pruneQuery).
+		WithArgs(cutoff.UnixNano(), status.Failed, status.Completed, constants.HederaNetworkId).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	pruned, err := repository.PruneMessagesBefore(cutoff)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), pruned)
+}
+
+func Test_PruneMessagesBefore_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	cutoff := time.Unix(1000, 0)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, pruneQuery, cutoff.UnixNano(), status.Failed, status.Completed, constants.HederaNetworkId)
+
+	pruned, err := repository.PruneMessagesBefore(cutoff)
+
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Equal(t, int64(0), pruned)
+}
+
+func Test_CreateOrphan(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	payload := []byte{1, 2, 3}
+	sqlMock.ExpectQuery(insertOrphanQuery).
+		WithArgs(transferId, sourceChainId, payload, transactionTimestamp, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	orphan := &entity.OrphanedSignature{TransferID: transferId, SourceChainID: sourceChainId, Payload: payload, TransactionTimestamp: transactionTimestamp}
+	err := repository.CreateOrphan(orphan)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), orphan.ID)
+}
+
+func Test_CreateOrphan_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	payload := []byte{1, 2, 3}
+	expectedErr := errors.New("some-error")
+	sqlMock.ExpectQuery(insertOrphanQuery).
+		WithArgs(transferId, sourceChainId, payload, transactionTimestamp, sqlmock.AnyArg()).
+		WillReturnError(expectedErr)
+
+	err := repository.CreateOrphan(&entity.OrphanedSignature{TransferID: transferId, SourceChainID: sourceChainId, Payload: payload, TransactionTimestamp: transactionTimestamp})
+
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func Test_PruneOrphansBefore(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	cutoff := time.Unix(1000, 0)
+	sqlMock.ExpectExec(pruneOrphansQuery).
+		WithArgs(cutoff).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	pruned, err := repository.PruneOrphansBefore(cutoff)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), pruned)
+}
+
+func Test_PruneOrphansBefore_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	cutoff := time.Unix(1000, 0)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, pruneOrphansQuery, cutoff)
+
+	pruned, err := repository.PruneOrphansBefore(cutoff)
+
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Equal(t, int64(0), pruned)
+}
+
+func Test_CountOrphans(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectQuery(countOrphansQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	count, err := repository.CountOrphans()
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), count)
+}
+
+func Test_ResolveOrphans(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectQuery(resolveOrphansQuery).
+		WithArgs(transferId, sourceChainId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "transfer_id", "source_chain_id", "payload", "transaction_timestamp"}).
+			AddRow(2, transferId, sourceChainId, []byte{2}, 20).
+			AddRow(1, transferId, sourceChainId, []byte{1}, 10))
+
+	orphans, err := repository.ResolveOrphans(sourceChainId, transferId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []entity.OrphanedSignature{
+		{ID: 1, TransferID: transferId, SourceChainID: sourceChainId, Payload: []byte{1}, TransactionTimestamp: 10},
+		{ID: 2, TransferID: transferId, SourceChainID: sourceChainId, Payload: []byte{2}, TransactionTimestamp: 20},
+	}, orphans)
+}
+
+func Test_ResolveOrphans_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := errors.New("some-error")
+	sqlMock.ExpectQuery(resolveOrphansQuery).
+		WithArgs(transferId, sourceChainId).
+		WillReturnError(expectedErr)
+
+	orphans, err := repository.ResolveOrphans(sourceChainId, transferId)
+
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Nil(t, orphans)
+}
+
 func setup() {
 	mocks.Setup()
 	dbConnection, sqlMock, db = helper.SetupSqlMock()
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue_message
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db     *gorm.DB
+	logger *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db:     dbClient,
+		logger: config.GetLoggerFor("Queue Message Repository"),
+	}
+}
+
+func (r *Repository) Create(entity *entity.QueueMessage) error {
+	return r.db.Create(entity).Error
+}
+
+func (r *Repository) Delete(id uint64) error {
+	return r.db.Delete(&entity.QueueMessage{}, id).Error
+}
+
+// Returns all stored messages, ordered by insertion
+func (r *Repository) GetAll() ([]*entity.QueueMessage, error) {
+	var messages []*entity.QueueMessage
+
+	err := r.db.
+		Order("id").
+		Find(&messages).Error
+	return messages, err
+}
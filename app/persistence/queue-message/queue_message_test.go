@@ -0,0 +1,137 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue_message
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository  *Repository
+	dbConn      *gorm.DB
+	sqlMock     sqlmock.Sqlmock
+	id          = uint64(1)
+	topic       = "topic"
+	payloadType = "transfer"
+	payload     = []byte("payload")
+	expected    = &entity.QueueMessage{
+		ID:          id,
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+	}
+	rowArgs = []driver.Value{id, topic, payloadType, payload}
+	columns = []string{"id", "topic", "payload_type", "payload"}
+
+	createQuery = regexp.QuoteMeta(`INSERT INTO "queue_messages" ("topic","payload_type","payload") VALUES ($1,$2,$3) RETURNING "id"`)
+	deleteQuery = regexp.QuoteMeta(`DELETE FROM "queue_messages" WHERE "queue_messages"."id" = $1`)
+	getAllQuery = regexp.QuoteMeta(`SELECT * FROM "queue_messages" ORDER BY id`)
+)
+
+func setup() {
+	mocks.Setup()
+	dbConn, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db:     dbConn,
+		logger: config.GetLoggerFor("Queue Message Repository"),
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+	actual := NewRepository(dbConn)
+	assert.Equal(t, repository, actual)
+}
+
+func Test_Create(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"id"}, []driver.Value{id}, createQuery, topic, payloadType, payload)
+
+	message := &entity.QueueMessage{
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+	}
+	err := repository.Create(message)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, message)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Create_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, createQuery, topic, payloadType, payload)
+
+	err := repository.Create(&entity.QueueMessage{
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+	})
+
+	assert.NotNil(t, err)
+}
+
+func Test_Delete(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareExec(sqlMock, deleteQuery, id)
+
+	err := repository.Delete(id)
+
+	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_Delete_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, deleteQuery, id)
+
+	err := repository.Delete(id)
+
+	assert.NotNil(t, err)
+}
+
+func Test_GetAll(t *testing.T) {
+	setup()
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getAllQuery)
+
+	actual, err := repository.GetAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.QueueMessage{expected}, actual)
+}
+
+func Test_GetAll_Err(t *testing.T) {
+	setup()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getAllQuery)
+
+	actual, err := repository.GetAll()
+
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
@@ -51,6 +51,10 @@ func (r *Repository) UpdateStatusFailed(txId string) error {
 	return r.updateStatus(txId, status.Failed)
 }
 
+func (r *Repository) UpdateStatusExpired(txId string) error {
+	return r.updateStatus(txId, status.Expired)
+}
+
 func (r *Repository) updateStatus(txId string, s string) error {
 	err := r.db.
 		Model(entity.Schedule{}).
@@ -86,11 +90,11 @@ func (r *Repository) Get(id string) (*entity.Schedule, error) {
 	return record, nil
 }
 
-func (r *Repository) GetReceiverTransferByTransactionID(id string) (*entity.Schedule, error) {
+func (r *Repository) GetReceiverTransferByTransactionID(sourceChainId uint64, id string) (*entity.Schedule, error) {
 	record := &entity.Schedule{}
 	result := r.db.
 		Model(entity.Schedule{}).
-		Where("transfer_id = ? AND operation IN (?, ?) AND has_receiver = true", id, schedule.TRANSFER, schedule.APPROVE).
+		Where("transfer_id = ? AND transfer_source_chain_id = ? AND operation IN (?, ?) AND has_receiver = true", id, sourceChainId, schedule.TRANSFER, schedule.APPROVE).
 		First(record)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
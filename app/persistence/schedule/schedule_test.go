@@ -39,11 +39,11 @@ var (
 	sqlMock      sqlmock.Sqlmock
 	db           *sql.DB
 
-	insertQuery                 = regexp.QuoteMeta(`INSERT INTO "schedules" ("transaction_id","schedule_id","has_receiver","operation","status","transfer_id") VALUES ($1,$2,$3,$4,$5,$6)`)
+	insertQuery                 = regexp.QuoteMeta(`INSERT INTO "schedules" ("transaction_id","schedule_id","has_receiver","operation","status","transfer_id","transfer_source_chain_id") VALUES ($1,$2,$3,$4,$5,$6,$7)`)
 	updateStatusQuery           = regexp.QuoteMeta(`UPDATE "schedules" SET "status"=$1 WHERE transaction_id = $2`)
 	selectQuery                 = regexp.QuoteMeta(`SELECT * FROM "schedules" WHERE transaction_id = $1 ORDER BY "schedules"."transaction_id" LIMIT 1`)
 	selectIdsByStatusQuery      = regexp.QuoteMeta(`SELECT "transaction_id" FROM "schedules" WHERE status = $1`)
-	selectReceiverTransferQuery = regexp.QuoteMeta(`SELECT * FROM "schedules" WHERE transfer_id = $1 AND operation IN ($2, $3) AND has_receiver = true ORDER BY "schedules"."transaction_id" LIMIT 1`)
+	selectReceiverTransferQuery = regexp.QuoteMeta(`SELECT * FROM "schedules" WHERE transfer_id = $1 AND transfer_source_chain_id = $2 AND operation IN ($3, $4) AND has_receiver = true ORDER BY "schedules"."transaction_id" LIMIT 1`)
 
 	transactionId  = "someTransactionId"
 	scheduleId     = "someScheduleId"
@@ -51,17 +51,19 @@ var (
 	operation      = "someOperation"
 	expectedStatus = status.Submitted
 	transferId     = sql.NullString{String: "someTransferId", Valid: true}
+	sourceChainId  = uint64(80001)
 
-	entityColumns = []string{"transaction_id", "schedule_id", "has_receiver", "operation", "status", "transfer_id"}
-	entityArgs    = []driver.Value{transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId}
+	entityColumns = []string{"transaction_id", "schedule_id", "has_receiver", "operation", "status", "transfer_id", "transfer_source_chain_id"}
+	entityArgs    = []driver.Value{transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId, sourceChainId}
 
 	expectedSchedule = &entity.Schedule{
-		TransactionID: transactionId,
-		ScheduleID:    scheduleId,
-		HasReceiver:   hasReceiver,
-		Operation:     operation,
-		Status:        expectedStatus,
-		TransferID:    transferId,
+		TransactionID:         transactionId,
+		ScheduleID:            scheduleId,
+		HasReceiver:           hasReceiver,
+		Operation:             operation,
+		Status:                expectedStatus,
+		TransferID:            transferId,
+		TransferSourceChainID: sourceChainId,
 	}
 )
 
@@ -76,7 +78,7 @@ func Test_NewRepository(t *testing.T) {
 func Test_Create(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareExec(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId)
+	helper.SqlMockPrepareExec(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId, sourceChainId)
 
 	err := repository.Create(expectedSchedule)
 
@@ -86,7 +88,7 @@ func Test_Create(t *testing.T) {
 func Test_Create_Error(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId, sourceChainId)
 
 	err := repository.Create(expectedSchedule)
 
@@ -163,9 +165,9 @@ func Test_Get_Error(t *testing.T) {
 func Test_GetReceiverTransferByTransactionID(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareQuery(sqlMock, entityColumns, entityArgs, selectReceiverTransferQuery, transferId.String, schedule.TRANSFER, schedule.APPROVE)
+	helper.SqlMockPrepareQuery(sqlMock, entityColumns, entityArgs, selectReceiverTransferQuery, transferId.String, sourceChainId, schedule.TRANSFER, schedule.APPROVE)
 
-	fetchedSchedule, err := repository.GetReceiverTransferByTransactionID(transferId.String)
+	fetchedSchedule, err := repository.GetReceiverTransferByTransactionID(sourceChainId, transferId.String)
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedSchedule, fetchedSchedule)
@@ -174,12 +176,12 @@ func Test_GetReceiverTransferByTransactionID(t *testing.T) {
 func Test_GetReceiverTransferByTransactionID_Error(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	expectedErr1 := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectReceiverTransferQuery, transferId.String, schedule.TRANSFER, schedule.APPROVE)
+	expectedErr1 := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectReceiverTransferQuery, transferId.String, sourceChainId, schedule.TRANSFER, schedule.APPROVE)
 
-	fetchedSchedule1, err1 := repository.GetReceiverTransferByTransactionID(transferId.String)
+	fetchedSchedule1, err1 := repository.GetReceiverTransferByTransactionID(sourceChainId, transferId.String)
 
-	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, selectReceiverTransferQuery, transferId.String, schedule.TRANSFER, schedule.APPROVE)
-	fetchedSchedule2, err2 := repository.GetReceiverTransferByTransactionID(transferId.String)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, selectReceiverTransferQuery, transferId.String, sourceChainId, schedule.TRANSFER, schedule.APPROVE)
+	fetchedSchedule2, err2 := repository.GetReceiverTransferByTransactionID(sourceChainId, transferId.String)
 
 	assert.Error(t, err1, expectedErr1)
 	assert.Nil(t, fetchedSchedule1)
@@ -27,6 +27,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/events"
+	evmhelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
+	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
@@ -35,6 +39,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -49,12 +54,12 @@ func NewRepository(dbClient *gorm.DB) *Repository {
 	}
 }
 
-// Returns Transfer. Returns nil if not found
-func (r *Repository) GetByTransactionId(txId string) (*entity.Transfer, error) {
+// Returns the Transfer with the given transaction id, originating from the given source chain. Returns nil if not found
+func (r *Repository) GetByTransactionId(sourceChainId uint64, txId string) (*entity.Transfer, error) {
 	tx := &entity.Transfer{}
 	result := r.db.
 		Model(entity.Transfer{}).
-		Where("transaction_id = ?", txId).
+		Where("transaction_id = ? and source_chain_id = ?", txId, sourceChainId).
 		First(tx)
 
 	if result.Error != nil {
@@ -68,13 +73,39 @@ func (r *Repository) GetByTransactionId(txId string) (*entity.Transfer, error) {
 	return tx, nil
 }
 
-func (r *Repository) GetWithPreloads(txId string) (*entity.Transfer, error) {
+// Returns the Transfer with the given transaction id, if it exists on a single source chain. Meant for API callers,
+// which do not specify the source chain. Returns service.ErrAmbiguousTransfer if the transaction id exists on more
+// than one source chain and nil if not found
+func (r *Repository) GetByTransactionIdOnAnyChain(txId string) (*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("transaction_id = ?", txId).
+		Limit(2).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(transfers) {
+	case 0:
+		return nil, nil
+	case 1:
+		r.updateHederaChainId(transfers[0])
+		return transfers[0], nil
+	default:
+		return nil, service.ErrAmbiguousTransfer
+	}
+}
+
+// Returns the Transfer with preloaded Fee and Message tables, originating from the given source chain. Returns nil if not found
+func (r *Repository) GetWithPreloads(sourceChainId uint64, txId string) (*entity.Transfer, error) {
 	tx := &entity.Transfer{}
 	result := r.db.
 		Preload("Fees").
 		Preload("Messages").
 		Model(entity.Transfer{}).
-		Where("transaction_id = ?", txId).
+		Where("transaction_id = ? and source_chain_id = ?", txId, sourceChainId).
 		First(tx)
 
 	if result.Error != nil {
@@ -88,13 +119,13 @@ func (r *Repository) GetWithPreloads(txId string) (*entity.Transfer, error) {
 	return tx, nil
 }
 
-// Returns Transfer with preloaded Fee table. Returns nil if not found
-func (r *Repository) GetWithFee(txId string) (*entity.Transfer, error) {
+// Returns Transfer with preloaded Fee table, originating from the given source chain. Returns nil if not found
+func (r *Repository) GetWithFee(sourceChainId uint64, txId string) (*entity.Transfer, error) {
 	tx := &entity.Transfer{}
 	result := r.db.
 		Preload("Fees").
 		Model(entity.Transfer{}).
-		Where("transaction_id = ?", txId).
+		Where("transaction_id = ? and source_chain_id = ?", txId, sourceChainId).
 		First(tx)
 
 	if result.Error != nil {
@@ -110,32 +141,155 @@ func (r *Repository) GetWithFee(txId string) (*entity.Transfer, error) {
 
 // Create creates new record of Transfer
 func (r *Repository) Create(ct *payload.Transfer) (*entity.Transfer, error) {
-	return r.create(ct, status.Initial)
+	tx, err := r.create(ct, status.Initial)
+	if err == nil {
+		events.FireTransferEvent(constants.EventTransferCreated, tx.SourceChainID, tx.TransactionID, tx.Status)
+	}
+	return tx, err
+}
+
+// CreateFailedIfAbsent stores the transfer as Failed, unless it has been stored already, so that a message
+// of the transfer, which is still to be handled, gets skipped. Returns whether the transfer was stored
+func (r *Repository) CreateFailedIfAbsent(ct *payload.Transfer) (bool, error) {
+	tx, err := newTransfer(ct, status.Failed)
+	if err != nil {
+		return false, err
+	}
+
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(tx)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	r.logger.Errorf("Stored TX [%s] with status [%s]", tx.TransactionID, status.Failed)
+	events.FireTransferEvent(constants.EventTransferFailed, tx.SourceChainID, tx.TransactionID, status.Failed)
+	return true, nil
 }
 
-// Save updates the provided Transfer instance
+// Save updates the provided Transfer instance.
+// The update is filtered explicitly, since gorm would insert transfers from Hedera, whose source chain id is zero
 func (r *Repository) Save(tx *entity.Transfer) error {
-	return r.db.Save(tx).Error
+	return r.db.
+		Model(entity.Transfer{}).
+		Where("transaction_id = ? and source_chain_id = ?", tx.TransactionID, tx.SourceChainID).
+		Select("*").
+		Omit("transaction_id", "source_chain_id", "created_at", clause.Associations).
+		Updates(tx).Error
 }
 
-func (r *Repository) UpdateFee(txId string, fee string) error {
+func (r *Repository) UpdateFee(sourceChainId uint64, txId string, fee string) error {
 	err := r.db.
 		Model(entity.Transfer{}).
-		Where("transaction_id = ?", txId).
+		Where("transaction_id = ? and source_chain_id = ?", txId, sourceChainId).
 		UpdateColumn("fee", fee).
 		Error
 	if err == nil {
 		r.logger.Debugf("Updated Fee of TX [%s] to [%s]", txId, fee)
+		events.FireTransferEvent(constants.EventTransferFeeUpdated, sourceChainId, txId, "")
+	}
+	return err
+}
+
+func (r *Repository) UpdateStatusCompleted(sourceChainId uint64, txId string) error {
+	updated, err := r.updateStatus(sourceChainId, txId, status.Completed)
+	if updated {
+		events.FireTransferEvent(constants.EventTransferCompleted, sourceChainId, txId, status.Completed)
 	}
 	return err
 }
 
-func (r *Repository) UpdateStatusCompleted(txId string) error {
-	return r.updateStatus(txId, status.Completed)
+func (r *Repository) UpdateStatusFailed(sourceChainId uint64, txId string) error {
+	updated, err := r.updateStatus(sourceChainId, txId, status.Failed)
+	if updated {
+		events.FireTransferEvent(constants.EventTransferFailed, sourceChainId, txId, status.Failed)
+	}
+	return err
+}
+
+// UpdateSignatureMsgStatus updates the submission status of the validator's signature for the given transfer
+func (r *Repository) UpdateSignatureMsgStatus(sourceChainId uint64, txId string, s string) error {
+	if s != status.SignaturePending &&
+		s != status.SignatureSubmitted &&
+		s != status.SignatureFailed {
+		return errors.New("invalid signature message status")
+	}
+
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("transaction_id = ? and source_chain_id = ?", txId, sourceChainId).
+		UpdateColumn("signature_msg_status", s).
+		Error
+	if err == nil {
+		r.logger.Debugf("Updated Signature Message Status of TX [%s] to [%s]", txId, s)
+	}
+	return err
+}
+
+// GetPendingSignatureSubmissions returns the Initial transfers, for which the signature submission is pending or has failed
+func (r *Repository) GetPendingSignatureSubmissions() ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("status = ? and signature_msg_status in (?, ?)", status.Initial, status.SignaturePending, status.SignatureFailed).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// GetInitialBefore returns the Initial transfers, which were stored before the given time
+func (r *Repository) GetInitialBefore(before time.Time) ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("status = ? and created_at < ?", status.Initial, before).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// GetAwaitingSignatureFrom returns the Initial transfers to EVM networks, for which no signature message
+// of the given signer has been recorded, ordered by the time of their source transaction
+func (r *Repository) GetAwaitingSignatureFrom(signer string) ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("status = ? and target_chain_id <> ?", status.Initial, constants.HederaNetworkId).
+		Where("not exists (select 1 from messages where messages.transfer_id = transfers.transaction_id and messages.transfer_source_chain_id = transfers.source_chain_id and lower(messages.signer) = lower(?))", signer).
+		Order("timestamp").
+		Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
 }
 
-func (r *Repository) UpdateStatusFailed(txId string) error {
-	return r.updateStatus(txId, status.Failed)
+// UpdateStatusFailedIfInitial fails the transfer, unless it has been completed or failed in the meantime.
+// Returns whether the transfer was failed
+func (r *Repository) UpdateStatusFailedIfInitial(sourceChainId uint64, txId string) (bool, error) {
+	result := r.db.
+		Model(entity.Transfer{}).
+		Where("transaction_id = ? and source_chain_id = ? and status = ?", txId, sourceChainId, status.Initial).
+		UpdateColumn("status", status.Failed)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	r.logger.Errorf("Updated Status of TX [%s] to [%s]", txId, status.Failed)
+	events.FireTransferEvent(constants.EventTransferFailed, sourceChainId, txId, status.Failed)
+	return true, nil
 }
 
 func formatTimestampFilter(q *gorm.DB, ts_query string) (*gorm.DB, error) {
@@ -186,6 +340,268 @@ func formatTimestampFilter(q *gorm.DB, ts_query string) (*gorm.DB, error) {
 	return q, nil
 }
 
+// CreateEventLog stores the raw on-chain log of a transfer. The log of an already stored transfer,
+// seen again after a restart or a reorg, is left as is
+func (r *Repository) CreateEventLog(eventLog *entity.EventLog) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(eventLog).Error
+}
+
+// Returns the raw on-chain log of a transfer from the given source chain. Returns nil if not found
+func (r *Repository) GetEventLog(sourceChainId uint64, txId string) (*entity.EventLog, error) {
+	eventLog := &entity.EventLog{}
+	result := r.db.
+		Model(entity.EventLog{}).
+		Where("transfer_id = ? and source_chain_id = ?", txId, sourceChainId).
+		First(eventLog)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return eventLog, nil
+}
+
+// CountByStatus returns the number of transfers per status, using a single grouped query.
+// Statuses without any transfers are included with a zero count
+func (r *Repository) CountByStatus() (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	err := r.db.
+		Model(entity.Transfer{}).
+		Select("status, count(*) as count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{
+		status.Initial:   0,
+		status.Completed: 0,
+		status.Failed:    0,
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts, nil
+}
+
+// MigrateCreationTimes stamps the transfers, stored before their creation time was recorded, with the current time,
+// so that timeouts measured from the creation of transfers apply to them as well. Returns the number of updated rows
+func (r *Repository) MigrateCreationTimes() (int64, error) {
+	result := r.db.
+		Model(entity.Transfer{}).
+		Where("created_at is null").
+		UpdateColumn("created_at", gorm.Expr("now()"))
+	return result.RowsAffected, result.Error
+}
+
+// GetTargetAssetsWithoutDecimals returns the distinct target chains and assets of the fungible transfers,
+// stored before the decimals of their amount were recorded
+func (r *Repository) GetTargetAssetsWithoutDecimals() ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	err := r.db.
+		Model(entity.Transfer{}).
+		Distinct("target_chain_id", "target_asset").
+		Where("decimals = ? and is_nft = ?", 0, false).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// MigrateDecimals backfills the decimals of the fungible transfers to the given target asset, stored before
+// the decimals of their amount were recorded. Returns the number of updated rows
+func (r *Repository) MigrateDecimals(targetChainId uint64, targetAsset string, decimals uint8) (int64, error) {
+	result := r.db.
+		Model(entity.Transfer{}).
+		Where("target_chain_id = ? and target_asset = ? and decimals = ? and is_nft = ?", targetChainId, targetAsset, 0, false).
+		UpdateColumn("decimals", decimals)
+	return result.RowsAffected, result.Error
+}
+
+// transferReferenceChainIdMigrations rewrite the legacy source chain of the rows, which reference a transfer migrated to the current
+// Hedera network id. Rows, whose source chain has been backfilled before their transfer was migrated, are repaired as well
+var transferReferenceChainIdMigrations = []string{
+	"UPDATE messages SET transfer_source_chain_id = ? WHERE transfer_source_chain_id = ? AND EXISTS (SELECT 1 FROM transfers WHERE transfers.transaction_id = messages.transfer_id AND transfers.source_chain_id = ?)",
+	"UPDATE fees SET transfer_source_chain_id = ? WHERE transfer_source_chain_id = ? AND EXISTS (SELECT 1 FROM transfers WHERE transfers.transaction_id = fees.transfer_id AND transfers.source_chain_id = ?)",
+	"UPDATE schedules SET transfer_source_chain_id = ? WHERE transfer_source_chain_id = ? AND EXISTS (SELECT 1 FROM transfers WHERE transfers.transaction_id = schedules.transfer_id AND transfers.source_chain_id = ?)",
+	"UPDATE event_logs SET source_chain_id = ? WHERE source_chain_id = ? AND EXISTS (SELECT 1 FROM transfers WHERE transfers.transaction_id = event_logs.transfer_id AND transfers.source_chain_id = ?)",
+}
+
+// MigrateLegacyChainIds rewrites the chain ids of transfers, stored with the legacy Hedera network id, to the current one,
+// so that queries filtering by chain id cover both legacy and new transfers. The messages, fees, schedules and event logs
+// of the migrated transfers follow them to the current Hedera network id.
+// Only legacy-shaped rows are updated, which makes the migration safe to re-run. Returns the number of updated transfers
+func (r *Repository) MigrateLegacyChainIds(hederaNetworkId uint64) (int64, error) {
+	if hederaNetworkId == constants.OldHederaNetworkId {
+		return 0, nil
+	}
+
+	var updated int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// A row may have more than one legacy column, so the rows are counted before their columns are updated
+		err := tx.
+			Model(entity.Transfer{}).
+			Where("source_chain_id = ? or target_chain_id = ? or native_chain_id = ?", constants.OldHederaNetworkId, constants.OldHederaNetworkId, constants.OldHederaNetworkId).
+			Count(&updated).Error
+		if err != nil {
+			return err
+		}
+
+		for _, column := range []string{"source_chain_id", "target_chain_id", "native_chain_id"} {
+			err := tx.
+				Model(entity.Transfer{}).
+				Where(column+" = ?", constants.OldHederaNetworkId).
+				UpdateColumn(column, hederaNetworkId).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, statement := range transferReferenceChainIdMigrations {
+			err := tx.Exec(statement, hederaNetworkId, constants.OldHederaNetworkId, hederaNetworkId).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, nil
+}
+
+// transferSourceChainMigrations backfill the source chain of the rows, which reference a transfer by its transaction id alone.
+// Rows without a transfer are left at chain 0
+var transferSourceChainMigrations = []string{
+	"UPDATE messages SET transfer_source_chain_id = transfers.source_chain_id FROM transfers WHERE messages.transfer_id = transfers.transaction_id AND messages.transfer_source_chain_id IS NULL",
+	"UPDATE messages SET transfer_source_chain_id = 0 WHERE transfer_source_chain_id IS NULL",
+	"UPDATE fees SET transfer_source_chain_id = transfers.source_chain_id FROM transfers WHERE fees.transfer_id = transfers.transaction_id AND fees.transfer_source_chain_id IS NULL",
+	"UPDATE fees SET transfer_source_chain_id = 0 WHERE transfer_source_chain_id IS NULL",
+	"UPDATE schedules SET transfer_source_chain_id = transfers.source_chain_id FROM transfers WHERE schedules.transfer_id = transfers.transaction_id AND schedules.transfer_source_chain_id IS NULL",
+	"UPDATE schedules SET transfer_source_chain_id = 0 WHERE transfer_source_chain_id IS NULL",
+	"UPDATE orphaned_signatures SET source_chain_id = 0 WHERE source_chain_id IS NULL",
+}
+
+// MigrateTransferSourceChains backfills the source chain of the messages, fees and schedules, stored while they referenced
+// their transfer by its transaction id alone, from their transfer. Orphaned signatures carry no transfer to backfill from,
+// so they are left at chain 0. Only rows without a source chain are updated, which makes the migration safe to re-run.
+// Returns the number of updated rows
+func (r *Repository) MigrateTransferSourceChains() (int64, error) {
+	var updated int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range transferSourceChainMigrations {
+			result := tx.Exec(statement)
+			if result.Error != nil {
+				return result.Error
+			}
+			updated += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, nil
+}
+
+// compositeForeignKey references the transaction id and the source chain id of transfers from the given table
+type compositeForeignKey struct {
+	table      string
+	constraint string
+}
+
+// compositeForeignKeys replace the foreign keys, which referenced the transaction id of transfers alone.
+// Event logs are stored ahead of their transfer and for events without a transfer, so they reference none
+var compositeForeignKeys = []compositeForeignKey{
+	{table: "messages", constraint: "fk_messages_transfer_identity"},
+	{table: "fees", constraint: "fk_fees_transfer_identity"},
+	{table: "schedules", constraint: "fk_schedules_transfer_identity"},
+}
+
+// MigrateCompositeForeignKeys references transfers by their transaction id and source chain id from the messages, fees and schedules.
+// It must run after their source chain has been backfilled. Legacy chain ids of transfers are rewritten through the foreign keys.
+// Existing foreign keys are left untouched, which makes the migration safe to re-run. Returns whether any foreign key has been added
+func (r *Repository) MigrateCompositeForeignKeys() (bool, error) {
+	migrated := false
+	for _, foreignKey := range compositeForeignKeys {
+		var constraints int64
+		err := r.db.
+			Table("information_schema.table_constraints").
+			Where("table_schema = current_schema() and table_name = ? and constraint_name = ?", foreignKey.table, foreignKey.constraint).
+			Count(&constraints).Error
+		if err != nil {
+			return migrated, err
+		}
+		if constraints != 0 {
+			continue
+		}
+
+		statement := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (transfer_id, transfer_source_chain_id) REFERENCES transfers (transaction_id, source_chain_id) ON UPDATE CASCADE",
+			foreignKey.table, foreignKey.constraint)
+		if err := r.db.Exec(statement).Error; err != nil {
+			return migrated, err
+		}
+		migrated = true
+	}
+
+	return migrated, nil
+}
+
+// compositeIdentityStatements drop the foreign keys, which reference the transaction id of transfers alone,
+// and widen the primary key of transfers to the transaction id and the source chain id
+var compositeIdentityStatements = []string{
+	"ALTER TABLE messages DROP CONSTRAINT IF EXISTS fk_transfers_messages",
+	"ALTER TABLE messages DROP CONSTRAINT IF EXISTS fk_messages_transfer",
+	"ALTER TABLE fees DROP CONSTRAINT IF EXISTS fk_transfers_fees",
+	"ALTER TABLE schedules DROP CONSTRAINT IF EXISTS fk_transfers_schedules",
+	"ALTER TABLE transfers DROP CONSTRAINT transfers_pkey, ADD PRIMARY KEY (transaction_id, source_chain_id)",
+}
+
+// MigrateCompositeIdentity makes the source chain id part of the primary key of transfers, created when the transaction id alone
+// identified a transfer, so that transfers with the same transaction id on different source chains can coexist.
+// A primary key, which already spans both columns, is left untouched, which makes the migration safe to re-run.
+// Returns whether the primary key has been migrated
+func (r *Repository) MigrateCompositeIdentity() (bool, error) {
+	var primaryKeyColumns int64
+	err := r.db.
+		Table("information_schema.key_column_usage").
+		Where("table_schema = current_schema() and table_name = ? and constraint_name = ?", "transfers", "transfers_pkey").
+		Count(&primaryKeyColumns).Error
+	if err != nil {
+		return false, err
+	}
+	if primaryKeyColumns != 1 {
+		return false, nil
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range compositeIdentityStatements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error) {
 	var (
 		err   error
@@ -200,12 +616,7 @@ func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int6
 		Order("timestamp desc, status asc")
 
 	if f.Originator != "" {
-		if strings.Contains(f.Originator, "0x") {
-			a := common.HexToAddress(f.Originator).String()
-			q = q.Where("originator = ?", a)
-		} else {
-			q = q.Where("originator = ?", f.Originator)
-		}
+		q = q.Where("originator = ?", normalizeAddress(f.Originator))
 	}
 
 	if f.TimestampQuery != "" {
@@ -227,6 +638,9 @@ func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int6
 	if f.TransactionId != "" {
 		q = q.Where("transaction_id LIKE ?", fmt.Sprintf(`%s%%`, f.TransactionId))
 	}
+	if f.Receiver != "" {
+		q = q.Where("receiver = ?", normalizeAddress(f.Receiver))
+	}
 
 	q = q.Count(&count).
 		Offset(int(offset)).
@@ -241,7 +655,41 @@ func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int6
 	return res, count, nil
 }
 
+// normalizeAddress returns the canonical form of the given EVM address or Hedera account, under which
+// receivers and originators are stored and looked up. Other values are returned as they are
+func normalizeAddress(address string) string {
+	if normalized, err := evmhelper.NormalizeAddress(address); err == nil {
+		return normalized
+	}
+	if strings.Contains(address, "0x") {
+		return common.HexToAddress(address).String()
+	}
+	if normalized, err := hederahelper.NormalizeAccount(address); err == nil {
+		return normalized
+	}
+	return address
+}
+
 func (r *Repository) create(ct *payload.Transfer, status string) (*entity.Transfer, error) {
+	tx, err := newTransfer(ct, status)
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.Create(tx).Error
+
+	return tx, err
+}
+
+func newTransfer(ct *payload.Transfer, status string) (*entity.Transfer, error) {
+	amount := ct.Amount
+	if !ct.IsNft {
+		var err error
+		amount, err = big_numbers.CanonicalAmount(ct.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tx := &entity.Transfer{
 		TransactionID: ct.TransactionId,
 		SourceChainID: ct.SourceChainId,
@@ -250,45 +698,64 @@ func (r *Repository) create(ct *payload.Transfer, status string) (*entity.Transf
 		SourceAsset:   ct.SourceAsset,
 		TargetAsset:   ct.TargetAsset,
 		NativeAsset:   ct.NativeAsset,
-		Receiver:      ct.Receiver,
-		Amount:        ct.Amount,
+		Receiver:      normalizeAddress(ct.Receiver),
+		Amount:        amount,
+		Decimals:      ct.Decimals,
 		Status:        status,
 		SerialNumber:  ct.SerialNum,
 		Metadata:      ct.Metadata,
 		IsNft:         ct.IsNft,
 		Timestamp:     entity.NanoTime{Time: ct.Timestamp},
-		Originator:    ct.Originator,
+		Originator:    normalizeAddress(ct.Originator),
 	}
-	err := r.db.Create(tx).Error
 
-	return tx, err
+	return tx, nil
 }
 
-func (r *Repository) updateStatus(txId string, s string) error {
+// updateStatus updates the status of the transfer, unless it already has the given one.
+// Returns whether the status was changed
+func (r *Repository) updateStatus(sourceChainId uint64, txId string, s string) (bool, error) {
 	// Sanity check
 	if s != status.Initial &&
 		s != status.Completed &&
 		s != status.Failed {
-		return errors.New("invalid status")
+		return false, errors.New("invalid status")
 	}
 
 	result := r.db.
 		Model(entity.Transfer{}).
-		Where("transaction_id = ?", txId).
+		Where("transaction_id = ? and source_chain_id = ? and status <> ?", txId, sourceChainId, s).
 		UpdateColumn("status", s)
-	if result.Error == nil {
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
 		if s == status.Failed {
-			r.logger.Errorf("Updated Status of TX [%s] to [%s]", txId, s)
-			return nil
+			return false, nil
 		}
-		r.logger.Infof("Updated Status of TX [%s] to [%s]", txId, s)
+		var count int64
+		err := r.db.
+			Model(entity.Transfer{}).
+			Where("transaction_id = ? and source_chain_id = ?", txId, sourceChainId).
+			Count(&count).
+			Error
+		if err != nil {
+			return false, err
+		}
+		if count != 1 {
+			return false, fmt.Errorf("updated %d rows, expected 1", result.RowsAffected)
+		}
+		r.logger.Debugf("Status of TX [%s] is already [%s]", txId, s)
+		return false, nil
 	}
 
-	if result.RowsAffected != 1 {
-		return fmt.Errorf("updated %d rows, expected 1", result.RowsAffected)
+	if s == status.Failed {
+		r.logger.Errorf("Updated Status of TX [%s] to [%s]", txId, s)
+	} else {
+		r.logger.Infof("Updated Status of TX [%s] to [%s]", txId, s)
 	}
-
-	return result.Error
+	return true, nil
 }
 
 func (r *Repository) updateHederaChainId(tx *entity.Transfer) {
@@ -21,6 +21,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,6 +34,10 @@ import (
 	model "github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gookit/event"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/events"
+	transfer_event "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -55,7 +60,8 @@ var (
 	targetAsset         = "targetAsset"
 	nativeAsset         = "nativeAsset"
 	receiver            = "receiver"
-	amount              = "amount"
+	amount              = "100"
+	decimals            = uint8(8)
 	fee                 = ""
 	someStatus          = status.Initial
 	serialNumber        = int64(0)
@@ -63,22 +69,26 @@ var (
 	isNft               = false
 	now                 = time.Now().UTC()
 	nanoTime            = entity.NanoTime{Time: now}
+	createdAt           = now.Add(time.Minute)
 	originator          = "originator"
 	originatorEVM       = "0x1235"
+	signatureMsgStatus  = ""
+	member              = "0x1236"
 
-	transferColumns = []string{"transaction_id", "source_chain_id", "target_chain_id", "native_chain_id", "source_asset", "target_asset", "native_asset", "receiver", "amount", "fee", "status", "serial_number", "metadata", "is_nft", "timestamp", "originator"}
-	feeColumns      = []string{"transaction_id", "schedule_id", "amount", "status", "transfer_id"}
-	messageColumns  = []string{"transfer_id", "hash", "signature", "signer", "transaction_timestamp"}
+	transferColumns = []string{"transaction_id", "source_chain_id", "target_chain_id", "native_chain_id", "source_asset", "target_asset", "native_asset", "receiver", "amount", "decimals", "fee", "status", "serial_number", "metadata", "is_nft", "timestamp", "originator", "created_at", "signature_msg_status"}
+	feeColumns      = []string{"transaction_id", "schedule_id", "amount", "status", "transfer_id", "transfer_source_chain_id"}
+	messageColumns  = []string{"transfer_id", "transfer_source_chain_id", "hash", "signature", "signer", "transaction_timestamp"}
 
-	transferRowArgs = []driver.Value{transactionId, sourceChainId, targetChainId, nativeChainId, sourceAsset, targetAsset, nativeAsset, receiver, amount, fee, someStatus, serialNumber, metadata, isNft, nanoTime, originator}
+	transferRowArgs = []driver.Value{transactionId, sourceChainId, targetChainId, nativeChainId, sourceAsset, targetAsset, nativeAsset, receiver, amount, decimals, fee, someStatus, serialNumber, metadata, isNft, nanoTime, originator, createdAt, signatureMsgStatus}
 	feesRowArgs     = []driver.Value{
 		transactionId,
 		expectedEntityFee.ScheduleID,
 		expectedEntityFee.Amount,
 		expectedEntityFee.Status,
 		expectedEntityFee.TransferID,
+		sourceChainId,
 	}
-	messageRowArgs = []driver.Value{transactionId, "hash", "signature", "signer", uint8(1)}
+	messageRowArgs = []driver.Value{transactionId, sourceChainId, "hash", "signature", "signer", uint8(1)}
 
 	expectedEntityTransfer = &entity.Transfer{
 		TransactionID: transactionId,
@@ -90,6 +100,7 @@ var (
 		NativeAsset:   nativeAsset,
 		Receiver:      receiver,
 		Amount:        amount,
+		Decimals:      decimals,
 		Fee:           fee,
 		Status:        someStatus,
 		SerialNumber:  serialNumber,
@@ -97,6 +108,7 @@ var (
 		IsNft:         isNft,
 		Timestamp:     nanoTime,
 		Originator:    originator,
+		CreatedAt:     createdAt,
 	}
 	expectedModelTransfer = &model.Transfer{
 		TransactionId:    transactionId,
@@ -108,6 +120,7 @@ var (
 		NativeAsset:      nativeAsset,
 		Receiver:         receiver,
 		Amount:           amount,
+		Decimals:         decimals,
 		SerialNum:        serialNumber,
 		Metadata:         metadata,
 		IsNft:            isNft,
@@ -125,13 +138,15 @@ var (
 			String: transactionId,
 			Valid:  true,
 		},
+		TransferSourceChainID: sourceChainId,
 	}
 	expectedEntityMessage = entity.Message{
-		TransferID:           transactionId,
-		Hash:                 "hash",
-		Signature:            "signature",
-		Signer:               "signer",
-		TransactionTimestamp: 1,
+		TransferID:            transactionId,
+		TransferSourceChainID: sourceChainId,
+		Hash:                  "hash",
+		Signature:             "signature",
+		Signer:                "signer",
+		TransactionTimestamp:  1,
 	}
 
 	expectedEntityTransferWithFee = &entity.Transfer{
@@ -144,6 +159,7 @@ var (
 		NativeAsset:   nativeAsset,
 		Receiver:      receiver,
 		Amount:        amount,
+		Decimals:      decimals,
 		Fee:           fee,
 		Status:        someStatus,
 		SerialNumber:  serialNumber,
@@ -151,6 +167,7 @@ var (
 		IsNft:         isNft,
 		Timestamp:     nanoTime,
 		Originator:    originator,
+		CreatedAt:     createdAt,
 		Fees: []entity.Fee{
 			expectedEntityFee,
 		},
@@ -165,6 +182,7 @@ var (
 		NativeAsset:   nativeAsset,
 		Receiver:      receiver,
 		Amount:        amount,
+		Decimals:      decimals,
 		Fee:           fee,
 		Status:        someStatus,
 		SerialNumber:  serialNumber,
@@ -172,6 +190,7 @@ var (
 		IsNft:         isNft,
 		Timestamp:     nanoTime,
 		Originator:    originator,
+		CreatedAt:     createdAt,
 		Fees: []entity.Fee{
 			expectedEntityFee,
 		},
@@ -180,20 +199,58 @@ var (
 		},
 	}
 
-	getByTransactionIdQuery       = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1`)
-	getWithPreloadsTransfersQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1`)
-	getWithPreloadsFeesQuery      = regexp.QuoteMeta(`SELECT * FROM "fees" WHERE "fees"."transfer_id" = $1`)
-	getWithPreloadsMessagesQuery  = regexp.QuoteMeta(`SELECT * FROM "messages" WHERE "messages"."transfer_id" = $1`)
-
-	createQuery       = regexp.QuoteMeta(`INSERT INTO "transfers" ("transaction_id","source_chain_id","target_chain_id","native_chain_id","source_asset","target_asset","native_asset","receiver","amount","fee","status","serial_number","metadata","is_nft","timestamp","originator") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`)
-	saveQuery         = regexp.QuoteMeta(`UPDATE "transfers" SET "source_chain_id"=$1,"target_chain_id"=$2,"native_chain_id"=$3,"source_asset"=$4,"target_asset"=$5,"native_asset"=$6,"receiver"=$7,"amount"=$8,"fee"=$9,"status"=$10,"serial_number"=$11,"metadata"=$12,"is_nft"=$13,"timestamp"=$14,"originator"=$15 WHERE "transaction_id" = $16`)
-	updateFeeQuery    = regexp.QuoteMeta(`UPDATE "transfers" SET "fee"=$1 WHERE transaction_id = $2`)
-	updateStatusQuery = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1 WHERE transaction_id = $2`)
+	getByTransactionIdQuery       = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1 and source_chain_id = $2`)
+	getOnAnyChainQuery            = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1 LIMIT 2`)
+	getWithFeeTransfersQuery      = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1 and source_chain_id = $2`)
+	getWithPreloadsTransfersQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1 and source_chain_id = $2`)
+	getWithPreloadsFeesQuery      = regexp.QuoteMeta(`SELECT * FROM "fees" WHERE ("fees"."transfer_id","fees"."transfer_source_chain_id") IN (($1,$2))`)
+	getWithPreloadsMessagesQuery  = regexp.QuoteMeta(`SELECT * FROM "messages" WHERE ("messages"."transfer_id","messages"."transfer_source_chain_id") IN (($1,$2))`)
+
+	createQuery       = regexp.QuoteMeta(`INSERT INTO "transfers" ("transaction_id","source_chain_id","target_chain_id","native_chain_id","source_asset","target_asset","native_asset","receiver","amount","decimals","fee","status","serial_number","metadata","is_nft","timestamp","originator","created_at","signature_msg_status") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19)`)
+	createFailedQuery = regexp.QuoteMeta(`INSERT INTO "transfers" ("transaction_id","source_chain_id","target_chain_id","native_chain_id","source_asset","target_asset","native_asset","receiver","amount","decimals","fee","status","serial_number","metadata","is_nft","timestamp","originator","created_at","signature_msg_status") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19) ON CONFLICT DO NOTHING`)
+	saveQuery         = regexp.QuoteMeta(`UPDATE "transfers" SET "target_chain_id"=$1,"native_chain_id"=$2,"source_asset"=$3,"target_asset"=$4,"native_asset"=$5,"receiver"=$6,"amount"=$7,"decimals"=$8,"fee"=$9,"status"=$10,"serial_number"=$11,"metadata"=$12,"is_nft"=$13,"timestamp"=$14,"originator"=$15,"signature_msg_status"=$16 WHERE transaction_id = $17 and source_chain_id = $18`)
+	updateFeeQuery    = regexp.QuoteMeta(`UPDATE "transfers" SET "fee"=$1 WHERE transaction_id = $2 and source_chain_id = $3`)
+	updateStatusQuery = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1 WHERE transaction_id = $2 and source_chain_id = $3 and status <> $4`)
+
+	statusRowsCountQuery = regexp.QuoteMeta(`SELECT count(*) FROM "transfers" WHERE transaction_id = $1 and source_chain_id = $2`)
+
+	updateSignatureMsgStatusQuery       = regexp.QuoteMeta(`UPDATE "transfers" SET "signature_msg_status"=$1 WHERE transaction_id = $2 and source_chain_id = $3`)
+	getPendingSignatureSubmissionsQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE status = $1 and signature_msg_status in ($2, $3)`)
+	getInitialBeforeQuery               = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE status = $1 and created_at < $2`)
+	getAwaitingSignatureFromQuery       = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE (status = $1 and target_chain_id <> $2) AND (not exists (select 1 from messages where messages.transfer_id = transfers.transaction_id and messages.transfer_source_chain_id = transfers.source_chain_id and lower(messages.signer) = lower($3))) ORDER BY timestamp`)
+	updateStatusFailedIfInitialQuery    = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1 WHERE transaction_id = $2 and source_chain_id = $3 and status = $4`)
+
+	eventLogColumns        = []string{"transfer_id", "source_chain_id", "address", "block_number", "block_hash", "tx_hash", "log_index", "topics", "data"}
+	eventLogRowArgs        = []driver.Value{transactionId, sourceChainId, expectedEventLog.Address, expectedEventLog.BlockNumber, expectedEventLog.BlockHash, expectedEventLog.TxHash, expectedEventLog.LogIndex, expectedEventLog.Topics, expectedEventLog.Data}
+	createEventLogQuery    = regexp.QuoteMeta(`INSERT INTO "event_logs" ("transfer_id","source_chain_id","address","block_number","block_hash","tx_hash","log_index","topics","data") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) ON CONFLICT DO NOTHING`)
+	countByStatusQuery     = regexp.QuoteMeta(`SELECT status, count(*) as count FROM "transfers" GROUP BY "status"`)
+	getEventLogQuery       = regexp.QuoteMeta(`SELECT * FROM "event_logs" WHERE transfer_id = $1 and source_chain_id = $2`)
+	migrateSourceQuery     = regexp.QuoteMeta(`UPDATE "transfers" SET "source_chain_id"=$1 WHERE source_chain_id = $2`)
+	migrateTargetQuery     = regexp.QuoteMeta(`UPDATE "transfers" SET "target_chain_id"=$1 WHERE target_chain_id = $2`)
+	migrateNativeQuery     = regexp.QuoteMeta(`UPDATE "transfers" SET "native_chain_id"=$1 WHERE native_chain_id = $2`)
+	migrateCreatedAtQuery  = regexp.QuoteMeta(`UPDATE "transfers" SET "created_at"=now() WHERE created_at is null`)
+	targetsWithoutDecimals = regexp.QuoteMeta(`SELECT DISTINCT "target_chain_id","target_asset" FROM "transfers" WHERE decimals = $1 and is_nft = $2`)
+	migrateDecimalsQuery   = regexp.QuoteMeta(`UPDATE "transfers" SET "decimals"=$1 WHERE target_chain_id = $2 and target_asset = $3 and decimals = $4 and is_nft = $5`)
+	legacyRowsCountQuery   = regexp.QuoteMeta(`SELECT count(*) FROM "transfers" WHERE source_chain_id = $1 or target_chain_id = $2 or native_chain_id = $3`)
+	primaryKeyColumnsQuery = regexp.QuoteMeta(`SELECT count(*) FROM "information_schema"."key_column_usage" WHERE table_schema = current_schema() and table_name = $1 and constraint_name = $2`)
+	foreignKeysQuery       = regexp.QuoteMeta(`SELECT count(*) FROM "information_schema"."table_constraints" WHERE table_schema = current_schema() and table_name = $1 and constraint_name = $2`)
+	expectedEventLog       = &entity.EventLog{
+		TransferID:    transactionId,
+		SourceChainID: sourceChainId,
+		Address:       "0x0000000000000000000000000000000000000001",
+		BlockNumber:   10,
+		BlockHash:     "0x0000000000000000000000000000000000000000000000000000000000000002",
+		TxHash:        "0x0000000000000000000000000000000000000000000000000000000000000003",
+		LogIndex:      1,
+		Topics:        "0x0000000000000000000000000000000000000000000000000000000000000004",
+		Data:          "0x01",
+	}
 
 	// "SELECT count(*) FROM \"transfers\"\"
 	countQuery                      = regexp.QuoteMeta(`SELECT count(*) FROM "transfers"`)
 	pagedQuery                      = regexp.QuoteMeta(`SELECT * FROM "transfers" ORDER BY timestamp desc, status asc LIMIT 10 OFFSET 10`)
 	pagedFilterOriginatorQuery      = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE originator = $1 ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedFilterReceiverQuery        = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE receiver = $1 ORDER BY timestamp desc, status asc LIMIT 10`)
 	pagedFilterTimestampQuery       = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE timestamp = $1 ORDER BY timestamp desc, status asc LIMIT 10`)
 	pagedFilterFromToTimestampQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE timestamp <= $1 AND timestamp >= $2 ORDER BY timestamp desc, status asc LIMIT 10`)
 	pagedFilterTransactionIdQuery   = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id LIKE $1 ORDER BY timestamp desc, status asc LIMIT 10`)
@@ -203,6 +260,7 @@ var (
 func setup() {
 	mocks.Setup()
 	dbConn, sqlMock, _ = helper.SetupSqlMock()
+	dbConn.Config.NowFunc = func() time.Time { return createdAt }
 	repository = &Repository{
 		db:     dbConn,
 		logger: config.GetLoggerFor("Transfer Repository"),
@@ -219,19 +277,37 @@ func Test_NewRepository(t *testing.T) {
 func Test_GetByTransactionId(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getByTransactionIdQuery, transactionId)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getByTransactionIdQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetByTransactionId(transactionId)
+	actual, err := repository.GetByTransactionId(sourceChainId, transactionId)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedEntityTransfer, actual)
 }
 
+func Test_GetByTransactionId_SameTransactionIdOnAnotherChain(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	otherSourceChainId := uint64(3)
+	otherRowArgs := append([]driver.Value{}, transferRowArgs...)
+	otherRowArgs[1] = otherSourceChainId
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, otherRowArgs, getByTransactionIdQuery, transactionId, otherSourceChainId)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getByTransactionIdQuery, transactionId, sourceChainId)
+
+	other, err := repository.GetByTransactionId(otherSourceChainId, transactionId)
+	assert.Nil(t, err)
+	assert.Equal(t, otherSourceChainId, other.SourceChainID)
+
+	actual, err := repository.GetByTransactionId(sourceChainId, transactionId)
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
 func Test_GetByTransactionId_NotFound(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getByTransactionIdQuery, transactionId)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getByTransactionIdQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetByTransactionId(transactionId)
+	actual, err := repository.GetByTransactionId(sourceChainId, transactionId)
 	assert.Nil(t, err)
 	assert.Nil(t, actual)
 }
@@ -239,21 +315,55 @@ func Test_GetByTransactionId_NotFound(t *testing.T) {
 func Test_GetByTransactionId_InvalidData(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getByTransactionIdQuery, transactionId)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getByTransactionIdQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetByTransactionId(transactionId)
+	actual, err := repository.GetByTransactionId(sourceChainId, transactionId)
 	assert.NotNil(t, err)
 	assert.Nil(t, actual)
 }
 
+func Test_GetByTransactionIdOnAnyChain(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getOnAnyChainQuery, transactionId)
+
+	actual, err := repository.GetByTransactionIdOnAnyChain(transactionId)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEntityTransfer, actual)
+}
+
+func Test_GetByTransactionIdOnAnyChain_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectQuery(getOnAnyChainQuery).WithArgs(transactionId).WillReturnRows(sqlmock.NewRows(transferColumns))
+
+	actual, err := repository.GetByTransactionIdOnAnyChain(transactionId)
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetByTransactionIdOnAnyChain_Ambiguous(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	otherChainRowArgs := append([]driver.Value{}, transferRowArgs...)
+	otherChainRowArgs[1] = targetChainId
+	sqlMock.ExpectQuery(getOnAnyChainQuery).WithArgs(transactionId).WillReturnRows(sqlmock.NewRows(transferColumns).
+		AddRow(transferRowArgs...).
+		AddRow(otherChainRowArgs...))
+
+	actual, err := repository.GetByTransactionIdOnAnyChain(transactionId)
+	assert.ErrorIs(t, err, service.ErrAmbiguousTransfer)
+	assert.Nil(t, actual)
+}
+
 func Test_GetWithPreloads(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getWithPreloadsTransfersQuery, transactionId)
-	helper.SqlMockPrepareQuery(sqlMock, feeColumns, feesRowArgs, getWithPreloadsFeesQuery, transactionId)
-	helper.SqlMockPrepareQuery(sqlMock, messageColumns, messageRowArgs, getWithPreloadsMessagesQuery, transactionId)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getWithPreloadsTransfersQuery, transactionId, sourceChainId)
+	helper.SqlMockPrepareQuery(sqlMock, feeColumns, feesRowArgs, getWithPreloadsFeesQuery, transactionId, sourceChainId)
+	helper.SqlMockPrepareQuery(sqlMock, messageColumns, messageRowArgs, getWithPreloadsMessagesQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetWithPreloads(transactionId)
+	actual, err := repository.GetWithPreloads(sourceChainId, transactionId)
 	assert.Nil(t, err)
 	assert.NotNil(t, actual)
 	assert.Equal(t, expectedEntityTransferWithPreloads, actual)
@@ -262,9 +372,9 @@ func Test_GetWithPreloads(t *testing.T) {
 func Test_GetWithPreloads_NotFound(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getWithPreloadsTransfersQuery, transactionId)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getWithPreloadsTransfersQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetWithPreloads(transactionId)
+	actual, err := repository.GetWithPreloads(sourceChainId, transactionId)
 	assert.Nil(t, err)
 	assert.Nil(t, actual)
 }
@@ -272,9 +382,9 @@ func Test_GetWithPreloads_NotFound(t *testing.T) {
 func Test_GetWithPreloads_InvalidData(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getWithPreloadsTransfersQuery, transactionId)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getWithPreloadsTransfersQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetWithPreloads(transactionId)
+	actual, err := repository.GetWithPreloads(sourceChainId, transactionId)
 	assert.NotNil(t, err)
 	assert.Nil(t, actual)
 }
@@ -282,10 +392,10 @@ func Test_GetWithPreloads_InvalidData(t *testing.T) {
 func Test_GetWithFee(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getWithPreloadsTransfersQuery, transactionId)
-	helper.SqlMockPrepareQuery(sqlMock, feeColumns, feesRowArgs, getWithPreloadsFeesQuery, transactionId)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getWithFeeTransfersQuery, transactionId, sourceChainId)
+	helper.SqlMockPrepareQuery(sqlMock, feeColumns, feesRowArgs, getWithPreloadsFeesQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetWithFee(transactionId)
+	actual, err := repository.GetWithFee(sourceChainId, transactionId)
 	assert.Nil(t, err)
 	assert.NotNil(t, actual)
 	assert.Equal(t, expectedEntityTransferWithFee, actual)
@@ -294,9 +404,9 @@ func Test_GetWithFee(t *testing.T) {
 func Test_GetWithFee_NotFound(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getWithPreloadsTransfersQuery, transactionId)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getWithFeeTransfersQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetWithFee(transactionId)
+	actual, err := repository.GetWithFee(sourceChainId, transactionId)
 	assert.Nil(t, err)
 	assert.Nil(t, actual)
 }
@@ -304,9 +414,9 @@ func Test_GetWithFee_NotFound(t *testing.T) {
 func Test_GetWithFee_InvalidData(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getWithPreloadsTransfersQuery, transactionId)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getWithFeeTransfersQuery, transactionId, sourceChainId)
 
-	actual, err := repository.GetWithFee(transactionId)
+	actual, err := repository.GetWithFee(sourceChainId, transactionId)
 	assert.NotNil(t, err)
 	assert.Nil(t, actual)
 }
@@ -324,13 +434,16 @@ func Test_Create(t *testing.T) {
 		nativeAsset,
 		receiver,
 		amount,
+		decimals,
 		"", //fee
 		someStatus,
 		serialNumber,
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
+		originator,
+		createdAt,
+		signatureMsgStatus)
 
 	actual, err := repository.Create(expectedModelTransfer)
 	assert.Nil(t, err)
@@ -350,24 +463,124 @@ func Test_Create_Err(t *testing.T) {
 		nativeAsset,
 		receiver,
 		amount,
+		decimals,
 		"", //fee
 		someStatus,
 		serialNumber,
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
+		originator,
+		createdAt,
+		signatureMsgStatus)
 
 	actual, err := repository.Create(expectedModelTransfer)
 	assert.NotNil(t, err)
 	assert.NotNil(t, actual)
 }
 
+func Test_Create_CanonicalizesAmount(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, createQuery,
+		transactionId,
+		sourceChainId,
+		targetChainId,
+		nativeChainId,
+		sourceAsset,
+		targetAsset,
+		nativeAsset,
+		receiver,
+		"123456789012345678901234567890",
+		decimals,
+		"", //fee
+		someStatus,
+		serialNumber,
+		metadata,
+		isNft,
+		nanoTime,
+		originator,
+		createdAt,
+		signatureMsgStatus)
+	transfer := *expectedModelTransfer
+	transfer.Amount = "000123456789012345678901234567890"
+
+	actual, err := repository.Create(&transfer)
+	assert.Nil(t, err)
+	assert.Equal(t, "123456789012345678901234567890", actual.Amount)
+}
+
+func Test_Create_NormalizesEvmReceiver(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	checksummed := "0xb083879B1e10C8476802016CB12cd2F25a896691"
+	helper.SqlMockPrepareExec(sqlMock, createQuery,
+		transactionId,
+		sourceChainId,
+		targetChainId,
+		nativeChainId,
+		sourceAsset,
+		targetAsset,
+		nativeAsset,
+		checksummed,
+		amount,
+		decimals,
+		"", //fee
+		someStatus,
+		serialNumber,
+		metadata,
+		isNft,
+		nanoTime,
+		originator,
+		createdAt,
+		signatureMsgStatus)
+	transfer := *expectedModelTransfer
+	transfer.Receiver = strings.ToLower(checksummed)
+
+	actual, err := repository.Create(&transfer)
+	assert.Nil(t, err)
+	assert.Equal(t, checksummed, actual.Receiver)
+}
+
+func Test_Create_InvalidAmount(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	transfer := *expectedModelTransfer
+	transfer.Amount = "1.5"
+
+	actual, err := repository.Create(&transfer)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_CreateFailedIfAbsent(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(createFailedQuery).
+		WithArgs(transactionId, sourceChainId, targetChainId, nativeChainId, sourceAsset, targetAsset, nativeAsset, receiver, amount, decimals, "", status.Failed, serialNumber, metadata, isNft, nanoTime, originator, createdAt, signatureMsgStatus).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	stored, err := repository.CreateFailedIfAbsent(expectedModelTransfer)
+	assert.Nil(t, err)
+	assert.True(t, stored)
+}
+
+func Test_CreateFailedIfAbsent_AlreadyStored(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(createFailedQuery).
+		WithArgs(transactionId, sourceChainId, targetChainId, nativeChainId, sourceAsset, targetAsset, nativeAsset, receiver, amount, decimals, "", status.Failed, serialNumber, metadata, isNft, nanoTime, originator, createdAt, signatureMsgStatus).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	stored, err := repository.CreateFailedIfAbsent(expectedModelTransfer)
+	assert.Nil(t, err)
+	assert.False(t, stored)
+}
+
 func Test_Save(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	helper.SqlMockPrepareExec(sqlMock, saveQuery,
-		sourceChainId,
 		targetChainId,
 		nativeChainId,
 		sourceAsset,
@@ -375,6 +588,7 @@ func Test_Save(t *testing.T) {
 		nativeAsset,
 		receiver,
 		amount,
+		decimals,
 		fee,
 		someStatus,
 		serialNumber,
@@ -382,7 +596,9 @@ func Test_Save(t *testing.T) {
 		isNft,
 		nanoTime,
 		originator,
-		transactionId)
+		signatureMsgStatus,
+		transactionId,
+		sourceChainId)
 
 	err := repository.Save(expectedEntityTransfer)
 	assert.Nil(t, err)
@@ -392,7 +608,6 @@ func Test_Save_Err(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, saveQuery,
-		sourceChainId,
 		targetChainId,
 		nativeChainId,
 		sourceAsset,
@@ -400,6 +615,7 @@ func Test_Save_Err(t *testing.T) {
 		nativeAsset,
 		receiver,
 		amount,
+		decimals,
 		fee,
 		someStatus,
 		serialNumber,
@@ -407,7 +623,9 @@ func Test_Save_Err(t *testing.T) {
 		isNft,
 		nanoTime,
 		originator,
-		transactionId)
+		signatureMsgStatus,
+		transactionId,
+		sourceChainId)
 
 	err := repository.Save(expectedEntityTransfer)
 	assert.NotNil(t, err)
@@ -417,9 +635,9 @@ func Test_UpdateFee(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	helper.SqlMockPrepareExec(sqlMock, updateFeeQuery,
-		fee, transactionId)
+		fee, transactionId, sourceChainId)
 
-	err := repository.UpdateFee(transactionId, fee)
+	err := repository.UpdateFee(sourceChainId, transactionId, fee)
 	assert.Nil(t, err)
 }
 
@@ -427,9 +645,449 @@ func Test_UpdateFee_Err(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateFeeQuery,
-		fee, transactionId)
+		fee, transactionId, sourceChainId)
+
+	err := repository.UpdateFee(sourceChainId, transactionId, fee)
+	assert.NotNil(t, err)
+}
+
+func Test_CreateEventLog(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, createEventLogQuery, eventLogRowArgs...)
+
+	err := repository.CreateEventLog(expectedEventLog)
+	assert.Nil(t, err)
+}
+
+func Test_CreateEventLog_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, createEventLogQuery, eventLogRowArgs...)
+
+	err := repository.CreateEventLog(expectedEventLog)
+	assert.NotNil(t, err)
+}
+
+func Test_GetEventLog(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, eventLogColumns, eventLogRowArgs, getEventLogQuery, transactionId, sourceChainId)
+
+	actual, err := repository.GetEventLog(sourceChainId, transactionId)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEventLog, actual)
+}
+
+func Test_GetEventLog_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getEventLogQuery, transactionId, sourceChainId)
+
+	actual, err := repository.GetEventLog(sourceChainId, transactionId)
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_CountByStatus(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	rows := sqlmock.NewRows([]string{"status", "count"}).
+		AddRow(status.Initial, 42).
+		AddRow(status.Failed, 3)
+	sqlMock.ExpectQuery(countByStatusQuery).WillReturnRows(rows)
+
+	actual, err := repository.CountByStatus()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{
+		status.Initial:   42,
+		status.Completed: 0,
+		status.Failed:    3,
+	}, actual)
+}
+
+func Test_CountByStatus_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectQuery(countByStatusQuery).WillReturnError(gorm.ErrInvalidData)
+
+	actual, err := repository.CountByStatus()
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_MigrateLegacyChainIds(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	hederaNetworkId := uint64(296)
+	// legacy-shaped rows: two transfers from Hedera, one to Hedera, three with a native Hedera asset,
+	// out of which two are from Hedera as well
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{4}, legacyRowsCountQuery, constants.OldHederaNetworkId, constants.OldHederaNetworkId, constants.OldHederaNetworkId)
+	sqlMock.ExpectExec(migrateSourceQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(migrateTargetQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(migrateNativeQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 3))
+	expectTransferReferenceChainIdMigrations(hederaNetworkId, 0)
+	sqlMock.ExpectCommit()
+
+	updated, err := repository.MigrateLegacyChainIds(hederaNetworkId)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), updated)
+}
+
+func Test_MigrateLegacyChainIds_AlreadyMigrated(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	hederaNetworkId := uint64(296)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{0}, legacyRowsCountQuery, constants.OldHederaNetworkId, constants.OldHederaNetworkId, constants.OldHederaNetworkId)
+	sqlMock.ExpectExec(migrateSourceQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(migrateTargetQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(migrateNativeQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 0))
+	expectTransferReferenceChainIdMigrations(hederaNetworkId, 0)
+	sqlMock.ExpectCommit()
+
+	updated, err := repository.MigrateLegacyChainIds(hederaNetworkId)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), updated)
+}
+
+func Test_MigrateLegacyChainIds_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	hederaNetworkId := uint64(296)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{3}, legacyRowsCountQuery, constants.OldHederaNetworkId, constants.OldHederaNetworkId, constants.OldHederaNetworkId)
+	sqlMock.ExpectExec(migrateSourceQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(migrateTargetQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnError(gorm.ErrInvalidData)
+	sqlMock.ExpectRollback()
+
+	updated, err := repository.MigrateLegacyChainIds(hederaNetworkId)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(0), updated)
+}
+
+func Test_MigrateLegacyChainIds_TransferReferences(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	hederaNetworkId := uint64(296)
+	// a transfer from Hedera, stored with chain id 0, with a message, a fee and a schedule, whose source chain
+	// has been backfilled from it with chain id 0 as well
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{1}, legacyRowsCountQuery, constants.OldHederaNetworkId, constants.OldHederaNetworkId, constants.OldHederaNetworkId)
+	sqlMock.ExpectExec(migrateSourceQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(migrateTargetQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(migrateNativeQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransferReferenceChainIdMigrations(hederaNetworkId, 1)
+	sqlMock.ExpectCommit()
+
+	updated, err := repository.MigrateLegacyChainIds(hederaNetworkId)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), updated)
+}
+
+func Test_MigrateLegacyChainIds_TransferReferencesErr(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	hederaNetworkId := uint64(296)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{1}, legacyRowsCountQuery, constants.OldHederaNetworkId, constants.OldHederaNetworkId, constants.OldHederaNetworkId)
+	sqlMock.ExpectExec(migrateSourceQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(migrateTargetQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(migrateNativeQuery).WithArgs(hederaNetworkId, constants.OldHederaNetworkId).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(regexp.QuoteMeta(numberPlaceholders(transferReferenceChainIdMigrations[0]))).
+		WithArgs(hederaNetworkId, constants.OldHederaNetworkId, hederaNetworkId).
+		WillReturnError(gorm.ErrInvalidData)
+	sqlMock.ExpectRollback()
+
+	updated, err := repository.MigrateLegacyChainIds(hederaNetworkId)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(0), updated)
+}
+
+func Test_MigrateCompositeForeignKeys(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	for _, foreignKey := range compositeForeignKeys {
+		helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{0}, foreignKeysQuery, foreignKey.table, foreignKey.constraint)
+		sqlMock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (transfer_id, transfer_source_chain_id) REFERENCES transfers (transaction_id, source_chain_id) ON UPDATE CASCADE", foreignKey.table, foreignKey.constraint))).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	migrated, err := repository.MigrateCompositeForeignKeys()
+	assert.Nil(t, err)
+	assert.True(t, migrated)
+}
+
+func Test_MigrateCompositeForeignKeys_AlreadyMigrated(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	for _, foreignKey := range compositeForeignKeys {
+		helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{1}, foreignKeysQuery, foreignKey.table, foreignKey.constraint)
+	}
+
+	migrated, err := repository.MigrateCompositeForeignKeys()
+	assert.Nil(t, err)
+	assert.False(t, migrated)
+}
+
+func Test_MigrateCompositeForeignKeys_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{0}, foreignKeysQuery, "messages", "fk_messages_transfer_identity")
+	sqlMock.ExpectExec(regexp.QuoteMeta("ALTER TABLE messages ADD CONSTRAINT")).WillReturnError(gorm.ErrInvalidData)
+
+	migrated, err := repository.MigrateCompositeForeignKeys()
+	assert.Error(t, err)
+	assert.False(t, migrated)
+}
+
+func Test_MigrateCompositeIdentity(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{1}, primaryKeyColumnsQuery, "transfers", "transfers_pkey")
+	sqlMock.ExpectBegin()
+	for _, statement := range compositeIdentityStatements {
+		sqlMock.ExpectExec(regexp.QuoteMeta(statement)).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	sqlMock.ExpectCommit()
+
+	migrated, err := repository.MigrateCompositeIdentity()
+	assert.Nil(t, err)
+	assert.True(t, migrated)
+}
+
+func Test_MigrateCompositeIdentity_AlreadyMigrated(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{2}, primaryKeyColumnsQuery, "transfers", "transfers_pkey")
+
+	migrated, err := repository.MigrateCompositeIdentity()
+	assert.Nil(t, err)
+	assert.False(t, migrated)
+}
+
+func Test_MigrateCompositeIdentity_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{1}, primaryKeyColumnsQuery, "transfers", "transfers_pkey")
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(regexp.QuoteMeta(compositeIdentityStatements[0])).WillReturnError(gorm.ErrInvalidData)
+	sqlMock.ExpectRollback()
+
+	migrated, err := repository.MigrateCompositeIdentity()
+	assert.Error(t, err)
+	assert.False(t, migrated)
+}
+
+func Test_MigrateTransferSourceChains(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	for _, statement := range transferSourceChainMigrations {
+		sqlMock.ExpectExec(regexp.QuoteMeta(statement)).WillReturnResult(sqlmock.NewResult(0, 2))
+	}
+	sqlMock.ExpectCommit()
+
+	updated, err := repository.MigrateTransferSourceChains()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2*len(transferSourceChainMigrations)), updated)
+}
+
+func Test_MigrateTransferSourceChains_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(regexp.QuoteMeta(transferSourceChainMigrations[0])).WillReturnError(gorm.ErrInvalidData)
+	sqlMock.ExpectRollback()
+
+	updated, err := repository.MigrateTransferSourceChains()
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), updated)
+}
+
+func Test_MigrateLegacyChainIds_LegacyNetworkId(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+
+	updated, err := repository.MigrateLegacyChainIds(constants.OldHederaNetworkId)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), updated)
+}
+
+func Test_UpdateSignatureMsgStatus(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, updateSignatureMsgStatusQuery,
+		status.SignatureSubmitted,
+		transactionId,
+		sourceChainId)
+
+	err := repository.UpdateSignatureMsgStatus(sourceChainId, transactionId, status.SignatureSubmitted)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateSignatureMsgStatus_InvalidStatus(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+
+	err := repository.UpdateSignatureMsgStatus(sourceChainId, transactionId, status.Completed)
+	assert.NotNil(t, err)
+}
+
+func Test_GetPendingSignatureSubmissions(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getPendingSignatureSubmissionsQuery, status.Initial, status.SignaturePending, status.SignatureFailed)
+
+	actual, err := repository.GetPendingSignatureSubmissions()
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{expectedEntityTransfer}, actual)
+}
+
+func Test_GetPendingSignatureSubmissions_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getPendingSignatureSubmissionsQuery, status.Initial, status.SignaturePending, status.SignatureFailed)
+
+	actual, err := repository.GetPendingSignatureSubmissions()
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetInitialBefore(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	before := time.Unix(1700000000, 0)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getInitialBeforeQuery, status.Initial, before)
+
+	actual, err := repository.GetInitialBefore(before)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{expectedEntityTransfer}, actual)
+}
+
+func Test_GetInitialBefore_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	before := time.Unix(1700000000, 0)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getInitialBeforeQuery, status.Initial, before)
 
-	err := repository.UpdateFee(transactionId, fee)
+	actual, err := repository.GetInitialBefore(before)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetAwaitingSignatureFrom(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getAwaitingSignatureFromQuery, status.Initial, constants.HederaNetworkId, member)
+
+	actual, err := repository.GetAwaitingSignatureFrom(member)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{expectedEntityTransfer}, actual)
+}
+
+func Test_GetAwaitingSignatureFrom_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getAwaitingSignatureFromQuery, status.Initial, constants.HederaNetworkId, member)
+
+	actual, err := repository.GetAwaitingSignatureFrom(member)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_UpdateStatusFailedIfInitial(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusFailedIfInitialQuery, status.Failed, transactionId, sourceChainId, status.Initial)
+
+	failed, err := repository.UpdateStatusFailedIfInitial(sourceChainId, transactionId)
+	assert.Nil(t, err)
+	assert.True(t, failed)
+}
+
+func Test_UpdateStatusFailedIfInitial_NoLongerInitial(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(updateStatusFailedIfInitialQuery).
+		WithArgs(status.Failed, transactionId, sourceChainId, status.Initial).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	failed, err := repository.UpdateStatusFailedIfInitial(sourceChainId, transactionId)
+	assert.Nil(t, err)
+	assert.False(t, failed)
+}
+
+func Test_UpdateStatusFailedIfInitial_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusFailedIfInitialQuery, status.Failed, transactionId, sourceChainId, status.Initial)
+
+	failed, err := repository.UpdateStatusFailedIfInitial(sourceChainId, transactionId)
+	assert.NotNil(t, err)
+	assert.False(t, failed)
+}
+
+func Test_MigrateCreationTimes(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(migrateCreatedAtQuery).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	actual, err := repository.MigrateCreationTimes()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), actual)
+}
+
+func Test_MigrateCreationTimes_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(migrateCreatedAtQuery).WillReturnError(gorm.ErrInvalidData)
+
+	_, err := repository.MigrateCreationTimes()
+	assert.NotNil(t, err)
+}
+
+func Test_GetTargetAssetsWithoutDecimals(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"target_chain_id", "target_asset"}, []driver.Value{targetChainId, targetAsset}, targetsWithoutDecimals, 0, false)
+
+	actual, err := repository.GetTargetAssetsWithoutDecimals()
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{{TargetChainID: targetChainId, TargetAsset: targetAsset}}, actual)
+}
+
+func Test_GetTargetAssetsWithoutDecimals_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, targetsWithoutDecimals, 0, false)
+
+	actual, err := repository.GetTargetAssetsWithoutDecimals()
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_MigrateDecimals(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(migrateDecimalsQuery).
+		WithArgs(decimals, targetChainId, targetAsset, 0, false).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	actual, err := repository.MigrateDecimals(targetChainId, targetAsset, decimals)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), actual)
+}
+
+func Test_MigrateDecimals_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(migrateDecimalsQuery).
+		WithArgs(decimals, targetChainId, targetAsset, 0, false).
+		WillReturnError(gorm.ErrInvalidData)
+
+	_, err := repository.MigrateDecimals(targetChainId, targetAsset, decimals)
 	assert.NotNil(t, err)
 }
 
@@ -438,9 +1096,11 @@ func Test_UpdateStatusCompleted(t *testing.T) {
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
 		status.Completed,
-		transactionId)
+		transactionId,
+		sourceChainId,
+		status.Completed)
 
-	err := repository.UpdateStatusCompleted(transactionId)
+	err := repository.UpdateStatusCompleted(sourceChainId, transactionId)
 	assert.Nil(t, err)
 }
 
@@ -449,9 +1109,11 @@ func Test_UpdateStatusCompleted_Err(t *testing.T) {
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
 		status.Completed,
-		transactionId)
+		transactionId,
+		sourceChainId,
+		status.Completed)
 
-	err := repository.UpdateStatusCompleted(transactionId)
+	err := repository.UpdateStatusCompleted(sourceChainId, transactionId)
 	assert.NotNil(t, err)
 }
 
@@ -460,9 +1122,11 @@ func Test_UpdateStatusFailed(t *testing.T) {
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
 		status.Failed,
-		transactionId)
+		transactionId,
+		sourceChainId,
+		status.Failed)
 
-	err := repository.UpdateStatusFailed(transactionId)
+	err := repository.UpdateStatusFailed(sourceChainId, transactionId)
 	assert.Nil(t, err)
 }
 
@@ -471,12 +1135,119 @@ func Test_UpdateStatusFailed_Err(t *testing.T) {
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
 		status.Failed,
-		transactionId)
+		transactionId,
+		sourceChainId,
+		status.Failed)
 
-	err := repository.UpdateStatusFailed(transactionId)
+	err := repository.UpdateStatusFailed(sourceChainId, transactionId)
 	assert.NotNil(t, err)
 }
 
+func Test_StatusTransitions_FireEvents(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	defer event.Reset()
+
+	var fired, observed []transfer_event.Params
+	events.OnTransferEvent("transfer.*", func(params *transfer_event.Params) error {
+		fired = append(fired, *params)
+		return nil
+	})
+	// a second subscriber must be notified as well
+	events.OnTransferEvent(constants.EventTransferCompleted, func(params *transfer_event.Params) error {
+		observed = append(observed, *params)
+		return nil
+	})
+
+	helper.SqlMockPrepareExec(sqlMock, createQuery,
+		transactionId,
+		sourceChainId,
+		targetChainId,
+		nativeChainId,
+		sourceAsset,
+		targetAsset,
+		nativeAsset,
+		receiver,
+		amount,
+		decimals,
+		"", //fee
+		someStatus,
+		serialNumber,
+		metadata,
+		isNft,
+		nanoTime,
+		originator,
+		createdAt,
+		signatureMsgStatus)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
+		status.Completed,
+		transactionId,
+		sourceChainId,
+		status.Completed)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
+		status.Failed,
+		transactionId,
+		sourceChainId,
+		status.Failed)
+
+	_, err := repository.Create(expectedModelTransfer)
+	assert.Nil(t, err)
+	err = repository.UpdateStatusCompleted(sourceChainId, transactionId)
+	assert.Nil(t, err)
+	err = repository.UpdateStatusFailed(sourceChainId, transactionId)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []transfer_event.Params{
+		{SourceChainID: sourceChainId, TransactionID: transactionId, Status: status.Initial},
+		{SourceChainID: sourceChainId, TransactionID: transactionId, Status: status.Completed},
+		{SourceChainID: sourceChainId, TransactionID: transactionId, Status: status.Failed},
+	}, fired)
+	assert.Equal(t, []transfer_event.Params{{SourceChainID: sourceChainId, TransactionID: transactionId, Status: status.Completed}}, observed)
+}
+
+func Test_UpdateStatusCompleted_Err_DoesNotFireEvent(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	defer event.Reset()
+
+	fired := false
+	events.OnTransferEvent(constants.EventTransferCompleted, func(params *transfer_event.Params) error {
+		fired = true
+		return nil
+	})
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
+		status.Completed,
+		transactionId,
+		sourceChainId,
+		status.Completed)
+
+	err := repository.UpdateStatusCompleted(sourceChainId, transactionId)
+	assert.NotNil(t, err)
+	assert.False(t, fired)
+}
+
+func Test_UpdateStatusCompleted_AlreadyCompleted_DoesNotFireEvent(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	defer event.Reset()
+
+	fired := false
+	events.OnTransferEvent(constants.EventTransferCompleted, func(params *transfer_event.Params) error {
+		fired = true
+		return nil
+	})
+	sqlMock.ExpectExec(updateStatusQuery).
+		WithArgs(status.Completed, transactionId, sourceChainId, status.Completed).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectQuery(statusRowsCountQuery).
+		WithArgs(transactionId, sourceChainId).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	err := repository.UpdateStatusCompleted(sourceChainId, transactionId)
+	assert.Nil(t, err)
+	assert.False(t, fired)
+}
+
 func Test_create(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
@@ -490,13 +1261,16 @@ func Test_create(t *testing.T) {
 		nativeAsset,
 		receiver,
 		amount,
+		decimals,
 		"", //fee
 		someStatus,
 		serialNumber,
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
+		originator,
+		createdAt,
+		signatureMsgStatus)
 
 	actual, err := repository.create(expectedModelTransfer, someStatus)
 	assert.Nil(t, err)
@@ -516,13 +1290,16 @@ func Test_create_Err(t *testing.T) {
 		nativeAsset,
 		receiver,
 		amount,
+		decimals,
 		"", //fee
 		someStatus,
 		serialNumber,
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
+		originator,
+		createdAt,
+		signatureMsgStatus)
 
 	actual, err := repository.create(expectedModelTransfer, someStatus)
 	assert.NotNil(t, err)
@@ -534,10 +1311,13 @@ func Test_updateStatus(t *testing.T) {
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
 		status.Initial,
-		transactionId)
+		transactionId,
+		sourceChainId,
+		status.Initial)
 
-	err := repository.updateStatus(transactionId, status.Initial)
+	updated, err := repository.updateStatus(sourceChainId, transactionId, status.Initial)
 	assert.Nil(t, err)
+	assert.True(t, updated)
 }
 
 func Test_updateStatus_Err(t *testing.T) {
@@ -545,10 +1325,43 @@ func Test_updateStatus_Err(t *testing.T) {
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
 		status.Initial,
-		transactionId)
+		transactionId,
+		sourceChainId,
+		status.Initial)
 
-	err := repository.updateStatus(transactionId, status.Initial)
+	updated, err := repository.updateStatus(sourceChainId, transactionId, status.Initial)
 	assert.NotNil(t, err)
+	assert.False(t, updated)
+}
+
+func Test_updateStatus_Unchanged(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(updateStatusQuery).
+		WithArgs(status.Completed, transactionId, sourceChainId, status.Completed).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectQuery(statusRowsCountQuery).
+		WithArgs(transactionId, sourceChainId).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	updated, err := repository.updateStatus(sourceChainId, transactionId, status.Completed)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+}
+
+func Test_updateStatus_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(updateStatusQuery).
+		WithArgs(status.Completed, transactionId, sourceChainId, status.Completed).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectQuery(statusRowsCountQuery).
+		WithArgs(transactionId, sourceChainId).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	updated, err := repository.updateStatus(sourceChainId, transactionId, status.Completed)
+	assert.NotNil(t, err)
+	assert.False(t, updated)
 }
 
 func Test_Paged(t *testing.T) {
@@ -611,6 +1424,28 @@ func Test_PagedWithFilterOriginatorHedera(t *testing.T) {
 	assert.NotEmpty(t, actual)
 }
 
+func Test_PagedWithFilterOriginatorHederaChecksum(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Originator: "0.0.1234-vfmkw",
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterOriginatorQuery, "0.0.1234")
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
 func Test_PagedWithFilterOriginatorEVM(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
@@ -633,6 +1468,74 @@ func Test_PagedWithFilterOriginatorEVM(t *testing.T) {
 	assert.NotEmpty(t, actual)
 }
 
+func Test_PagedWithFilterReceiverEVM(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Receiver: originatorEVM,
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterReceiverQuery, common.HexToAddress(originatorEVM).String())
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedWithFilterReceiverEVM_AnyCasing(t *testing.T) {
+	checksummed := "0xb083879B1e10C8476802016CB12cd2F25a896691"
+	for _, receiver := range []string{checksummed, strings.ToLower(checksummed), strings.ToUpper(checksummed[2:])} {
+		setup()
+		req := &transfer.PagedRequest{
+			Page:     1,
+			PageSize: 10,
+			Filter: transfer.Filter{
+				Receiver: receiver,
+			},
+		}
+
+		helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{int64(1)}, countQuery)
+		helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterReceiverQuery, checksummed)
+
+		actual, _, err := repository.Paged(req)
+
+		assert.Nil(t, err, receiver)
+		assert.Len(t, actual, 1, receiver)
+		assert.Equal(t, transactionId, actual[0].TransactionID, receiver)
+		helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	}
+}
+
+func Test_PagedWithFilterReceiverHedera(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Receiver: "0.0.1234-vfmkw",
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterReceiverQuery, "0.0.1234")
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
 func Test_PagedWithFilterLegacyTimestamp(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
@@ -735,3 +1638,21 @@ func Test_PagedWithFilterTokenId(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotEmpty(t, actual)
 }
+
+func expectTransferReferenceChainIdMigrations(hederaNetworkId uint64, rowsAffected int64) {
+	for _, statement := range transferReferenceChainIdMigrations {
+		sqlMock.ExpectExec(regexp.QuoteMeta(numberPlaceholders(statement))).
+			WithArgs(hederaNetworkId, constants.OldHederaNetworkId, hederaNetworkId).
+			WillReturnResult(sqlmock.NewResult(0, rowsAffected))
+	}
+}
+
+// numberPlaceholders numbers the placeholders of the given statement the way the postgres dialect does
+func numberPlaceholders(statement string) string {
+	parts := strings.Split(statement, "?")
+	numbered := parts[0]
+	for i, part := range parts[1:] {
+		numbered += fmt.Sprintf("$%d%s", i+1, part)
+	}
+	return numbered
+}
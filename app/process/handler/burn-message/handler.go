@@ -42,6 +42,7 @@ func (mhh Handler) Handle(p interface{}) {
 		mhh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	mhh.logger = config.WithContext(mhh.logger, transferMsg.Context())
 
 	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
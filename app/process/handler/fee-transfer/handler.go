@@ -41,5 +41,6 @@ func (fth Handler) Handle(p interface{}) {
 		fth.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	fth.logger = config.WithContext(fth.logger, event.Context())
 	fth.burnService.ProcessEvent(*event)
 }
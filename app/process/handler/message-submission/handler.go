@@ -17,26 +17,46 @@
 package message_submission
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	initialSubmissionBackoff = 1 * time.Second
+	maxSubmissionBackoff     = 1 * time.Minute
+)
+
 // Handler is transfers event handler
 type Handler struct {
 	hederaNode         client.HederaNode
 	mirrorNode         client.MirrorNode
 	transfersService   service.Transfers
 	transferRepository repository.Transfer
-	topicID            hedera.TopicID
-	messageService     service.Messages
-	logger             *log.Entry
+	// The topics, across which signatures are sharded by transfer id
+	topicIDs       []hedera.TopicID
+	messageService service.Messages
+	maxRetries     int
+	// The maximum retry attempts for confirming the signature message on the topic
+	// through the mirror node. Zero disables the confirmation
+	confirmationRetries int
+	// The transfers, whose signature is being re-submitted on request of another validator
+	resubmitting *sync.Map
+	sleep        func(time.Duration)
+	logger       *log.Entry
 }
 
 func NewHandler(
@@ -45,21 +65,27 @@ func NewHandler(
 	transfersService service.Transfers,
 	transferRepository repository.Transfer,
 	messageService service.Messages,
-	topicId string,
+	topicIds []string,
+	maxRetries int,
+	confirmationRetries int,
 ) *Handler {
-	topicID, err := hedera.TopicIDFromString(topicId)
-	if err != nil {
-		log.Fatalf("Invalid topic id: [%v]", topicId)
+	topicIDs, err := hederahelper.TopicIDsFromStrings(topicIds)
+	if err != nil || len(topicIDs) == 0 {
+		log.Fatalf("Invalid topic ids: [%v]", topicIds)
 	}
 
 	return &Handler{
-		hederaNode:         hederaNode,
-		mirrorNode:         mirrorNode,
-		logger:             config.GetLoggerFor("Topic Message Submission Handler"),
-		transfersService:   transfersService,
-		transferRepository: transferRepository,
-		messageService:     messageService,
-		topicID:            topicID,
+		hederaNode:          hederaNode,
+		mirrorNode:          mirrorNode,
+		logger:              config.GetLoggerFor("Topic Message Submission Handler"),
+		transfersService:    transfersService,
+		transferRepository:  transferRepository,
+		messageService:      messageService,
+		topicIDs:            topicIDs,
+		maxRetries:          maxRetries,
+		confirmationRetries: confirmationRetries,
+		resubmitting:        &sync.Map{},
+		sleep:               time.Sleep,
 	}
 }
 
@@ -69,6 +95,8 @@ func (smh Handler) Handle(p interface{}) {
 		smh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	smh.logger = config.WithContext(smh.logger, transferMsg.Context())
+
 	transactionRecord, err := smh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		smh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
@@ -87,34 +115,201 @@ func (smh Handler) Handle(p interface{}) {
 	}
 }
 
+// ResumePendingSubmissions re-submits the signatures of the EVM-originated transfers, which were
+// not successfully submitted to the topic before the last shutdown of the node
+func (smh Handler) ResumePendingSubmissions() {
+	transfers, err := smh.transferRepository.GetPendingSignatureSubmissions()
+	if err != nil {
+		smh.logger.Errorf("Failed to get pending signature submissions. Error: [%s]", err)
+		return
+	}
+
+	for _, t := range transfers {
+		if t.SourceChainID == constants.HederaNetworkId {
+			// Resumed by the transfers service, which signs them the way it processes them
+			continue
+		}
+
+		smh.logger.Infof("[%s] - Resuming signature submission with status [%s].", t.TransactionID, t.SignatureMsgStatus)
+		tm := payload.New(t.TransactionID, t.SourceChainID, t.TargetChainID, t.NativeChainID, t.Receiver, t.SourceAsset, t.TargetAsset, t.NativeAsset, t.Amount)
+		resumed := smh
+		resumed.logger = config.WithContext(smh.logger, tm.Context())
+		err = resumed.submitMessage(tm)
+		if err != nil {
+			smh.logger.Errorf("[%s] - Resuming signature submission failed. Error: [%s]", t.TransactionID, err)
+		}
+	}
+}
+
+// ResubmitSignature re-signs and re-submits the signature of the given transfer, requested by another validator,
+// if the transfer is short of majority and still awaits the signature of this validator. Requests for a transfer,
+// whose signature is already being re-submitted, are ignored
+func (smh Handler) ResubmitSignature(sourceChainId uint64, transferID string) {
+	key := resubmissionKey(sourceChainId, transferID)
+	if _, inProgress := smh.resubmitting.LoadOrStore(key, true); inProgress {
+		smh.logger.Debugf("[%s] - Signature re-submission already in progress. Skipping request.", transferID)
+		return
+	}
+	defer smh.resubmitting.Delete(key)
+
+	t, err := smh.transferRepository.GetByTransactionId(sourceChainId, transferID)
+	if err != nil {
+		smh.logger.Errorf("[%s] - Failed to get requested transfer. Error: [%s]", transferID, err)
+		return
+	}
+	if t == nil || t.Status != status.Initial || t.IsNft {
+		smh.logger.Debugf("[%s] - Requested transfer is not an initial fungible transfer. Skipping request.", transferID)
+		return
+	}
+
+	awaits, err := smh.messageService.AwaitsOwnSignature(sourceChainId, transferID)
+	if err != nil {
+		smh.logger.Errorf("[%s] - Failed to check for own signature. Error: [%s]", transferID, err)
+		return
+	}
+	if !awaits {
+		smh.logger.Debugf("[%s] - Signature already recorded. Skipping request.", transferID)
+		return
+	}
+
+	amount, err := t.SignedAmount()
+	if err != nil {
+		smh.logger.Errorf("[%s] - Failed to subtract the fee from the transfer amount. Error: [%s]", transferID, err)
+		return
+	}
+
+	smh.logger.Infof("[%s] - Re-submitting signature on request.", transferID)
+	tm := payload.New(t.TransactionID, t.SourceChainID, t.TargetChainID, t.NativeChainID, t.Receiver, t.SourceAsset, t.TargetAsset, t.NativeAsset, amount)
+	err = smh.submitMessage(tm)
+	if err != nil {
+		smh.logger.Errorf("[%s] - Re-submitting signature failed. Error: [%s]", transferID, err)
+	}
+}
+
+// resubmissionKey identifies the in-progress re-submission of the signature of the given transfer
+func resubmissionKey(sourceChainId uint64, transferID string) string {
+	return fmt.Sprintf("%d-%s", sourceChainId, transferID)
+}
+
 func (smh Handler) submitMessage(tm *payload.Transfer) error {
 	signatureMessageBytes, err := smh.messageService.SignFungibleMessage(*tm)
 	if err != nil {
 		return err
 	}
 
-	messageTxId, err := smh.hederaNode.SubmitTopicConsensusMessage(
-		smh.topicID,
-		signatureMessageBytes)
+	smh.updateSignatureMsgStatus(tm.SourceChainId, tm.TransactionId, status.SignaturePending)
+	messageTxId, err := smh.submitWithRetry(tm.TransactionId, signatureMessageBytes)
 	if err != nil {
 		smh.logger.Errorf("[%s] - Failed to submit Signature Message to Topic. Error: [%s]", tm.TransactionId, err)
+		smh.updateSignatureMsgStatus(tm.SourceChainId, tm.TransactionId, status.SignatureFailed)
 		return err
 	}
+	smh.updateSignatureMsgStatus(tm.SourceChainId, tm.TransactionId, status.SignatureSubmitted)
 
 	// Attach update callbacks on Signature HCS Message
-	smh.logger.Infof("[%s] - Submitted signature on Topic [%s]", tm.TransactionId, smh.topicID)
-	onSuccessfulAuthMessage, onFailedAuthMessage := smh.authMessageSubmissionCallbacks(tm.TransactionId)
-	smh.mirrorNode.WaitForTransaction(hederahelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
+	smh.logger.Infof("[%s] - Submitted signature on Topic [%s]", tm.TransactionId, smh.topic(tm.TransactionId))
+	mirrorNodeTxId := hederahelper.ToMirrorNodeTransactionID(messageTxId.String())
+	onSuccessfulAuthMessage, onFailedAuthMessage := smh.authMessageSubmissionCallbacks(tm.SourceChainId, tm.TransactionId, mirrorNodeTxId)
+	smh.mirrorNode.WaitForTransaction(mirrorNodeTxId, onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
-func (smh Handler) authMessageSubmissionCallbacks(txId string) (onSuccess, onRevert func()) {
+// submitWithRetry submits the signature message to the topic, retrying with an exponential backoff
+// up to the configured maximum retries
+func (smh Handler) submitWithRetry(txId string, message []byte) (*hedera.TransactionID, error) {
+	backoff := initialSubmissionBackoff
+	for attempt := 0; ; attempt++ {
+		messageTxId, err := smh.hederaNode.SubmitTopicConsensusMessage(smh.topic(txId), message)
+		if err == nil {
+			return messageTxId, nil
+		}
+		if attempt >= smh.maxRetries {
+			return nil, err
+		}
+
+		smh.logger.Warnf("[%s] - Failed to submit Signature Message to Topic, retrying in [%s]. Error: [%s]", txId, backoff, err)
+		smh.sleep(backoff)
+		backoff *= 2
+		if backoff > maxSubmissionBackoff {
+			backoff = maxSubmissionBackoff
+		}
+	}
+}
+
+// topic returns the topic, to which the signature of the given transfer is submitted
+func (smh Handler) topic(txId string) hedera.TopicID {
+	return hederahelper.SignatureTopic(txId, smh.topicIDs)
+}
+
+func (smh Handler) updateSignatureMsgStatus(sourceChainId uint64, txId, s string) {
+	err := smh.transferRepository.UpdateSignatureMsgStatus(sourceChainId, txId, s)
+	if err != nil {
+		smh.logger.Errorf("[%s] - Failed to update Signature Message status to [%s]. Error: [%s]", txId, s, err)
+	}
+}
+
+func (smh Handler) authMessageSubmissionCallbacks(sourceChainId uint64, txId, messageTxId string) (onSuccess, onRevert func()) {
 	onSuccess = func() {
 		smh.logger.Debugf("Authorisation Signature TX successfully executed for TX [%s]", txId)
+		if smh.confirmationRetries > 0 {
+			syncHelper.Go(func() {
+				smh.confirmTopicMessage(sourceChainId, txId, messageTxId)
+			})
+		}
 	}
 
 	onRevert = func() {
 		smh.logger.Debugf("Authorisation Signature TX failed for TX ID [%s]", txId)
+		smh.updateSignatureMsgStatus(sourceChainId, txId, status.SignatureFailed)
 	}
 	return onSuccess, onRevert
 }
+
+// confirmTopicMessage waits for the signature message to appear on the topic and marks it as mined.
+// Mirror node errors are retried with an exponential backoff. The signature is left as submitted, if the message
+// does not appear after the maximum confirmation retries, as its transaction has succeeded and re-submitting it
+// would duplicate the signature on the topic
+func (smh Handler) confirmTopicMessage(sourceChainId uint64, txId, messageTxId string) {
+	backoff := initialSubmissionBackoff
+	for attempt := 0; ; attempt++ {
+		recorded, err := smh.isMessageOnTopic(txId, messageTxId)
+		if err == nil && recorded {
+			smh.logger.Infof("[%s] - Confirmed signature message [%s] on Topic [%s].", txId, messageTxId, smh.topic(txId))
+			smh.updateSignatureMsgStatus(sourceChainId, txId, status.SignatureMined)
+			return
+		}
+		if attempt >= smh.confirmationRetries {
+			smh.logger.Errorf("[%s] - Signature message [%s] did not appear on Topic [%s] after [%d] retries. Leaving it as submitted.", txId, messageTxId, smh.topic(txId), smh.confirmationRetries)
+			return
+		}
+
+		if err != nil {
+			smh.logger.Warnf("[%s] - Failed to confirm signature message [%s], retrying in [%s]. Error: [%s]", txId, messageTxId, backoff, err)
+		}
+		smh.sleep(backoff)
+		backoff *= 2
+		if backoff > maxSubmissionBackoff {
+			backoff = maxSubmissionBackoff
+		}
+	}
+}
+
+// isMessageOnTopic returns whether the topic of the transfer has a message at the consensus timestamp of the given transaction
+func (smh Handler) isMessageOnTopic(txId, messageTxId string) (bool, error) {
+	tx, err := smh.mirrorNode.GetSuccessfulTransaction(messageTxId)
+	if err != nil {
+		return false, err
+	}
+
+	consensusTimestamp, err := timestamp.FromString(tx.ConsensusTimestamp)
+	if err != nil {
+		return false, err
+	}
+
+	messages, err := smh.mirrorNode.GetMessagesForTopicBetween(smh.topic(txId), consensusTimestamp-1, consensusTimestamp+1)
+	if err != nil {
+		return false, err
+	}
+
+	return len(messages) > 0, nil
+}
@@ -18,19 +18,24 @@ package message_submission
 
 import (
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -89,23 +94,30 @@ var (
 		},
 		ValidStart: &date,
 	}
+	maxRetries = 2
+	sleeps     []time.Duration
 )
 
 func Test_NewHandler(t *testing.T) {
 	mocks.Setup()
-	h := NewHandler(mocks.MHederaNodeClient, mocks.MHederaMirrorClient, mocks.MTransferService, mocks.MTransferRepository, mocks.MMessageService, "0.0.1111")
+	h := NewHandler(mocks.MHederaNodeClient, mocks.MHederaMirrorClient, mocks.MTransferService, mocks.MTransferRepository, mocks.MMessageService, []string{"0.0.1111"}, maxRetries, 3)
+	assert.NotNil(t, h.sleep)
+	h.sleep = nil
 	assert.Equal(t, &Handler{
 		hederaNode:         mocks.MHederaNodeClient,
 		mirrorNode:         mocks.MHederaMirrorClient,
 		transfersService:   mocks.MTransferService,
 		transferRepository: mocks.MTransferRepository,
-		topicID: hedera.TopicID{
+		topicIDs: []hedera.TopicID{{
 			Shard: 0,
 			Realm: 0,
 			Topic: 1111,
-		},
-		messageService: mocks.MMessageService,
-		logger:         config.GetLoggerFor("Topic Message Submission Handler"),
+		}},
+		messageService:      mocks.MMessageService,
+		maxRetries:          maxRetries,
+		confirmationRetries: 3,
+		resubmitting:        &sync.Map{},
+		logger:              config.GetLoggerFor("Topic Message Submission Handler"),
 	}, h)
 }
 
@@ -127,9 +139,78 @@ func Test_Invalid_Payload(t *testing.T) {
 
 func Test_AuthMessageSubmissionCallbacks(t *testing.T) {
 	setup()
-	onSuccess, onFail := msHandler.authMessageSubmissionCallbacks("some-tx-id")
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureFailed).Return(nil)
+	onSuccess, onFail := msHandler.authMessageSubmissionCallbacks(tr.SourceChainId, "some-tx-id", "some-message-tx-id")
 	onSuccess()
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureFailed)
 	onFail()
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureFailed)
+}
+
+// mockMessageOnTopic mocks the mirror node lookup of the signature message at the given consensus timestamp
+func mockMessageOnTopic(messages []message.Message, err error) {
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", "some-message-tx-id").
+		Return(transaction.Transaction{ConsensusTimestamp: "1000.000000001"}, nil)
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicId, int64(1000000000000), int64(1000000000002)).
+		Return(messages, err)
+}
+
+func Test_AuthMessageSubmissionCallbacks_ConfirmsMessage(t *testing.T) {
+	setup()
+	msHandler.confirmationRetries = 2
+	mockMessageOnTopic([]message.Message{{ConsensusTimestamp: "1000.000000001"}}, nil)
+	confirmed := make(chan struct{})
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureMined).
+		Run(func(args mock.Arguments) { close(confirmed) }).
+		Return(nil)
+
+	onSuccess, _ := msHandler.authMessageSubmissionCallbacks(tr.SourceChainId, "some-tx-id", "some-message-tx-id")
+	onSuccess()
+
+	select {
+	case <-confirmed:
+	case <-time.After(time.Second):
+		t.Fatal("signature message was not confirmed")
+	}
+}
+
+func Test_ConfirmTopicMessage(t *testing.T) {
+	setup()
+	msHandler.confirmationRetries = 2
+	mockMessageOnTopic([]message.Message{{ConsensusTimestamp: "1000.000000001"}}, nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureMined).Return(nil)
+
+	msHandler.confirmTopicMessage(tr.SourceChainId, "some-tx-id", "some-message-tx-id")
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureMined)
+	assert.Empty(t, sleeps)
+}
+
+func Test_ConfirmTopicMessage_AfterMirrorNodeError(t *testing.T) {
+	setup()
+	msHandler.confirmationRetries = 2
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", "some-message-tx-id").
+		Return(transaction.Transaction{}, errors.New("some-error")).Once()
+	mockMessageOnTopic([]message.Message{{ConsensusTimestamp: "1000.000000001"}}, nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureMined).Return(nil)
+
+	msHandler.confirmTopicMessage(tr.SourceChainId, "some-tx-id", "some-message-tx-id")
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, "some-tx-id", status.SignatureMined)
+	assert.Equal(t, []time.Duration{time.Second}, sleeps)
+}
+
+func Test_ConfirmTopicMessage_MessageFailsToAppear(t *testing.T) {
+	setup()
+	msHandler.confirmationRetries = 2
+	mockMessageOnTopic([]message.Message{}, nil)
+
+	msHandler.confirmTopicMessage(tr.SourceChainId, "some-tx-id", "some-message-tx-id")
+
+	// The submission succeeded, so the signature is not re-submitted
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateSignatureMsgStatus", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetMessagesForTopicBetween", 3)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, sleeps)
 }
 
 func Test_Handle(t *testing.T) {
@@ -137,8 +218,27 @@ func Test_Handle(t *testing.T) {
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
 	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
 	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignaturePending).Return(nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted).Return(nil)
 	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 	msHandler.Handle(&tr)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted)
+	assert.Empty(t, sleeps)
+}
+
+func Test_Handle_ShardedTopics(t *testing.T) {
+	setup()
+	msHandler.topicIDs = []hedera.TopicID{topicId, {Topic: 11}, {Topic: 12}}
+	expectedTopic := hederahelper.SignatureTopic(tr.TransactionId, msHandler.topicIDs)
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", expectedTopic, mock.Anything).Return(txId, nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignaturePending).Return(nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted).Return(nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	msHandler.Handle(&tr)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+	mocks.MHederaNodeClient.AssertCalled(t, "SubmitTopicConsensusMessage", expectedTopic, mock.Anything)
 }
 
 func Test_Handle_SubmitTopicConsensusMessageFails(t *testing.T) {
@@ -146,8 +246,134 @@ func Test_Handle_SubmitTopicConsensusMessageFails(t *testing.T) {
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
 	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
 	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, errors.New("some-error"))
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignaturePending).Return(nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureFailed).Return(nil)
 	msHandler.Handle(&tr)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", maxRetries+1)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureFailed)
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, sleeps)
+}
+
+func Test_Handle_SubmitTopicConsensusMessageFailsThenSucceeds(t *testing.T) {
+	setup()
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, errors.New("some-error")).Once()
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, nil).Once()
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignaturePending).Return(nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted).Return(nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	msHandler.Handle(&tr)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 2)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureFailed)
+	assert.Equal(t, []time.Duration{time.Second}, sleeps)
+}
+
+func Test_ResumePendingSubmissions(t *testing.T) {
+	setup()
+	pending := *transferRecord
+	pending.SourceChainID = 80001
+	pending.SignatureMsgStatus = status.SignatureFailed
+	mocks.MTransferRepository.On("GetPendingSignatureSubmissions").Return([]*entity.Transfer{&pending}, nil)
+	mocks.MMessageService.On("SignFungibleMessage", *payload.New(tr.TransactionId, pending.SourceChainID, tr.TargetChainId, tr.NativeChainId, tr.Receiver, tr.SourceAsset, tr.TargetAsset, tr.NativeAsset, tr.Amount)).Return(authMsgBytes, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, authMsgBytes).Return(txId, nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", pending.SourceChainID, tr.TransactionId, status.SignaturePending).Return(nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", pending.SourceChainID, tr.TransactionId, status.SignatureSubmitted).Return(nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	msHandler.ResumePendingSubmissions()
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", pending.SourceChainID, tr.TransactionId, status.SignatureSubmitted)
+}
+
+func Test_ResumePendingSubmissions_SkipsHederaOriginated(t *testing.T) {
+	setup()
+	pending := *transferRecord
+	pending.SourceChainID = constants.HederaNetworkId
+	pending.SignatureMsgStatus = status.SignaturePending
+	mocks.MTransferRepository.On("GetPendingSignatureSubmissions").Return([]*entity.Transfer{&pending}, nil)
+	msHandler.ResumePendingSubmissions()
+	mocks.MMessageService.AssertNotCalled(t, "SignFungibleMessage", mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
+}
+
+func Test_ResumePendingSubmissions_Fails(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetPendingSignatureSubmissions").Return(nil, errors.New("some-error"))
+	msHandler.ResumePendingSubmissions()
+	mocks.MMessageService.AssertNotCalled(t, "SignFungibleMessage", mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
+}
+
+func Test_ResubmitSignature(t *testing.T) {
+	setup()
+	wrapped := *transferRecord
+	wrapped.NativeChainID = tr.TargetChainId
+	mocks.MTransferRepository.On("GetByTransactionId", tr.SourceChainId, tr.TransactionId).Return(&wrapped, nil)
+	mocks.MMessageService.On("AwaitsOwnSignature", tr.SourceChainId, tr.TransactionId).Return(true, nil)
+	mocks.MMessageService.On("SignFungibleMessage", *payload.New(tr.TransactionId, tr.SourceChainId, tr.TargetChainId, tr.TargetChainId, tr.Receiver, tr.SourceAsset, tr.TargetAsset, tr.NativeAsset, tr.Amount)).Return(authMsgBytes, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, authMsgBytes).Return(txId, nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignaturePending).Return(nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted).Return(nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+
+	msHandler.ResubmitSignature(tr.SourceChainId, tr.TransactionId)
+
+	mocks.MHederaNodeClient.AssertCalled(t, "SubmitTopicConsensusMessage", topicId, authMsgBytes)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted)
+}
+
+func Test_ResubmitSignature_HederaNative_SignsAmountNetOfFee(t *testing.T) {
+	setup()
+	native := *transferRecord
+	native.NativeChainID = constants.HederaNetworkId
+	native.Fee = "10"
+	signed := payload.New(tr.TransactionId, tr.SourceChainId, tr.TargetChainId, constants.HederaNetworkId, tr.Receiver, tr.SourceAsset, tr.TargetAsset, tr.NativeAsset, "90")
+	mocks.MTransferRepository.On("GetByTransactionId", tr.SourceChainId, tr.TransactionId).Return(&native, nil)
+	mocks.MMessageService.On("AwaitsOwnSignature", tr.SourceChainId, tr.TransactionId).Return(true, nil)
+	mocks.MMessageService.On("SignFungibleMessage", *signed).Return(authMsgBytes, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, authMsgBytes).Return(txId, nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignaturePending).Return(nil)
+	mocks.MTransferRepository.On("UpdateSignatureMsgStatus", tr.SourceChainId, tr.TransactionId, status.SignatureSubmitted).Return(nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+
+	msHandler.ResubmitSignature(tr.SourceChainId, tr.TransactionId)
+
+	mocks.MMessageService.AssertCalled(t, "SignFungibleMessage", *signed)
+	mocks.MHederaNodeClient.AssertCalled(t, "SubmitTopicConsensusMessage", topicId, authMsgBytes)
+}
+
+func Test_ResubmitSignature_AlreadySigned(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", tr.SourceChainId, tr.TransactionId).Return(transferRecord, nil)
+	mocks.MMessageService.On("AwaitsOwnSignature", tr.SourceChainId, tr.TransactionId).Return(false, nil)
+
+	msHandler.ResubmitSignature(tr.SourceChainId, tr.TransactionId)
+
+	mocks.MMessageService.AssertNotCalled(t, "SignFungibleMessage", mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, mock.Anything)
+}
+
+func Test_ResubmitSignature_NotInitial(t *testing.T) {
+	setup()
+	completed := *transferRecord
+	completed.Status = status.Completed
+	mocks.MTransferRepository.On("GetByTransactionId", tr.SourceChainId, tr.TransactionId).Return(&completed, nil)
+
+	msHandler.ResubmitSignature(tr.SourceChainId, tr.TransactionId)
+
+	mocks.MMessageService.AssertNotCalled(t, "AwaitsOwnSignature", mock.Anything, mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, mock.Anything)
+}
+
+func Test_ResubmitSignature_InProgress(t *testing.T) {
+	setup()
+	msHandler.resubmitting.Store(resubmissionKey(tr.SourceChainId, tr.TransactionId), true)
+
+	msHandler.ResubmitSignature(tr.SourceChainId, tr.TransactionId)
+
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", mock.Anything, mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
@@ -159,6 +385,18 @@ func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 }
 
+func Test_Handle_LogsCorrelationId(t *testing.T) {
+	setup()
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, errors.New("some-error"))
+
+	msHandler.Handle(&tr)
+
+	assert.NotNil(t, hook.LastEntry())
+	assert.Equal(t, tr.CorrelationId(), hook.LastEntry().Data[config.CorrelationIdField])
+}
+
 func Test_Handle_InitiateNewTransfer_NotInitial(t *testing.T) {
 	setup()
 	transferRecord.Status = "not-initial"
@@ -189,7 +427,13 @@ func setup() {
 		transfersService:   mocks.MTransferService,
 		transferRepository: mocks.MTransferRepository,
 		messageService:     mocks.MMessageService,
-		topicID:            topicId,
-		logger:             config.GetLoggerFor("Hedera Mint and Transfer Handler"),
+		topicIDs:           []hedera.TopicID{topicId},
+		maxRetries:         maxRetries,
+		resubmitting:       &sync.Map{},
+		sleep: func(d time.Duration) {
+			sleeps = append(sleeps, d)
+		},
+		logger: config.GetLoggerFor("Hedera Mint and Transfer Handler"),
 	}
+	sleeps = nil
 }
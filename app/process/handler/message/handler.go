@@ -17,16 +17,19 @@
 package message
 
 import (
+	"errors"
 	"fmt"
 	"github.com/dariubs/percent"
-	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/events"
+	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	msgHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
@@ -34,6 +37,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"math"
 	"math/big"
+	"strings"
+	"time"
 )
 
 type Handler struct {
@@ -45,20 +50,28 @@ type Handler struct {
 	participationRateGauge prometheus.Gauge
 	prometheusService      service.Prometheus
 	assetsService          service.Assets
+	// The EIP-712 domain of the authorisation signatures. Nil, unless the eip712 scheme is configured
+	typedDataDomain *auth_message.TypedDataDomain
+	// The age, after which orphaned signature messages are pruned, and the maximum number of them stored
+	orphanTtl  time.Duration
+	maxOrphans int
 }
 
 func NewHandler(
-	topicId string,
+	topicIds []string,
 	transferRepository repository.Transfer,
 	messageRepository repository.Message,
 	contractServices map[uint64]service.Contracts,
 	messages service.Messages,
 	prometheusService service.Prometheus,
 	assetsService service.Assets,
+	typedDataDomain *auth_message.TypedDataDomain,
+	orphanTtl time.Duration,
+	maxOrphans int,
 ) *Handler {
-	topicID, err := hedera.TopicIDFromString(topicId)
-	if err != nil {
-		log.Fatalf("Invalid topic id: [%v]", topicId)
+	topicIDs, err := hederahelper.TopicIDsFromStrings(topicIds)
+	if err != nil || len(topicIDs) == 0 {
+		log.Fatalf("Invalid topic ids: [%v]", topicIds)
 	}
 
 	var participationRate prometheus.Gauge
@@ -76,10 +89,13 @@ func NewHandler(
 		messageRepository:      messageRepository,
 		contracts:              contractServices,
 		messages:               messages,
-		logger:                 config.GetLoggerFor(fmt.Sprintf("Topic [%s] Handler", topicID.String())),
+		logger:                 config.GetLoggerFor(fmt.Sprintf("Topic [%s] Handler", strings.Join(topicIds, ", "))),
 		prometheusService:      prometheusService,
 		participationRateGauge: participationRate,
 		assetsService:          assetsService,
+		typedDataDomain:        typedDataDomain,
+		orphanTtl:              orphanTtl,
+		maxOrphans:             maxOrphans,
 	}
 }
 
@@ -99,6 +115,9 @@ func (cmh Handler) Handle(payload interface{}) {
 		msgHelper.UpdateHederaChainIdOfNftMsg(msg.NftSignatureMessage)
 		cmh.handleNftSignatureMessage(msg.NftSignatureMessage, m.TransactionTimestamp)
 		break
+	case *proto.TopicMessage_SignatureRequestMessage:
+		cmh.handleSignatureRequestMessage(msg.SignatureRequestMessage)
+		break
 	default:
 		cmh.logger.Errorf("Invalid topic message provided: [%v]", msg)
 		break
@@ -109,6 +128,10 @@ func (cmh Handler) Handle(payload interface{}) {
 func (cmh Handler) handleFungibleSignatureMessage(tsm *proto.TopicEthSignatureMessage, timestamp int64) {
 
 	valid, err := cmh.messages.SanityCheckFungibleSignature(tsm)
+	if awaitsTransfer(err) {
+		cmh.storeOrphan(tsm.TransferID, tsm.SourceChainId, message.NewFungibleSignature(tsm), timestamp)
+		return
+	}
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to perform sanity check on incoming signature [%s].", tsm.TransferID, tsm.GetSignature())
 		return
@@ -125,18 +148,31 @@ func (cmh Handler) handleFungibleSignatureMessage(tsm *proto.TopicEthSignatureMe
 		return
 	}
 
-	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes)
+	var typedDataBytes []byte
+	if cmh.typedDataDomain != nil {
+		typedDataBytes, err = auth_message.EncodeFungibleTypedDataFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, tsm.Recipient, tsm.Amount, cmh.contracts[tsm.TargetChainId].Address().String(), *cmh.typedDataDomain)
+		if err != nil {
+			cmh.logger.Errorf("[%s] - Failed to encode the authorisation typed data. Error: [%s]", tsm.TransferID, err)
+			return
+		}
+	}
+
+	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.SourceChainId, tsm.TargetChainId, timestamp, authMsgBytes, typedDataBytes)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not process signature [%s]", tsm.TransferID, tsm.GetSignature())
 		return
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, false)
+	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, false, timestamp)
 }
 
 // handleNftSignatureMessage is the main component responsible for the processing of new incoming Signature Messages
 func (cmh Handler) handleNftSignatureMessage(tsm *proto.TopicEthNftSignatureMessage, timestamp int64) {
 	valid, err := cmh.messages.SanityCheckNftSignature(tsm)
+	if awaitsTransfer(err) {
+		cmh.storeOrphan(tsm.TransferID, tsm.SourceChainId, message.NewNftSignature(tsm), timestamp)
+		return
+	}
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to perform sanity check on nft incoming signature [%s].", tsm.TransferID, tsm.GetSignature())
 		return
@@ -153,23 +189,128 @@ func (cmh Handler) handleNftSignatureMessage(tsm *proto.TopicEthNftSignatureMess
 		return
 	}
 
-	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes)
+	var typedDataBytes []byte
+	if cmh.typedDataDomain != nil {
+		typedDataBytes, err = auth_message.EncodeNftTypedDataFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, int64(tsm.TokenId), tsm.Metadata, tsm.Recipient, cmh.contracts[tsm.TargetChainId].Address().String(), *cmh.typedDataDomain)
+		if err != nil {
+			cmh.logger.Errorf("[%s] - Failed to encode the authorisation nft typed data. Error: [%s]", tsm.TransferID, err)
+			return
+		}
+	}
+
+	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.SourceChainId, tsm.TargetChainId, timestamp, authMsgBytes, typedDataBytes)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not process nft signature [%s]", tsm.TransferID, tsm.GetSignature())
 		return
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true)
+	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true, timestamp)
+}
+
+// handleSignatureRequestMessage notifies the listeners of a request for the missing signatures of a transfer,
+// which is short of majority. Validators re-submit their signature on it, unless it has already been recorded
+func (cmh Handler) handleSignatureRequestMessage(tsrm *proto.TopicSignatureRequestMessage) {
+	cmh.logger.Infof("[%s] - Received request for missing signatures.", tsrm.TransferID)
+	events.FireTransferEvent(constants.EventTransferSignatureRequested, tsrm.SourceChainId, tsrm.TransferID, "")
+}
+
+// awaitsTransfer returns whether the sanity check of a signature message failed, because its transfer,
+// or the fee of its Hedera-native transfer, has not been stored yet
+func awaitsTransfer(err error) bool {
+	return errors.Is(err, service.ErrTransferNotFound) || errors.Is(err, service.ErrTransferFeeNotFound)
+}
+
+// storeOrphan stores a signature message, whose transfer or its fee has not been stored yet, so that it is handled
+// again once they are. If they got stored in the meantime, the message is resolved right away
+func (cmh Handler) storeOrphan(transferID string, sourceChainId uint64, msg *message.Message, timestamp int64) {
+	if !cmh.hasRoomForOrphan(transferID) {
+		return
+	}
+
+	payload, err := msg.ToBytes()
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Failed to encode orphaned signature message. Error: [%s]", transferID, err)
+		return
+	}
+
+	err = cmh.messageRepository.CreateOrphan(&entity.OrphanedSignature{
+		TransferID:           transferID,
+		SourceChainID:        sourceChainId,
+		Payload:              payload,
+		TransactionTimestamp: timestamp,
+	})
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Failed to store orphaned signature message. Error: [%s]", transferID, err)
+		return
+	}
+	cmh.logger.Infof("[%s] - Stored signature message, received before its transfer or fee, until they are stored.", transferID)
+
+	t, err := cmh.transferRepository.GetByTransactionId(sourceChainId, transferID)
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Failed to retrieve transfer of orphaned signature message. Error: [%s]", transferID, err)
+		return
+	}
+	if t != nil && (t.NativeChainID != constants.HederaNetworkId || t.Fee != "") {
+		cmh.ResolveOrphans(sourceChainId, transferID)
+	}
+}
+
+// hasRoomForOrphan prunes the orphaned signature messages, older than the TTL, and returns whether
+// another one can be stored without exceeding the maximum
+func (cmh Handler) hasRoomForOrphan(transferID string) bool {
+	cutoff := time.Now().Add(-cmh.orphanTtl)
+	pruned, err := cmh.messageRepository.PruneOrphansBefore(cutoff)
+	if err != nil {
+		cmh.logger.Errorf("Failed to prune orphaned signature messages before [%s]. Error: [%s]", cutoff, err)
+	} else if pruned > 0 {
+		cmh.logger.Infof("Pruned [%d] orphaned signature messages before [%s].", pruned, cutoff)
+	}
+
+	count, err := cmh.messageRepository.CountOrphans()
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Failed to count orphaned signature messages. Error: [%s]", transferID, err)
+		return false
+	}
+	if count >= int64(cmh.maxOrphans) {
+		cmh.logger.Errorf("[%s] - Dropping signature message, received before its transfer or fee, as the maximum of [%d] orphaned signature messages is reached.", transferID, cmh.maxOrphans)
+		return false
+	}
+
+	return true
 }
 
-func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChainId uint64, asset string, isNFT bool) {
-	majorityReached, err := cmh.checkMajority(transferID, targetChainId)
+// ResolveOrphans handles the signature messages, which were received before the given transfer or its fee was stored
+func (cmh Handler) ResolveOrphans(sourceChainId uint64, transferID string) {
+	orphans, err := cmh.messageRepository.ResolveOrphans(sourceChainId, transferID)
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Failed to resolve orphaned signature messages. Error: [%s]", transferID, err)
+		return
+	}
+
+	for _, orphan := range orphans {
+		msg, err := message.FromBytesWithTS(orphan.Payload, orphan.TransactionTimestamp)
+		if err != nil {
+			cmh.logger.Errorf("[%s] - Failed to decode orphaned signature message. Error: [%s]", transferID, err)
+			continue
+		}
+		cmh.logger.Infof("[%s] - Handling orphaned signature message.", transferID)
+		cmh.Handle(msg)
+	}
+}
+
+func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChainId uint64, asset string, isNFT bool, timestamp int64) {
+	majorityReached, signatureMessages, err := cmh.checkMajority(transferID, sourceChainId, targetChainId)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not determine whether majority was reached. Error: [%s]", transferID, err)
 		return
 	}
 
 	if majorityReached {
+		cmh.observeSignatureToMajority(signatureMessages, sourceChainId, targetChainId, timestamp)
+		// Signatures, received after the majority, do not transition the transfer again
+		if !isCompleted(signatureMessages) {
+			events.FireTransferEvent(constants.EventTransferSignaturesReached, sourceChainId, transferID, "")
+		}
 		if !isNFT { // metrics for fungible only
 			oppositeAsset := cmh.assetsService.OppositeAsset(sourceChainId, targetChainId, asset)
 			metrics.SetMajorityReached(
@@ -181,18 +322,18 @@ func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChai
 				cmh.logger,
 			)
 		}
-		err = cmh.transferRepository.UpdateStatusCompleted(transferID)
+		err = cmh.transferRepository.UpdateStatusCompleted(sourceChainId, transferID)
 		if err != nil {
 			cmh.logger.Errorf("[%s] - Failed to complete. Error: [%s]", transferID, err)
 		}
 	}
 }
 
-func (cmh *Handler) checkMajority(transferID string, targetChainId uint64) (majorityReached bool, err error) {
-	signatureMessages, err := cmh.messageRepository.Get(transferID)
+func (cmh *Handler) checkMajority(transferID string, sourceChainId, targetChainId uint64) (majorityReached bool, signatureMessages []entity.Message, err error) {
+	signatureMessages, err = cmh.messageRepository.Get(sourceChainId, transferID)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to query all Signature Messages. Error: [%s]", transferID, err)
-		return false, err
+		return false, nil, err
 	}
 
 	membersCount := len(cmh.contracts[targetChainId].GetMembers())
@@ -200,7 +341,27 @@ func (cmh *Handler) checkMajority(transferID string, targetChainId uint64) (majo
 	cmh.setParticipationRate(signatureMessages, membersCount)
 	cmh.logger.Infof("[%s] - Collected [%d/%d] Signatures", transferID, len(signatureMessages), membersCount)
 
-	return cmh.contracts[targetChainId].HasValidSignaturesLength(bnSignaturesLength)
+	majorityReached, err = cmh.contracts[targetChainId].HasValidSignaturesLength(bnSignaturesLength)
+	return majorityReached, signatureMessages, err
+}
+
+// observeSignatureToMajority records the time between the first signature of the transfer and the one, with which
+// majority was reached. Signatures arriving after the transfer has already been completed are not observed.
+func (cmh *Handler) observeSignatureToMajority(signatureMessages []entity.Message, sourceChainId, targetChainId uint64, timestamp int64) {
+	if len(signatureMessages) == 0 || isCompleted(signatureMessages) {
+		return
+	}
+
+	latency := time.Duration(timestamp - signatureMessages[0].TransactionTimestamp)
+	if latency < 0 {
+		latency = 0
+	}
+	metrics.ObserveSignatureToMajorityLatency(sourceChainId, targetChainId, latency.Seconds(), cmh.prometheusService)
+}
+
+// isCompleted returns whether the transfer of the given signature messages is already completed
+func isCompleted(signatureMessages []entity.Message) bool {
+	return len(signatureMessages) > 0 && signatureMessages[0].Transfer.Status == status.Completed
 }
 
 func (cmh *Handler) setParticipationRate(signatureMessages []entity.Message, membersCount int) {
@@ -19,19 +19,25 @@ package message
 import (
 	"errors"
 	"fmt"
+	"github.com/gookit/event"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/events"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	transfer_event "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"math/big"
 	"testing"
+	"time"
 )
 
 var (
@@ -61,19 +67,23 @@ var (
 		},
 	}
 	transactionTimestamp = int64(0)
+	typedDataDomain      = auth_message.TypedDataDomain{Name: "Router", Version: "1"}
+	orphanTtl            = time.Hour
+	maxOrphans           = 10
 	authMsgBytes, _      = auth_message.EncodeFungibleBytesFrom(tesm.SourceChainId, tesm.TargetChainId, tesm.TransferID, tesm.Asset, tesm.Recipient, tesm.Amount)
+	typedDataBytes, _    = auth_message.EncodeFungibleTypedDataFrom(tesm.SourceChainId, tesm.TargetChainId, tesm.TransferID, tesm.Asset, tesm.Recipient, tesm.Amount, mocks.MBridgeContractService.Address().String(), typedDataDomain)
 )
 
 func Test_NewHandler(t *testing.T) {
 	setup()
-	assert.Equal(t, h, NewHandler(topicId.String(), mocks.MTransferRepository, mocks.MMessageRepository, map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, mocks.MAssetsService))
+	assert.Equal(t, h, NewHandler([]string{topicId.String()}, mocks.MTransferRepository, mocks.MMessageRepository, map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, mocks.MAssetsService, &typedDataDomain, orphanTtl, maxOrphans))
 }
 
 func Test_Handle_Fails(t *testing.T) {
 	setup()
 	h.Handle("invalid-payload")
 	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", mock.Anything)
-	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything)
+	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
 	mocks.MBridgeContractService.AssertNotCalled(t, "GetMembers")
 }
 
@@ -94,49 +104,188 @@ func Test_HandleSignatureMessage_SanityCheckIsNotValid(t *testing.T) {
 func Test_HandleSignatureMessage_ProcessSignatureFails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(errors.New("some-error"))
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().SourceChainId, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(errors.New("some-error"))
 	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
 	mocks.MTransferRepository.AssertNotCalled(t, "Update", mock.Anything)
-	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything)
+	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
 	mocks.MBridgeContractService.AssertNotCalled(t, "GetMembers")
 }
 
+func Test_HandleSignatureMessage_WithoutTypedData(t *testing.T) {
+	setup()
+	h.typedDataDomain = nil
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tesm).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, transactionTimestamp, authMsgBytes, []byte(nil)).Return(errors.New("some-error"))
+
+	h.handleFungibleSignatureMessage(tesm, transactionTimestamp)
+
+	mocks.MMessageService.AssertCalled(t, "ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, transactionTimestamp, authMsgBytes, []byte(nil))
+}
+
 func Test_HandleSignatureMessage_MajorityReached(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
-	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().SourceChainId, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
-	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
 	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
 	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID)
+}
+
+func Test_HandleSignatureMessage_MajorityReached_FiresEvent(t *testing.T) {
+	setup()
+	defer event.Reset()
+	var fired []string
+	events.OnTransferEvent(constants.EventTransferSignaturesReached, func(params *transfer_event.Params) error {
+		fired = append(fired, params.TransactionID)
+		return nil
+	})
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().SourceChainId, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
+	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
+
+	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+
+	assert.Equal(t, []string{tsm.GetFungibleSignatureMessage().TransferID}, fired)
+}
+
+func Test_HandleSignatureMessage_AfterMajority_DoesNotFireEvents(t *testing.T) {
+	setup()
+	defer event.Reset()
+	var fired []string
+	events.OnTransferEvent("transfer.*", func(params *transfer_event.Params) error {
+		fired = append(fired, params.TransactionID)
+		return nil
+	})
+	completed := entity.Message{Transfer: entity.Transfer{Status: status.Completed}}
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().SourceChainId, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{completed, completed, completed, completed}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(4)).Return(true, nil)
+	// The repository fires the completed event only on a status change, so the mock does not fire it
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
+	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
+
+	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+
+	assert.Empty(t, fired)
+}
+
+func Test_Handle_SignatureRequest_FiresEvent(t *testing.T) {
+	setup()
+	defer event.Reset()
+	var fired []string
+	events.OnTransferEvent(constants.EventTransferSignatureRequested, func(params *transfer_event.Params) error {
+		fired = append(fired, params.TransactionID)
+		return nil
+	})
+
+	h.Handle(message.NewSignatureRequest(tesm.SourceChainId, tesm.TransferID))
+
+	assert.Equal(t, []string{tesm.TransferID}, fired)
+	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func Test_Handle_MajorityReachedAcrossTopics(t *testing.T) {
+	setup()
+	// The same transfer, signed by two validators, whose signatures are read from different topics
+	other := &proto.TopicEthSignatureMessage{
+		SourceChainId: tesm.SourceChainId,
+		TargetChainId: tesm.TargetChainId,
+		TransferID:    tesm.TransferID,
+		Asset:         tesm.Asset,
+		Recipient:     tesm.Recipient,
+		Amount:        tesm.Amount,
+		Signature:     "other-signature",
+	}
+	otherTsm := message.Message{
+		TopicMessage: &proto.TopicMessage{
+			Message: &proto.TopicMessage_FungibleSignatureMessage{FungibleSignatureMessage: other},
+		},
+		TransactionTimestamp: 1,
+	}
+	mocks.MMessageService.On("SanityCheckFungibleSignature", mock.Anything).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tesm.TransferID, mock.Anything, tesm.SourceChainId, tesm.TargetChainId, mock.Anything, authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tesm.TransferID).Return([]entity.Message{{}}, nil).Once()
+	mocks.MMessageRepository.On("Get", SourceChainId, tesm.TransferID).Return([]entity.Message{{}, {}}, nil).Once()
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(false, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(2)).Return(true, nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tesm.TransferID).Return(nil)
+	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
+
+	h.Handle(&tsm)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", SourceChainId, tesm.TransferID)
+
+	h.Handle(&otherTsm)
+	mocks.MMessageService.AssertCalled(t, "ProcessSignature", tesm.TransferID, other.Signature, tesm.SourceChainId, tesm.TargetChainId, int64(1), authMsgBytes, typedDataBytes)
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "UpdateStatusCompleted", 1)
+}
+
+func Test_HandleSignatureMessage_MajorityReached_ObservesSignatureToMajority(t *testing.T) {
+	histogram := setupMonitoring()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, int64(5*time.Second), authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tesm.TransferID).Return([]entity.Message{{TransactionTimestamp: int64(2 * time.Second)}, {}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tesm.TransferID).Return(nil)
+	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
+
+	h.handleFungibleSignatureMessage(tesm, int64(5*time.Second))
+
+	assert.Equal(t, []float64{3}, histogram.observed)
+}
+
+func Test_HandleSignatureMessage_AlreadyCompleted_DoesNotObserveSignatureToMajority(t *testing.T) {
+	histogram := setupMonitoring()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(nil)
+	completed := entity.Transfer{Status: status.Completed}
+	mocks.MMessageRepository.On("Get", SourceChainId, tesm.TransferID).Return([]entity.Message{{Transfer: completed}, {Transfer: completed}, {Transfer: completed}, {Transfer: completed}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(4)).Return(true, nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tesm.TransferID).Return(nil)
+	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
+
+	h.handleFungibleSignatureMessage(tesm, transactionTimestamp)
+
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateHistogramIfNotExists", mock.Anything)
+	assert.Empty(t, histogram.observed)
 }
 
 func Test_Handle(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
-	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().SourceChainId, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
-	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
 	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
 	h.Handle(&tsm)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID)
 }
 
 func Test_HandleSignatureMessage_UpdateStatusCompleted_Fails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
-	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().SourceChainId, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
-	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(errors.New("some-error"))
+	mocks.MTransferRepository.On("UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return(errors.New("some-error"))
 	mocks.MAssetsService.On("OppositeAsset", SourceChainId, TargetChainId, Asset).Return("0.0.2")
 	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
@@ -146,11 +295,121 @@ func Test_HandleSignatureMessage_UpdateStatusCompleted_Fails(t *testing.T) {
 func Test_HandleSignatureMessage_CheckMajority_Fails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
-	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
-	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, errors.New("some-error"))
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().SourceChainId, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, errors.New("some-error"))
 	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
 	mocks.MBridgeContractService.AssertNotCalled(t, "GetMembers")
-	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", SourceChainId, tsm.GetFungibleSignatureMessage().TransferID)
+}
+
+// setupMonitoring sets up the handler with monitoring enabled and returns the signature to majority histogram
+func Test_HandleSignatureMessage_BeforeTransfer_StoresOrphan(t *testing.T) {
+	setup()
+	payload, _ := tsm.ToBytes()
+	mockStoredOrphans(0)
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(false, fmt.Errorf("some-error: %w", service.ErrTransferNotFound))
+	mocks.MMessageRepository.On("CreateOrphan", &entity.OrphanedSignature{TransferID: tesm.TransferID, SourceChainID: SourceChainId, Payload: payload, TransactionTimestamp: transactionTimestamp}).Return(nil)
+	mocks.MTransferRepository.On("GetByTransactionId", SourceChainId, tesm.TransferID).Return((*entity.Transfer)(nil), nil)
+
+	h.handleFungibleSignatureMessage(tesm, transactionTimestamp)
+
+	mocks.MMessageRepository.AssertCalled(t, "PruneOrphansBefore", mock.Anything)
+	mocks.MMessageRepository.AssertCalled(t, "CreateOrphan", mock.Anything)
+	mocks.MMessageRepository.AssertNotCalled(t, "ResolveOrphans", mock.Anything, mock.Anything)
+	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_HandleSignatureMessage_BeforeFee_StoresOrphan(t *testing.T) {
+	setup()
+	mockStoredOrphans(0)
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tesm).Return(false, fmt.Errorf("some-error: %w", service.ErrTransferFeeNotFound))
+	mocks.MMessageRepository.On("CreateOrphan", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("GetByTransactionId", SourceChainId, tesm.TransferID).Return(&entity.Transfer{TransactionID: tesm.TransferID, NativeChainID: constants.HederaNetworkId}, nil)
+
+	h.handleFungibleSignatureMessage(tesm, transactionTimestamp)
+
+	mocks.MMessageRepository.AssertCalled(t, "CreateOrphan", mock.Anything)
+	mocks.MMessageRepository.AssertNotCalled(t, "ResolveOrphans", mock.Anything, mock.Anything)
+}
+
+func Test_HandleSignatureMessage_BeforeTransfer_MaxOrphansReached(t *testing.T) {
+	setup()
+	mockStoredOrphans(int64(maxOrphans))
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tesm).Return(false, fmt.Errorf("some-error: %w", service.ErrTransferNotFound))
+
+	h.handleFungibleSignatureMessage(tesm, transactionTimestamp)
+
+	mocks.MMessageRepository.AssertNotCalled(t, "CreateOrphan", mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", mock.Anything, mock.Anything)
+}
+
+func Test_HandleSignatureMessage_BeforeTransfer_CreatedMeanwhile_ResolvesOrphan(t *testing.T) {
+	setup()
+	payload, _ := tsm.ToBytes()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tesm).Return(false, fmt.Errorf("some-error: %w", service.ErrTransferNotFound)).Once()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", mock.Anything).Return(true, nil)
+	mockStoredOrphans(0)
+	mocks.MMessageRepository.On("CreateOrphan", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("GetByTransactionId", SourceChainId, tesm.TransferID).Return(&entity.Transfer{TransactionID: tesm.TransferID, NativeChainID: constants.HederaNetworkId, Fee: "10"}, nil)
+	mocks.MMessageRepository.On("ResolveOrphans", SourceChainId, tesm.TransferID).Return([]entity.OrphanedSignature{{TransferID: tesm.TransferID, Payload: payload, TransactionTimestamp: transactionTimestamp}}, nil)
+	mocks.MMessageService.On("ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes).Return(errors.New("some-error"))
+
+	h.handleFungibleSignatureMessage(tesm, transactionTimestamp)
+
+	mocks.MMessageRepository.AssertCalled(t, "ResolveOrphans", SourceChainId, tesm.TransferID)
+	mocks.MMessageService.AssertNumberOfCalls(t, "SanityCheckFungibleSignature", 2)
+	mocks.MMessageService.AssertCalled(t, "ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, transactionTimestamp, authMsgBytes, typedDataBytes)
+}
+
+func Test_ResolveOrphans_HandlesSignaturesBeforeTransfer(t *testing.T) {
+	setup()
+	payload, _ := tsm.ToBytes()
+	mocks.MMessageRepository.On("ResolveOrphans", SourceChainId, tesm.TransferID).Return([]entity.OrphanedSignature{{TransferID: tesm.TransferID, Payload: payload, TransactionTimestamp: 5}}, nil)
+	mocks.MMessageService.On("SanityCheckFungibleSignature", mock.Anything).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, int64(5), authMsgBytes, typedDataBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", SourceChainId, tesm.TransferID).Return([]entity.Message{{}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(false, nil)
+
+	h.ResolveOrphans(SourceChainId, tesm.TransferID)
+
+	mocks.MMessageService.AssertCalled(t, "ProcessSignature", tesm.TransferID, tesm.Signature, tesm.SourceChainId, tesm.TargetChainId, int64(5), authMsgBytes, typedDataBytes)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", mock.Anything, mock.Anything)
+}
+
+func Test_ResolveOrphans_Err(t *testing.T) {
+	setup()
+	mocks.MMessageRepository.On("ResolveOrphans", SourceChainId, tesm.TransferID).Return([]entity.OrphanedSignature(nil), errors.New("some-error"))
+
+	h.ResolveOrphans(SourceChainId, tesm.TransferID)
+
+	mocks.MMessageService.AssertNotCalled(t, "SanityCheckFungibleSignature", mock.Anything)
+}
+
+func setupMonitoring() *recordingHistogram {
+	setup()
+	mocks.MPrometheusService.ExpectedCalls = nil
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	h.participationRateGauge = prometheus.NewGauge(prometheus.GaugeOpts{Name: "participation_rate"})
+	mocks.MPrometheusService.On("CreateSuccessRateGaugeIfNotExists", tesm.TransferID, SourceChainId, TargetChainId, "0.0.2", constants.MajorityReachedNameSuffix, constants.MajorityReachedHelp).
+		Return(prometheus.NewGauge(prometheus.GaugeOpts{Name: "majority_reached"}), nil)
+
+	histogram := &recordingHistogram{}
+	mocks.MPrometheusService.On("CreateHistogramIfNotExists", mock.MatchedBy(func(opts prometheus.HistogramOpts) bool {
+		return opts.Name == fmt.Sprintf("%s%d_%d", constants.SignatureToMajorityHistogramNamePrefix, SourceChainId, TargetChainId)
+	})).Return(histogram)
+
+	return histogram
+}
+
+// recordingHistogram records the values it observes
+type recordingHistogram struct {
+	prometheus.Histogram
+	observed []float64
+}
+
+func (rh *recordingHistogram) Observe(value float64) {
+	rh.observed = append(rh.observed, value)
 }
 
 func setup() {
@@ -166,5 +425,14 @@ func setup() {
 		prometheusService:      mocks.MPrometheusService,
 		assetsService:          mocks.MAssetsService,
 		participationRateGauge: nil,
+		typedDataDomain:        &typedDataDomain,
+		orphanTtl:              orphanTtl,
+		maxOrphans:             maxOrphans,
 	}
+	mocks.MMessageRepository.On("PruneOrphansBefore", mock.Anything).Return(int64(0), nil)
+}
+
+// mockStoredOrphans mocks the number of already stored orphaned signature messages
+func mockStoredOrphans(count int64) {
+	mocks.MMessageRepository.On("CountOrphans").Return(count, nil)
 }
@@ -41,5 +41,6 @@ func (mhh Handler) Handle(p interface{}) {
 		mhh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	mhh.logger = config.WithContext(mhh.logger, event.Context())
 	mhh.lockService.ProcessEvent(*event)
 }
@@ -68,6 +68,7 @@ func (nth Handler) Handle(p interface{}) {
 		nth.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	nth.logger = config.WithContext(nth.logger, transfer.Context())
 
 	receiver, err := hedera.AccountIDFromString(transfer.Receiver)
 	if err != nil {
@@ -99,8 +100,8 @@ func (nth Handler) Handle(p interface{}) {
 	var statusResult string
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
-	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(nth.repository, nth.scheduleRepository, nth.logger, transfer.TransactionId, true, &statusResult, schedule.APPROVE, wg)
-	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(nth.repository, nth.scheduleRepository, nth.logger, transfer.TransactionId, &statusResult, wg)
+	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(nth.repository, nth.scheduleRepository, nth.logger, transfer.SourceChainId, transfer.TransactionId, true, &statusResult, schedule.APPROVE, wg)
+	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(nth.repository, nth.scheduleRepository, nth.logger, transfer.SourceChainId, transfer.TransactionId, &statusResult, wg)
 
 	nth.scheduledService.ExecuteScheduledNftAllowTransaction(transfer.TransactionId, nftID, nth.bridgeAccount, receiver, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 }
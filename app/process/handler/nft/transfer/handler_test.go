@@ -237,8 +237,8 @@ func Test_Handle_NotInitialStatus(t *testing.T) {
 func Test_scheduledTxMinedCallbacks(t *testing.T) {
 	setup(t)
 
-	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(nilErr)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(nilErr)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", sourceChainId, transactionId).Return(nilErr)
+	mocks.MTransferRepository.On("UpdateStatusFailed", sourceChainId, transactionId).Return(nilErr)
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", transactionId).Return(nilErr)
 	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(nilErr)
 
@@ -249,14 +249,15 @@ func Test_scheduledTxMinedCallbacks(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		statusResult,
 		wg)
 	onSuccess(transactionId)
 	onFailure(transactionId)
 
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", transactionId)
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", transactionId)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", sourceChainId, transactionId)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", sourceChainId, transactionId)
 	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusCompleted", transactionId)
 	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusFailed", transactionId)
 }
@@ -265,7 +266,7 @@ func Test_scheduledTxMinedCallbacks_TransferRepoErrorOnSuccess(t *testing.T) {
 	setup(t)
 
 	err := errors.New("some error")
-	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(err)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", sourceChainId, transactionId).Return(err)
 
 	statusResult := new(string)
 	wg := new(sync.WaitGroup)
@@ -274,12 +275,13 @@ func Test_scheduledTxMinedCallbacks_TransferRepoErrorOnSuccess(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		statusResult,
 		wg)
 	onSuccess(transactionId)
 
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", transactionId)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", sourceChainId, transactionId)
 	mocks.MScheduleRepository.AssertNotCalled(t, "UpdateStatusCompleted")
 }
 
@@ -287,7 +289,7 @@ func Test_scheduledTxMinedCallbacks_ScheduleRepoErrorOnSuccess(t *testing.T) {
 	setup(t)
 
 	err := errors.New("some error")
-	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(nilErr)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", sourceChainId, transactionId).Return(nilErr)
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", transactionId).Return(err)
 
 	statusResult := new(string)
@@ -297,12 +299,13 @@ func Test_scheduledTxMinedCallbacks_ScheduleRepoErrorOnSuccess(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		statusResult,
 		wg)
 	onSuccess(transactionId)
 
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", transactionId)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", sourceChainId, transactionId)
 	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusCompleted", transactionId)
 }
 
@@ -311,7 +314,7 @@ func Test_scheduledTxMinedCallbacks_TransferRepoErrorOnFailure(t *testing.T) {
 
 	err := errors.New("some error")
 	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(nilErr)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(err)
+	mocks.MTransferRepository.On("UpdateStatusFailed", sourceChainId, transactionId).Return(err)
 
 	statusResult := new(string)
 	wg := new(sync.WaitGroup)
@@ -320,13 +323,14 @@ func Test_scheduledTxMinedCallbacks_TransferRepoErrorOnFailure(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		statusResult,
 		wg)
 	onFailure(transactionId)
 
 	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusFailed", transactionId)
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", transactionId)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", sourceChainId, transactionId)
 }
 
 func Test_scheduledTxMinedCallbacks_ScheduledRepoErrorOnFailure(t *testing.T) {
@@ -342,6 +346,7 @@ func Test_scheduledTxMinedCallbacks_ScheduledRepoErrorOnFailure(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		statusResult,
 		wg)
@@ -363,6 +368,7 @@ func Test_scheduledTxExecutionCallbacks_OnSuccess(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		true,
 		statusResult,
@@ -387,6 +393,7 @@ func Test_scheduledTxExecutionCallbacks_OnSuccess_Err(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		true,
 		statusResult,
@@ -403,7 +410,7 @@ func Test_scheduledTxExecutionCallbacks_OnFailure(t *testing.T) {
 	setup(t)
 
 	mocks.MScheduleRepository.On("Create", onFailureScheduleEntity).Return(nilErr)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(nilErr)
+	mocks.MTransferRepository.On("UpdateStatusFailed", sourceChainId, transactionId).Return(nilErr)
 
 	statusResult := new(string)
 	wg := new(sync.WaitGroup)
@@ -412,6 +419,7 @@ func Test_scheduledTxExecutionCallbacks_OnFailure(t *testing.T) {
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		true,
 		statusResult,
@@ -420,7 +428,7 @@ func Test_scheduledTxExecutionCallbacks_OnFailure(t *testing.T) {
 	OnFailure(transactionId)
 
 	mocks.MScheduleRepository.AssertCalled(t, "Create", onFailureScheduleEntity)
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", transactionId)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", sourceChainId, transactionId)
 }
 
 func Test_scheduledTxExecutionCallbacks_OnFailure_CreateEntityErr(t *testing.T) {
@@ -435,6 +443,7 @@ func Test_scheduledTxExecutionCallbacks_OnFailure_CreateEntityErr(t *testing.T)
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		true,
 		statusResult,
@@ -449,7 +458,7 @@ func Test_scheduledTxExecutionCallbacks_OnFailure_UpdateStatusErr(t *testing.T)
 	setup(t)
 
 	mocks.MScheduleRepository.On("Create", onFailureScheduleEntity).Return(nilErr)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(errors.New("some error"))
+	mocks.MTransferRepository.On("UpdateStatusFailed", sourceChainId, transactionId).Return(errors.New("some error"))
 
 	statusResult := new(string)
 	wg := new(sync.WaitGroup)
@@ -458,6 +467,7 @@ func Test_scheduledTxExecutionCallbacks_OnFailure_UpdateStatusErr(t *testing.T)
 		handler.repository,
 		handler.scheduleRepository,
 		handler.logger,
+		sourceChainId,
 		transactionId,
 		true,
 		statusResult,
@@ -466,7 +476,7 @@ func Test_scheduledTxExecutionCallbacks_OnFailure_UpdateStatusErr(t *testing.T)
 	OnFailure(transactionId)
 
 	mocks.MScheduleRepository.AssertCalled(t, "Create", onFailureScheduleEntity)
-	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", transactionId)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", sourceChainId, transactionId)
 }
 
 func setup(t *testing.T) {
@@ -70,6 +70,7 @@ func (mhh Handler) Handle(p interface{}) {
 		mhh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	mhh.logger = config.WithContext(mhh.logger, transferMsg.Context())
 
 	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
@@ -89,9 +90,9 @@ func (mhh Handler) Handle(p interface{}) {
 		func(transactionID, scheduleID, s string) error {
 
 			if s == status.Completed {
-				err = mhh.transferRepository.UpdateStatusCompleted(transferMsg.TransactionId)
+				err = mhh.transferRepository.UpdateStatusCompleted(transferMsg.SourceChainId, transferMsg.TransactionId)
 			} else {
-				err = mhh.transferRepository.UpdateStatusFailed(transferMsg.TransactionId)
+				err = mhh.transferRepository.UpdateStatusFailed(transferMsg.SourceChainId, transferMsg.TransactionId)
 			}
 
 			if err != nil {
@@ -107,6 +108,7 @@ func (mhh Handler) Handle(p interface{}) {
 					String: transferMsg.TransactionId,
 					Valid:  true,
 				},
+				TransferSourceChainID: transferMsg.SourceChainId,
 			})
 		})
 }
@@ -28,6 +28,7 @@ import (
 	util "github.com/limechain/hedera-eth-bridge-validator/app/helper/fee"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
@@ -90,27 +91,28 @@ func (fmh *Handler) Handle(p interface{}) {
 		fmh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	logger := config.WithContext(fmh.logger, transferMsg.Context())
 
 	receiver, err := hedera.AccountIDFromString(transferMsg.Receiver)
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transferMsg.TransactionId, transferMsg.Receiver, err)
+		logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transferMsg.TransactionId, transferMsg.Receiver, err)
 		return
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
+		logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
 		return
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
-		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
 		return
 	}
 
 	intAmount, err := strconv.ParseInt(transferMsg.Amount, 10, 64)
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferMsg.TransactionId, err)
+		logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferMsg.TransactionId, err)
 		return
 	}
 
@@ -121,9 +123,9 @@ func (fmh *Handler) Handle(p interface{}) {
 		remainder += calculatedFee - validFee
 	}
 
-	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
+	err = fmh.transferRepository.UpdateFee(transferMsg.SourceChainId, transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
+		logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
 		return
 	}
 
@@ -153,7 +155,7 @@ func (fmh *Handler) Handle(p interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount, hasReceiver := util.TotalFeeFromTransfers(splitTransfer, receiver)
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() (*mirrorNodeTransaction.Response, error) {
+		fmh.readOnlyService.FindAssetTransfer(transferMsg.SourceChainId, transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() (*mirrorNodeTransaction.Response, error) {
 			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
 		}, func(transactionID, scheduleID, status string) error {
 			result := false
@@ -176,9 +178,10 @@ func (fmh *Handler) Handle(p interface{}) {
 					String: transferMsg.TransactionId,
 					Valid:  true,
 				},
+				TransferSourceChainID: transferMsg.SourceChainId,
 			})
 			if err != nil {
-				fmh.logger.Errorf("[%s] - Failed to create scheduled entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
+				logger.Errorf("[%s] - Failed to create scheduled entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
 				return err
 			}
 			err = fmh.feeRepository.Create(&entity.Fee{
@@ -190,9 +193,10 @@ func (fmh *Handler) Handle(p interface{}) {
 					String: transferMsg.TransactionId,
 					Valid:  true,
 				},
+				TransferSourceChainID: transferMsg.SourceChainId,
 			})
 			if err != nil {
-				fmh.logger.Errorf("[%s] - Failed to create fee  entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
+				logger.Errorf("[%s] - Failed to create fee  entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
 			}
 			return err
 		})
@@ -207,24 +211,28 @@ func (fmh *Handler) startAwaitingFunctionsForMetrics(userOutParams *hederaHelper
 	}
 
 	// Await results to set metrics for 'user_get_his_tokens'
-	go hederaHelper.AwaitMultipleScheduledTransactions(
-		userOutParams.OutParams,
-		transferMsg.SourceChainId,
-		transferMsg.TargetChainId,
-		transferMsg.TargetAsset,
-		transferMsg.TransactionId,
-		fmh.onMinedUserTransactionSetMetrics,
-	)
+	syncHelper.Go(func() {
+		hederaHelper.AwaitMultipleScheduledTransactions(
+			userOutParams.OutParams,
+			transferMsg.SourceChainId,
+			transferMsg.TargetChainId,
+			transferMsg.TargetAsset,
+			transferMsg.TransactionId,
+			fmh.onMinedUserTransactionSetMetrics,
+		)
+	})
 
 	// Await results to set metrics for 'fee_transferred'
-	go hederaHelper.AwaitMultipleScheduledTransactions(
-		feeOutParams.OutParams,
-		transferMsg.SourceChainId,
-		transferMsg.TargetChainId,
-		transferMsg.TargetAsset,
-		transferMsg.TransactionId,
-		fmh.onMinedFeeTransactionsSetMetrics,
-	)
+	syncHelper.Go(func() {
+		hederaHelper.AwaitMultipleScheduledTransactions(
+			feeOutParams.OutParams,
+			transferMsg.SourceChainId,
+			transferMsg.TargetChainId,
+			transferMsg.TargetAsset,
+			transferMsg.TransactionId,
+			fmh.onMinedFeeTransactionsSetMetrics,
+		)
+	})
 }
 
 func (fmh *Handler) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainId uint64, nativeAsset string, transferID string, isTransferSuccessful bool) {
@@ -88,7 +88,7 @@ func Test_Handle(t *testing.T) {
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(tr, nil)
 	mocks.MFeeService.On("CalculateFee", tr.TargetAsset, int64(100)).Return(int64(10), int64(0))
 	mocks.MDistributorService.On("ValidAmount", 10).Return(int64(3))
-	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	h.Handle(tr)
 }
 
@@ -97,9 +97,9 @@ func Test_Handle_FindTransfer(t *testing.T) {
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
 	mocks.MFeeService.On("CalculateFee", tr.TargetAsset, int64(100)).Return(int64(10), int64(0))
 	mocks.MDistributorService.On("ValidAmount", int64(10)).Return(int64(3))
-	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, "3").Return(nil)
+	mocks.MTransferRepository.On("UpdateFee", tr.SourceChainId, tr.TransactionId, "3").Return(nil)
 	mocks.MDistributorService.On("CalculateMemberDistribution", int64(3)).Return([]model.Hedera{})
-	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	h.Handle(tr)
 }
 
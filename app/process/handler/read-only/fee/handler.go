@@ -27,6 +27,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
@@ -89,6 +90,7 @@ func (fmh Handler) Handle(p interface{}) {
 		fmh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	fmh.logger = config.WithContext(fmh.logger, transferMsg.Context())
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
@@ -110,7 +112,7 @@ func (fmh Handler) Handle(p interface{}) {
 	calculatedFee, _ := fmh.feeService.CalculateFee(transferMsg.SourceAsset, intAmount)
 	validFee := fmh.distributor.ValidAmount(calculatedFee)
 
-	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
+	err = fmh.transferRepository.UpdateFee(transferMsg.SourceChainId, transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
 		return
@@ -135,7 +137,7 @@ func (fmh Handler) Handle(p interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount := -splitTransfer[len(splitTransfer)-1].Amount
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.NativeAsset, splitTransfer,
+		fmh.readOnlyService.FindAssetTransfer(transferMsg.SourceChainId, transferMsg.TransactionId, transferMsg.NativeAsset, splitTransfer,
 			func() (*mirrorNodeTransaction.Response, error) {
 				return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
 			},
@@ -159,6 +161,7 @@ func (fmh Handler) Handle(p interface{}) {
 						String: transferMsg.TransactionId,
 						Valid:  true,
 					},
+					TransferSourceChainID: transferMsg.SourceChainId,
 				})
 				if err != nil {
 					fmh.logger.Errorf("[%s] - Failed to create scheduled entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
@@ -173,6 +176,7 @@ func (fmh Handler) Handle(p interface{}) {
 						String: transferMsg.TransactionId,
 						Valid:  true,
 					},
+					TransferSourceChainID: transferMsg.SourceChainId,
 				})
 				if err != nil {
 					fmh.logger.Errorf("[%s] - Failed to create fee  entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
@@ -182,14 +186,16 @@ func (fmh Handler) Handle(p interface{}) {
 	}
 
 	if fmh.prometheusService.GetIsMonitoringEnabled() {
-		go hederaHelper.AwaitMultipleScheduledTransactions(
-			feeOutParams.OutParams,
-			transferMsg.SourceChainId,
-			transferMsg.TargetChainId,
-			transferMsg.SourceAsset,
-			transferMsg.TransactionId,
-			fmh.onMinedFeeTransactionsSetMetrics,
-		)
+		syncHelper.Go(func() {
+			hederaHelper.AwaitMultipleScheduledTransactions(
+				feeOutParams.OutParams,
+				transferMsg.SourceChainId,
+				transferMsg.TargetChainId,
+				transferMsg.SourceAsset,
+				transferMsg.TransactionId,
+				fmh.onMinedFeeTransactionsSetMetrics,
+			)
+		})
 	}
 }
 
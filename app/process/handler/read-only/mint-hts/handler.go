@@ -86,15 +86,16 @@ func (fmh *Handler) Handle(p interface{}) {
 		fmh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	logger := config.WithContext(fmh.logger, transferMsg.Context())
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
+		logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
 		return
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
-		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
 		return
 	}
 
@@ -113,6 +114,7 @@ func (fmh *Handler) Handle(p interface{}) {
 					String: transferMsg.TransactionId,
 					Valid:  true,
 				},
+				TransferSourceChainID: transferMsg.SourceChainId,
 			})
 		})
 
@@ -130,16 +132,16 @@ func (fmh *Handler) Handle(p interface{}) {
 					transferMsg.SourceAsset,
 					transferMsg.TransactionId,
 					fmh.prometheusService,
-					fmh.logger,
+					logger,
 				)
 
-				err = fmh.transferRepository.UpdateStatusCompleted(transferMsg.TransactionId)
+				err = fmh.transferRepository.UpdateStatusCompleted(transferMsg.SourceChainId, transferMsg.TransactionId)
 			} else {
-				err = fmh.transferRepository.UpdateStatusFailed(transferMsg.TransactionId)
+				err = fmh.transferRepository.UpdateStatusFailed(transferMsg.SourceChainId, transferMsg.TransactionId)
 			}
 
 			if err != nil {
-				fmh.logger.Errorf("[%s] - Failed to update status. Error: [%s]", transferMsg.TransactionId, err)
+				logger.Errorf("[%s] - Failed to update status. Error: [%s]", transferMsg.TransactionId, err)
 			}
 
 			return fmh.scheduleRepository.Create(&entity.Schedule{
@@ -152,6 +154,7 @@ func (fmh *Handler) Handle(p interface{}) {
 					String: transferMsg.TransactionId,
 					Valid:  true,
 				},
+				TransferSourceChainID: transferMsg.SourceChainId,
 			})
 		})
 }
@@ -84,6 +84,7 @@ func (fmh Handler) Handle(p interface{}) {
 		fmh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	fmh.logger = config.WithContext(fmh.logger, transferMsg.Context())
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
@@ -113,18 +114,19 @@ func (fmh Handler) Handle(p interface{}) {
 					String: transferMsg.TransactionId,
 					Valid:  true,
 				},
+				TransferSourceChainID: transferMsg.SourceChainId,
 			})
 			if err != nil {
 				fmh.logger.Errorf("[%s] - Failed to create scheduled entity [%s]. Error: [%s]", transactionID, scheduleID, err)
 				return err
 			}
 
-			return fmh.transferRepository.UpdateStatusCompleted(transactionID)
+			return fmh.transferRepository.UpdateStatusCompleted(transferMsg.SourceChainId, transactionID)
 		},
 	)
 
 	validFee := fmh.distributor.ValidAmount(transferMsg.Fee)
-	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
+	err = fmh.transferRepository.UpdateFee(transferMsg.SourceChainId, transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
 		return
@@ -140,7 +142,7 @@ func (fmh Handler) Handle(p interface{}) {
 
 	for _, splitTransfer := range splitTransfers {
 		feeAmount := -splitTransfer[len(splitTransfer)-1].Amount
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, constants.Hbar, splitTransfer,
+		fmh.readOnlyService.FindAssetTransfer(transferMsg.SourceChainId, transferMsg.TransactionId, constants.Hbar, splitTransfer,
 			func() (*mirror_node.Response, error) {
 				return fmh.feeTransfersFetch(transferMsg)
 			},
@@ -164,6 +166,7 @@ func (fmh Handler) feeTransfersSave(transactionID string, scheduleID string, sta
 			String: transferMsg.TransactionId,
 			Valid:  true,
 		},
+		TransferSourceChainID: transferMsg.SourceChainId,
 	})
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to create scheduled entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
@@ -178,6 +181,7 @@ func (fmh Handler) feeTransfersSave(transactionID string, scheduleID string, sta
 			String: transferMsg.TransactionId,
 			Valid:  true,
 		},
+		TransferSourceChainID: transferMsg.SourceChainId,
 	})
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to create fee  entity [%s]. Error: [%s]", transferMsg.TransactionId, scheduleID, err)
@@ -109,19 +109,21 @@ var (
 	scheduleId               = "33333"
 
 	scheduleEntity = &entity.Schedule{
-		TransactionID: transactionId,
-		ScheduleID:    scheduleId,
-		HasReceiver:   false,
-		Operation:     schedule.TRANSFER,
-		Status:        status.Completed,
-		TransferID:    sql.NullString{String: transactionId, Valid: true},
+		TransactionID:         transactionId,
+		ScheduleID:            scheduleId,
+		HasReceiver:           false,
+		Operation:             schedule.TRANSFER,
+		Status:                status.Completed,
+		TransferID:            sql.NullString{String: transactionId, Valid: true},
+		TransferSourceChainID: sourceChainId,
 	}
 	feeEntity = &entity.Fee{
-		TransactionID: transactionId,
-		ScheduleID:    scheduleId,
-		Amount:        strconv.FormatInt(-validFee, 10),
-		Status:        status.Completed,
-		TransferID:    sql.NullString{String: transactionId, Valid: true},
+		TransactionID:         transactionId,
+		ScheduleID:            scheduleId,
+		Amount:                strconv.FormatInt(-validFee, 10),
+		Status:                status.Completed,
+		TransferID:            sql.NullString{String: transactionId, Valid: true},
+		TransferSourceChainID: sourceChainId,
 	}
 )
 
@@ -168,19 +170,19 @@ func Test_Handle(t *testing.T) {
 
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(entityTransfer, nil)
 	mocks.MDistributorService.On("ValidAmount", hederaFeeForSourceAsset).Return(validFee)
-	mocks.MTransferRepository.On("UpdateFee", transactionId, formattedValidFee).Return(nil)
+	mocks.MTransferRepository.On("UpdateFee", sourceChainId, transactionId, formattedValidFee).Return(nil)
 	mocks.MDistributorService.On("CalculateMemberDistribution", validFee).Return(hederaTransfers, nilErr)
 	mocks.MReadOnlyService.On("FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
-	mocks.MReadOnlyService.On("FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.On("FindAssetTransfer", sourceChainId, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 
 	handler.Handle(p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertCalled(t, "ValidAmount", hederaFeeForSourceAsset)
-	mocks.MTransferRepository.AssertCalled(t, "UpdateFee", transactionId, formattedValidFee)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateFee", sourceChainId, transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertCalled(t, "CalculateMemberDistribution", validFee)
 	mocks.MReadOnlyService.AssertCalled(t, "FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
-	mocks.MReadOnlyService.AssertCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertCalled(t, "FindAssetTransfer", sourceChainId, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_ErrOnCast(t *testing.T) {
@@ -191,9 +193,9 @@ func Test_Handle_ErrOnCast(t *testing.T) {
 
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
-	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", transactionId, formattedValidFee)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", sourceChainId, transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", sourceChainId, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_ErrOnTransactionRecord(t *testing.T) {
@@ -205,9 +207,9 @@ func Test_Handle_ErrOnTransactionRecord(t *testing.T) {
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
-	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", transactionId, formattedValidFee)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", sourceChainId, transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", sourceChainId, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_TransactionRecordNotInitialStatus(t *testing.T) {
@@ -221,9 +223,9 @@ func Test_Handle_TransactionRecordNotInitialStatus(t *testing.T) {
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
-	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", transactionId, formattedValidFee)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", sourceChainId, transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", sourceChainId, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_ErrOnUpdateFee(t *testing.T) {
@@ -231,16 +233,16 @@ func Test_Handle_ErrOnUpdateFee(t *testing.T) {
 
 	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(entityTransfer, nil)
 	mocks.MDistributorService.On("ValidAmount", hederaFeeForSourceAsset).Return(validFee)
-	mocks.MTransferRepository.On("UpdateFee", transactionId, formattedValidFee).Return(errors.New("failed to create transaction record"))
+	mocks.MTransferRepository.On("UpdateFee", sourceChainId, transactionId, formattedValidFee).Return(errors.New("failed to create transaction record"))
 	mocks.MReadOnlyService.On("FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
 
 	handler.Handle(p)
 
 	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
 	mocks.MDistributorService.AssertCalled(t, "ValidAmount", hederaFeeForSourceAsset)
-	mocks.MTransferRepository.AssertCalled(t, "UpdateFee", transactionId, formattedValidFee)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateFee", sourceChainId, transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", sourceChainId, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 	mocks.MReadOnlyService.AssertCalled(t, "FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
 }
 
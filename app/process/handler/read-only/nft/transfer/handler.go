@@ -74,6 +74,7 @@ func (rnth Handler) Handle(p interface{}) {
 		rnth.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	rnth.logger = config.WithContext(rnth.logger, transfer.Context())
 
 	transactionRecord, err := rnth.transfersService.InitiateNewTransfer(*transfer)
 	if err != nil {
@@ -100,12 +101,13 @@ func (rnth Handler) Handle(p interface{}) {
 					String: transfer.TransactionId,
 					Valid:  true,
 				},
+				TransferSourceChainID: transfer.SourceChainId,
 			})
 			if err != nil {
 				rnth.logger.Errorf("[%s] - Error to create scheduled entity. Error: [%s]", transactionID, err)
 				return err
 			}
-			return rnth.transferRepository.UpdateStatusCompleted(transfer.TransactionId)
+			return rnth.transferRepository.UpdateStatusCompleted(transfer.SourceChainId, transfer.TransactionId)
 		},
 	)
 }
@@ -43,6 +43,7 @@ func (fmh Handler) Handle(p interface{}) {
 		fmh.logger.Errorf("Could not cast payload [%s]", p)
 		return
 	}
+	fmh.logger = config.WithContext(fmh.logger, transferMsg.Context())
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
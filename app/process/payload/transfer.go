@@ -16,7 +16,15 @@
 
 package payload
 
-import "time"
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/correlation"
+)
 
 // Transfer serves as a model between Transfer Watcher and Handler
 type Transfer struct {
@@ -29,6 +37,7 @@ type Transfer struct {
 	NativeAsset      string
 	Receiver         string
 	Amount           string
+	Decimals         uint8 // of the target asset, in which the amount is denominated
 	SerialNum        int64
 	Metadata         string
 	IsNft            bool
@@ -75,3 +84,15 @@ func NewNft(
 		Fee:           fee,
 	}
 }
+
+// CorrelationId returns the id, correlating the log lines of the transfer. Derived from the source chain
+// and the transaction id of the transfer, so that it is stable across restarts and validators
+func (t Transfer) CorrelationId() string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d-%s", t.SourceChainId, t.TransactionId)))
+	return hex.EncodeToString(hash[:8])
+}
+
+// Context returns a context, carrying the correlation id of the transfer
+func (t Transfer) Context() context.Context {
+	return correlation.NewContext(context.Background(), t.CorrelationId())
+}
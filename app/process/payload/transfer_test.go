@@ -3,6 +3,7 @@ package payload
 import (
 	"testing"
 
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/correlation"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -75,3 +76,13 @@ func Test_NewNft(t *testing.T) {
 		nftFee)
 	assert.Equal(t, expected, actual)
 }
+
+func Test_CorrelationId(t *testing.T) {
+	transfer := New(txId, sourceChainId, targetChainId, nativeChainId, receiver, sourceAsset, targetAsset, nativeAsset, amount)
+	other := New(txId, targetChainId, sourceChainId, nativeChainId, receiver, sourceAsset, targetAsset, nativeAsset, amount)
+
+	assert.Len(t, transfer.CorrelationId(), 16)
+	assert.Equal(t, transfer.CorrelationId(), New(txId, sourceChainId, targetChainId, nativeChainId, "", "", "", "", "").CorrelationId())
+	assert.NotEqual(t, transfer.CorrelationId(), other.CorrelationId())
+	assert.Equal(t, transfer.CorrelationId(), correlation.FromContext(transfer.Context()))
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"fmt"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultRebuildBatchBlocks = int64(500)
+
+// ChainHistory is the block range of an EVM watcher, whose router events are replayed
+type ChainHistory struct {
+	// The database identifier of the watcher
+	WatcherId string
+	FromBlock int64
+	ToBlock   int64
+}
+
+// RebuildResult summarises the reconstructed history
+type RebuildResult struct {
+	// Transfers, created from router events
+	Created int
+	// Transfers, which were already stored
+	Existing int
+	// Router events, which do not initiate a transfer or were rejected by the watcher
+	Skipped int
+	// Topic messages, replayed through the signature handler
+	Messages int
+}
+
+// Rebuild reconstructs the transfer records of a lost database. The router events are replayed through the
+// dry-run of the EVM watchers, so that transfers are parsed exactly as they are on-chain, and the signature messages
+// of the signature topics are then replayed through the signature handler, which stores them and completes the transfers,
+// which have reached majority. Transfers to Hedera remain Initial, as their status is not recorded in the topic.
+type Rebuild struct {
+	transferRepository repository.Transfer
+	watchersService    service.Watchers
+	mirrorClient       client.MirrorNode
+	topicIDs           []hedera.TopicID
+	messageHandler     server.Handler
+	batchBlocks        int64
+	logger             *log.Entry
+}
+
+func NewRebuild(
+	transferRepository repository.Transfer,
+	watchersService service.Watchers,
+	mirrorClient client.MirrorNode,
+	topicIDs []hedera.TopicID,
+	messageHandler server.Handler,
+	batchBlocks int64) *Rebuild {
+	if batchBlocks <= 0 {
+		batchBlocks = defaultRebuildBatchBlocks
+	}
+
+	return &Rebuild{
+		transferRepository: transferRepository,
+		watchersService:    watchersService,
+		mirrorClient:       mirrorClient,
+		topicIDs:           topicIDs,
+		messageHandler:     messageHandler,
+		batchBlocks:        batchBlocks,
+		logger:             config.GetLoggerFor("Rebuild"),
+	}
+}
+
+// Execute replays the router events of the given chains, followed by the messages of every topic after the given timestamp
+func (r *Rebuild) Execute(chains []ChainHistory, fromTimestamp int64) (*RebuildResult, error) {
+	result := &RebuildResult{}
+
+	for _, chain := range chains {
+		if err := r.replayEvents(chain, result); err != nil {
+			return result, fmt.Errorf("failed to replay events of watcher [%s]: %w", chain.WatcherId, err)
+		}
+	}
+
+	for _, topicID := range r.topicIDs {
+		if err := r.replayMessages(topicID, fromTimestamp, result); err != nil {
+			return result, fmt.Errorf("failed to replay messages of topic [%s]: %w", topicID, err)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Rebuild) replayEvents(chain ChainHistory, result *RebuildResult) error {
+	r.logger.Infof("[%s] - Replaying events from [%d] to [%d].", chain.WatcherId, chain.FromBlock, chain.ToBlock)
+
+	for from := chain.FromBlock; from <= chain.ToBlock; from += r.batchBlocks {
+		to := from + r.batchBlocks - 1
+		if to > chain.ToBlock {
+			to = chain.ToBlock
+		}
+
+		events, err := r.watchersService.Simulate(chain.WatcherId, from, to)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := r.restoreTransfer(event, result); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Rebuild) restoreTransfer(event *watcher.SimResult, result *RebuildResult) error {
+	if event.Decision == watcher.SimDecisionSkip || event.Payload == nil {
+		r.logger.Debugf("[%s] - Skipped [%s] event. Reason: [%s]", event.TransactionId, event.EventType, event.Reason)
+		result.Skipped++
+		return nil
+	}
+
+	existing, err := r.transferRepository.GetByTransactionId(event.Payload.SourceChainId, event.Payload.TransactionId)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		result.Existing++
+		return nil
+	}
+
+	_, err = r.transferRepository.Create(event.Payload)
+	if err != nil {
+		return err
+	}
+	r.logger.Debugf("[%s] - Restored transfer.", event.Payload.TransactionId)
+	result.Created++
+
+	return nil
+}
+
+func (r *Rebuild) replayMessages(topicID hedera.TopicID, fromTimestamp int64, result *RebuildResult) error {
+	r.logger.Infof("Replaying messages of topic [%s] after [%s].", topicID, timestamp.ToHumanReadable(fromTimestamp))
+
+	milestone := fromTimestamp
+	for {
+		messages, err := r.mirrorClient.GetMessagesAfterTimestamp(topicID, milestone, r.mirrorClient.QueryMaxLimit())
+		if err != nil {
+			return err
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		for _, topicMsg := range messages {
+			milestone, err = timestamp.FromString(topicMsg.ConsensusTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg, err := message.FromString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
+			if err != nil {
+				r.logger.Errorf("Could not decode message [%s]. Error: [%s]", topicMsg.Contents, err)
+				continue
+			}
+
+			r.messageHandler.Handle(msg)
+			result.Messages++
+		}
+	}
+}
@@ -0,0 +1,234 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	mirrorNodeMsg "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	messageHandler "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	rebuildTopicID = hedera.TopicID{Topic: 1}
+	watcherId      = "80001-0x0000000000000000000000000000000000000001"
+	lockTransfer   = &payload.Transfer{
+		TransactionId: "0xlock-1",
+		SourceChainId: 80001,
+		TargetChainId: 1,
+		NativeChainId: 80001,
+		SourceAsset:   "0xsource",
+		TargetAsset:   "0xtarget",
+		NativeAsset:   "0xsource",
+		Receiver:      "0xreceiver",
+		Amount:        "100",
+	}
+	burnTransfer = &payload.Transfer{
+		TransactionId: "0xburn-2",
+		SourceChainId: 80001,
+		TargetChainId: constants.HederaNetworkId,
+		NativeChainId: constants.HederaNetworkId,
+		SourceAsset:   "0xwrapped",
+		TargetAsset:   "0.0.2",
+		NativeAsset:   "0.0.2",
+		Receiver:      "0.0.3",
+		Amount:        "50",
+	}
+)
+
+// recordingHandler keeps the handled payloads
+type recordingHandler struct {
+	handled []interface{}
+}
+
+func (h *recordingHandler) Handle(payload interface{}) {
+	h.handled = append(h.handled, payload)
+}
+
+// signatureMessage encodes a fungible signature of the lock transfer, as stored on the topic
+func signatureMessage(t *testing.T, signature, consensusTimestamp string) mirrorNodeMsg.Message {
+	msg := message.NewFungibleSignature(&proto.TopicEthSignatureMessage{
+		SourceChainId: lockTransfer.SourceChainId,
+		TargetChainId: lockTransfer.TargetChainId,
+		TransferID:    lockTransfer.TransactionId,
+		Asset:         lockTransfer.TargetAsset,
+		Recipient:     lockTransfer.Receiver,
+		Amount:        lockTransfer.Amount,
+		Signature:     signature,
+	})
+	bytes, err := msg.ToBytes()
+	assert.Nil(t, err)
+
+	return mirrorNodeMsg.Message{
+		ConsensusTimestamp: consensusTimestamp,
+		Contents:           base64.StdEncoding.EncodeToString(bytes),
+	}
+}
+
+func setupRebuild(handler interface{ Handle(interface{}) }, batchBlocks int64) *Rebuild {
+	mocks.Setup()
+	mocks.MHederaMirrorClient.On("QueryMaxLimit").Return(int64(100))
+	return NewRebuild(mocks.MTransferRepository, mocks.MWatchersService, mocks.MHederaMirrorClient, []hedera.TopicID{rebuildTopicID}, handler, batchBlocks)
+}
+
+func Test_Rebuild_ReconstructsHistory(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	handler := messageHandler.NewHandler([]string{rebuildTopicID.String()}, mocks.MTransferRepository, mocks.MMessageRepository,
+		map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, mocks.MAssetsService, nil, time.Hour, 10)
+	r := NewRebuild(mocks.MTransferRepository, mocks.MWatchersService, mocks.MHederaMirrorClient, []hedera.TopicID{rebuildTopicID}, handler, 10)
+	mocks.MHederaMirrorClient.On("QueryMaxLimit").Return(int64(100))
+
+	// Router events of blocks [100, 119], replayed in two batches
+	mocks.MWatchersService.On("Simulate", watcherId, int64(100), int64(109)).Return([]*watcher.SimResult{
+		{TransactionId: lockTransfer.TransactionId, EventType: "Lock", Decision: watcher.SimDecisionProcess, Payload: lockTransfer},
+		{TransactionId: "0xmint-1", EventType: "Mint", Decision: watcher.SimDecisionSkip, Reason: "event does not initiate a transfer"},
+	}, nil)
+	mocks.MWatchersService.On("Simulate", watcherId, int64(110), int64(119)).Return([]*watcher.SimResult{
+		{TransactionId: burnTransfer.TransactionId, EventType: "Burn", Decision: watcher.SimDecisionReadOnly, Payload: burnTransfer},
+	}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", mock.Anything, mock.Anything).Return((*entity.Transfer)(nil), nil)
+	mocks.MTransferRepository.On("Create", lockTransfer).Return(&entity.Transfer{}, nil)
+	mocks.MTransferRepository.On("Create", burnTransfer).Return(&entity.Transfer{}, nil)
+
+	// Two signatures of the lock transfer, reaching majority
+	first := signatureMessage(t, "signature-1", "1000.000000001")
+	second := signatureMessage(t, "signature-2", "1000.000000002")
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(0), int64(100)).Return([]mirrorNodeMsg.Message{first, second}, nil)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(1000000000002), int64(100)).Return([]mirrorNodeMsg.Message{}, nil)
+	mocks.MMessageService.On("SanityCheckFungibleSignature", mock.Anything).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", lockTransfer.TransactionId, mock.Anything, lockTransfer.SourceChainId, lockTransfer.TargetChainId, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mocks.MBridgeContractService.On("Address").Return(hedera.AccountID{}.ToSolidityAddress())
+	mocks.MMessageRepository.On("Get", lockTransfer.SourceChainId, lockTransfer.TransactionId).Return([]entity.Message{{}}, nil).Once()
+	mocks.MMessageRepository.On("Get", lockTransfer.SourceChainId, lockTransfer.TransactionId).Return([]entity.Message{{}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"0x1", "0x2"})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(false, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(2)).Return(true, nil)
+	mocks.MAssetsService.On("OppositeAsset", lockTransfer.SourceChainId, lockTransfer.TargetChainId, lockTransfer.TargetAsset).Return(lockTransfer.SourceAsset)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", lockTransfer.SourceChainId, lockTransfer.TransactionId).Return(nil)
+
+	result, err := r.Execute([]ChainHistory{{WatcherId: watcherId, FromBlock: 100, ToBlock: 119}}, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &RebuildResult{Created: 2, Skipped: 1, Messages: 2}, result)
+	mocks.MTransferRepository.AssertCalled(t, "Create", lockTransfer)
+	mocks.MTransferRepository.AssertCalled(t, "Create", burnTransfer)
+	mocks.MMessageService.AssertNumberOfCalls(t, "ProcessSignature", 2)
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "UpdateStatusCompleted", 1)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", burnTransfer.SourceChainId, burnTransfer.TransactionId)
+}
+
+func Test_Rebuild_AggregatesSignatureTopics(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	otherTopicID := hedera.TopicID{Topic: 2}
+	handler := messageHandler.NewHandler([]string{rebuildTopicID.String(), otherTopicID.String()}, mocks.MTransferRepository, mocks.MMessageRepository,
+		map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, mocks.MAssetsService, nil, time.Hour, 10)
+	r := NewRebuild(mocks.MTransferRepository, mocks.MWatchersService, mocks.MHederaMirrorClient, []hedera.TopicID{rebuildTopicID, otherTopicID}, handler, 10)
+	mocks.MHederaMirrorClient.On("QueryMaxLimit").Return(int64(100))
+
+	// Two signatures of the lock transfer, sharded across the topics, reaching majority together
+	first := signatureMessage(t, "signature-1", "1000.000000001")
+	second := signatureMessage(t, "signature-2", "1000.000000002")
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(0), int64(100)).Return([]mirrorNodeMsg.Message{first}, nil)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(1000000000001), int64(100)).Return([]mirrorNodeMsg.Message{}, nil)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", otherTopicID, int64(0), int64(100)).Return([]mirrorNodeMsg.Message{second}, nil)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", otherTopicID, int64(1000000000002), int64(100)).Return([]mirrorNodeMsg.Message{}, nil)
+	mocks.MMessageService.On("SanityCheckFungibleSignature", mock.Anything).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", lockTransfer.TransactionId, mock.Anything, lockTransfer.SourceChainId, lockTransfer.TargetChainId, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mocks.MBridgeContractService.On("Address").Return(hedera.AccountID{}.ToSolidityAddress())
+	mocks.MMessageRepository.On("Get", lockTransfer.SourceChainId, lockTransfer.TransactionId).Return([]entity.Message{{}}, nil).Once()
+	mocks.MMessageRepository.On("Get", lockTransfer.SourceChainId, lockTransfer.TransactionId).Return([]entity.Message{{}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"0x1", "0x2"})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(false, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(2)).Return(true, nil)
+	mocks.MAssetsService.On("OppositeAsset", lockTransfer.SourceChainId, lockTransfer.TargetChainId, lockTransfer.TargetAsset).Return(lockTransfer.SourceAsset)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", lockTransfer.SourceChainId, lockTransfer.TransactionId).Return(nil)
+
+	result, err := r.Execute(nil, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &RebuildResult{Messages: 2}, result)
+	mocks.MMessageService.AssertNumberOfCalls(t, "ProcessSignature", 2)
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "UpdateStatusCompleted", 1)
+}
+
+func Test_Rebuild_KeepsExistingTransfers(t *testing.T) {
+	handler := &recordingHandler{}
+	r := setupRebuild(handler, 0)
+	mocks.MWatchersService.On("Simulate", watcherId, int64(1), int64(1)).Return([]*watcher.SimResult{
+		{TransactionId: lockTransfer.TransactionId, Decision: watcher.SimDecisionProcess, Payload: lockTransfer},
+	}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", lockTransfer.SourceChainId, lockTransfer.TransactionId).Return(&entity.Transfer{}, nil)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(5), int64(100)).Return([]mirrorNodeMsg.Message{}, nil)
+
+	result, err := r.Execute([]ChainHistory{{WatcherId: watcherId, FromBlock: 1, ToBlock: 1}}, 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &RebuildResult{Existing: 1}, result)
+	mocks.MTransferRepository.AssertNotCalled(t, "Create", mock.Anything)
+	assert.Empty(t, handler.handled)
+}
+
+func Test_Rebuild_SimulationFails(t *testing.T) {
+	r := setupRebuild(&recordingHandler{}, 0)
+	mocks.MWatchersService.On("Simulate", watcherId, int64(1), int64(500)).Return(nil, errors.New("some-error"))
+
+	_, err := r.Execute([]ChainHistory{{WatcherId: watcherId, FromBlock: 1, ToBlock: 1000}}, 0)
+
+	assert.Error(t, err)
+	mocks.MWatchersService.AssertNumberOfCalls(t, "Simulate", 1)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "GetMessagesAfterTimestamp", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Rebuild_SkipsUndecodableMessages(t *testing.T) {
+	handler := &recordingHandler{}
+	r := setupRebuild(handler, 0)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(0), int64(100)).
+		Return([]mirrorNodeMsg.Message{{ConsensusTimestamp: "1.000000001", Contents: "not-base64"}}, nil)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(1000000001), int64(100)).Return([]mirrorNodeMsg.Message{}, nil)
+
+	result, err := r.Execute(nil, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &RebuildResult{}, result)
+	assert.Empty(t, handler.handled)
+}
+
+func Test_Rebuild_MessagesQueryFails(t *testing.T) {
+	r := setupRebuild(&recordingHandler{}, 0)
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", rebuildTopicID, int64(0), int64(100)).Return([]mirrorNodeMsg.Message{}, errors.New("some-error"))
+
+	_, err := r.Execute(nil, 0)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database_pool
+
+import (
+	"database/sql"
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// The default interval, on which the pool statistics are polled
+const defaultPollingInterval = time.Minute
+
+// Pool is the subset of sql.DB, used to read the connection pool statistics
+type Pool interface {
+	Stats() sql.DBStats
+}
+
+// Watcher periodically publishes the database connection pool statistics as Prometheus gauges
+type Watcher struct {
+	pool              Pool
+	prometheusService service.Prometheus
+	pollingInterval   time.Duration
+	logger            *log.Entry
+}
+
+func NewWatcher(pool Pool, prometheusService service.Prometheus, pollingInterval time.Duration) *Watcher {
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+
+	return &Watcher{
+		pool:              pool,
+		prometheusService: prometheusService,
+		pollingInterval:   pollingInterval,
+		logger:            config.GetLoggerFor("Database Pool Watcher"),
+	}
+}
+
+func (dpw *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			dpw.watchIteration()
+			time.Sleep(dpw.pollingInterval)
+		}
+	}()
+}
+
+func (dpw *Watcher) watchIteration() {
+	stats := dpw.pool.Stats()
+
+	dpw.setGauge(constants.DatabasePoolInUseGaugeName, constants.DatabasePoolInUseGaugeHelp, float64(stats.InUse))
+	dpw.setGauge(constants.DatabasePoolIdleGaugeName, constants.DatabasePoolIdleGaugeHelp, float64(stats.Idle))
+	dpw.setGauge(constants.DatabasePoolWaitCountGaugeName, constants.DatabasePoolWaitCountGaugeHelp, float64(stats.WaitCount))
+}
+
+func (dpw *Watcher) setGauge(name, help string, value float64) {
+	metrics.SetGauge(prometheus.GaugeOpts{
+		Name: name,
+		Help: help,
+	}, value, dpw.prometheusService)
+}
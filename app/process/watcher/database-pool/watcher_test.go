@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database_pool
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	watcher *Watcher
+	pool    = &stubPool{stats: sql.DBStats{InUse: 3, Idle: 2, WaitCount: 7}}
+)
+
+type stubPool struct {
+	stats sql.DBStats
+}
+
+func (s *stubPool) Stats() sql.DBStats {
+	return s.stats
+}
+
+func Test_NewWatcher(t *testing.T) {
+	setup()
+
+	actual := NewWatcher(pool, mocks.MPrometheusService, 0)
+
+	assert.Equal(t, watcher, actual)
+}
+
+func Test_watchIteration(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	expected := map[string]float64{
+		constants.DatabasePoolInUseGaugeName:     3,
+		constants.DatabasePoolIdleGaugeName:      2,
+		constants.DatabasePoolWaitCountGaugeName: 7,
+	}
+	helps := map[string]string{
+		constants.DatabasePoolInUseGaugeName:     constants.DatabasePoolInUseGaugeHelp,
+		constants.DatabasePoolIdleGaugeName:      constants.DatabasePoolIdleGaugeHelp,
+		constants.DatabasePoolWaitCountGaugeName: constants.DatabasePoolWaitCountGaugeHelp,
+	}
+
+	gauges := map[string]prometheus.Gauge{}
+	for name := range expected {
+		opts := prometheus.GaugeOpts{Name: name, Help: helps[name]}
+		gauges[name] = prometheus.NewGauge(opts)
+		mocks.MPrometheusService.On("CreateGaugeIfNotExists", opts).Return(gauges[name])
+	}
+
+	watcher.watchIteration()
+
+	for name, value := range expected {
+		assert.Equal(t, value, testutil.ToFloat64(gauges[name]))
+	}
+}
+
+func setup() {
+	mocks.Setup()
+
+	watcher = &Watcher{
+		pool:              pool,
+		prometheusService: mocks.MPrometheusService,
+		pollingInterval:   time.Minute,
+		logger:            config.GetLoggerFor("Database Pool Watcher"),
+	}
+}
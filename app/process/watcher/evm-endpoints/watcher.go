@@ -0,0 +1,124 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm_endpoints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// The default interval, on which the endpoint health is polled
+const defaultPollingInterval = 30 * time.Second
+
+// Watcher periodically publishes the health of the RPC endpoints of the EVM clients as Prometheus gauges
+type Watcher struct {
+	clients           map[uint64]client.EvmEndpoints
+	prometheusService service.Prometheus
+	pollingInterval   time.Duration
+	// The endpoints at the previous poll, keyed by chain id and host, used to average the queue wait between polls
+	previous map[string]client.EvmEndpoint
+	logger   *log.Entry
+}
+
+func NewWatcher(clients map[uint64]client.EvmEndpoints, prometheusService service.Prometheus, pollingInterval time.Duration) *Watcher {
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+
+	return &Watcher{
+		clients:           clients,
+		prometheusService: prometheusService,
+		pollingInterval:   pollingInterval,
+		previous:          make(map[string]client.EvmEndpoint),
+		logger:            config.GetLoggerFor("EVM Endpoints Watcher"),
+	}
+}
+
+func (eew *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			eew.watchIteration()
+			time.Sleep(eew.pollingInterval)
+		}
+	}()
+}
+
+func (eew *Watcher) watchIteration() {
+	for chainId, evmClient := range eew.clients {
+		for _, endpoint := range evmClient.Endpoints() {
+			healthy := float64(0)
+			if endpoint.Failures == 0 {
+				healthy = 1
+			}
+			metrics.SetGauge(prometheus.GaugeOpts{
+				Name: fmt.Sprintf("%s%d_%s", constants.EvmEndpointHealthyGaugeNamePrefix, chainId, hostToMetricName(endpoint.Host)),
+				Help: constants.EvmEndpointHealthyGaugeHelp,
+				ConstLabels: prometheus.Labels{
+					constants.NetworkMetricLabelKey:  strconv.FormatUint(chainId, 10),
+					constants.EndpointMetricLabelKey: endpoint.Host,
+				},
+			}, healthy, eew.prometheusService)
+
+			if endpoint.LimitedCalls > 0 {
+				eew.setQueueWait(chainId, endpoint)
+			}
+		}
+	}
+}
+
+// setQueueWait publishes the average queue wait of the requests to the endpoint, which went through its
+// concurrency limit since the previous poll
+func (eew *Watcher) setQueueWait(chainId uint64, endpoint client.EvmEndpoint) {
+	key := fmt.Sprintf("%d_%s", chainId, endpoint.Host)
+	previous := eew.previous[key]
+	eew.previous[key] = endpoint
+
+	average := float64(0)
+	if calls := endpoint.LimitedCalls - previous.LimitedCalls; calls > 0 {
+		average = (endpoint.QueueWait - previous.QueueWait).Seconds() / float64(calls)
+	}
+	metrics.SetGauge(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s%d_%s", constants.EvmEndpointQueueWaitGaugeNamePrefix, chainId, hostToMetricName(endpoint.Host)),
+		Help: constants.EvmEndpointQueueWaitGaugeHelp,
+		ConstLabels: prometheus.Labels{
+			constants.NetworkMetricLabelKey:  strconv.FormatUint(chainId, 10),
+			constants.EndpointMetricLabelKey: endpoint.Host,
+		},
+	}, average, eew.prometheusService)
+}
+
+// hostToMetricName replaces the symbols of the host, which are not allowed in metric names
+func hostToMetricName(host string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, host)
+}
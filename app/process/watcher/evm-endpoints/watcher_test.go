@@ -0,0 +1,129 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm_endpoints
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	watcher *Watcher
+	pool    = &stubPool{endpoints: []client.EvmEndpoint{
+		{Host: "eth-mainnet.g.alchemy.com", Failures: 0},
+		{Host: "localhost:8545", Failures: 3},
+	}}
+)
+
+type stubPool struct {
+	endpoints []client.EvmEndpoint
+}
+
+func (s *stubPool) Endpoints() []client.EvmEndpoint {
+	return s.endpoints
+}
+
+func setup() {
+	mocks.Setup()
+	watcher = &Watcher{
+		clients:           map[uint64]client.EvmEndpoints{1: pool},
+		prometheusService: mocks.MPrometheusService,
+		pollingInterval:   defaultPollingInterval,
+		previous:          make(map[string]client.EvmEndpoint),
+		logger:            config.GetLoggerFor("EVM Endpoints Watcher"),
+	}
+}
+
+func Test_NewWatcher(t *testing.T) {
+	setup()
+
+	actual := NewWatcher(map[uint64]client.EvmEndpoints{1: pool}, mocks.MPrometheusService, 0)
+
+	assert.Equal(t, watcher, actual)
+}
+
+func Test_watchIteration(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	expected := map[string]float64{
+		constants.EvmEndpointHealthyGaugeNamePrefix + "1_eth_mainnet_g_alchemy_com": 1,
+		constants.EvmEndpointHealthyGaugeNamePrefix + "1_localhost_8545":            0,
+	}
+	gauges := make(map[string]prometheus.Gauge)
+	for name := range expected {
+		name := name
+		gauges[name] = prometheus.NewGauge(prometheus.GaugeOpts{Name: name})
+		mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+			return opts.Name == name && opts.ConstLabels[constants.NetworkMetricLabelKey] == "1"
+		})).Return(gauges[name])
+	}
+	gauges[constants.EvmEndpointHealthyGaugeNamePrefix+"1_localhost_8545"].Set(1)
+
+	watcher.watchIteration()
+
+	for name, value := range expected {
+		assert.Equal(t, value, testutil.ToFloat64(gauges[name]), name)
+	}
+}
+
+func Test_watchIteration_QueueWait(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	limited := &stubPool{endpoints: []client.EvmEndpoint{{Host: "localhost:8545", LimitedCalls: 4, QueueWait: 2 * time.Second}}}
+	watcher.clients = map[uint64]client.EvmEndpoints{1: limited}
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+		return opts.Name == constants.EvmEndpointHealthyGaugeNamePrefix+"1_localhost_8545"
+	})).Return(prometheus.NewGauge(prometheus.GaugeOpts{Name: "healthy"}))
+	queueWait := prometheus.NewGauge(prometheus.GaugeOpts{Name: "queue_wait"})
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+		return opts.Name == constants.EvmEndpointQueueWaitGaugeNamePrefix+"1_localhost_8545" && opts.ConstLabels[constants.EndpointMetricLabelKey] == "localhost:8545"
+	})).Return(queueWait)
+
+	watcher.watchIteration()
+	assert.Equal(t, 0.5, testutil.ToFloat64(queueWait))
+
+	// Averaged over the requests since the previous poll
+	limited.endpoints[0].LimitedCalls = 6
+	limited.endpoints[0].QueueWait = 5 * time.Second
+	watcher.watchIteration()
+	assert.Equal(t, 1.5, testutil.ToFloat64(queueWait))
+
+	watcher.watchIteration()
+	assert.Equal(t, float64(0), testutil.ToFloat64(queueWait))
+}
+
+func Test_watchIteration_NoLimitedCalls(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.Anything).Return(prometheus.NewGauge(prometheus.GaugeOpts{Name: "healthy"}))
+
+	watcher.watchIteration()
+
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+		return strings.HasPrefix(opts.Name, constants.EvmEndpointQueueWaitGaugeNamePrefix)
+	}))
+}
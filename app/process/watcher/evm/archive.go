@@ -0,0 +1,60 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// filterLogs queries the logs of the given range from the primary endpoints. Since some providers prune the logs
+// of old blocks and return no logs for them, old ranges without logs are re-queried from the archive endpoint
+func (ew Watcher) filterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := ew.evmClient.RetryFilterLogs(query)
+	if err != nil || len(logs) > 0 || !ew.isArchivedRange(query.ToBlock.Int64()) {
+		return logs, err
+	}
+
+	archived, err := ew.archive.FilterLogs(context.Background(), query)
+	if err != nil {
+		ew.logger.Errorf("Failed to filter logs from [%s] to [%s] at the archive endpoint. Error: [%s]", query.FromBlock, query.ToBlock, err)
+		return nil, err
+	}
+	if len(archived) > 0 {
+		ew.logger.Infof("Recovered [%d] logs from [%s] to [%s] from the archive endpoint.", len(archived), query.FromBlock, query.ToBlock)
+	}
+
+	return archived, nil
+}
+
+// isArchivedRange checks whether the range, ending at the given block, is older than the archive age,
+// so that its logs might have been pruned by the primary endpoints
+func (ew Watcher) isArchivedRange(endBlock int64) bool {
+	if ew.archive == nil {
+		return false
+	}
+
+	latest, err := ew.evmClient.RetryBlockNumber()
+	if err != nil {
+		ew.logger.Warnf("Failed to retrieve the latest block. Treating the range ending at [%d] as archived. Error: [%s]", endBlock, err)
+		return true
+	}
+
+	return int64(latest)-endBlock > ew.archiveAge
+}
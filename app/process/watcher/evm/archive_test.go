@@ -0,0 +1,118 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var archivedLogs = []types.Log{{BlockNumber: 100, Index: 1}}
+
+// setupArchive enables the archive fallback and returns a query of the range, ending at the given block
+func setupArchive(age, endBlock int64) ethereum.FilterQuery {
+	setup()
+	w.archive = mocks.MEVMCoreClient
+	w.archiveAge = age
+
+	return ethereum.FilterQuery{FromBlock: big.NewInt(endBlock - 10), ToBlock: big.NewInt(endBlock)}
+}
+
+func Test_FilterLogs_OldEmptyRange_FallsBack(t *testing.T) {
+	query := setupArchive(500, 100)
+	mocks.MEVMClient.On("RetryFilterLogs", query).Return([]types.Log{}, nil)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(1000), nil)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, query).Return(archivedLogs, nil)
+
+	logs, err := w.filterLogs(query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, archivedLogs, logs)
+}
+
+func Test_FilterLogs_RecentEmptyRange(t *testing.T) {
+	query := setupArchive(500, 600)
+	mocks.MEVMClient.On("RetryFilterLogs", query).Return([]types.Log{}, nil)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(1000), nil)
+
+	logs, err := w.filterLogs(query)
+
+	assert.Nil(t, err)
+	assert.Empty(t, logs)
+	mocks.MEVMCoreClient.AssertNotCalled(t, "FilterLogs", mock.Anything, mock.Anything)
+}
+
+func Test_FilterLogs_PrimaryLogs(t *testing.T) {
+	query := setupArchive(500, 100)
+	primaryLogs := []types.Log{{BlockNumber: 95}}
+	mocks.MEVMClient.On("RetryFilterLogs", query).Return(primaryLogs, nil)
+
+	logs, err := w.filterLogs(query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primaryLogs, logs)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryBlockNumber")
+	mocks.MEVMCoreClient.AssertNotCalled(t, "FilterLogs", mock.Anything, mock.Anything)
+}
+
+func Test_FilterLogs_PrimaryError(t *testing.T) {
+	query := setupArchive(500, 100)
+	mocks.MEVMClient.On("RetryFilterLogs", query).Return([]types.Log{}, errors.New("some-error"))
+
+	_, err := w.filterLogs(query)
+
+	assert.Error(t, err)
+	mocks.MEVMCoreClient.AssertNotCalled(t, "FilterLogs", mock.Anything, mock.Anything)
+}
+
+func Test_FilterLogs_ArchiveError(t *testing.T) {
+	query := setupArchive(500, 100)
+	mocks.MEVMClient.On("RetryFilterLogs", query).Return([]types.Log{}, nil)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(1000), nil)
+	mocks.MEVMCoreClient.On("FilterLogs", mock.Anything, query).Return(nil, errors.New("some-error"))
+
+	logs, err := w.filterLogs(query)
+
+	assert.Error(t, err)
+	assert.Nil(t, logs)
+}
+
+func Test_FilterLogs_Disabled(t *testing.T) {
+	query := setupArchive(500, 100)
+	w.archive = nil
+	mocks.MEVMClient.On("RetryFilterLogs", query).Return([]types.Log{}, nil)
+
+	logs, err := w.filterLogs(query)
+
+	assert.Nil(t, err)
+	assert.Empty(t, logs)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryBlockNumber")
+}
+
+func Test_IsArchivedRange_LatestBlockError(t *testing.T) {
+	setupArchive(500, 100)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(0), errors.New("some-error"))
+
+	assert.True(t, w.isArchivedRange(900))
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// The retries of a failed confirmations callback, if none are configured
+	defaultConfirmationsCallbackRetries = 3
+	// The wait before the first retry of a failed confirmations callback, doubled with every next retry
+	confirmationsCallbackBackoff = time.Second
+	// The timeout of a single confirmations callback request
+	confirmationsCallbackTimeout = 10 * time.Second
+	// The amount of callbacks, which can be pending delivery, before new ones are dropped
+	confirmationsCallbackBuffer = 256
+)
+
+// ConfirmationsReached is the payload, posted to the confirmations callback once a transfer
+// has reached its required block confirmations and has been dispatched
+type ConfirmationsReached struct {
+	TransactionId string `json:"transactionId"`
+	SourceChainId uint64 `json:"sourceChainId"`
+	TargetChainId uint64 `json:"targetChainId"`
+	SourceAsset   string `json:"sourceAsset"`
+	TargetAsset   string `json:"targetAsset"`
+	Receiver      string `json:"receiver"`
+	Amount        string `json:"amount"`
+	TxHash        string `json:"txHash"`
+	BlockNumber   uint64 `json:"blockNumber"`
+	BlockHash     string `json:"blockHash"`
+	Confirmations uint64 `json:"confirmations"`
+}
+
+// confirmationsCallback posts ConfirmationsReached payloads to an integration endpoint. Payloads are delivered
+// asynchronously, so that a slow or failing endpoint never holds the watcher
+type confirmationsCallback struct {
+	url        string
+	retries    int
+	httpClient client.HttpClient
+	pending    chan *ConfirmationsReached
+	sleep      func(time.Duration)
+	logger     *log.Entry
+}
+
+func newConfirmationsCallback(url string, retries int, httpClient client.HttpClient, logger *log.Entry) *confirmationsCallback {
+	if retries == 0 {
+		retries = defaultConfirmationsCallbackRetries
+	}
+
+	callback := &confirmationsCallback{
+		url:        url,
+		retries:    retries,
+		httpClient: httpClient,
+		pending:    make(chan *ConfirmationsReached, confirmationsCallbackBuffer),
+		sleep:      time.Sleep,
+		logger:     logger,
+	}
+	go callback.deliver()
+	return callback
+}
+
+// notify queues the given callback for delivery without blocking. The callback is dropped if the buffer is full
+func (cc *confirmationsCallback) notify(reached *ConfirmationsReached) {
+	select {
+	case cc.pending <- reached:
+	default:
+		cc.logger.Warnf("[%s] - Confirmations callback buffer is full. Dropping callback.", reached.TransactionId)
+	}
+}
+
+func (cc *confirmationsCallback) deliver() {
+	for reached := range cc.pending {
+		cc.post(reached)
+	}
+}
+
+// post delivers the given callback, retrying with exponential backoff on failure
+func (cc *confirmationsCallback) post(reached *ConfirmationsReached) {
+	body, err := json.Marshal(reached)
+	if err != nil {
+		cc.logger.Errorf("[%s] - Failed to encode confirmations callback. Error: [%s]", reached.TransactionId, err)
+		return
+	}
+
+	backoff := confirmationsCallbackBackoff
+	for attempt := 0; ; attempt++ {
+		err = cc.send(body)
+		if err == nil {
+			return
+		}
+		if attempt == cc.retries {
+			break
+		}
+		cc.logger.Warnf("[%s] - Confirmations callback failed, attempt [%d]. Error: [%s]", reached.TransactionId, attempt+1, err)
+		cc.sleep(backoff)
+		backoff *= 2
+	}
+
+	cc.logger.Errorf("[%s] - Confirmations callback failed after [%d] retries. Error: [%s]", reached.TransactionId, cc.retries, err)
+}
+
+func (cc *confirmationsCallback) send(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, cc.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := cc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response with status code [%d]", res.StatusCode)
+	}
+	return nil
+}
+
+// confirmationsReached invokes the confirmations callback, if configured, for a dispatched transfer, which has passed the
+// confirmation gate with the given required confirmations. Transfers without confirmation tiers, e.g. read-only ones,
+// pass it with the block confirmations of the chain
+func (ew *Watcher) confirmationsReached(raw types.Log, confirmations uint64, tr *payload.Transfer) {
+	if ew.confirmationsCallback == nil {
+		return
+	}
+
+	if blockConfirmations := ew.evmClient.BlockConfirmations(); blockConfirmations > confirmations {
+		confirmations = blockConfirmations
+	}
+	ew.confirmationsCallback.notify(&ConfirmationsReached{
+		TransactionId: tr.TransactionId,
+		SourceChainId: tr.SourceChainId,
+		TargetChainId: tr.TargetChainId,
+		SourceAsset:   tr.SourceAsset,
+		TargetAsset:   tr.TargetAsset,
+		Receiver:      tr.Receiver,
+		Amount:        tr.Amount,
+		TxHash:        raw.TxHash.String(),
+		BlockNumber:   raw.BlockNumber,
+		BlockHash:     raw.BlockHash.String(),
+		Confirmations: confirmations,
+	})
+}
@@ -0,0 +1,261 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// callbackServer serves the confirmations callback, failing the given amount of requests first,
+// and forwards the successfully delivered callbacks
+func callbackServer(t *testing.T, failures int32) (*httptest.Server, chan ConfirmationsReached, *int32) {
+	delivered := make(chan ConfirmationsReached, 1)
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var reached ConfirmationsReached
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&reached))
+		delivered <- reached
+	}))
+	t.Cleanup(server.Close)
+	return server, delivered, &requests
+}
+
+func newTestConfirmationsCallback(server *httptest.Server, retries int) *confirmationsCallback {
+	callback := &confirmationsCallback{
+		url:        server.URL,
+		retries:    retries,
+		httpClient: server.Client(),
+		pending:    make(chan *ConfirmationsReached, 1),
+		sleep:      func(time.Duration) {},
+		logger:     config.GetLoggerFor("Confirmations Callback"),
+	}
+	go callback.deliver()
+	return callback
+}
+
+func awaitCallback(t *testing.T, delivered chan ConfirmationsReached) ConfirmationsReached {
+	select {
+	case reached := <-delivered:
+		return reached
+	case <-time.After(time.Second):
+		t.Fatal("confirmations callback was not delivered")
+		return ConfirmationsReached{}
+	}
+}
+
+func Test_ConfirmationsReached_FiresAtTierDepth(t *testing.T) {
+	p := newPipeline(t)
+	server, delivered, _ := callbackServer(t, 0)
+	w.confirmationsCallback = newTestConfirmationsCallback(server, 0)
+	w.confirmationTiers = map[uint64]uint64{1: 30}
+	lock := pipelineLock(10, 10000)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(20), nil).Once()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(40), nil).Once()
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(&types.Receipt{BlockHash: lock.Raw.BlockHash}, nil)
+
+	w.handleLockLog(lock, p.queue)
+
+	assert.Empty(t, delivered, "callback fired before the required depth")
+	assert.Empty(t, p.queue.messages)
+	assert.Equal(t, []types.Log{lock.Raw}, w.deferred.pending())
+
+	w.deferred.remove(lock.Raw)
+	w.handleLockLog(lock, p.queue)
+
+	reached := awaitCallback(t, delivered)
+	assert.Equal(t, uint64(10), reached.BlockNumber)
+	assert.Equal(t, uint64(30), reached.Confirmations)
+	assert.Equal(t, lock.Raw.TxHash.String(), reached.TxHash)
+	assert.Equal(t, sourceChainId, reached.SourceChainId)
+	assert.Equal(t, pipelineTargetChainId, reached.TargetChainId)
+	assert.Equal(t, "10000", reached.Amount)
+	assert.Len(t, p.queue.messages, 1)
+	mocks.MEVMClient.AssertNumberOfCalls(t, "RetryBlockNumber", 2)
+}
+
+func Test_ConfirmationsReached_DefaultDepth(t *testing.T) {
+	p := newPipeline(t)
+	server, delivered, _ := callbackServer(t, 0)
+	w.confirmationsCallback = newTestConfirmationsCallback(server, 0)
+	lock := pipelineLock(10, 10000)
+
+	w.handleLockLog(lock, p.queue)
+
+	reached := awaitCallback(t, delivered)
+	assert.Equal(t, uint64(10), reached.BlockNumber)
+	assert.Equal(t, uint64(5), reached.Confirmations)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryBlockNumber")
+}
+
+func Test_ConfirmationsReached_NotFiredWhenMovedFromBlock(t *testing.T) {
+	p := newPipeline(t)
+	server, delivered, requests := callbackServer(t, 0)
+	w.confirmationsCallback = newTestConfirmationsCallback(server, 0)
+	w.confirmationTiers = map[uint64]uint64{1: 30}
+	w.sleep = func(time.Duration) {}
+	lock := pipelineLock(10, 10000)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(40), nil)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(&types.Receipt{BlockHash: common.HexToHash("0x3")}, nil)
+
+	w.handleLockLog(lock, p.queue)
+
+	assert.Empty(t, p.queue.messages)
+	assert.Empty(t, delivered)
+	assert.Equal(t, int32(0), atomic.LoadInt32(requests))
+}
+
+// queuedConfirmationsCallback creates a callback, which queues the payloads without delivering them
+func queuedConfirmationsCallback() *confirmationsCallback {
+	return &confirmationsCallback{
+		pending: make(chan *ConfirmationsReached, 10),
+		logger:  config.GetLoggerFor("Confirmations Callback"),
+	}
+}
+
+func Test_ConfirmationsReached_OncePerTransfer(t *testing.T) {
+	p := newPipeline(t)
+	callback := queuedConfirmationsCallback()
+	w.confirmationsCallback = callback
+	lock := pipelineLock(10, 10000)
+
+	w.handleLockLog(lock, p.queue)
+	w.handleLockLog(lock, p.queue)
+
+	assert.Len(t, p.queue.messages, 1)
+	assert.Len(t, callback.pending, 1)
+}
+
+func Test_ConfirmationsReached_NotFiredWhenCrossVerificationFails(t *testing.T) {
+	p := newPipeline(t)
+	callback := queuedConfirmationsCallback()
+	w.confirmationsCallback = callback
+	w.verifier = mocks.MEVMCoreClient
+	w.crossVerificationThreshold = 1
+	w.sleep = func(time.Duration) {}
+	lock := pipelineLock(10, 10000)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(nil, errors.New("some-error"))
+
+	w.handleLockLog(lock, p.queue)
+
+	assert.Empty(t, p.queue.messages)
+	assert.Empty(t, callback.pending)
+}
+
+func Test_ConfirmationsReached_ReadOnly(t *testing.T) {
+	p := newPipeline(t)
+	callback := queuedConfirmationsCallback()
+	w.confirmationsCallback = callback
+	w.validator = false
+	lock := pipelineLock(10, 10000)
+
+	w.handleLockLog(lock, p.queue)
+
+	assert.Len(t, p.queue.messages, 1)
+	assert.Len(t, callback.pending, 1)
+	reached := <-callback.pending
+	assert.Equal(t, lock.Raw.TxHash.String(), reached.TxHash)
+	assert.Equal(t, uint64(5), reached.Confirmations)
+}
+
+func Test_ConfirmationsReached_BurnERC721(t *testing.T) {
+	setup()
+	callback := queuedConfirmationsCallback()
+	w.confirmationsCallback = callback
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("GetBlockTimestamp", mock.Anything).Return(uint64(time.Now().Unix()))
+	mocks.MEVMClient.On("RetryTransactionByHash", mock.Anything).Return(signedTx(t), nil)
+	mocks.MAssetsService.On("WrappedToNative", tokenAddressString, sourceChainId).Return(&asset.NativeAsset{ChainId: constants.HederaNetworkId, Asset: "0.0.2"})
+	mocks.MQueue.On("Push", mock.Anything).Return()
+	burn := &router.RouterBurnERC721{
+		TargetChain:  new(big.Int).SetUint64(constants.HederaNetworkId),
+		WrappedToken: tokenAddress,
+		TokenId:      big.NewInt(1),
+		Receiver:     hederaAcc.ToBytes(),
+		Raw:          types.Log{TxHash: common.HexToHash("0x8"), BlockNumber: 10},
+	}
+
+	w.handleBurnERC721(burn, mocks.MQueue)
+	w.handleBurnERC721(burn, mocks.MQueue)
+
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	assert.Len(t, callback.pending, 1)
+	reached := <-callback.pending
+	assert.Equal(t, constants.HederaNetworkId, reached.TargetChainId)
+	assert.Equal(t, uint64(5), reached.Confirmations)
+}
+
+func Test_ConfirmationsCallback_RetriesOnFailure(t *testing.T) {
+	server, delivered, requests := callbackServer(t, 2)
+	callback := newTestConfirmationsCallback(server, 2)
+	var backoffs []time.Duration
+	callback.sleep = func(d time.Duration) { backoffs = append(backoffs, d) }
+
+	callback.post(&ConfirmationsReached{TransactionId: "0x1-0", Confirmations: 30})
+
+	reached := awaitCallback(t, delivered)
+	assert.Equal(t, "0x1-0", reached.TransactionId)
+	assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+	assert.Equal(t, []time.Duration{confirmationsCallbackBackoff, 2 * confirmationsCallbackBackoff}, backoffs)
+}
+
+func Test_ConfirmationsCallback_GivesUpAfterRetries(t *testing.T) {
+	server, delivered, requests := callbackServer(t, 3)
+	callback := newTestConfirmationsCallback(server, 2)
+
+	callback.post(&ConfirmationsReached{TransactionId: "0x1-0"})
+
+	assert.Empty(t, delivered)
+	assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+}
+
+func Test_ConfirmationsCallback_NotifyDoesNotBlock(t *testing.T) {
+	callback := &confirmationsCallback{
+		pending: make(chan *ConfirmationsReached, 1),
+		logger:  config.GetLoggerFor("Confirmations Callback"),
+	}
+
+	callback.notify(&ConfirmationsReached{TransactionId: "0x1-0"})
+	callback.notify(&ConfirmationsReached{TransactionId: "0x2-0"})
+
+	assert.Len(t, callback.pending, 1)
+	assert.Equal(t, "0x1-0", (<-callback.pending).TransactionId)
+}
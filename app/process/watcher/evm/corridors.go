@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gookit/event"
+	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+)
+
+// corridors tracks the disabled corridors between a source and a target network.
+// Events of disabled corridors are not auto-processed, but routed to the read-only path.
+// The corridors are updated with every bridge config update.
+type corridors struct {
+	mutex    sync.RWMutex
+	disabled map[uint64]map[uint64]bool
+}
+
+func newCorridors(disabled map[uint64]map[uint64]bool) *corridors {
+	return &corridors{disabled: disabled}
+}
+
+// enabled checks whether transfers from the source to the target network are enabled
+func (c *corridors) enabled(sourceChainId, targetChainId uint64) bool {
+	if c == nil {
+		return true
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return !c.disabled[sourceChainId][targetChainId]
+}
+
+func (c *corridors) update(disabled map[uint64]map[uint64]bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.disabled = disabled
+}
+
+func (c *corridors) bridgeCfgUpdateEventHandler(e event.Event) error {
+	params, ok := e.Get(constants.BridgeConfigUpdateEventParamsKey).(*bridge_config_event.Params)
+	if !ok {
+		return fmt.Errorf("failed to cast params from event [%s]", constants.EventBridgeConfigUpdate)
+	}
+
+	c.update(params.Bridge.DisabledCorridors)
+	return nil
+}
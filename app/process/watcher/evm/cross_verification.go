@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+)
+
+// The attempts to fetch the receipt of a transfer from the verification endpoint, before the transfer is deferred
+const crossVerificationAttempts = 3
+
+// crossVerify re-fetches the log of a transfer, whose amount reaches the cross-verification threshold, from the
+// verification endpoint and confirms that it matches the log, returned by the primary endpoints.
+// Transfers, which cannot be verified, are deferred and verified again on the following polls, holding the checkpoint
+// until they are. Returns false if the transfer has been deferred.
+func (ew *Watcher) crossVerify(raw types.Log, amount, minAmount *big.Int) bool {
+	if ew.verifier == nil || ew.crossVerificationThreshold == 0 || minAmount == nil || minAmount.Sign() <= 0 {
+		return true
+	}
+	threshold := new(big.Int).Mul(minAmount, new(big.Int).SetUint64(ew.crossVerificationThreshold))
+	if amount.Cmp(threshold) < 0 {
+		return true
+	}
+
+	var receipt *types.Receipt
+	var err error
+	for attempt := 1; attempt <= crossVerificationAttempts; attempt++ {
+		receipt, err = ew.verifier.TransactionReceipt(context.Background(), raw.TxHash)
+		if err == nil {
+			break
+		}
+		ew.logger.Warnf("[%s] - Failed to fetch receipt from the verification endpoint, attempt [%d]. Error: [%s]", raw.TxHash, attempt, err)
+		if attempt < crossVerificationAttempts {
+			ew.sleep(ew.sleepDuration)
+		}
+	}
+	if err != nil {
+		ew.logger.Errorf("[%s] - Could not cross-verify log [%d] of high-value transfer. Deferring it.", raw.TxHash, raw.Index)
+		metrics.IncrementCrossVerificationFailures(ew.dbIdentifier, ew.prometheusService)
+		ew.deferred.add(raw)
+		return false
+	}
+
+	if !receiptContainsLog(receipt, raw) {
+		ew.logger.Errorf("[%s] - Log [%d] of high-value transfer does not match the verification endpoint. The primary endpoint might be compromised. Deferring it.", raw.TxHash, raw.Index)
+		metrics.IncrementCrossVerificationFailures(ew.dbIdentifier, ew.prometheusService)
+		ew.deferred.add(raw)
+		return false
+	}
+
+	return true
+}
+
+// receiptContainsLog returns whether the given successful receipt contains a log, equal to the given one
+func receiptContainsLog(receipt *types.Receipt, raw types.Log) bool {
+	if receipt.Status != types.ReceiptStatusSuccessful || receipt.BlockHash != raw.BlockHash {
+		return false
+	}
+
+	for _, l := range receipt.Logs {
+		if l.Index != raw.Index {
+			continue
+		}
+		if l.Address != raw.Address || !bytes.Equal(l.Data, raw.Data) || len(l.Topics) != len(raw.Topics) {
+			return false
+		}
+		for i := range l.Topics {
+			if l.Topics[i] != raw.Topics[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// deferredEvents keeps the raw logs of the events, which are not ready to be dispatched yet, so that they are handled again
+// on the following polls, without blocking the watcher. The checkpoint is held at the block of the earliest deferred event,
+// so that it is scanned again after a restart, while the watcher keeps scanning the blocks after it.
+type deferredEvents struct {
+	mutex sync.Mutex
+	logs  map[string]types.Log
+	// The next block to scan, ahead of the checkpoint while events are deferred
+	next int64
+}
+
+func newDeferredEvents() *deferredEvents {
+	return &deferredEvents{
+		logs: make(map[string]types.Log),
+	}
+}
+
+func deferredKey(raw types.Log) string {
+	return fmt.Sprintf("%s-%d", raw.TxHash, raw.Index)
+}
+
+// add defers the given event until a later poll
+func (d *deferredEvents) add(raw types.Log) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.logs[deferredKey(raw)] = raw
+}
+
+// remove stops deferring the given event
+func (d *deferredEvents) remove(raw types.Log) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.logs, deferredKey(raw))
+}
+
+// pending returns the deferred events in chain order
+func (d *deferredEvents) pending() []types.Log {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	logs := make([]types.Log, 0, len(d.logs))
+	for _, raw := range d.logs {
+		logs = append(logs, raw)
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+	return logs
+}
+
+func (d *deferredEvents) size() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return len(d.logs)
+}
+
+// hold returns the given checkpoint, held at the block of the earliest deferred event
+func (d *deferredEvents) hold(checkpoint int64) int64 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for _, raw := range d.logs {
+		if int64(raw.BlockNumber) < checkpoint {
+			checkpoint = int64(raw.BlockNumber)
+		}
+	}
+	return checkpoint
+}
+
+// scanned records the block after the last scanned one
+func (d *deferredEvents) scanned(next int64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.next = next
+}
+
+// scanFrom returns the block, from which the scan continues. While events are deferred,
+// it is ahead of the checkpoint, which is held at the earliest deferred event
+func (d *deferredEvents) scanFrom(checkpoint int64) int64 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if len(d.logs) > 0 && d.next > checkpoint {
+		return d.next
+	}
+	return checkpoint
+}
+
+// reset drops the deferred events and the scanned blocks, so that the scan continues from the checkpoint
+func (d *deferredEvents) reset() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.logs = make(map[string]types.Log)
+	d.next = 0
+}
+
+// rewind drops the deferred events in the given block or after it, as they are scanned again
+func (d *deferredEvents) rewind(fromBlock int64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for key, raw := range d.logs {
+		if int64(raw.BlockNumber) >= fromBlock {
+			delete(d.logs, key)
+		}
+	}
+	if d.next > fromBlock {
+		d.next = fromBlock
+	}
+}
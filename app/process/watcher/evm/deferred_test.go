@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DeferredEvents(t *testing.T) {
+	d := newDeferredEvents()
+	first := types.Log{BlockNumber: 12, TxHash: common.HexToHash("0x1")}
+	second := types.Log{BlockNumber: 15, TxHash: common.HexToHash("0x2")}
+
+	assert.Equal(t, int64(20), d.hold(20))
+	assert.Equal(t, int64(20), d.scanFrom(20))
+
+	d.add(second)
+	d.add(first)
+	d.add(first)
+	d.scanned(21)
+
+	assert.Equal(t, []types.Log{first, second}, d.pending())
+	assert.Equal(t, int64(12), d.hold(21))
+	assert.Equal(t, int64(21), d.scanFrom(12))
+
+	d.remove(first)
+	assert.Equal(t, int64(15), d.hold(21))
+}
+
+func Test_DeferredEvents_Rewind(t *testing.T) {
+	d := newDeferredEvents()
+	first := types.Log{BlockNumber: 12, TxHash: common.HexToHash("0x1")}
+	second := types.Log{BlockNumber: 15, TxHash: common.HexToHash("0x2")}
+	d.add(first)
+	d.add(second)
+	d.scanned(21)
+
+	d.rewind(14)
+
+	assert.Equal(t, []types.Log{first}, d.pending())
+	assert.Equal(t, int64(14), d.scanFrom(12))
+}
+
+func Test_DeferredEvents_Reset(t *testing.T) {
+	d := newDeferredEvents()
+	d.add(types.Log{BlockNumber: 12, TxHash: common.HexToHash("0x1")})
+	d.scanned(21)
+
+	d.reset()
+
+	assert.Empty(t, d.pending())
+	// A manual checkpoint below the scanned blocks is no longer overtaken by them
+	assert.Equal(t, int64(5), d.scanFrom(5))
+	assert.Equal(t, int64(5), d.hold(5))
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+)
+
+// dispatchedTransfer is a transfer pushed for processing together with the block of its event
+type dispatchedTransfer struct {
+	transfer *payload.Transfer
+	block    uint64
+}
+
+// dispatchedTransfers keeps track of the transfers pushed for processing and the block of their event,
+// so that a transfer whose event disappears from the chain after a reorg can be invalidated.
+type dispatchedTransfers struct {
+	mutex  sync.Mutex
+	blocks map[string]dispatchedTransfer
+	// The dispatched transfers in rewound blocks, awaiting their event to be seen again by the re-scan
+	reorged map[string]dispatchedTransfer
+	// The events, which have been dropped instead of dispatched, so that they are counted once
+	dropped map[string]uint64
+	// The time of the last dispatch, or of the creation if nothing has been dispatched yet
+	last time.Time
+}
+
+func newDispatchedTransfers() *dispatchedTransfers {
+	return &dispatchedTransfers{
+		blocks:  make(map[string]dispatchedTransfer),
+		reorged: make(map[string]dispatchedTransfer),
+		dropped: make(map[string]uint64),
+		last:    time.Now(),
+	}
+}
+
+func (d *dispatchedTransfers) add(transfer *payload.Transfer, blockNumber uint64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.blocks[transfer.TransactionId] = dispatchedTransfer{transfer: transfer, block: blockNumber}
+	d.last = time.Now()
+}
+
+// sinceLast returns the time elapsed since the last dispatch
+func (d *dispatchedTransfers) sinceLast() time.Duration {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return time.Since(d.last)
+}
+
+// has returns whether the given transfer has been dispatched
+func (d *dispatchedTransfers) has(transactionId string) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	_, ok := d.blocks[transactionId]
+	return ok
+}
+
+// remove returns whether the given transfer has been dispatched and stops tracking it
+func (d *dispatchedTransfers) remove(transactionId string) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	_, ok := d.blocks[transactionId]
+	delete(d.blocks, transactionId)
+	return ok
+}
+
+// drop tracks the given event as dropped and returns whether it has not been dropped before
+func (d *dispatchedTransfers) drop(transactionId string, blockNumber uint64) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if _, ok := d.dropped[transactionId]; ok {
+		return false
+	}
+	d.dropped[transactionId] = blockNumber
+	return true
+}
+
+// rewind marks the transfers with events in the given block or after it as reorged,
+// until their event is seen again by the re-scan of the rewound blocks
+func (d *dispatchedTransfers) rewind(fromBlock uint64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for id, dispatched := range d.blocks {
+		if dispatched.block >= fromBlock {
+			d.reorged[id] = dispatched
+			delete(d.blocks, id)
+		}
+	}
+}
+
+// reconfirm returns whether the given transfer has been reorged and tracks it as dispatched again,
+// now that its event has been seen in the given block of the new chain
+func (d *dispatchedTransfers) reconfirm(transactionId string, blockNumber uint64) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	dispatched, ok := d.reorged[transactionId]
+	if !ok {
+		return false
+	}
+	delete(d.reorged, transactionId)
+	dispatched.block = blockNumber
+	d.blocks[transactionId] = dispatched
+	return true
+}
+
+// vanished stops tracking and returns the reorged transfers with events up to the given block,
+// which the re-scan of the new chain has not seen again
+func (d *dispatchedTransfers) vanished(uptoBlock uint64) []*payload.Transfer {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	var transfers []*payload.Transfer
+	for id, dispatched := range d.reorged {
+		if dispatched.block <= uptoBlock {
+			transfers = append(transfers, dispatched.transfer)
+			delete(d.reorged, id)
+		}
+	}
+	return transfers
+}
+
+// prune stops tracking transfers and dropped events in blocks before the given one,
+// as they can no longer be affected by a reorg
+func (d *dispatchedTransfers) prune(beforeBlock uint64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for id, dispatched := range d.blocks {
+		if dispatched.block < beforeBlock {
+			delete(d.blocks, id)
+		}
+	}
+	for id, block := range d.dropped {
+		if block < beforeBlock {
+			delete(d.dropped, id)
+		}
+	}
+}
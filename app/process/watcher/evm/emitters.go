@@ -0,0 +1,137 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+// Handlers of the watched events of auxiliary emitters
+const (
+	// EmitterHandlerStore logs the event and stores it as a raw event log
+	EmitterHandlerStore = "store"
+	// EmitterHandlerLog only logs the event
+	EmitterHandlerLog = "log"
+)
+
+// Emitter is an auxiliary contract, e.g. a fee distributor or a vault, whose events are watched next to the ones of the router
+type Emitter struct {
+	Address common.Address
+	// The JSON ABI of the contract
+	Abi string
+	// The handlers of the watched events, keyed by event name
+	Events map[string]string
+}
+
+// emitterEvent is a watched event of an auxiliary emitter
+type emitterEvent struct {
+	name    string
+	handler string
+	abi     abi.ABI
+}
+
+// addEmitters watches the configured events of the given auxiliary emitters next to the events of the router
+func (fc *FilterConfig) addEmitters(emitters []Emitter) error {
+	for _, emitter := range emitters {
+		for _, address := range fc.addresses {
+			if address == emitter.Address {
+				return fmt.Errorf("emitter [%s] is already watched", emitter.Address)
+			}
+		}
+		if len(emitter.Events) == 0 {
+			return fmt.Errorf("emitter [%s] has no watched events", emitter.Address)
+		}
+
+		contractAbi, err := abi.JSON(strings.NewReader(emitter.Abi))
+		if err != nil {
+			return fmt.Errorf("failed to parse ABI of emitter [%s]: %w", emitter.Address, err)
+		}
+
+		names := make([]string, 0, len(emitter.Events))
+		for name := range emitter.Events {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		events := make(map[common.Hash]emitterEvent, len(names))
+		for _, name := range names {
+			handler := emitter.Events[name]
+			if handler != EmitterHandlerStore && handler != EmitterHandlerLog {
+				return fmt.Errorf("unsupported handler [%s] of event [%s] of emitter [%s]", handler, name, emitter.Address)
+			}
+			event, ok := contractAbi.Events[name]
+			if !ok {
+				return fmt.Errorf("ABI of emitter [%s] is missing the [%s] event", emitter.Address, name)
+			}
+			events[event.ID] = emitterEvent{name: name, handler: handler, abi: contractAbi}
+			fc.watchTopic(event.ID)
+		}
+
+		if fc.emitterEvents == nil {
+			fc.emitterEvents = make(map[common.Address]map[common.Hash]emitterEvent)
+		}
+		fc.emitterEvents[emitter.Address] = events
+		fc.addresses = append(fc.addresses, emitter.Address)
+	}
+	return nil
+}
+
+// watchTopic adds the given event to the watched topics, unless it is already watched
+func (fc *FilterConfig) watchTopic(id common.Hash) {
+	for _, watched := range fc.topics[0] {
+		if watched == id {
+			return
+		}
+	}
+	fc.topics[0] = append(fc.topics[0], id)
+}
+
+// emitterEvent returns whether the log is emitted by an auxiliary emitter and its watched event, if any
+func (fc *FilterConfig) emitterEvent(raw types.Log) (event emitterEvent, watched bool, isEmitter bool) {
+	events, isEmitter := fc.emitterEvents[raw.Address]
+	if !isEmitter {
+		return emitterEvent{}, false, false
+	}
+	event, watched = events[raw.Topics[0]]
+	return event, watched, true
+}
+
+// handleEmitterLog logs the watched event of an auxiliary emitter and stores it, if the handler of the event says so
+func (ew *Watcher) handleEmitterLog(event emitterEvent, raw types.Log) {
+	id := fmt.Sprintf("%s-%d", raw.TxHash, raw.Index)
+	fields := make(map[string]interface{})
+	err := event.abi.UnpackIntoMap(fields, event.name, raw.Data)
+	if err != nil {
+		ew.logger.Warnf("[%s] - Failed to unpack [%s] event data of emitter [%s]. Error: [%s]", id, event.name, raw.Address, err)
+	}
+	ew.logger.Infof("[%s] - New [%s] Event Log of emitter [%s] received with fields [%v].", id, event.name, raw.Address, fields)
+
+	if event.handler != EmitterHandlerStore {
+		return
+	}
+	err = ew.transferRepository.CreateEventLog(entity.NewEventLog(id, ew.evmClient.GetChainID(), raw))
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to store raw [%s] event log of emitter [%s]. Error: [%s]", id, event.name, raw.Address, err)
+	}
+}
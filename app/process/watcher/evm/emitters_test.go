@@ -0,0 +1,149 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	vaultAddress = common.HexToAddress("0x5555555555555555555555555555555555555555")
+	// vaultAbi is the ABI of an auxiliary vault, which emits a Deposited event and a Lock event, colliding with the one of the router
+	vaultAbi = `[
+		{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Deposited","type":"event"},
+		{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"targetChain","type":"uint256"},{"indexed":false,"internalType":"address","name":"token","type":"address"},{"indexed":false,"internalType":"bytes","name":"receiver","type":"bytes"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"serviceFee","type":"uint256"}],"name":"Lock","type":"event"},
+		{"anonymous":false,"inputs":[],"name":"Withdrawn","type":"event"}
+	]`
+)
+
+func vaultFilterConfig(t *testing.T, events map[string]string) FilterConfig {
+	cfg, err := newFilterConfig("", nil, common.Address{}, 220)
+	assert.Nil(t, err)
+	err = cfg.addEmitters([]Emitter{{Address: vaultAddress, Abi: vaultAbi, Events: events}})
+	assert.Nil(t, err)
+	return cfg
+}
+
+func Test_AddEmitters(t *testing.T) {
+	cfg := vaultFilterConfig(t, map[string]string{"Deposited": EmitterHandlerStore, "Lock": EmitterHandlerLog})
+
+	assert.Equal(t, []common.Address{{}, vaultAddress}, cfg.addresses)
+	assert.Len(t, cfg.emitterEvents[vaultAddress], 2)
+	// The Lock event of the vault has the same id as the one of the router, so that it is watched once
+	assert.Len(t, cfg.topics[0], len(topics[0])+1)
+	assert.Equal(t, lockHash, cfg.lockHash)
+}
+
+func Test_AddEmitters_None(t *testing.T) {
+	cfg, err := newFilterConfig("", nil, common.Address{}, 220)
+	assert.Nil(t, err)
+
+	err = cfg.addEmitters(nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, topics, cfg.topics)
+	assert.Equal(t, []common.Address{{}}, cfg.addresses)
+}
+
+func Test_AddEmitters_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		emitter Emitter
+	}{
+		{"router address", Emitter{Address: common.Address{}, Abi: vaultAbi, Events: map[string]string{"Deposited": EmitterHandlerStore}}},
+		{"no events", Emitter{Address: vaultAddress, Abi: vaultAbi}},
+		{"invalid ABI", Emitter{Address: vaultAddress, Abi: "invalid", Events: map[string]string{"Deposited": EmitterHandlerStore}}},
+		{"unknown event", Emitter{Address: vaultAddress, Abi: vaultAbi, Events: map[string]string{"Unknown": EmitterHandlerStore}}},
+		{"unsupported handler", Emitter{Address: vaultAddress, Abi: vaultAbi, Events: map[string]string{"Deposited": "push"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := newFilterConfig("", nil, common.Address{}, 220)
+			assert.Nil(t, err)
+
+			assert.Error(t, cfg.addEmitters([]Emitter{test.emitter}))
+		})
+	}
+}
+
+func vaultLog(t *testing.T, event string, data []byte) types.Log {
+	contractAbi, err := abi.JSON(strings.NewReader(vaultAbi))
+	assert.Nil(t, err)
+	return types.Log{
+		Address: vaultAddress,
+		Topics:  []common.Hash{contractAbi.Events[event].ID},
+		Data:    data,
+		TxHash:  common.HexToHash("0x1"),
+		Index:   2,
+	}
+}
+
+func Test_ProcessLogs_EmitterEvent_Stored(t *testing.T) {
+	setup()
+	w.filterConfig = vaultFilterConfig(t, map[string]string{"Deposited": EmitterHandlerStore})
+	deposited := vaultLog(t, "Deposited", common.LeftPadBytes(big.NewInt(10).Bytes(), 32))
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{deposited}, nil)
+	mocks.MEVMClient.On("GetChainID").Return(uint64(80001))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
+
+	err := w.processLogs(0, 0, mocks.MQueue)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertCalled(t, "CreateEventLog", entity.NewEventLog(fmt.Sprintf("%s-%d", deposited.TxHash, deposited.Index), uint64(80001), deposited))
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_ProcessLogs_EmitterEvent_Logged(t *testing.T) {
+	setup()
+	w.filterConfig = vaultFilterConfig(t, map[string]string{"Deposited": EmitterHandlerLog})
+	deposited := vaultLog(t, "Deposited", common.LeftPadBytes(big.NewInt(10).Bytes(), 32))
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{deposited}, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
+
+	err := w.processLogs(0, 0, mocks.MQueue)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "CreateEventLog", mock.Anything)
+}
+
+func Test_ProcessLogs_EmitterEvent_NotHandledAsRouterEvent(t *testing.T) {
+	setup()
+	w.filterConfig = vaultFilterConfig(t, map[string]string{"Deposited": EmitterHandlerStore})
+	// A Lock event of the vault, which is not watched for the vault, but shares its id with the router event
+	lock := types.Log{Address: vaultAddress, Topics: []common.Hash{lockHash}, TxHash: common.HexToHash("0x2")}
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{lock}, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
+
+	err := w.processLogs(0, 0, mocks.MQueue)
+
+	assert.Nil(t, err)
+	mocks.MBridgeContractService.AssertNotCalled(t, "ParseLockLog", mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "CreateEventLog", mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+// logsRange sizes the block range of the log queries. On sparse chains, the range grows exponentially
+// while consecutive queries return no logs, up to a ceiling, and snaps back to its base size
+// once logs reappear or a query fails.
+type logsRange struct {
+	base    int64
+	ceiling int64
+	size    int64
+}
+
+func newLogsRange(base, ceiling int64) *logsRange {
+	if ceiling < base {
+		ceiling = base
+	}
+
+	return &logsRange{
+		base:    base,
+		ceiling: ceiling,
+		size:    base,
+	}
+}
+
+// blocks returns the current maximum number of blocks of a single log query
+func (r *logsRange) blocks() int64 {
+	return r.size
+}
+
+// update grows the range if the last query returned no logs and resets it otherwise
+func (r *logsRange) update(logs int) {
+	if logs > 0 {
+		r.reset()
+		return
+	}
+
+	r.size *= 2
+	if r.size > r.ceiling {
+		r.size = r.ceiling
+	}
+}
+
+func (r *logsRange) reset() {
+	r.size = r.base
+}
@@ -0,0 +1,424 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/handler/message-submission"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/transfers"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	pipelineTopicId       = "0.0.125563"
+	pipelineTargetChainId = uint64(80001)
+	pipelineWrappedAsset  = "0x0000000000000000000000000000000000000003"
+	pipelineReceiver      = common.HexToAddress("0x0000000000000000000000000000000000000004")
+	pipelineSignature     = []byte("signature")
+	pipelineMessageTxId   = hedera.NewTransactionIDWithValidStart(hedera.AccountID{Account: 1}, time.Unix(1, 0))
+)
+
+// pipeline drives synthetic router logs through the full processing pipeline - the watcher, the queue
+// and the handler of the dispatched topic - down to the transfer repository. The watcher reads the logs
+// from stub clients, while the queue and the transfer repository are in-memory.
+type pipeline struct {
+	watcher   *Watcher
+	queue     *memoryQueue
+	transfers *memoryTransferRepository
+	// The handlers of the dispatched topics. Messages of other topics are only recorded by the queue
+	handlers map[string]server.Handler
+}
+
+// newPipeline creates a pipeline, whose stub clients accept a Lock of the token to pipelineTargetChainId.
+// Its Lock transfers are signed and submitted to the topic by the message submission handler.
+func newPipeline(t *testing.T) *pipeline {
+	setup()
+	w.transferRepository = newMemoryTransferRepository()
+
+	mocks.MStatusRepository.On("Update", dbIdentifier, mock.Anything).Return(nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("GetBlockTimestamp", mock.Anything).Return(uint64(time.Now().Unix()))
+	mocks.MEVMClient.On("RetryTransactionByHash", mock.Anything).Return(signedTx(t), nil)
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, pipelineTargetChainId).Return(pipelineWrappedAsset)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", pipelineTargetChainId, pipelineWrappedAsset).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleNativeAsset", sourceChainId, tokenAddressString).Return(&asset.NativeAsset{ChainId: sourceChainId, Asset: tokenAddressString})
+	mocks.MPricingService.On("GetTokenPriceInfo", sourceChainId, tokenAddressString).Return(tokenPriceInfo, true)
+
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(pipelineSignature, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", mock.Anything, pipelineSignature).Return(&pipelineMessageTxId, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	repository := w.transferRepository.(*memoryTransferRepository)
+	transfersService := transfers.NewService(
+		mocks.MHederaNodeClient,
+		mocks.MHederaMirrorClient,
+		nil,
+		repository,
+		mocks.MScheduleRepository,
+		mocks.MFeeRepository,
+		mocks.MFeeService,
+		mocks.MDistributorService,
+		[]string{pipelineTopicId},
+		hederaAcc.String(),
+		mocks.MScheduledService,
+		mocks.MMessageService,
+		mocks.MPrometheusService,
+		mocks.MAssetsService,
+		0,
+		config.Shard{})
+
+	return &pipeline{
+		watcher:   w,
+		queue:     &memoryQueue{},
+		transfers: repository,
+		handlers: map[string]server.Handler{
+			constants.TopicMessageSubmission: message_submission.NewHandler(
+				mocks.MHederaNodeClient,
+				mocks.MHederaMirrorClient,
+				transfersService,
+				repository,
+				mocks.MMessageService,
+				[]string{pipelineTopicId},
+				0,
+				0),
+		},
+	}
+}
+
+// pipelineLock creates a synthetic Lock of the given amount of the token to pipelineTargetChainId, emitted in the given block
+func pipelineLock(block uint64, amount int64) *router.RouterLock {
+	return &router.RouterLock{
+		TargetChain: new(big.Int).SetUint64(pipelineTargetChainId),
+		Token:       tokenAddress,
+		Receiver:    pipelineReceiver.Bytes(),
+		Amount:      big.NewInt(amount),
+		ServiceFee:  big.NewInt(0),
+		Raw: types.Log{
+			Topics:      []common.Hash{lockHash},
+			TxHash:      common.BigToHash(new(big.Int).SetUint64(block)),
+			BlockNumber: block,
+		},
+	}
+}
+
+// replayLock serves the given Lock log from the stub client, processes its block with the watcher
+// and handles the dispatched messages
+func (p *pipeline) replayLock(t *testing.T, lock *router.RouterLock) {
+	mocks.MBridgeContractService.On("ParseLockLog", lock.Raw).Return(lock, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{lock.Raw}, nil).Once()
+
+	block := int64(lock.Raw.BlockNumber)
+	if err := p.watcher.processLogs(block, block, p.queue); err != nil {
+		t.Fatalf("failed to process block [%d]: %s", block, err)
+	}
+	p.drain()
+}
+
+// drain handles the pending messages of the queue in order
+func (p *pipeline) drain() {
+	for ; p.queue.handled < len(p.queue.messages); p.queue.handled++ {
+		message := p.queue.messages[p.queue.handled]
+		if handler, ok := p.handlers[message.Topic]; ok {
+			handler.Handle(message.Payload)
+		}
+	}
+}
+
+// memoryQueue records the pushed messages, which are handled by the pipeline in order
+type memoryQueue struct {
+	messages []*queue.Message
+	handled  int
+}
+
+func (q *memoryQueue) Push(message *queue.Message) {
+	q.messages = append(q.messages, message)
+}
+
+func (q *memoryQueue) Ack(message *queue.Message) {}
+
+func (q *memoryQueue) Channel() chan *queue.Message {
+	return nil
+}
+
+// topics returns the topics of the pushed messages
+func (q *memoryQueue) topics() []string {
+	topics := make([]string, 0, len(q.messages))
+	for _, message := range q.messages {
+		topics = append(topics, message.Topic)
+	}
+	return topics
+}
+
+// memoryTransferRepository keeps the transfers and their event logs in memory, keyed by transaction id
+type memoryTransferRepository struct {
+	mutex     sync.Mutex
+	transfers map[string]*entity.Transfer
+	eventLogs map[string]*entity.EventLog
+}
+
+func newMemoryTransferRepository() *memoryTransferRepository {
+	return &memoryTransferRepository{
+		transfers: make(map[string]*entity.Transfer),
+		eventLogs: make(map[string]*entity.EventLog),
+	}
+}
+
+func (r *memoryTransferRepository) GetByTransactionId(sourceChainId uint64, txId string) (*entity.Transfer, error) {
+	t, _ := r.GetByTransactionIdOnAnyChain(txId)
+	if t == nil || t.SourceChainID != sourceChainId {
+		return nil, nil
+	}
+	return t, nil
+}
+
+func (r *memoryTransferRepository) GetByTransactionIdOnAnyChain(txId string) (*entity.Transfer, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.transfers[txId], nil
+}
+
+func (r *memoryTransferRepository) GetWithFee(sourceChainId uint64, txId string) (*entity.Transfer, error) {
+	return r.GetByTransactionId(sourceChainId, txId)
+}
+
+func (r *memoryTransferRepository) GetWithPreloads(sourceChainId uint64, txId string) (*entity.Transfer, error) {
+	return r.GetByTransactionId(sourceChainId, txId)
+}
+
+func (r *memoryTransferRepository) UpdateFee(sourceChainId uint64, txId string, fee string) error {
+	return r.update(txId, func(t *entity.Transfer) { t.Fee = fee })
+}
+
+func (r *memoryTransferRepository) Create(ct *payload.Transfer) (*entity.Transfer, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.store(ct, status.Initial), nil
+}
+
+func (r *memoryTransferRepository) CreateFailedIfAbsent(ct *payload.Transfer) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.transfers[ct.TransactionId]; ok {
+		return false, nil
+	}
+	r.store(ct, status.Failed)
+	return true, nil
+}
+
+func (r *memoryTransferRepository) store(ct *payload.Transfer, s string) *entity.Transfer {
+	t := &entity.Transfer{
+		TransactionID: ct.TransactionId,
+		SourceChainID: ct.SourceChainId,
+		TargetChainID: ct.TargetChainId,
+		NativeChainID: ct.NativeChainId,
+		SourceAsset:   ct.SourceAsset,
+		TargetAsset:   ct.TargetAsset,
+		NativeAsset:   ct.NativeAsset,
+		Receiver:      ct.Receiver,
+		Amount:        ct.Amount,
+		Decimals:      ct.Decimals,
+		Status:        s,
+		SerialNumber:  ct.SerialNum,
+		Metadata:      ct.Metadata,
+		IsNft:         ct.IsNft,
+		Timestamp:     entity.NanoTime{Time: ct.Timestamp},
+		Originator:    ct.Originator,
+	}
+	r.transfers[t.TransactionID] = t
+	return t
+}
+
+func (r *memoryTransferRepository) UpdateStatusCompleted(sourceChainId uint64, txId string) error {
+	return r.update(txId, func(t *entity.Transfer) { t.Status = status.Completed })
+}
+
+func (r *memoryTransferRepository) UpdateStatusFailed(sourceChainId uint64, txId string) error {
+	return r.update(txId, func(t *entity.Transfer) { t.Status = status.Failed })
+}
+
+func (r *memoryTransferRepository) UpdateSignatureMsgStatus(sourceChainId uint64, txId string, s string) error {
+	return r.update(txId, func(t *entity.Transfer) { t.SignatureMsgStatus = s })
+}
+
+func (r *memoryTransferRepository) GetPendingSignatureSubmissions() ([]*entity.Transfer, error) {
+	return nil, nil
+}
+
+func (r *memoryTransferRepository) GetInitialBefore(before time.Time) ([]*entity.Transfer, error) {
+	return nil, nil
+}
+
+func (r *memoryTransferRepository) GetAwaitingSignatureFrom(signer string) ([]*entity.Transfer, error) {
+	return nil, nil
+}
+
+func (r *memoryTransferRepository) UpdateStatusFailedIfInitial(sourceChainId uint64, txId string) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	t, ok := r.transfers[txId]
+	if !ok || t.Status != status.Initial {
+		return false, nil
+	}
+	t.Status = status.Failed
+	return true, nil
+}
+
+func (r *memoryTransferRepository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error) {
+	return nil, 0, nil
+}
+
+func (r *memoryTransferRepository) CountByStatus() (map[string]int64, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	counts := make(map[string]int64)
+	for _, t := range r.transfers {
+		counts[t.Status]++
+	}
+	return counts, nil
+}
+
+func (r *memoryTransferRepository) MigrateLegacyChainIds(hederaNetworkId uint64) (int64, error) {
+	return 0, nil
+}
+
+func (r *memoryTransferRepository) MigrateCreationTimes() (int64, error) {
+	return 0, nil
+}
+
+func (r *memoryTransferRepository) GetTargetAssetsWithoutDecimals() ([]*entity.Transfer, error) {
+	return nil, nil
+}
+
+func (r *memoryTransferRepository) MigrateDecimals(targetChainId uint64, targetAsset string, decimals uint8) (int64, error) {
+	return 0, nil
+}
+
+func (r *memoryTransferRepository) MigrateCompositeIdentity() (bool, error) {
+	return false, nil
+}
+
+func (r *memoryTransferRepository) MigrateTransferSourceChains() (int64, error) {
+	return 0, nil
+}
+
+func (r *memoryTransferRepository) MigrateCompositeForeignKeys() (bool, error) {
+	return false, nil
+}
+
+func (r *memoryTransferRepository) CreateEventLog(eventLog *entity.EventLog) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := fmt.Sprintf("%d-%s", eventLog.SourceChainID, eventLog.TransferID)
+	if _, exists := r.eventLogs[key]; !exists {
+		r.eventLogs[key] = eventLog
+	}
+	return nil
+}
+
+func (r *memoryTransferRepository) GetEventLog(sourceChainId uint64, txId string) (*entity.EventLog, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.eventLogs[fmt.Sprintf("%d-%s", sourceChainId, txId)], nil
+}
+
+func (r *memoryTransferRepository) update(txId string, update func(t *entity.Transfer)) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if t, ok := r.transfers[txId]; ok {
+		update(t)
+	}
+	return nil
+}
+
+func Test_Pipeline_Lock(t *testing.T) {
+	p := newPipeline(t)
+	lock := pipelineLock(10, 20000)
+	transactionId := lock.Raw.TxHash.String() + "-0"
+
+	p.replayLock(t, lock)
+
+	assert.Equal(t, []string{constants.TopicMessageSubmission}, p.queue.topics())
+	persisted, _ := p.transfers.GetByTransactionId(sourceChainId, transactionId)
+	if assert.NotNil(t, persisted) {
+		assert.Equal(t, sourceChainId, persisted.SourceChainID)
+		assert.Equal(t, pipelineTargetChainId, persisted.TargetChainID)
+		assert.Equal(t, tokenAddressString, persisted.NativeAsset)
+		assert.Equal(t, pipelineWrappedAsset, persisted.TargetAsset)
+		assert.Equal(t, pipelineReceiver.String(), persisted.Receiver)
+		assert.Equal(t, "20000", persisted.Amount)
+		assert.Equal(t, status.Initial, persisted.Status)
+		assert.Equal(t, status.SignatureSubmitted, persisted.SignatureMsgStatus)
+	}
+	eventLog, _ := p.transfers.GetEventLog(sourceChainId, transactionId)
+	assert.NotNil(t, eventLog)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(11))
+}
+
+func Test_Pipeline_Lock_Replayed(t *testing.T) {
+	p := newPipeline(t)
+	lock := pipelineLock(10, 20000)
+
+	p.replayLock(t, lock)
+	p.replayLock(t, lock)
+
+	assert.Len(t, p.queue.messages, 1)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+}
+
+func Test_Pipeline_Lock_DisabledCorridor(t *testing.T) {
+	p := newPipeline(t)
+	p.watcher.corridors = newCorridors(map[uint64]map[uint64]bool{sourceChainId: {pipelineTargetChainId: true}})
+	lock := pipelineLock(10, 20000)
+
+	p.replayLock(t, lock)
+
+	assert.Equal(t, []string{constants.ReadOnlyTransferSave}, p.queue.topics())
+	persisted, _ := p.transfers.GetByTransactionIdOnAnyChain(lock.Raw.TxHash.String() + "-0")
+	assert.Nil(t, persisted)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import "time"
+
+const (
+	defaultMinPollingInterval = time.Second
+	defaultMaxPollingInterval = time.Minute
+)
+
+// pollInterval tunes the interval between polls to the observed block time of the chain,
+// bounded by a minimum and a maximum, so that fast chains are polled promptly and slow
+// chains do not waste RPC calls on polls without new blocks.
+type pollInterval struct {
+	min     time.Duration
+	max     time.Duration
+	current time.Duration
+	// The last observed block and the time of its observation
+	block    uint64
+	observed time.Time
+	now      func() time.Time
+}
+
+func newPollInterval(initial, min, max time.Duration) *pollInterval {
+	if max < min {
+		max = min
+	}
+
+	i := &pollInterval{
+		min: min,
+		max: max,
+		now: time.Now,
+	}
+	i.current = i.bound(initial)
+	return i
+}
+
+// duration returns the current interval between polls
+func (i *pollInterval) duration() time.Duration {
+	return i.current
+}
+
+// observe records the latest block of the chain and, once new blocks are produced,
+// tunes the interval to the average block time since the previous observation
+func (i *pollInterval) observe(block uint64) {
+	now := i.now()
+	if i.observed.IsZero() || block < i.block {
+		i.block = block
+		i.observed = now
+		return
+	}
+
+	if block == i.block {
+		return
+	}
+
+	blockTime := now.Sub(i.observed) / time.Duration(block-i.block)
+	i.current = i.bound(blockTime)
+	i.block = block
+	i.observed = now
+}
+
+func (i *pollInterval) bound(d time.Duration) time.Duration {
+	if d < i.min {
+		return i.min
+	}
+	if d > i.max {
+		return i.max
+	}
+	return d
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+)
+
+// blockHashes holds the hashes of the last processed blocks, against which forks of the chain are detected.
+// Only the last maxDepth + 1 blocks are kept, so that a fork deeper than maxDepth has no matching block.
+type blockHashes struct {
+	mutex    sync.Mutex
+	maxDepth int64
+	hashes   map[int64]common.Hash
+}
+
+func newBlockHashes(maxDepth int64) *blockHashes {
+	if maxDepth == 0 {
+		return nil
+	}
+	return &blockHashes{
+		maxDepth: maxDepth,
+		hashes:   make(map[int64]common.Hash),
+	}
+}
+
+func (b *blockHashes) record(number int64, hash common.Hash) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.hashes[number] = hash
+	for block := range b.hashes {
+		if block < number-b.maxDepth {
+			delete(b.hashes, block)
+		}
+	}
+}
+
+func (b *blockHashes) get(number int64) (common.Hash, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	hash, ok := b.hashes[number]
+	return hash, ok
+}
+
+// dropFrom forgets the hashes of the given block and the ones after it
+func (b *blockHashes) dropFrom(number int64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for block := range b.hashes {
+		if block >= number {
+			delete(b.hashes, block)
+		}
+	}
+}
+
+func (b *blockHashes) reset() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.hashes = make(map[int64]common.Hash)
+}
+
+// recordBlocks records the hashes of the processed blocks up to the given one, within the maximum reorg depth.
+// On failure, the recorded hashes are discarded, so that forks are never searched across a gap of unknown blocks.
+func (ew Watcher) recordBlocks(fromBlock, toBlock int64) {
+	if ew.blockHashes == nil {
+		return
+	}
+
+	if fromBlock < toBlock-ew.blockHashes.maxDepth {
+		fromBlock = toBlock - ew.blockHashes.maxDepth
+	}
+	for block := fromBlock; block <= toBlock; block++ {
+		hash, err := ew.blockHash(block)
+		if err != nil {
+			ew.logger.Warnf("Failed to retrieve the hash of block [%d], restarting reorg detection. Error: [%s]", block, err)
+			ew.blockHashes.reset()
+			return
+		}
+		ew.blockHashes.record(block, hash)
+	}
+}
+
+func (ew Watcher) blockHash(number int64) (common.Hash, error) {
+	header, err := ew.evmClient.HeaderByNumber(context.Background(), big.NewInt(number))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Hash(), nil
+}
+
+// checkReorg compares the recorded hashes of the processed blocks before the given checkpoint with the chain.
+// On a fork within the maximum reorg depth, the checkpoint is rewound to the block after the fork point,
+// and the rewound checkpoint is returned. On a deeper fork, the checkpoint is left as is and the watcher is paused
+// until an operator intervenes, in which case halted is true.
+func (ew Watcher) checkReorg(checkpoint int64) (rewound int64, halted bool) {
+	if ew.blockHashes == nil {
+		return checkpoint, false
+	}
+
+	lastProcessed := checkpoint - 1
+	forkPoint, complete := lastProcessed, true
+	for ; ; forkPoint-- {
+		recorded, ok := ew.blockHashes.get(forkPoint)
+		if !ok {
+			complete = false
+			break
+		}
+		hash, err := ew.blockHash(forkPoint)
+		if err != nil {
+			ew.logger.Errorf("Failed to retrieve the hash of block [%d] while checking for reorgs. Error: [%s]", forkPoint, err)
+			return checkpoint, false
+		}
+		if hash == recorded {
+			break
+		}
+	}
+
+	depth := lastProcessed - forkPoint
+	if depth == 0 {
+		return checkpoint, false
+	}
+	if !complete {
+		if depth > ew.blockHashes.maxDepth {
+			return ew.haltOnReorg(checkpoint)
+		}
+		// The fork point is older than the recorded blocks, so the whole window is re-scanned
+		depth = ew.blockHashes.maxDepth
+		forkPoint = lastProcessed - depth
+		if forkPoint < -1 {
+			forkPoint = -1
+		}
+	}
+
+	rewound = forkPoint + 1
+	ew.deferred.rewind(rewound)
+	err := ew.repository.Update(ew.dbIdentifier, ew.deferred.hold(rewound))
+	if err != nil {
+		ew.logger.Errorf("Failed to rewind the checkpoint to [%d] after a reorg. Error: [%s]", rewound, err)
+		return checkpoint, false
+	}
+	ew.blockHashes.dropFrom(rewound)
+	ew.dispatched.rewind(uint64(rewound))
+	ew.logger.Warnf("Detected a reorg of [%d] blocks. Rewound the checkpoint from [%d] to [%d].", depth, checkpoint, rewound)
+	metrics.IncrementReorgs(ew.dbIdentifier, ew.prometheusService)
+
+	return rewound, false
+}
+
+// haltOnReorg pauses the watcher on a fork deeper than the maximum reorg depth, instead of rewinding.
+// Resuming the watcher continues from the current checkpoint, which an operator may override beforehand.
+func (ew Watcher) haltOnReorg(checkpoint int64) (int64, bool) {
+	ew.blockHashes.reset()
+	ew.watchersService.Pause(ew.dbIdentifier)
+	ew.logger.Errorf("Detected a reorg of more than [%d] blocks before checkpoint [%d], exceeding the maximum reorg depth. Paused the watcher until an operator resumes it.", ew.blockHashes.maxDepth, checkpoint)
+	metrics.IncrementReorgHalts(ew.dbIdentifier, ew.prometheusService)
+
+	return checkpoint, true
+}
@@ -0,0 +1,229 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func canonicalHeader(number int64) *types.Header {
+	return &types.Header{Number: big.NewInt(number)}
+}
+
+func forkedHeader(number int64) *types.Header {
+	return &types.Header{Number: big.NewInt(number), Extra: []byte("fork")}
+}
+
+func mockHeader(header *types.Header) {
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, header.Number).Return(header, nil)
+}
+
+// setupReorg records the canonical hashes of the given processed blocks
+func setupReorg(maxDepth, fromBlock, toBlock int64) {
+	setup()
+	w.blockHashes = newBlockHashes(maxDepth)
+	for block := fromBlock; block <= toBlock; block++ {
+		w.blockHashes.record(block, canonicalHeader(block).Hash())
+	}
+}
+
+func Test_CheckReorg_NoReorg(t *testing.T) {
+	setupReorg(5, 10, 12)
+	mockHeader(canonicalHeader(12))
+
+	rewound, halted := w.checkReorg(13)
+
+	assert.False(t, halted)
+	assert.Equal(t, int64(13), rewound)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_CheckReorg_RewindsWithinLimit(t *testing.T) {
+	setupReorg(5, 10, 14)
+	mockHeader(forkedHeader(14))
+	mockHeader(forkedHeader(13))
+	mockHeader(canonicalHeader(12))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(13)).Return(nil)
+
+	rewound, halted := w.checkReorg(15)
+
+	assert.False(t, halted)
+	assert.Equal(t, int64(13), rewound)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(13))
+	mocks.MWatchersService.AssertNotCalled(t, "Pause", mock.Anything)
+	_, ok := w.blockHashes.get(13)
+	assert.False(t, ok)
+	_, ok = w.blockHashes.get(12)
+	assert.True(t, ok)
+}
+
+func Test_CheckReorg_HaltsOverLimit(t *testing.T) {
+	setupReorg(2, 8, 12)
+	mockHeader(forkedHeader(12))
+	mockHeader(forkedHeader(11))
+	mockHeader(forkedHeader(10))
+	mocks.MWatchersService.On("Pause", dbIdentifier).Return()
+
+	rewound, halted := w.checkReorg(13)
+
+	assert.True(t, halted)
+	assert.Equal(t, int64(13), rewound)
+	mocks.MWatchersService.AssertCalled(t, "Pause", dbIdentifier)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mocks.MEVMClient.AssertNotCalled(t, "HeaderByNumber", mock.Anything, big.NewInt(9))
+	// Resuming continues from the checkpoint, without halting on the same fork again
+	_, ok := w.blockHashes.get(12)
+	assert.False(t, ok)
+}
+
+func Test_CheckReorg_RewindsWholeWindowOnIncompleteHistory(t *testing.T) {
+	setupReorg(5, 11, 12)
+	mockHeader(forkedHeader(12))
+	mockHeader(forkedHeader(11))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(8)).Return(nil)
+
+	rewound, halted := w.checkReorg(13)
+
+	assert.False(t, halted)
+	assert.Equal(t, int64(8), rewound)
+	mocks.MWatchersService.AssertNotCalled(t, "Pause", mock.Anything)
+}
+
+func Test_CheckReorg_HeaderFails(t *testing.T) {
+	setupReorg(5, 10, 12)
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(12)).Return((*types.Header)(nil), errors.New("some-error"))
+
+	rewound, halted := w.checkReorg(13)
+
+	assert.False(t, halted)
+	assert.Equal(t, int64(13), rewound)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_CheckReorg_Disabled(t *testing.T) {
+	setup()
+
+	rewound, halted := w.checkReorg(13)
+
+	assert.False(t, halted)
+	assert.Equal(t, int64(13), rewound)
+	mocks.MEVMClient.AssertNotCalled(t, "HeaderByNumber", mock.Anything, mock.Anything)
+}
+
+func Test_RecordBlocks_KeepsMaxDepthWindow(t *testing.T) {
+	setupReorg(2, 0, -1)
+	for block := int64(5); block <= 10; block++ {
+		mockHeader(canonicalHeader(block))
+	}
+
+	w.recordBlocks(5, 10)
+
+	for block := int64(5); block <= 10; block++ {
+		_, ok := w.blockHashes.get(block)
+		assert.Equal(t, block >= 8, ok, "block [%d]", block)
+	}
+	mocks.MEVMClient.AssertNotCalled(t, "HeaderByNumber", mock.Anything, big.NewInt(7))
+}
+
+func Test_RecordBlocks_ResetsOnFailure(t *testing.T) {
+	setupReorg(5, 5, 9)
+	mockHeader(canonicalHeader(10))
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(11)).Return((*types.Header)(nil), errors.New("some-error"))
+
+	w.recordBlocks(10, 11)
+
+	for block := int64(5); block <= 11; block++ {
+		_, ok := w.blockHashes.get(block)
+		assert.False(t, ok, "block [%d]", block)
+	}
+}
+
+func Test_CheckReorg_InvalidatesVanishedTransfers(t *testing.T) {
+	setupReorg(5, 10, 14)
+	mocks.MTransferRepository.On("CreateEventLog", mock.Anything).Return(nil)
+	vanished := &payload.Transfer{TransactionId: "0x1-1", SourceChainId: sourceChainId}
+	reincluded := &payload.Transfer{TransactionId: "0x2-1", SourceChainId: sourceChainId}
+	mocks.MQueue.On("Push", mock.Anything).Return()
+	w.dispatch(mocks.MQueue, vanished, constants.HederaMintHtsTransfer, types.Log{BlockNumber: 13})
+	w.dispatch(mocks.MQueue, reincluded, constants.HederaMintHtsTransfer, types.Log{BlockNumber: 14})
+	mockHeader(forkedHeader(14))
+	mockHeader(forkedHeader(13))
+	mockHeader(canonicalHeader(12))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(13)).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusFailedIfInitial", sourceChainId, vanished.TransactionId).Return(true, nil)
+
+	w.checkReorg(15)
+	// The re-scan of the new chain sees only the event of the re-included transfer, in another block
+	w.dispatch(mocks.MQueue, reincluded, constants.HederaMintHtsTransfer, types.Log{BlockNumber: 13})
+	w.invalidateVanished(14)
+
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 2)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailedIfInitial", sourceChainId, vanished.TransactionId)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailedIfInitial", sourceChainId, reincluded.TransactionId)
+	mocks.MTransferRepository.AssertNotCalled(t, "CreateFailedIfAbsent", mock.Anything)
+	assert.True(t, w.dispatched.has(reincluded.TransactionId))
+	assert.False(t, w.dispatched.has(vanished.TransactionId))
+}
+
+func Test_InvalidateVanished_StoresQueuedTransferAsFailed(t *testing.T) {
+	setup()
+	queued := &payload.Transfer{TransactionId: "0x1-1", SourceChainId: sourceChainId}
+	w.dispatched.add(queued, 20)
+	w.dispatched.rewind(15)
+	mocks.MTransferRepository.On("UpdateStatusFailedIfInitial", sourceChainId, queued.TransactionId).Return(false, nil)
+	mocks.MTransferRepository.On("CreateFailedIfAbsent", queued).Return(true, nil)
+
+	w.invalidateVanished(20)
+
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "UpdateStatusFailedIfInitial", 1)
+	mocks.MTransferRepository.AssertCalled(t, "CreateFailedIfAbsent", queued)
+}
+
+func Test_InvalidateVanished_LeavesCompletedTransfer(t *testing.T) {
+	setup()
+	completed := &payload.Transfer{TransactionId: "0x1-1", SourceChainId: sourceChainId}
+	w.dispatched.add(completed, 20)
+	w.dispatched.rewind(15)
+	mocks.MTransferRepository.On("UpdateStatusFailedIfInitial", sourceChainId, completed.TransactionId).Return(false, nil)
+	mocks.MTransferRepository.On("CreateFailedIfAbsent", completed).Return(false, nil)
+
+	w.invalidateVanished(20)
+
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "UpdateStatusFailedIfInitial", 2)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", mock.Anything, mock.Anything)
+}
+
+func Test_InvalidateVanished_AwaitsRescan(t *testing.T) {
+	setup()
+	reorged := &payload.Transfer{TransactionId: "0x1-1"}
+	w.dispatched.add(reorged, 20)
+	w.dispatched.rewind(15)
+
+	w.invalidateVanished(19)
+
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailedIfInitial", mock.Anything, mock.Anything)
+	assert.Equal(t, []*payload.Transfer{reorged}, w.dispatched.vanished(20))
+}
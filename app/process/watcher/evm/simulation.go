@@ -0,0 +1,223 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	log "github.com/sirupsen/logrus"
+)
+
+const readOnlyTopicPrefix = "READ_ONLY_"
+
+// Simulate runs the handlers over the events in the given block range, without any side effects -
+// nothing is pushed for processing, stored, reported as a metric or to the confirmations callback. Returns the decision for every event.
+func (ew *Watcher) Simulate(from, to int64) ([]*watcher.SimResult, error) {
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetInt64(from),
+		ToBlock:   new(big.Int).SetInt64(to),
+		Addresses: ew.filterConfig.addresses,
+		Topics:    ew.filterConfig.topics,
+	}
+
+	logs, err := ew.evmClient.RetryFilterLogs(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*watcher.SimResult, 0, len(logs))
+	for _, raw := range logs {
+		if len(raw.Topics) == 0 {
+			continue
+		}
+		results = append(results, ew.simulateLog(raw))
+	}
+
+	return results, nil
+}
+
+// simulateLog runs the handler of the given log against a copy of the watcher, whose side effects are disabled.
+// The reason of a skipped or read-only event is the last error or warning, logged by the handler.
+func (ew *Watcher) simulateLog(raw types.Log) *watcher.SimResult {
+	result := &watcher.SimResult{
+		TransactionId: fmt.Sprintf("%s-%d", raw.TxHash, raw.Index),
+		Decision:      watcher.SimDecisionSkip,
+	}
+
+	hook := &lastMessageHook{}
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	logger.AddHook(hook)
+
+	sim := *ew
+	sim.logger = logger.WithField("context", ew.logger.Data["context"])
+	sim.prometheusService = disabledPrometheus{ew.prometheusService}
+	sim.transferRepository = simulatedTransferRepository{ew.transferRepository}
+	sim.dispatched = newDispatchedTransfers()
+	sim.deferred = newDeferredEvents()
+	sim.confirmationTiers = nil
+	sim.readOnlyFinality = 0
+	sim.confirmationsCallback = nil
+	// Without a sampler of its own, the simulation would advance the sampling of the live watcher
+	sim.logSampler = nil
+	q := &simulatedQueue{}
+
+	if event, watched, isEmitter := ew.filterConfig.emitterEvent(raw); isEmitter {
+		result.EventType = raw.Topics[0].Hex()
+		if watched {
+			result.EventType = event.name
+		}
+		result.Reason = fmt.Sprintf("event of emitter [%s] does not initiate a transfer", raw.Address)
+		return result
+	}
+
+	switch raw.Topics[0] {
+	case ew.filterConfig.lockHash:
+		result.EventType = "Lock"
+		if !simulatable(raw, result) {
+			return result
+		}
+		lock, err := ew.contracts.ParseLockLog(raw)
+		if err != nil {
+			result.Reason = fmt.Sprintf("failed to parse log: %s", err)
+			return result
+		}
+		sim.handleLockLog(lock, q)
+	case ew.filterConfig.burnHash:
+		result.EventType = "Burn"
+		if !simulatable(raw, result) {
+			return result
+		}
+		burn, err := ew.contracts.ParseBurnLog(raw)
+		if err != nil {
+			result.Reason = fmt.Sprintf("failed to parse log: %s", err)
+			return result
+		}
+		sim.handleBurnLog(burn, q)
+	case ew.filterConfig.burnERC721Hash:
+		result.EventType = "BurnERC721"
+		if !simulatable(raw, result) {
+			return result
+		}
+		burn, err := ew.contracts.ParseBurnERC721Log(raw)
+		if err != nil {
+			result.Reason = fmt.Sprintf("failed to parse log: %s", err)
+			return result
+		}
+		sim.handleBurnERC721(burn, q)
+	default:
+		result.EventType = ew.eventName(raw.Topics[0])
+		result.Reason = "event does not initiate a transfer"
+		return result
+	}
+
+	if len(q.messages) == 0 {
+		result.Reason = hook.message
+		if result.Reason == "" {
+			result.Reason = "no transfer emitted"
+		}
+		return result
+	}
+
+	message := q.messages[0]
+	result.Topic = message.Topic
+	result.Payload, _ = message.Payload.(*payload.Transfer)
+	result.Decision = watcher.SimDecisionProcess
+	if strings.HasPrefix(message.Topic, readOnlyTopicPrefix) {
+		result.Decision = watcher.SimDecisionReadOnly
+		result.Reason = hook.message
+	}
+	return result
+}
+
+// simulatable returns false for removed logs, as their handling invalidates already dispatched transfers
+func simulatable(raw types.Log, result *watcher.SimResult) bool {
+	if raw.Removed {
+		result.Reason = "event log was removed"
+		return false
+	}
+	return true
+}
+
+// eventName returns the name of the router ABI event with the given topic
+func (ew *Watcher) eventName(topic common.Hash) string {
+	for name, event := range ew.filterConfig.abi.Events {
+		if event.ID == topic {
+			return name
+		}
+	}
+	return topic.Hex()
+}
+
+// lastMessageHook keeps the last error or warning message of the logger
+type lastMessageHook struct {
+	message string
+}
+
+func (h *lastMessageHook) Levels() []log.Level {
+	return []log.Level{log.ErrorLevel, log.WarnLevel}
+}
+
+func (h *lastMessageHook) Fire(entry *log.Entry) error {
+	h.message = entry.Message
+	return nil
+}
+
+// disabledPrometheus reports the monitoring as disabled, so that no metrics are created during a simulation
+type disabledPrometheus struct {
+	service.Prometheus
+}
+
+func (disabledPrometheus) GetIsMonitoringEnabled() bool {
+	return false
+}
+
+// simulatedTransferRepository does not store the raw event logs of the simulated transfers
+type simulatedTransferRepository struct {
+	repository.Transfer
+}
+
+func (simulatedTransferRepository) CreateEventLog(*entity.EventLog) error {
+	return nil
+}
+
+// simulatedQueue keeps the pushed messages, instead of sending them for processing
+type simulatedQueue struct {
+	messages []*queue.Message
+}
+
+func (q *simulatedQueue) Push(message *queue.Message) {
+	q.messages = append(q.messages, message)
+}
+
+func (q *simulatedQueue) Ack(*queue.Message) {}
+
+func (q *simulatedQueue) Channel() chan *queue.Message {
+	return nil
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/sampling"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	simLockRaw = types.Log{
+		Topics:      []common.Hash{lockHash},
+		TxHash:      common.HexToHash("0x1"),
+		BlockNumber: 5,
+	}
+	simBurnRaw = types.Log{
+		Topics:      []common.Hash{burnHash},
+		TxHash:      common.HexToHash("0x2"),
+		Index:       1,
+		BlockNumber: 6,
+	}
+	simMembersRaw = types.Log{
+		Topics: []common.Hash{membersHash},
+		TxHash: common.HexToHash("0x3"),
+	}
+	simRemovedRaw = types.Log{
+		Topics:  []common.Hash{lockHash},
+		TxHash:  common.HexToHash("0x4"),
+		Removed: true,
+	}
+	simWrappedAsset = "0.0.5"
+)
+
+func setupSimulation(t *testing.T, logs []types.Log) {
+	setup()
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return(logs, nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("GetBlockTimestamp", mock.Anything).Return(uint64(time.Now().Unix()))
+	mocks.MEVMClient.On("RetryTransactionByHash", mock.Anything).Return(signedTx(t), nil)
+
+	lock := &router.RouterLock{
+		TargetChain: targetChainIdBigInt,
+		Token:       tokenAddress,
+		Receiver:    hederaBytes,
+		Amount:      big.NewInt(20000),
+		ServiceFee:  big.NewInt(0),
+		Raw:         simLockRaw,
+	}
+	mocks.MBridgeContractService.On("ParseLockLog", simLockRaw).Return(lock, nil)
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return(simWrappedAsset)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, simWrappedAsset).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleNativeAsset", sourceChainId, tokenAddressString).Return(&asset.NativeAsset{ChainId: sourceChainId, Asset: tokenAddressString})
+	mocks.MPricingService.On("GetTokenPriceInfo", sourceChainId, tokenAddressString).Return(tokenPriceInfo, true)
+
+	burn := &router.RouterBurn{
+		TargetChain: targetChainIdBigInt,
+		Token:       tokenAddress,
+		Receiver:    hederaBytes,
+		Amount:      big.NewInt(20000),
+		Raw:         simBurnRaw,
+	}
+	mocks.MBridgeContractService.On("ParseBurnLog", simBurnRaw).Return(burn, nil)
+	mocks.MAssetsService.On("WrappedToNative", tokenAddressString, sourceChainId).Return(nilNativeAsset)
+}
+
+func signedTx(t *testing.T) *types.Transaction {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(int64(sourceChainId))), &types.LegacyTx{Gas: 21000, GasPrice: big.NewInt(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func Test_Simulate(t *testing.T) {
+	setupSimulation(t, []types.Log{simLockRaw, simBurnRaw, simMembersRaw, simRemovedRaw})
+
+	results, err := w.Simulate(5, 10)
+
+	assert.Nil(t, err)
+	assert.Len(t, results, 4)
+
+	assert.Equal(t, "Lock", results[0].EventType)
+	assert.Equal(t, watcher.SimDecisionProcess, results[0].Decision)
+	assert.Equal(t, constants.HederaMintHtsTransfer, results[0].Topic)
+	assert.Equal(t, "20000", results[0].Payload.Amount)
+	assert.Equal(t, simWrappedAsset, results[0].Payload.TargetAsset)
+	assert.Equal(t, hederaAcc.String(), results[0].Payload.Receiver)
+
+	assert.Equal(t, "Burn", results[1].EventType)
+	assert.Equal(t, simBurnRaw.TxHash.String()+"-1", results[1].TransactionId)
+	assert.Equal(t, watcher.SimDecisionSkip, results[1].Decision)
+	assert.Contains(t, results[1].Reason, "Failed to retrieve native asset")
+	assert.Nil(t, results[1].Payload)
+
+	assert.Equal(t, membersHash.Hex(), results[2].EventType)
+	assert.Equal(t, watcher.SimDecisionSkip, results[2].Decision)
+	assert.Equal(t, "event does not initiate a transfer", results[2].Reason)
+
+	assert.Equal(t, "Lock", results[3].EventType)
+	assert.Equal(t, watcher.SimDecisionSkip, results[3].Decision)
+	assert.Equal(t, "event log was removed", results[3].Reason)
+
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "CreateEventLog", mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", mock.Anything, mock.Anything)
+	assert.Empty(t, w.dispatched.blocks)
+}
+
+func Test_Simulate_ReadOnly(t *testing.T) {
+	setupSimulation(t, []types.Log{simLockRaw})
+	w.validator = false
+
+	results, err := w.Simulate(5, 10)
+
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, watcher.SimDecisionReadOnly, results[0].Decision)
+	assert.Equal(t, constants.ReadOnlyHederaMintHtsTransfer, results[0].Topic)
+	assert.NotNil(t, results[0].Payload)
+}
+
+func Test_Simulate_FilterLogsFails(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{}, errors.New("some-error"))
+
+	results, err := w.Simulate(5, 10)
+
+	assert.Error(t, err)
+	assert.Nil(t, results)
+}
+
+func Test_Simulate_SkipsConfirmationsCallbackAndLogSampling(t *testing.T) {
+	setupSimulation(t, []types.Log{simLockRaw})
+	w.confirmationsCallback = &confirmationsCallback{pending: make(chan *ConfirmationsReached, 1)}
+	w.logSampler = sampling.New(2, 0)
+
+	results, err := w.Simulate(5, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, watcher.SimDecisionProcess, results[0].Decision)
+	assert.Empty(t, w.confirmationsCallback.pending)
+	// The first event after a simulation is still logged by the live watcher
+	assert.False(t, w.logSampler.Skip(nil, nil))
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+)
+
+// diamondCutHash is the id of the EIP-2535 DiamondCut event, which the router diamond emits when facets are added,
+// replaced or removed
+var diamondCutHash = crypto.Keccak256Hash([]byte("DiamondCut((address,uint8,bytes4[])[],address,bytes)"))
+
+// errPausedOnUpgrade stops the processing of a range at an upgrade of the router, on which the watcher got paused
+var errPausedOnUpgrade = errors.New("paused on router upgrade")
+
+// upgrades tracks the facet changes of the router diamond
+type upgrades struct {
+	// Whether the watcher is paused on an upgrade, until an operator resumes it
+	pauseOnUpgrade bool
+	// The already reported cuts, which are not reported again when their block is re-scanned
+	reported map[cutKey]bool
+}
+
+type cutKey struct {
+	txHash string
+	index  uint
+}
+
+func newUpgrades(pauseOnUpgrade bool) *upgrades {
+	return &upgrades{
+		pauseOnUpgrade: pauseOnUpgrade,
+		reported:       make(map[cutKey]bool),
+	}
+}
+
+// isDiamondCut returns whether the given log is a facet change of the watched router
+func (ew Watcher) isDiamondCut(log types.Log) bool {
+	return ew.upgrades != nil &&
+		len(log.Topics) > 0 &&
+		log.Topics[0] == diamondCutHash &&
+		log.Address == ew.contracts.Address()
+}
+
+// handleDiamondCut reports a facet change of the router, which may change the signatures of its events.
+// Returns true if the watcher got paused as a result, in which case it stays paused until an operator
+// acknowledges the upgrade by resuming it. The logs after the cut are processed only once it is resumed
+func (ew Watcher) handleDiamondCut(log types.Log) bool {
+	key := cutKey{txHash: log.TxHash.String(), index: log.Index}
+	if ew.upgrades.reported[key] {
+		return false
+	}
+	ew.upgrades.reported[key] = true
+
+	ew.logger.Errorf("Router facets changed by diamond cut in TX [%s] at block [%d].", log.TxHash, log.BlockNumber)
+	metrics.IncrementRouterUpgrades(ew.dbIdentifier, ew.prometheusService)
+	if !ew.upgrades.pauseOnUpgrade {
+		return false
+	}
+
+	ew.watchersService.Pause(ew.dbIdentifier)
+	ew.logger.Errorf("Paused the watcher until the upgrade is acknowledged by resuming it.")
+	return true
+}
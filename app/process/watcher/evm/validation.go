@@ -0,0 +1,91 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+)
+
+// The default bit length, above which event amounts are considered implausible
+const defaultMaxAmountBits = 128
+
+// validationError describes why the decoded arguments of an event failed validation.
+// Its reason labels the dropped events metric
+type validationError struct {
+	reason  string
+	message string
+}
+
+func newValidationError(reason, format string, args ...interface{}) *validationError {
+	return &validationError{reason: reason, message: fmt.Sprintf(format, args...)}
+}
+
+func (e *validationError) Error() string {
+	return e.message
+}
+
+// eventArgs are the decoded arguments of a transfer event, which are validated before the event is handled
+type eventArgs struct {
+	receiver    []byte
+	token       common.Address
+	targetChain *big.Int
+	// Nil for events without an amount, such as ERC-721 burns
+	amount *big.Int
+}
+
+// validateEvent checks the decoded arguments of a transfer event against sanity bounds
+func (ew *Watcher) validateEvent(args eventArgs) *validationError {
+	if len(args.receiver) == 0 {
+		return newValidationError(constants.DropReasonEmptyReceiver, "empty receiver account")
+	}
+	if args.token == (common.Address{}) {
+		return newValidationError(constants.DropReasonZeroToken, "zero token address")
+	}
+	if args.targetChain == nil || !args.targetChain.IsUint64() {
+		return newValidationError(constants.DropReasonUnsupportedChain, "target chain [%s] is not a chain id", args.targetChain)
+	}
+	if len(ew.servicedChains) != 0 && !ew.servicedChains[args.targetChain.Uint64()] {
+		return newValidationError(constants.DropReasonUnsupportedChain, "unsupported target chain [%d]", args.targetChain.Uint64())
+	}
+	if args.amount != nil {
+		if args.amount.Sign() <= 0 {
+			return newValidationError(constants.DropReasonInvalidAmount, "non-positive amount [%s]", args.amount)
+		}
+		if ew.maxAmountBits > 0 && args.amount.BitLen() > ew.maxAmountBits {
+			return newValidationError(constants.DropReasonInvalidAmount, "amount [%s] exceeds [%d] bits", args.amount, ew.maxAmountBits)
+		}
+	}
+
+	return nil
+}
+
+// isValidEvent validates the decoded arguments of a transfer event. Invalid events are dropped and counted.
+func (ew *Watcher) isValidEvent(args eventArgs, txHash common.Hash) bool {
+	err := ew.validateEvent(args)
+	if err == nil {
+		return true
+	}
+
+	ew.logger.Errorf("[%s] - Invalid event: %s. Skipping.", txHash, err)
+	metrics.IncrementDroppedEvents(ew.dbIdentifier, err.reason, ew.prometheusService)
+	return false
+}
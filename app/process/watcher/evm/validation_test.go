@@ -0,0 +1,166 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func validationWatcher() *Watcher {
+	mocks.Setup()
+	return &Watcher{
+		dbIdentifier:      dbIdentifier,
+		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		prometheusService: mocks.MPrometheusService,
+		servicedChains:    toChainSet([]uint64{sourceChainId, targetChainId}),
+		maxAmountBits:     defaultMaxAmountBits,
+	}
+}
+
+func validArgs() eventArgs {
+	return eventArgs{
+		receiver:    hederaBytes,
+		token:       tokenAddress,
+		targetChain: new(big.Int).SetUint64(targetChainId),
+		amount:      big.NewInt(100),
+	}
+}
+
+func Test_ValidateEvent(t *testing.T) {
+	w := validationWatcher()
+
+	assert.Nil(t, w.validateEvent(validArgs()))
+}
+
+func Test_ValidateEvent_WithoutAmount(t *testing.T) {
+	w := validationWatcher()
+	args := validArgs()
+	args.amount = nil
+
+	assert.Nil(t, w.validateEvent(args))
+}
+
+func Test_ValidateEvent_EmptyReceiver(t *testing.T) {
+	w := validationWatcher()
+	args := validArgs()
+	args.receiver = []byte{}
+
+	err := w.validateEvent(args)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.DropReasonEmptyReceiver, err.reason)
+}
+
+func Test_ValidateEvent_ZeroToken(t *testing.T) {
+	w := validationWatcher()
+	args := validArgs()
+	args.token = common.Address{}
+
+	err := w.validateEvent(args)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.DropReasonZeroToken, err.reason)
+}
+
+func Test_ValidateEvent_TargetChainOverflow(t *testing.T) {
+	w := validationWatcher()
+	args := validArgs()
+	args.targetChain = new(big.Int).Lsh(big.NewInt(1), 64)
+
+	err := w.validateEvent(args)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.DropReasonUnsupportedChain, err.reason)
+}
+
+func Test_ValidateEvent_UnsupportedTargetChain(t *testing.T) {
+	w := validationWatcher()
+	args := validArgs()
+	args.targetChain = big.NewInt(12345)
+
+	err := w.validateEvent(args)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.DropReasonUnsupportedChain, err.reason)
+}
+
+func Test_ValidateEvent_AnyTargetChainWithoutServicedChains(t *testing.T) {
+	w := validationWatcher()
+	w.servicedChains = nil
+	args := validArgs()
+	args.targetChain = big.NewInt(12345)
+
+	assert.Nil(t, w.validateEvent(args))
+}
+
+func Test_ValidateEvent_NonPositiveAmount(t *testing.T) {
+	w := validationWatcher()
+	args := validArgs()
+	args.amount = big.NewInt(0)
+
+	err := w.validateEvent(args)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.DropReasonInvalidAmount, err.reason)
+}
+
+func Test_ValidateEvent_AmountTooLarge(t *testing.T) {
+	w := validationWatcher()
+	args := validArgs()
+	args.amount = new(big.Int).Lsh(big.NewInt(1), defaultMaxAmountBits)
+
+	err := w.validateEvent(args)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.DropReasonInvalidAmount, err.reason)
+}
+
+func Test_ValidateEvent_AmountBoundDisabled(t *testing.T) {
+	w := validationWatcher()
+	w.maxAmountBits = 0
+	args := validArgs()
+	args.amount = new(big.Int).Lsh(big.NewInt(1), 255)
+
+	assert.Nil(t, w.validateEvent(args))
+}
+
+func Test_HandleBurnLog_ZeroToken_CountsDropped(t *testing.T) {
+	w := validationWatcher()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "dropped"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == fmt.Sprintf("%s%s_%s", constants.DroppedEventsCounterNamePrefix, constants.DropReasonZeroToken, metrics.PrepareValueForPrometheusMetricName(dbIdentifier))
+	})).Return(counter)
+
+	w.handleBurnLog(&router.RouterBurn{TargetChain: new(big.Int).SetUint64(targetChainId), Receiver: hederaBytes, Amount: big.NewInt(1)}, mocks.MQueue)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
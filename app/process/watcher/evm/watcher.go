@@ -17,11 +17,16 @@
 package evm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
+	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -29,6 +34,9 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gookit/event"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
@@ -41,7 +49,11 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/sampling"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/shard"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	c "github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
@@ -55,6 +67,7 @@ type Watcher struct {
 	// EVM networks might be the same, a concatenation between
 	// <chain-id>-<contract-address> removes possible duplication.
 	dbIdentifier        string
+	transferRepository  repository.Transfer
 	contracts           service.Contracts
 	prometheusService   service.Prometheus
 	pricingService      service.Pricing
@@ -66,6 +79,69 @@ type Watcher struct {
 	validator           bool
 	filterConfig        FilterConfig
 	blacklistedAccounts []string
+	dispatched          *dispatchedTransfers
+	// The events, which are not ready to be dispatched yet, handled again on the following polls
+	deferred *deferredEvents
+	// Whether a reload of the router members is in flight, so that at most one reload runs at a time
+	reloadingMembers *atomic.Bool
+	watchersService  service.Watchers
+	// The minimum age of a block, relative to the latest block, before
+	// read-only events from it are emitted. Zero disables the check.
+	readOnlyFinality time.Duration
+	sleep            func(time.Duration)
+	// Events older than maxTransferAge are not auto-processed, but routed to the read-only path
+	maxTransferAge time.Duration
+	// Block confirmations, keyed by a multiplier of the asset's minimum amount.
+	// Transfers with an amount of at least minimum amount * multiplier await
+	// the given confirmations before they are dispatched.
+	confirmationTiers map[uint64]uint64
+	// The native tokens, which deduct a fee on transfer, keyed by token address. The locked amount of such tokens
+	// is the value of their Transfer to the router, logged in the same transaction, instead of the event amount.
+	feeOnTransferTokens map[string]bool
+	// Chains serviced by the validator. Events, referencing other chains, are dropped.
+	// Empty disables the check.
+	servicedChains map[uint64]bool
+	// Sizes the block range of the log queries, growing it while no logs are returned
+	logsRange *logsRange
+	// Whether the fully dispatched blocks of a range are committed, if processing fails mid-range,
+	// so that only the undispatched tail of the range is reprocessed
+	partialRangeCommit bool
+	// The amount of blocks before the checkpoint, which are re-scanned on every poll to catch shallow reorgs.
+	// Zero disables the re-scan.
+	reorgGrace int64
+	// Tracks the facet changes of the router diamond. Nil disables the check
+	upgrades *upgrades
+	// A secondary endpoint, against which the logs of high-value transfers are verified. Nil disables the check
+	verifier client.Core
+	// Transfers with an amount of at least minimum amount * crossVerificationThreshold are cross-verified
+	crossVerificationThreshold uint64
+	// Tunes the interval between polls to the block time of the chain. Nil polls every sleepDuration
+	pollInterval *pollInterval
+	// Dust thresholds of the native fungible assets, keyed by network id and asset.
+	// Transfers of a smaller amount, in the lowest denomination of the native asset, are dropped
+	dustAmounts map[uint64]map[string]*big.Int
+	// Rounding policies of the native fungible assets, keyed by network id and asset.
+	// Applied when an amount is scaled down to fewer decimals. Missing assets are truncated
+	roundingPolicies map[uint64]map[string]string
+	// Whether transfers, whose receiver is their originator, are dropped
+	dropSelfTransfers bool
+	// The disabled corridors, whose events are routed to the read-only path. Nil enables all corridors
+	corridors *corridors
+	// Events with an amount of a larger bit length are dropped as implausible. Zero disables the check
+	maxAmountBits int
+	// The hashes of the last processed blocks, used to detect forks of up to the maximum reorg depth. Nil disables the check
+	blockHashes *blockHashes
+	// The shard of receivers, whose transfers are processed. Transfers of other shards are routed to the read-only path
+	shard c.Shard
+	// An archive endpoint, from which old ranges are re-queried, when the primary endpoints return no logs for them.
+	// Nil disables the fallback
+	archive client.Core
+	// The amount of blocks behind the latest block, after which a range is old
+	archiveAge int64
+	// Notified of transfers, which have passed the confirmation gate. Nil disables the callback
+	confirmationsCallback *confirmationsCallback
+	// Samples the routine per-transfer log lines. Nil logs every line
+	logSampler *sampling.Sampler
 }
 
 // Certain node providers (Alchemy, Infura) have a limitation on how many blocks
@@ -78,6 +154,15 @@ const defaultMaxLogsBlocks = int64(500)
 // The default polling interval (in seconds) when querying for upcoming events/logs
 const defaultSleepDuration = 15 * time.Second
 
+// nativeTokenUpdatedEvent is emitted by the router, when a native token is added, updated or disabled
+const nativeTokenUpdatedEvent = "NativeTokenUpdated"
+
+// Bounds of the exponential backoff, used when reloading the router members fails
+const (
+	minReloadMembersBackoff = time.Second
+	maxReloadMembersBackoff = 5 * time.Minute
+)
+
 type FilterConfig struct {
 	abi               abi.ABI
 	topics            [][]common.Hash
@@ -88,11 +173,270 @@ type FilterConfig struct {
 	unlockHash        common.Hash
 	burnERC721Hash    common.Hash
 	memberUpdatedHash common.Hash
-	maxLogsBlocks     int64
+	// Optional, as not every router ABI emits it. Left empty if missing
+	nativeTokenUpdatedHash common.Hash
+	// Events of the router ABI, which are watched, but not handled by a dedicated handler
+	extraEvents map[common.Hash]string
+	// Watched events of the auxiliary emitters, keyed by emitter address and event id
+	emitterEvents map[common.Address]map[common.Hash]emitterEvent
+	maxLogsBlocks int64
 }
 
+// newFilterConfig builds the watched topics from the given router ABI and the names of the extra events to be watched.
+// Falls back to the embedded router ABI if none is provided.
+func newFilterConfig(routerAbi string, extraEventNames []string, address common.Address, maxLogsBlocks int64) (FilterConfig, error) {
+	if routerAbi == "" {
+		routerAbi = router.RouterABI
+	}
+
+	abi, err := abi.JSON(strings.NewReader(routerAbi))
+	if err != nil {
+		return FilterConfig{}, fmt.Errorf("failed to parse router ABI: %w", err)
+	}
+
+	handledEvents := []string{"Mint", "Burn", "Lock", "Unlock", "MemberUpdated", "BurnERC721"}
+	hashes := make(map[string]common.Hash, len(handledEvents))
+	var watched []common.Hash
+	for _, name := range handledEvents {
+		event, ok := abi.Events[name]
+		if !ok {
+			return FilterConfig{}, fmt.Errorf("router ABI is missing the [%s] event", name)
+		}
+		hashes[name] = event.ID
+		watched = append(watched, event.ID)
+	}
+
+	if event, ok := abi.Events[nativeTokenUpdatedEvent]; ok {
+		hashes[nativeTokenUpdatedEvent] = event.ID
+		watched = append(watched, event.ID)
+	}
+
+	extraEvents := make(map[common.Hash]string)
+	for _, name := range extraEventNames {
+		event, ok := abi.Events[name]
+		if !ok {
+			return FilterConfig{}, fmt.Errorf("router ABI is missing the configured [%s] event", name)
+		}
+		if _, ok := hashes[name]; ok {
+			continue
+		}
+		extraEvents[event.ID] = name
+		watched = append(watched, event.ID)
+	}
+
+	return FilterConfig{
+		abi:                    abi,
+		topics:                 [][]common.Hash{watched},
+		addresses:              []common.Address{address},
+		mintHash:               hashes["Mint"],
+		burnHash:               hashes["Burn"],
+		lockHash:               hashes["Lock"],
+		unlockHash:             hashes["Unlock"],
+		burnERC721Hash:         hashes["BurnERC721"],
+		memberUpdatedHash:      hashes["MemberUpdated"],
+		nativeTokenUpdatedHash: hashes[nativeTokenUpdatedEvent],
+		extraEvents:            extraEvents,
+		maxLogsBlocks:          maxLogsBlocks,
+	}, nil
+}
+
+// WatcherConfig holds the dependencies and the configuration of an EVM watcher
+type WatcherConfig struct {
+	Repository         repository.Status
+	TransferRepository repository.Transfer
+	Contracts          service.Contracts
+	PrometheusService  service.Prometheus
+	PricingService     service.Pricing
+	EvmClient          client.EVM
+	AssetsService      service.Assets
+	WatchersService    service.Watchers
+	// A unique database identifier, used as a key to track the progress of the watcher
+	DbIdentifier string
+	// The block, from which the watcher starts. Zero resumes from the last processed block
+	StartBlock int64
+	Validator  bool
+	// The polling interval in seconds. Zero defaults to defaultSleepDuration
+	PollingInterval time.Duration
+	// Whether the polling interval is tuned to the observed block time, bounded by
+	// MinPollingInterval and MaxPollingInterval in seconds
+	AutoTunePolling    bool
+	MinPollingInterval time.Duration
+	MaxPollingInterval time.Duration
+	// The block range of the log queries. Zero defaults to defaultMaxLogsBlocks
+	MaxLogsBlocks        int64
+	MaxLogsBlocksCeiling int64
+	PartialRangeCommit   bool
+	ReorgGrace           int64
+	// Whether the EIP-2535 DiamondCut events of the router are watched for upgrades
+	WatchUpgrades bool
+	// Whether the watcher is paused on an upgrade, until it is resumed
+	PauseOnUpgrade bool
+	// A secondary endpoint, against which the logs of high-value transfers are verified
+	Verifier client.Core
+	// The multiplier of the asset's minimum amount, from which transfers are cross-verified. Zero disables the check
+	CrossVerificationThreshold uint64
+	// The minimum age of a block, before read-only events from it are emitted
+	ReadOnlyFinality    time.Duration
+	MaxTransferAge      time.Duration
+	ConfirmationTiers   map[uint64]uint64
+	RouterAbi           string
+	ExtraEvents         []string
+	FeeOnTransferTokens map[string]bool
+	ServicedChains      []uint64
+	BlacklistedAccounts []string
+	// Dust thresholds of the native fungible assets, keyed by network id and asset
+	DustAmounts map[uint64]map[string]*big.Int
+	// Rounding policies of the native fungible assets, keyed by network id and asset
+	RoundingPolicies  map[uint64]map[string]string
+	DropSelfTransfers bool
+	// Target network ids, keyed by source network id, whose events are routed to the read-only path.
+	// Updated with every bridge config update
+	DisabledCorridors map[uint64]map[uint64]bool
+	// The bit length, above which event amounts are dropped as implausible. Zero defaults to defaultMaxAmountBits
+	MaxAmountBits int
+	// The maximum amount of blocks, by which the checkpoint is rewound on a reorg. The watcher is paused on deeper reorgs.
+	// Zero disables reorg detection
+	MaxReorgDepth int64
+	// The shard of receivers, whose transfers are processed by the watcher
+	Shard c.Shard
+	// An archive endpoint, from which old ranges without logs are re-queried
+	Archive client.Core
+	// The amount of blocks behind the latest block, after which a range without logs is re-queried from the archive endpoint
+	ArchiveAge int64
+	// Auxiliary contracts, whose events are watched next to the ones of the router
+	Emitters []Emitter
+	// The endpoint, to which transfers are posted once they reach their block confirmations. Empty disables the callback
+	ConfirmationsCallbackUrl string
+	// The retries of a failed confirmations callback. Zero defaults to defaultConfirmationsCallbackRetries
+	ConfirmationsCallbackRetries int
+	// Samples the routine per-transfer log lines. Nil logs every line
+	LogSampler *sampling.Sampler
+	// The amount of blocks before the latest confirmed block, from which the watcher starts when it has
+	// neither a start block, nor a checkpoint. Zero starts from the latest confirmed block
+	ColdStartLookback int64
+}
+
+// Validate checks the invariants of the configuration, taking the defaults into account
+func (cfg WatcherConfig) Validate() error {
+	if cfg.Repository == nil || cfg.TransferRepository == nil || cfg.Contracts == nil || cfg.PrometheusService == nil ||
+		cfg.PricingService == nil || cfg.EvmClient == nil || cfg.AssetsService == nil || cfg.WatchersService == nil {
+		return errors.New("missing watcher dependency")
+	}
+	if cfg.DbIdentifier == "" {
+		return errors.New("empty database identifier")
+	}
+	if cfg.StartBlock < 0 {
+		return fmt.Errorf("negative start block [%d]", cfg.StartBlock)
+	}
+	if cfg.PollingInterval < 0 {
+		return fmt.Errorf("negative polling interval [%d]", cfg.PollingInterval)
+	}
+	if cfg.MinPollingInterval < 0 || cfg.MaxPollingInterval < 0 {
+		return fmt.Errorf("negative polling interval bounds [%d, %d]", cfg.MinPollingInterval, cfg.MaxPollingInterval)
+	}
+	if cfg.MaxPollingInterval != 0 && cfg.MaxPollingInterval < cfg.MinPollingInterval {
+		return fmt.Errorf("max polling interval [%d] is less than min polling interval [%d]", cfg.MaxPollingInterval, cfg.MinPollingInterval)
+	}
+	if cfg.maxLogsBlocks() <= 0 {
+		return fmt.Errorf("non-positive max logs blocks [%d]", cfg.MaxLogsBlocks)
+	}
+	if cfg.MaxLogsBlocksCeiling < 0 {
+		return fmt.Errorf("negative max logs blocks ceiling [%d]", cfg.MaxLogsBlocksCeiling)
+	}
+	if cfg.ReorgGrace < 0 {
+		return fmt.Errorf("negative reorg grace [%d]", cfg.ReorgGrace)
+	}
+	if cfg.MaxReorgDepth < 0 {
+		return fmt.Errorf("negative max reorg depth [%d]", cfg.MaxReorgDepth)
+	}
+	if cfg.MaxReorgDepth != 0 && cfg.ReorgGrace > cfg.MaxReorgDepth {
+		return fmt.Errorf("reorg grace [%d] exceeds max reorg depth [%d]", cfg.ReorgGrace, cfg.MaxReorgDepth)
+	}
+	if cfg.ArchiveAge < 0 {
+		return fmt.Errorf("negative archive age [%d]", cfg.ArchiveAge)
+	}
+	if cfg.ReadOnlyFinality < 0 {
+		return fmt.Errorf("negative read-only finality [%s]", cfg.ReadOnlyFinality)
+	}
+	if cfg.MaxAmountBits < 0 || cfg.MaxAmountBits > 256 {
+		return fmt.Errorf("max amount bits [%d] out of range [0, 256]", cfg.MaxAmountBits)
+	}
+	if cfg.ConfirmationsCallbackRetries < 0 {
+		return fmt.Errorf("negative confirmations callback retries [%d]", cfg.ConfirmationsCallbackRetries)
+	}
+	if cfg.ColdStartLookback < 0 {
+		return fmt.Errorf("negative cold start lookback [%d]", cfg.ColdStartLookback)
+	}
+
+	return nil
+}
+
+func (cfg WatcherConfig) maxLogsBlocks() int64 {
+	if cfg.MaxLogsBlocks == 0 {
+		return defaultMaxLogsBlocks
+	}
+	return cfg.MaxLogsBlocks
+}
+
+func (cfg WatcherConfig) maxAmountBits() int {
+	if cfg.MaxAmountBits == 0 {
+		return defaultMaxAmountBits
+	}
+	return cfg.MaxAmountBits
+}
+
+func (cfg WatcherConfig) upgrades() *upgrades {
+	if !cfg.WatchUpgrades {
+		return nil
+	}
+	return newUpgrades(cfg.PauseOnUpgrade)
+}
+
+func (cfg WatcherConfig) confirmationsCallback(logger *log.Entry) *confirmationsCallback {
+	if cfg.ConfirmationsCallbackUrl == "" {
+		return nil
+	}
+	httpClient := &http.Client{Timeout: confirmationsCallbackTimeout}
+	return newConfirmationsCallback(cfg.ConfirmationsCallbackUrl, cfg.ConfirmationsCallbackRetries, httpClient, logger)
+}
+
+func (cfg WatcherConfig) sleepDuration() time.Duration {
+	if cfg.PollingInterval == 0 {
+		return defaultSleepDuration
+	}
+	return cfg.PollingInterval * time.Second
+}
+
+func (cfg WatcherConfig) pollInterval() *pollInterval {
+	if !cfg.AutoTunePolling {
+		return nil
+	}
+
+	min, max := defaultMinPollingInterval, defaultMaxPollingInterval
+	if cfg.MinPollingInterval != 0 {
+		min = cfg.MinPollingInterval * time.Second
+	}
+	if cfg.MaxPollingInterval != 0 {
+		max = cfg.MaxPollingInterval * time.Second
+	}
+	return newPollInterval(cfg.sleepDuration(), min, max)
+}
+
+// coldStartBlock returns the block, from which a watcher without a checkpoint starts -
+// the given lookback of blocks before the latest confirmed block, but not before the genesis block
+func coldStartBlock(confirmedBlock uint64, lookback int64) uint64 {
+	if uint64(lookback) >= confirmedBlock {
+		return 0
+	}
+	return confirmedBlock - uint64(lookback)
+}
+
+// NewWatcher creates an EVM watcher from the given positional configuration
+//
+// Deprecated: use NewWatcherFromConfig
 func NewWatcher(
 	repository repository.Status,
+	transferRepository repository.Transfer,
 	contracts service.Contracts,
 	prometheusService service.Prometheus,
 	pricingService service.Pricing,
@@ -103,99 +447,160 @@ func NewWatcher(
 	validator bool,
 	pollingInterval time.Duration,
 	maxLogsBlocks int64,
-	blacklistedAccounts []string) *Watcher {
-	currentBlock, err := evmClient.RetryBlockNumber()
-	if err != nil {
-		log.Fatalf("Could not retrieve latest block. Error: [%s].", err)
-	}
-	targetBlock := bigNumbersHelper.Max(0, currentBlock-evmClient.BlockConfirmations())
-
-	abi, err := abi.JSON(strings.NewReader(router.RouterABI))
-	if err != nil {
-		log.Fatalf("Failed to parse router ABI. Error: [%s]", err)
-	}
-
-	mintHash := abi.Events["Mint"].ID
-	burnHash := abi.Events["Burn"].ID
-	lockHash := abi.Events["Lock"].ID
-	unlockHash := abi.Events["Unlock"].ID
-	memberUpdatedHash := abi.Events["MemberUpdated"].ID
-	burnERC721Hash := abi.Events["BurnERC721"].ID
+	maxLogsBlocksCeiling int64,
+	partialRangeCommit bool,
+	reorgGrace int64,
+	readOnlyFinality time.Duration,
+	maxTransferAge time.Duration,
+	confirmationTiers map[uint64]uint64,
+	routerAbi string,
+	extraEvents []string,
+	feeOnTransferTokens map[string]bool,
+	servicedChains []uint64,
+	blacklistedAccounts []string,
+	watchersService service.Watchers) (*Watcher, error) {
+	return NewWatcherFromConfig(WatcherConfig{
+		Repository:           repository,
+		TransferRepository:   transferRepository,
+		Contracts:            contracts,
+		PrometheusService:    prometheusService,
+		PricingService:       pricingService,
+		EvmClient:            evmClient,
+		AssetsService:        assetsService,
+		WatchersService:      watchersService,
+		DbIdentifier:         dbIdentifier,
+		StartBlock:           startBlock,
+		Validator:            validator,
+		PollingInterval:      pollingInterval,
+		MaxLogsBlocks:        maxLogsBlocks,
+		MaxLogsBlocksCeiling: maxLogsBlocksCeiling,
+		PartialRangeCommit:   partialRangeCommit,
+		ReorgGrace:           reorgGrace,
+		ReadOnlyFinality:     readOnlyFinality,
+		MaxTransferAge:       maxTransferAge,
+		ConfirmationTiers:    confirmationTiers,
+		RouterAbi:            routerAbi,
+		ExtraEvents:          extraEvents,
+		FeeOnTransferTokens:  feeOnTransferTokens,
+		ServicedChains:       servicedChains,
+		BlacklistedAccounts:  blacklistedAccounts,
+	})
+}
 
-	topics := [][]common.Hash{
-		{
-			mintHash,
-			burnHash,
-			lockHash,
-			unlockHash,
-			memberUpdatedHash,
-			burnERC721Hash,
-		},
+// NewWatcherFromConfig validates the given configuration and creates an EVM watcher from it
+func NewWatcherFromConfig(cfg WatcherConfig) (*Watcher, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid watcher config: %w", err)
 	}
 
-	addresses := []common.Address{
-		contracts.Address(),
+	currentBlock, err := cfg.EvmClient.RetryBlockNumber()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve latest block: %w", err)
 	}
+	targetBlock := bigNumbersHelper.Max(0, currentBlock-cfg.EvmClient.BlockConfirmations())
 
-	if maxLogsBlocks == 0 {
-		maxLogsBlocks = defaultMaxLogsBlocks
+	maxLogsBlocks := cfg.maxLogsBlocks()
+	filterConfig, err := newFilterConfig(cfg.RouterAbi, cfg.ExtraEvents, cfg.Contracts.Address(), maxLogsBlocks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create filter config: %w", err)
 	}
-
-	filterConfig := FilterConfig{
-		abi:               abi,
-		topics:            topics,
-		addresses:         addresses,
-		mintHash:          mintHash,
-		burnHash:          burnHash,
-		lockHash:          lockHash,
-		unlockHash:        unlockHash,
-		burnERC721Hash:    burnERC721Hash,
-		memberUpdatedHash: memberUpdatedHash,
-		maxLogsBlocks:     maxLogsBlocks,
+	err = filterConfig.addEmitters(cfg.Emitters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add emitters to filter config: %w", err)
 	}
-
-	if pollingInterval == 0 {
-		pollingInterval = defaultSleepDuration
-	} else {
-		pollingInterval = pollingInterval * time.Second
+	if cfg.WatchUpgrades {
+		filterConfig.topics[0] = append(filterConfig.topics[0], diamondCutHash)
 	}
 
-	if startBlock == 0 {
-		_, err := repository.Get(dbIdentifier)
+	if cfg.StartBlock == 0 {
+		_, err := cfg.Repository.Get(cfg.DbIdentifier)
 		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				err := repository.Create(dbIdentifier, int64(targetBlock))
-				if err != nil {
-					log.Fatalf("[%s] - Failed to create Transfer Watcher timestamp. Error: [%s]", dbIdentifier, err)
-				}
-				log.Tracef("[%s] - Created new Transfer Watcher timestamp [%s]", dbIdentifier, timestamp.ToHumanReadable(int64(targetBlock)))
-			} else {
-				log.Fatalf("[%s] - Failed to fetch last Transfer Watcher timestamp. Error: [%s]", dbIdentifier, err)
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("[%s] - failed to fetch last Transfer Watcher timestamp: %w", cfg.DbIdentifier, err)
 			}
+			targetBlock = coldStartBlock(targetBlock, cfg.ColdStartLookback)
+			err := cfg.Repository.Create(cfg.DbIdentifier, int64(targetBlock))
+			if err != nil {
+				return nil, fmt.Errorf("[%s] - failed to create Transfer Watcher timestamp: %w", cfg.DbIdentifier, err)
+			}
+			log.Tracef("[%s] - Created new Transfer Watcher timestamp [%s]", cfg.DbIdentifier, timestamp.ToHumanReadable(int64(targetBlock)))
 		}
 	} else {
-		err := repository.Update(dbIdentifier, startBlock)
+		err := cfg.Repository.Update(cfg.DbIdentifier, cfg.StartBlock)
 		if err != nil {
-			log.Fatalf("[%s] - Failed to update Transfer Watcher Status timestamp. Error [%s]", dbIdentifier, err)
+			return nil, fmt.Errorf("[%s] - failed to update Transfer Watcher Status timestamp: %w", cfg.DbIdentifier, err)
 		}
-		targetBlock = uint64(startBlock)
-		log.Tracef("[%s] - Updated Transfer Watcher timestamp to [%s]", dbIdentifier, timestamp.ToHumanReadable(startBlock))
+		targetBlock = uint64(cfg.StartBlock)
+		log.Tracef("[%s] - Updated Transfer Watcher timestamp to [%s]", cfg.DbIdentifier, timestamp.ToHumanReadable(cfg.StartBlock))
 	}
-	return &Watcher{
-		repository:          repository,
-		dbIdentifier:        dbIdentifier,
-		contracts:           contracts,
-		prometheusService:   prometheusService,
-		pricingService:      pricingService,
-		evmClient:           evmClient,
-		logger:              c.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		assetsService:       assetsService,
-		targetBlock:         targetBlock,
-		validator:           validator,
-		sleepDuration:       pollingInterval,
-		filterConfig:        filterConfig,
-		blacklistedAccounts: blacklistedAccounts,
+
+	logger := c.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", cfg.DbIdentifier))
+	instance := &Watcher{
+		repository:                 cfg.Repository,
+		transferRepository:         cfg.TransferRepository,
+		dbIdentifier:               cfg.DbIdentifier,
+		contracts:                  cfg.Contracts,
+		prometheusService:          cfg.PrometheusService,
+		pricingService:             cfg.PricingService,
+		evmClient:                  cfg.EvmClient,
+		logger:                     logger,
+		assetsService:              cfg.AssetsService,
+		targetBlock:                targetBlock,
+		validator:                  cfg.Validator,
+		sleepDuration:              cfg.sleepDuration(),
+		filterConfig:               filterConfig,
+		blacklistedAccounts:        cfg.BlacklistedAccounts,
+		dispatched:                 newDispatchedTransfers(),
+		deferred:                   newDeferredEvents(),
+		reloadingMembers:           new(atomic.Bool),
+		watchersService:            cfg.WatchersService,
+		readOnlyFinality:           cfg.ReadOnlyFinality,
+		sleep:                      time.Sleep,
+		maxTransferAge:             cfg.MaxTransferAge,
+		confirmationTiers:          cfg.ConfirmationTiers,
+		feeOnTransferTokens:        cfg.FeeOnTransferTokens,
+		servicedChains:             toChainSet(cfg.ServicedChains),
+		logsRange:                  newLogsRange(maxLogsBlocks, cfg.MaxLogsBlocksCeiling),
+		partialRangeCommit:         cfg.PartialRangeCommit,
+		reorgGrace:                 cfg.ReorgGrace,
+		upgrades:                   cfg.upgrades(),
+		verifier:                   cfg.Verifier,
+		crossVerificationThreshold: cfg.CrossVerificationThreshold,
+		pollInterval:               cfg.pollInterval(),
+		dustAmounts:                cfg.DustAmounts,
+		roundingPolicies:           cfg.RoundingPolicies,
+		dropSelfTransfers:          cfg.DropSelfTransfers,
+		corridors:                  newCorridors(cfg.DisabledCorridors),
+		maxAmountBits:              cfg.maxAmountBits(),
+		blockHashes:                newBlockHashes(cfg.MaxReorgDepth),
+		shard:                      cfg.Shard,
+		archive:                    cfg.Archive,
+		archiveAge:                 cfg.ArchiveAge,
+		confirmationsCallback:      cfg.confirmationsCallback(logger),
+		logSampler:                 cfg.LogSampler,
 	}
+	event.On(constants.EventBridgeConfigUpdate, event.ListenerFunc(func(e event.Event) error {
+		return instance.corridors.bridgeCfgUpdateEventHandler(e)
+	}), constants.WatcherEventPriority)
+
+	return instance, nil
+}
+
+func toChainSet(chains []uint64) map[uint64]bool {
+	if len(chains) == 0 {
+		return nil
+	}
+
+	set := make(map[uint64]bool, len(chains))
+	for _, chain := range chains {
+		set[chain] = true
+	}
+	return set
+}
+
+// Partition returns the queue partition of the watcher, named by the chain id of its network
+func (ew *Watcher) Partition() string {
+	return strconv.FormatUint(ew.evmClient.GetChainID(), 10)
 }
 
 func (ew *Watcher) Watch(queue qi.Queue) {
@@ -214,40 +619,131 @@ func (ew Watcher) beginWatching(queue qi.Queue) {
 	}
 
 	ew.logger.Infof("Processing events from [%d]", fromBlock)
+	// The block, from which the last scan continued, is ahead of the checkpoint while events are deferred
+	lastScanFrom := fromBlock
+
+	if ew.contracts.MembersStale() {
+		ew.startMembersReload()
+	}
 
 	for {
-		fromBlock, err := ew.repository.Get(ew.dbIdentifier)
-		if err != nil {
-			ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
-			continue
-		}
+		sleep := ew.sleepDuration
+		ew.watchersService.Iterate(ew.dbIdentifier, func() {
+			sleep = ew.poll(queue, &lastScanFrom)
+		})
+		time.Sleep(sleep)
+	}
+}
 
-		currentBlock, err := ew.evmClient.RetryBlockNumber()
-		if err != nil {
-			ew.logger.Errorf("Failed to retrieve latest block number. Error [%s]", err)
-			time.Sleep(ew.sleepDuration)
-			continue
-		}
+// poll processes the logs of the next range of blocks and returns the time until the next poll.
+// It runs as an iteration of the watcher, which pausing and checkpoint overrides await
+func (ew Watcher) poll(queue qi.Queue, lastScanFrom *int64) time.Duration {
+	ew.reportSinceLastEvent()
 
-		toBlock := int64(currentBlock - ew.evmClient.BlockConfirmations())
-		if fromBlock > toBlock {
-			time.Sleep(ew.sleepDuration)
-			continue
-		}
+	checkpoint, err := ew.repository.Get(ew.dbIdentifier)
+	if err != nil {
+		ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
+		return 0
+	}
+	ew.checkCheckpointGap(*lastScanFrom, checkpoint)
 
-		if toBlock-fromBlock > ew.filterConfig.maxLogsBlocks {
-			toBlock = fromBlock + ew.filterConfig.maxLogsBlocks
-		}
+	// While events are deferred, the checkpoint is held behind the scanned blocks
+	scanFrom, halted := ew.checkReorg(ew.deferred.scanFrom(checkpoint))
+	if halted {
+		return 0
+	}
+	*lastScanFrom = scanFrom
 
-		err = ew.processLogs(fromBlock, toBlock, queue)
-		if err != nil {
-			ew.logger.Errorf("Failed to process logs. Error: [%s].", err)
-			time.Sleep(ew.sleepDuration)
-			continue
-		}
+	currentBlock, err := ew.evmClient.RetryBlockNumber()
+	if err != nil {
+		ew.logger.Errorf("Failed to retrieve latest block number. Error [%s]", err)
+		return ew.sleepDuration
+	}
+	ew.observeBlock(currentBlock)
 
-		time.Sleep(ew.sleepDuration)
+	confirmations := ew.evmClient.BlockConfirmations()
+	toBlock := int64(currentBlock - confirmations)
+	if scanFrom > toBlock {
+		return ew.pollDuration()
+	}
+
+	if toBlock-scanFrom > ew.logsRange.blocks() {
+		toBlock = scanFrom + ew.logsRange.blocks()
+	}
+
+	fromBlock := ew.rescanFrom(scanFrom)
+
+	err = ew.processLogs(fromBlock, toBlock, queue)
+	if err != nil {
+		ew.logger.Errorf("Failed to process logs. Error: [%s].", err)
+		return ew.sleepDuration
+	}
+	ew.recordBlocks(scanFrom, toBlock)
+	ew.invalidateVanished(toBlock)
+
+	// Events older than the confirmations window can no longer be removed by a reorg
+	if uint64(fromBlock) > confirmations {
+		ew.dispatched.prune(uint64(fromBlock) - confirmations)
+	}
+
+	return ew.pollDuration()
+}
+
+// ResetCheckpoint drops the deferred events and the blocks scanned ahead of the checkpoint, once it has been overridden,
+// so that the watcher continues from the new checkpoint
+func (ew *Watcher) ResetCheckpoint() {
+	ew.deferred.reset()
+	metrics.SetDeferredEvents(ew.dbIdentifier, 0, ew.prometheusService)
+	ew.logger.Warnf("Dropped the deferred events and the blocks scanned ahead of the overridden checkpoint.")
+}
+
+// observeBlock feeds the latest block of the chain to the poll interval, if auto-tuning is enabled
+func (ew Watcher) observeBlock(block uint64) {
+	if ew.pollInterval == nil {
+		return
+	}
+
+	previous := ew.pollInterval.duration()
+	ew.pollInterval.observe(block)
+	if current := ew.pollInterval.duration(); current != previous {
+		ew.logger.Debugf("Tuned polling interval from [%s] to [%s]", previous, current)
+	}
+}
+
+// pollDuration returns the interval until the next poll
+func (ew Watcher) pollDuration() time.Duration {
+	if ew.pollInterval == nil {
+		return ew.sleepDuration
 	}
+	return ew.pollInterval.duration()
+}
+
+// reportSinceLastEvent reports the time since the last dispatched event on every poll, so that it keeps
+// climbing on an idle chain, while the duration of the fetch phase proves that the watcher is polling
+func (ew Watcher) reportSinceLastEvent() {
+	metrics.SetSecondsSinceLastEvent(ew.dbIdentifier, ew.dispatched.sinceLast(), ew.prometheusService)
+}
+
+// rescanFrom returns the block, from which the logs are queried, so that the last reorgGrace blocks
+// before the checkpoint are re-scanned. Repeated dispatch of their transfers is guarded by the dispatched transfers.
+func (ew Watcher) rescanFrom(checkpoint int64) int64 {
+	if checkpoint < ew.reorgGrace {
+		return 0
+	}
+	return checkpoint - ew.reorgGrace
+}
+
+// checkCheckpointGap reports a checkpoint, which is ahead of the block, from which the last scan continued, by more than
+// a single range of blocks. The checkpoint is compared to the scanned blocks rather than the previous checkpoint, as it is
+// held behind them while events are deferred and jumps over them once the events are released.
+// Events in the skipped blocks are never processed, which is either a bug or the result of a manual override.
+func (ew Watcher) checkCheckpointGap(lastScanFrom, next int64) {
+	if next-lastScanFrom <= ew.logsRange.ceiling+1 {
+		return
+	}
+
+	ew.logger.Warnf("Checkpoint jumped from [%d] to [%d], beyond the maximum range of [%d] blocks. Events in the skipped blocks are not processed.", lastScanFrom, next, ew.logsRange.ceiling)
+	metrics.IncrementCheckpointGaps(ew.dbIdentifier, ew.prometheusService)
 }
 
 func (ew Watcher) CheckBlacklistedOriginator(hash common.Hash) (*string, error) {
@@ -271,7 +767,21 @@ func (ew Watcher) CheckBlacklistedOriginator(hash common.Hash) (*string, error)
 	return &originator, nil
 }
 
-func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
+// processLogs recovers from any unexpected panic in the log handlers and returns it as an error,
+// so that the range is not marked as processed and the watcher keeps running
+func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) (err error) {
+	// The last block of the range, whose logs have all been dispatched
+	completedBlock := fromBlock - 1
+	defer func() {
+		if r := recover(); r != nil {
+			ew.logger.Errorf("Recovered from panic while processing logs from [%d] to [%d]. Error: [%v]\n%s", fromBlock, endBlock, r, debug.Stack())
+			err = fmt.Errorf("panic while processing logs: %v", r)
+		}
+		if err != nil {
+			ew.commitPartialRange(fromBlock, completedBlock)
+		}
+	}()
+
 	query := ethereum.FilterQuery{
 		FromBlock: new(big.Int).SetInt64(fromBlock),
 		ToBlock:   new(big.Int).SetInt64(endBlock),
@@ -279,61 +789,56 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 		Topics:    ew.filterConfig.topics,
 	}
 
-	logs, err := ew.evmClient.RetryFilterLogs(query)
+	fetchStart := time.Now()
+	logs, err := ew.filterLogs(query)
+	metrics.ObserveWatcherPhaseDuration(ew.dbIdentifier, constants.WatcherPhaseFetch, fetchStart, ew.prometheusService)
 	if err != nil {
 		ew.logger.Errorf("Failed to filter logs. Error: [%s]", err)
+		ew.logsRange.reset()
 		return err
 	}
+	ew.logsRange.update(len(logs))
+
+	// Logs are dispatched in chain order, so that every block before the one of the current log is fully dispatched
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
 
+	dispatchStart := time.Now()
+	ew.retryDeferred(queue)
 	for _, log := range logs {
-		if len(log.Topics) > 0 {
-			if log.Topics[0] == ew.filterConfig.lockHash {
-				lock, err := ew.contracts.ParseLockLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse lock log [%s]. Error [%s].", lock.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleLockLog(lock, queue)
-			} else if log.Topics[0] == ew.filterConfig.unlockHash {
-				unlock, err := ew.contracts.ParseUnlockLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse unlock log [%s]. Error [%s].", unlock.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleUnlockLog(unlock)
-			} else if log.Topics[0] == ew.filterConfig.mintHash {
-				mint, err := ew.contracts.ParseMintLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse mint log [%s]. Error [%s].", mint.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleMintLog(mint)
-			} else if log.Topics[0] == ew.filterConfig.burnHash {
-				burn, err := ew.contracts.ParseBurnLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse burn log [%s]. Error [%s].", burn.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleBurnLog(burn, queue)
-			} else if log.Topics[0] == ew.filterConfig.memberUpdatedHash {
-				go ew.contracts.ReloadMembers()
-			} else if log.Topics[0] == ew.filterConfig.burnERC721Hash {
-				event, err := ew.contracts.ParseBurnERC721Log(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse burn ERC-721 log [%s]. Error [%s].", event.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleBurnERC721(event, queue)
+		if int64(log.BlockNumber) > completedBlock+1 {
+			completedBlock = int64(log.BlockNumber) - 1
+		}
+
+		if ew.isDiamondCut(log) {
+			if ew.handleDiamondCut(log) {
+				return errPausedOnUpgrade
 			}
+			continue
 		}
+		ew.handleLog(log, queue)
 	}
 
+	metrics.ObserveWatcherPhaseDuration(ew.dbIdentifier, constants.WatcherPhaseDispatch, dispatchStart, ew.prometheusService)
+
 	// Given that the log filtering boundaries are inclusive,
 	// the next time log filtering is done will start from the next block,
-	// so that processing of duplicate events does not occur
-	blockToBeUpdated := endBlock + 1
+	// so that processing of duplicate events does not occur.
+	// The checkpoint is held at the earliest deferred event, so that it is scanned again after a restart
+	ew.deferred.scanned(endBlock + 1)
+	blockToBeUpdated := ew.deferred.hold(endBlock + 1)
+	metrics.SetDeferredEvents(ew.dbIdentifier, ew.deferred.size(), ew.prometheusService)
+	if blockToBeUpdated <= endBlock {
+		ew.logger.Debugf("Holding the checkpoint at block [%d] of the earliest deferred event, while scanned up to [%d].", blockToBeUpdated, endBlock)
+	}
 
+	checkpointStart := time.Now()
 	err = ew.repository.Update(ew.dbIdentifier, blockToBeUpdated)
+	metrics.ObserveWatcherPhaseDuration(ew.dbIdentifier, constants.WatcherPhaseCheckpoint, checkpointStart, ew.prometheusService)
 	if err != nil {
 		ew.logger.Errorf("Failed to update latest processed block [%d]. Error: [%s]", blockToBeUpdated, err)
 		return err
@@ -342,8 +847,451 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 	return nil
 }
 
+// retryDeferred handles the deferred events again. Events, which are still not ready to be dispatched, are deferred again
+func (ew *Watcher) retryDeferred(queue qi.Queue) {
+	for _, raw := range ew.deferred.pending() {
+		ew.deferred.remove(raw)
+		ew.handleLog(raw, queue)
+	}
+}
+
+// handleLog handles the given log of the router or an auxiliary emitter
+func (ew *Watcher) handleLog(log types.Log, queue qi.Queue) {
+	if len(log.Topics) == 0 {
+		return
+	}
+
+	// Logs of auxiliary emitters are never handled as router events, even if their signatures match
+	if event, watched, isEmitter := ew.filterConfig.emitterEvent(log); isEmitter {
+		if watched {
+			ew.handleEmitterLog(event, log)
+		}
+		return
+	}
+
+	if log.Topics[0] == ew.filterConfig.lockHash {
+		lock, err := ew.contracts.ParseLockLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse lock log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleLockLog(lock, queue)
+	} else if log.Topics[0] == ew.filterConfig.unlockHash {
+		unlock, err := ew.contracts.ParseUnlockLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse unlock log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleUnlockLog(unlock)
+	} else if log.Topics[0] == ew.filterConfig.mintHash {
+		mint, err := ew.contracts.ParseMintLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse mint log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleMintLog(mint)
+	} else if log.Topics[0] == ew.filterConfig.burnHash {
+		burn, err := ew.contracts.ParseBurnLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse burn log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleBurnLog(burn, queue)
+	} else if log.Topics[0] == ew.filterConfig.memberUpdatedHash {
+		ew.startMembersReload()
+	} else if log.Topics[0] == ew.filterConfig.burnERC721Hash {
+		event, err := ew.contracts.ParseBurnERC721Log(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse burn ERC-721 log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleBurnERC721(event, queue)
+	} else if log.Topics[0] == ew.filterConfig.nativeTokenUpdatedHash {
+		ew.handleNativeTokenUpdated(log)
+	} else if name, ok := ew.filterConfig.extraEvents[log.Topics[0]]; ok {
+		ew.handleExtraLog(name, log)
+	}
+}
+
+// commitPartialRange checkpoints the blocks of the range, whose logs were all dispatched before processing failed,
+// so that only the undispatched tail of the range is reprocessed. The repeated dispatch of the logs of the failed
+// block is guarded by the dispatched transfers. The checkpoint is never lowered, as the range starts before it,
+// when the last blocks before the checkpoint are re-scanned for reorgs.
+func (ew Watcher) commitPartialRange(fromBlock, completedBlock int64) {
+	if !ew.partialRangeCommit || completedBlock < fromBlock {
+		return
+	}
+
+	checkpoint := ew.deferred.hold(completedBlock + 1)
+	current, err := ew.repository.Get(ew.dbIdentifier)
+	if err != nil {
+		ew.logger.Errorf("Failed to retrieve the checkpoint to commit partially processed range up to block [%d]. Error: [%s]", completedBlock, err)
+		return
+	}
+	if checkpoint <= current {
+		return
+	}
+
+	err = ew.repository.Update(ew.dbIdentifier, checkpoint)
+	if err != nil {
+		ew.logger.Errorf("Failed to commit partially processed range up to block [%d]. Error: [%s]", completedBlock, err)
+		return
+	}
+
+	ew.logger.Warnf("Committed partially processed range from [%d] to [%d]. Processing resumes from [%d].", fromBlock, completedBlock, completedBlock+1)
+}
+
+// dispatch stores the raw event log of the transfer, pushes the transfer for processing,
+// keeps track of it in case its event log gets removed and counts the pushes per topic.
+// Returns false for transfers, which have already been dispatched
+func (ew *Watcher) dispatch(q qi.Queue, transfer *payload.Transfer, topic string, raw types.Log) bool {
+	ctx, span := tracing.Start(transfer.Context(), "evm.dispatch", transfer.TransactionId)
+	defer span.End()
+
+	logger := c.WithContext(ew.logger, ctx)
+	if ew.dispatched.reconfirm(transfer.TransactionId, raw.BlockNumber) {
+		logger.Debugf("[%s] - Transfer is still included after a reorg.", transfer.TransactionId)
+		return false
+	}
+	if ew.dispatched.has(transfer.TransactionId) {
+		logger.Debugf("[%s] - Skipping already dispatched transfer.", transfer.TransactionId)
+		return false
+	}
+
+	err := ew.transferRepository.CreateEventLog(entity.NewEventLog(transfer.TransactionId, transfer.SourceChainId, raw))
+	if err != nil {
+		logger.Errorf("[%s] - Failed to store raw event log. Error: [%s]", transfer.TransactionId, err)
+	}
+
+	ew.dispatched.add(transfer, raw.BlockNumber)
+	q.Push(&queue.Message{Payload: transfer, Topic: topic, Trace: tracing.Inject(ctx)})
+	metrics.IncrementQueuePushes(topic, ew.prometheusService)
+	return true
+}
+
+// invalidateVanished marks the dispatched transfers as failed, whose event has disappeared from the chain after a reorg,
+// now that the rewound blocks up to the given one have been re-scanned, so that they do not get completed.
+// Transfers, which are still queued for processing, are stored as failed, so that their handler skips them,
+// while transfers, which have already been completed, are left as they are
+func (ew Watcher) invalidateVanished(uptoBlock int64) {
+	for _, transfer := range ew.dispatched.vanished(uint64(uptoBlock)) {
+		invalidated, err := ew.invalidate(transfer)
+		if err != nil {
+			ew.logger.Errorf("[%s] - Failed to invalidate dispatched transfer, whose event disappeared after a reorg. Error: [%s]", transfer.TransactionId, err)
+			continue
+		}
+		if !invalidated {
+			ew.logger.Warnf("[%s] - Dispatched transfer, whose event disappeared after a reorg, is no longer pending. Leaving it as it is.", transfer.TransactionId)
+			continue
+		}
+		ew.logger.Warnf("[%s] - Invalidated dispatched transfer, whose event disappeared after a reorg.", transfer.TransactionId)
+	}
+}
+
+// invalidate fails the given transfer, if it is still Initial, or stores it as failed, if it has not been stored yet.
+// Returns whether the transfer has been invalidated
+func (ew Watcher) invalidate(transfer *payload.Transfer) (bool, error) {
+	failed, err := ew.transferRepository.UpdateStatusFailedIfInitial(transfer.SourceChainId, transfer.TransactionId)
+	if err != nil || failed {
+		return failed, err
+	}
+
+	stored, err := ew.transferRepository.CreateFailedIfAbsent(transfer)
+	if err != nil || stored {
+		return stored, err
+	}
+
+	// The handler may have stored the transfer in the meantime
+	return ew.transferRepository.UpdateStatusFailedIfInitial(transfer.SourceChainId, transfer.TransactionId)
+}
+
+// startMembersReload reloads the router members in the background, unless a reload is already in flight
+func (ew *Watcher) startMembersReload() {
+	if !ew.reloadingMembers.CompareAndSwap(false, true) {
+		ew.logger.Debugf("Router members reload is already in flight.")
+		return
+	}
+
+	go func() {
+		defer ew.reloadingMembers.Store(false)
+		ew.reloadMembers()
+	}()
+}
+
+// reloadMembers reloads the router members, retrying with exponential backoff until it succeeds
+func (ew *Watcher) reloadMembers() {
+	chainId := ew.evmClient.GetChainID()
+	backoff := minReloadMembersBackoff
+	for {
+		err := ew.contracts.ReloadMembers()
+		if err == nil {
+			metrics.SetMembersStale(chainId, false, ew.prometheusService)
+			return
+		}
+
+		ew.logger.Errorf("Failed to reload router members. Retrying in [%s]. Error: [%s]", backoff, err)
+		metrics.SetMembersStale(chainId, true, ew.prometheusService)
+		ew.sleep(backoff)
+
+		backoff *= 2
+		if backoff > maxReloadMembersBackoff {
+			backoff = maxReloadMembersBackoff
+		}
+	}
+}
+
+// shouldProcess checks whether an event should be auto-processed, or only routed to the read-only path
+func (ew *Watcher) shouldProcess(blockNumber, blockTimestamp, sourceChainId, targetChainId uint64) bool {
+	if !ew.validator || blockNumber < ew.targetBlock {
+		return false
+	}
+
+	if !ew.corridors.enabled(sourceChainId, targetChainId) {
+		ew.logger.Warnf("Event from block [%d] is in the disabled corridor [%d] -> [%d]. Routing it for manual review.", blockNumber, sourceChainId, targetChainId)
+		return false
+	}
+
+	if timestamp.Expired(time.Unix(int64(blockTimestamp), 0), time.Now(), ew.maxTransferAge) {
+		ew.logger.Warnf("Event from block [%d] is older than the max transfer age [%s]. Routing it for manual review.", blockNumber, ew.maxTransferAge)
+		return false
+	}
+
+	return true
+}
+
+// ownsSubmission checks whether the Hedera submission of a transfer falls in the shard of the watcher. Transfers to
+// other shards are submitted by the other instances of the validator and only recorded as read-only. Transfers to EVM
+// networks are only signed, so they are processed by every instance and the majority signing is unaffected by sharding
+func (ew *Watcher) ownsSubmission(transfer *payload.Transfer, txHash common.Hash) bool {
+	if transfer.TargetChainId != constants.HederaNetworkId || shard.Owns(transfer.Receiver, ew.shard.Index, ew.shard.Count) {
+		return true
+	}
+
+	ew.logger.Debugf("[%s] - Receiver [%s] is outside of shard [%d/%d]. Recording as read-only.", txHash, transfer.Receiver, ew.shard.Index, ew.shard.Count)
+	return false
+}
+
+// isServicedChain checks whether the given chain, referenced by an event, is serviced by the validator.
+// Events, referencing unsupported chains, are dropped and counted.
+func (ew *Watcher) isServicedChain(chainId uint64, txHash common.Hash) bool {
+	if len(ew.servicedChains) == 0 || ew.servicedChains[chainId] {
+		return true
+	}
+
+	ew.logger.Errorf("[%s] - Event references unsupported chain [%d]. Skipping.", txHash, chainId)
+	metrics.IncrementDroppedEvents(ew.dbIdentifier, constants.DropReasonUnsupportedChain, ew.prometheusService)
+	return false
+}
+
+// isDeniedAsset checks whether the given asset of the watched network is on the runtime deny-list.
+// Events for denied assets are dropped and counted.
+func (ew *Watcher) isDeniedAsset(chainId uint64, asset string, txHash common.Hash) bool {
+	if !ew.watchersService.IsAssetDenied(chainId, asset) {
+		return false
+	}
+
+	ew.logger.Warnf("[%s] - Asset [%s] is denied. Skipping.", txHash, asset)
+	metrics.IncrementDroppedEvents(ew.dbIdentifier, constants.DropReasonDeniedAsset, ew.prometheusService)
+	return true
+}
+
+// isDust checks whether the given amount, in the lowest denomination of the given native asset, is zero or
+// below the dust threshold of the asset. Dust transfers are dropped and counted.
+func (ew *Watcher) isDust(nativeChainId uint64, nativeAsset string, amount *big.Int, txHash common.Hash) bool {
+	threshold := ew.dustAmounts[nativeChainId][nativeAsset]
+	if amount.Sign() > 0 && (threshold == nil || amount.Cmp(threshold) >= 0) {
+		return false
+	}
+
+	ew.logger.Warnf("[%s] - Amount [%s] of asset [%s] is dust. Skipping.", txHash, amount, nativeAsset)
+	metrics.IncrementDroppedEvents(ew.dbIdentifier, constants.DropReasonDust, ew.prometheusService)
+	return true
+}
+
+// isSelfTransfer checks whether the receiver of a transfer is its originator, if such transfers are dropped.
+// Self-transfers are dropped and counted.
+func (ew *Watcher) isSelfTransfer(originator, receiver string, txHash common.Hash) bool {
+	if !ew.dropSelfTransfers || !strings.EqualFold(originator, receiver) {
+		return false
+	}
+
+	ew.logger.Warnf("[%s] - Receiver [%s] is the originator of the transfer. Skipping.", txHash, receiver)
+	metrics.IncrementDroppedEvents(ew.dbIdentifier, constants.DropReasonSelfTransfer, ew.prometheusService)
+	return true
+}
+
+// erc20TransferHash is the topic of the ERC-20 Transfer event
+var erc20TransferHash = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// lockedAmount returns the amount, actually received by the router for the given Lock event.
+// For fee-on-transfer tokens, it is the value of the last Transfer of the token to the router, logged
+// before the Lock event in the same transaction receipt, capped at the event amount. Returns nil, if there
+// is no such Transfer. For every other token, it is the event amount.
+func (ew *Watcher) lockedAmount(eventLog *router.RouterLock) (*big.Int, error) {
+	if !ew.feeOnTransferTokens[eventLog.Token.String()] {
+		return eventLog.Amount, nil
+	}
+
+	receipt, err := ew.evmClient.GetClient().TransactionReceipt(context.Background(), eventLog.Raw.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	routerTopic := common.BytesToHash(ew.contracts.Address().Bytes())
+	var received *big.Int
+	for _, l := range receipt.Logs {
+		if l.Index >= eventLog.Raw.Index || l.Address != eventLog.Token {
+			continue
+		}
+		if len(l.Topics) != 3 || l.Topics[0] != erc20TransferHash || l.Topics[2] != routerTopic {
+			continue
+		}
+		received = new(big.Int).SetBytes(l.Data)
+	}
+
+	if received == nil || received.Sign() <= 0 {
+		return nil, nil
+	}
+	if received.Cmp(eventLog.Amount) > 0 {
+		return eventLog.Amount, nil
+	}
+	return received, nil
+}
+
+// tierConfirmations returns the block confirmations of the highest tier, reached by the given amount.
+// Returns 0 if the amount does not reach any of the configured tiers.
+func (ew *Watcher) tierConfirmations(amount, minAmount *big.Int) uint64 {
+	confirmations := uint64(0)
+	if minAmount == nil || minAmount.Sign() <= 0 {
+		return confirmations
+	}
+
+	for multiplier, tierConfirmations := range ew.confirmationTiers {
+		threshold := new(big.Int).Mul(minAmount, new(big.Int).SetUint64(multiplier))
+		if amount.Cmp(threshold) >= 0 && tierConfirmations > confirmations {
+			confirmations = tierConfirmations
+		}
+	}
+
+	return confirmations
+}
+
+// confirmed returns whether the block of an event has the given confirmations, if they exceed the default
+// block confirmations of the client. Otherwise, or if they cannot be checked, the event is deferred to a later poll.
+// Events, which have been moved out of their block meanwhile, are dropped
+func (ew *Watcher) confirmed(raw types.Log, confirmations uint64) bool {
+	if confirmations <= ew.evmClient.BlockConfirmations() {
+		return true
+	}
+
+	head, err := ew.evmClient.RetryBlockNumber()
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to retrieve latest block number. Deferring the event. Error [%s]", raw.TxHash, err)
+		ew.deferred.add(raw)
+		return false
+	}
+	if head < raw.BlockNumber+confirmations {
+		ew.logger.Infof("[%s] - Deferring the event until its block has [%d] confirmations.", raw.TxHash, confirmations)
+		ew.deferred.add(raw)
+		return false
+	}
+
+	receipt, err := ew.evmClient.GetClient().TransactionReceipt(context.Background(), raw.TxHash)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to get transaction receipt after awaiting confirmations. Deferring the event. Error: [%s]", raw.TxHash, err)
+		ew.deferred.add(raw)
+		return false
+	}
+	if receipt.BlockHash != raw.BlockHash {
+		ew.logger.Errorf("[%s] - Transaction has been moved from its original block [%s].", raw.TxHash, raw.BlockHash)
+		return false
+	}
+
+	return true
+}
+
+// readOnlyFinal returns whether the block timestamp of a read-only event is at least readOnlyFinality old,
+// relative to the timestamp of the latest block. Otherwise, the event is deferred to a later poll
+func (ew *Watcher) readOnlyFinal(raw types.Log, blockTimestamp uint64) bool {
+	if ew.readOnlyFinality == 0 {
+		return true
+	}
+
+	head, err := ew.evmClient.RetryBlockNumber()
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to retrieve latest block number. Deferring the read-only event. Error [%s]", raw.TxHash, err)
+		ew.deferred.add(raw)
+		return false
+	}
+
+	headTimestamp := ew.evmClient.GetBlockTimestamp(new(big.Int).SetUint64(head))
+	age := time.Duration(int64(headTimestamp)-int64(blockTimestamp)) * time.Second
+	if age < ew.readOnlyFinality {
+		ew.logger.Debugf("[%s] - Deferring the read-only event for [%s], until its block is final.", raw.TxHash, ew.readOnlyFinality-age)
+		ew.deferred.add(raw)
+		return false
+	}
+
+	return true
+}
+
+// handleExtraLog logs and stores the raw log of a watched event, which has no dedicated handler
+func (ew *Watcher) handleExtraLog(name string, raw types.Log) {
+	id := fmt.Sprintf("%s-%d", raw.TxHash, raw.Index)
+	fields := make(map[string]interface{})
+	err := ew.filterConfig.abi.UnpackIntoMap(fields, name, raw.Data)
+	if err != nil {
+		ew.logger.Warnf("[%s] - Failed to unpack [%s] event data. Error: [%s]", id, name, err)
+	}
+	if !ew.logSampler.Skip(nil, nil) {
+		ew.logger.Infof("[%s] - New [%s] Event Log received with fields [%v].", id, name, fields)
+	}
+
+	err = ew.transferRepository.CreateEventLog(entity.NewEventLog(id, ew.evmClient.GetChainID(), raw))
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to store raw [%s] event log. Error: [%s]", id, name, err)
+	}
+}
+
+// nativeTokenUpdated holds the data of the NativeTokenUpdated router event
+type nativeTokenUpdated struct {
+	Token      common.Address
+	ServiceFee *big.Int
+	Status     bool
+}
+
+// handleNativeTokenUpdated adds the token to the runtime deny-list once the router disables it.
+// Re-enabling the token on-chain does not remove it from the deny-list, as that has to be done explicitly.
+func (ew *Watcher) handleNativeTokenUpdated(raw types.Log) {
+	if raw.Removed {
+		ew.logger.Debugf("[%s] - Uncle block transaction was removed.", raw.TxHash)
+		return
+	}
+
+	event := new(nativeTokenUpdated)
+	err := ew.filterConfig.abi.UnpackIntoInterface(event, nativeTokenUpdatedEvent, raw.Data)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Could not parse native token updated log. Error [%s].", raw.TxHash, err)
+		return
+	}
+
+	chainId := ew.evmClient.GetChainID()
+	token := event.Token.String()
+	if !event.Status {
+		ew.logger.Warnf("[%s] - Token [%s] was disabled by the router. Denying its transfers.", raw.TxHash, token)
+		ew.watchersService.DenyAsset(chainId, token)
+		return
+	}
+
+	if ew.watchersService.IsAssetDenied(chainId, token) {
+		ew.logger.Warnf("[%s] - Token [%s] was enabled by the router, but stays denied until explicitly re-enabled.", raw.TxHash, token)
+	}
+}
+
 func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
-	ew.logger.Infof("[%s] - New Mint Event Log received [%s]", eventLog.TransactionId, eventLog.Raw.TxHash)
+	if !ew.logSampler.Skip(nil, nil) {
+		ew.logger.Infof("[%s] - New Mint Event Log received [%s]", eventLog.TransactionId, eventLog.Raw.TxHash)
+	}
 
 	if eventLog.Raw.Removed {
 		ew.logger.Debugf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
@@ -352,6 +1300,15 @@ func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
 
 	transactionId := string(eventLog.TransactionId)
 	sourceChainId := eventLog.SourceChain.Uint64()
+	if !ew.isServicedChain(sourceChainId, eventLog.Raw.TxHash) {
+		return
+	}
+
+	// the log is only used to record the transfer as completed
+	if !ew.prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
 	targetChainId := ew.evmClient.GetChainID()
 	oppositeToken := ew.assetsService.OppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
 
@@ -366,12 +1323,15 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		return
 	}
 
-	if len(eventLog.Receiver) == 0 {
-		ew.logger.Errorf("[%s] - Empty receiver account.", eventLog.Raw.TxHash)
+	if !ew.isValidEvent(eventArgs{eventLog.Receiver, eventLog.Token, eventLog.TargetChain, eventLog.Amount}, eventLog.Raw.TxHash) {
 		return
 	}
 
 	sourceChainId := ew.evmClient.GetChainID()
+	if ew.isDeniedAsset(sourceChainId, eventLog.Token.String(), eventLog.Raw.TxHash) {
+		return
+	}
+
 	nativeAsset := ew.assetsService.WrappedToNative(eventLog.Token.String(), sourceChainId)
 	if nativeAsset == nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve native asset of [%s].", eventLog.Raw.TxHash, eventLog.Token)
@@ -411,7 +1371,7 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		recipientAccount = common.BytesToAddress(eventLog.Receiver).String()
 	}
 
-	targetAmount, err := ew.convertTargetAmount(sourceChainId, targetChainId, token, nativeAsset.Asset, eventLog.Amount)
+	targetAmount, targetDecimals, remainder, err := ew.convertTargetAmount(eventLog.Raw, sourceChainId, targetChainId, token, nativeAsset.Asset, eventLog.Amount)
 	if err != nil {
 		ew.logger.Errorf("[%s] - Failed to convert to target amount. Error: [%s]", eventLog.Raw.TxHash, err)
 		return
@@ -428,6 +1388,10 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		return
 	}
 
+	if ew.isDust(nativeAsset.ChainId, nativeAsset.Asset, targetAmount, eventLog.Raw.TxHash) {
+		return
+	}
+
 	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
 	originator, err := ew.CheckBlacklistedOriginator(eventLog.Raw.TxHash)
 	if err != nil {
@@ -435,6 +1399,10 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		return
 	}
 
+	if ew.isSelfTransfer(*originator, recipientAccount, eventLog.Raw.TxHash) {
+		return
+	}
+
 	burnEvent := &payload.Transfer{
 		TransactionId: transactionId,
 		SourceChainId: sourceChainId,
@@ -445,31 +1413,54 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		NativeAsset:   nativeAsset.Asset,
 		Receiver:      recipientAccount,
 		Amount:        targetAmount.String(),
+		Decimals:      targetDecimals,
 		Originator:    *originator,
 		Timestamp:     time.Unix(int64(blockTimestamp), 0).UTC(),
 	}
 
-	ew.logger.Infof("[%s] - New Burn Event Log with Amount [%s], Receiver Address [%s] has been found.",
-		eventLog.Raw.TxHash.String(),
-		eventLog.Amount.String(),
-		recipientAccount)
+	if !ew.logSampler.Skip(targetAmount, tokenPriceInfo.MinAmountWithFee) {
+		ew.logger.Infof("[%s] - New Burn Event Log with Amount [%s], Receiver Address [%s] has been found.",
+			eventLog.Raw.TxHash.String(),
+			eventLog.Amount.String(),
+			recipientAccount)
+	}
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
 
-	if ew.validator && currentBlockNumber >= ew.targetBlock {
+	var topic string
+	var confirmations uint64
+	if ew.shouldProcess(currentBlockNumber, blockTimestamp, burnEvent.SourceChainId, burnEvent.TargetChainId) && ew.ownsSubmission(burnEvent, eventLog.Raw.TxHash) {
+		confirmations = ew.tierConfirmations(targetAmount, tokenPriceInfo.MinAmountWithFee)
+		if !ew.confirmed(eventLog.Raw, confirmations) {
+			return
+		}
+		if !ew.crossVerify(eventLog.Raw, targetAmount, tokenPriceInfo.MinAmountWithFee) {
+			return
+		}
 		if burnEvent.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.HederaFeeTransfer})
+			topic = constants.HederaFeeTransfer
 		} else {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.TopicMessageSubmission})
+			topic = constants.TopicMessageSubmission
 		}
 	} else {
+		if !ew.readOnlyFinal(eventLog.Raw, blockTimestamp) {
+			return
+		}
 		burnEvent.NetworkTimestamp = strconv.FormatUint(blockTimestamp, 10)
 		if burnEvent.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.ReadOnlyHederaTransfer})
+			topic = constants.ReadOnlyHederaTransfer
 		} else {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.ReadOnlyTransferSave})
+			topic = constants.ReadOnlyTransferSave
 		}
 	}
+
+	if !ew.dispatch(q, burnEvent, topic, eventLog.Raw) {
+		return
+	}
+	ew.confirmationsReached(eventLog.Raw, confirmations, burnEvent)
+	if remainder.Sign() != 0 {
+		metrics.AddRoundingLoss(sourceChainId, token, remainder, ew.prometheusService)
+	}
 }
 
 func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
@@ -484,19 +1475,51 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		return
 	}
 
-	if len(eventLog.Receiver) == 0 {
-		ew.logger.Errorf("[%s] - Empty receiver account.", eventLog.Raw.TxHash)
+	if !ew.isValidEvent(eventArgs{eventLog.Receiver, eventLog.Token, eventLog.TargetChain, eventLog.Amount}, eventLog.Raw.TxHash) {
+		return
+	}
+
+	if eventLog.ServiceFee.Cmp(eventLog.Amount) > 0 {
+		ew.logger.Errorf("[%s] - Service Fee [%s] exceeds Amount [%s].", eventLog.Raw.TxHash, eventLog.ServiceFee, eventLog.Amount)
+		return
+	}
+
+	amount := new(big.Int).Sub(eventLog.Amount, eventLog.ServiceFee)
+	if amount.Sign() <= 0 {
+		ew.logger.Errorf("[%s] - Non-positive Amount [%s] after Service Fee [%s].", eventLog.Raw.TxHash, amount, eventLog.ServiceFee)
+		return
+	}
+
+	lockedAmount, err := ew.lockedAmount(eventLog)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to get transaction receipt to determine locked amount. Deferring the event. Error: [%s]", eventLog.Raw.TxHash, err)
+		ew.deferred.add(eventLog.Raw)
+		return
+	}
+	if lockedAmount == nil {
+		ew.logger.Errorf("[%s] - No Transfer of fee-on-transfer token [%s] to the router found before the Lock event.", eventLog.Raw.TxHash, token)
 		return
 	}
+	if lockedAmount.Cmp(eventLog.Amount) != 0 {
+		ew.logger.Infof("[%s] - Fee-on-transfer token [%s] locked [%s] out of event Amount [%s].", eventLog.Raw.TxHash, token, lockedAmount, eventLog.Amount)
+		amount = new(big.Int).Sub(lockedAmount, eventLog.ServiceFee)
+		if amount.Sign() <= 0 {
+			ew.logger.Errorf("[%s] - Non-positive Amount [%s] after Service Fee [%s].", eventLog.Raw.TxHash, amount, eventLog.ServiceFee)
+			return
+		}
+	}
 
 	sourceChainId := ew.evmClient.GetChainID()
+	if ew.isDeniedAsset(sourceChainId, token, eventLog.Raw.TxHash) {
+		return
+	}
+
 	if targetChainId != constants.HederaNetworkId {
 		metrics.CreateMajorityReachedIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
 	}
 	metrics.CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
 
 	recipientAccount := ""
-	var err error
 	if targetChainId == constants.HederaNetworkId {
 		recipient, err := hedera.AccountIDFromBytes(eventLog.Receiver)
 		if err != nil {
@@ -514,8 +1537,7 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		return
 	}
 
-	amount := new(big.Int).Sub(eventLog.Amount, eventLog.ServiceFee)
-	targetAmount, err := ew.convertTargetAmount(sourceChainId, targetChainId, token, wrappedAsset, amount)
+	targetAmount, targetDecimals, remainder, err := ew.convertTargetAmount(eventLog.Raw, sourceChainId, targetChainId, token, wrappedAsset, amount)
 	if err != nil {
 		ew.logger.Errorf("[%s] - Failed to convert to target amount. Error: [%s]", eventLog.Raw.TxHash, err)
 		return
@@ -528,8 +1550,12 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		return
 	}
 
-	if eventLog.Amount.Cmp(tokenPriceInfo.MinAmountWithFee) < 0 {
-		ew.logger.Errorf("[%s] - Transfer Amount [%s] less than Minimum Amount [%s].", eventLog.Raw.TxHash, eventLog.Amount, tokenPriceInfo.MinAmountWithFee)
+	if lockedAmount.Cmp(tokenPriceInfo.MinAmountWithFee) < 0 {
+		ew.logger.Errorf("[%s] - Transfer Amount [%s] less than Minimum Amount [%s].", eventLog.Raw.TxHash, lockedAmount, tokenPriceInfo.MinAmountWithFee)
+		return
+	}
+
+	if ew.isDust(sourceChainId, token, lockedAmount, eventLog.Raw.TxHash) {
 		return
 	}
 
@@ -540,6 +1566,10 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		return
 	}
 
+	if ew.isSelfTransfer(*originator, recipientAccount, eventLog.Raw.TxHash) {
+		return
+	}
+
 	tr := &payload.Transfer{
 		TransactionId: transactionId,
 		SourceChainId: sourceChainId,
@@ -550,33 +1580,56 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		NativeAsset:   token,
 		Receiver:      recipientAccount,
 		Amount:        targetAmount.String(),
+		Decimals:      targetDecimals,
 		Originator:    *originator,
 		Timestamp:     time.Unix(int64(blockTimestamp), 0).UTC(),
 	}
 
-	ew.logger.Infof("[%s] - New Lock Event Log with Amount [%s], Receiver Address [%s], Source Chain [%d] and Target Chain [%d] has been found.",
-		eventLog.Raw.TxHash.String(),
-		targetAmount,
-		recipientAccount,
-		sourceChainId,
-		eventLog.TargetChain.Int64())
+	if !ew.logSampler.Skip(lockedAmount, tokenPriceInfo.MinAmountWithFee) {
+		ew.logger.Infof("[%s] - New Lock Event Log with Amount [%s], Receiver Address [%s], Source Chain [%d] and Target Chain [%d] has been found.",
+			eventLog.Raw.TxHash.String(),
+			targetAmount,
+			recipientAccount,
+			sourceChainId,
+			eventLog.TargetChain.Int64())
+	}
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
 
-	if ew.validator && currentBlockNumber >= ew.targetBlock {
+	var topic string
+	var confirmations uint64
+	if ew.shouldProcess(currentBlockNumber, blockTimestamp, tr.SourceChainId, tr.TargetChainId) && ew.ownsSubmission(tr, eventLog.Raw.TxHash) {
+		confirmations = ew.tierConfirmations(lockedAmount, tokenPriceInfo.MinAmountWithFee)
+		if !ew.confirmed(eventLog.Raw, confirmations) {
+			return
+		}
+		if !ew.crossVerify(eventLog.Raw, lockedAmount, tokenPriceInfo.MinAmountWithFee) {
+			return
+		}
 		if tr.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.HederaMintHtsTransfer})
+			topic = constants.HederaMintHtsTransfer
 		} else {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.TopicMessageSubmission})
+			topic = constants.TopicMessageSubmission
 		}
 	} else {
+		if !ew.readOnlyFinal(eventLog.Raw, blockTimestamp) {
+			return
+		}
 		tr.NetworkTimestamp = strconv.FormatUint(blockTimestamp, 10)
 		if tr.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.ReadOnlyHederaMintHtsTransfer})
+			topic = constants.ReadOnlyHederaMintHtsTransfer
 		} else {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.ReadOnlyTransferSave})
+			topic = constants.ReadOnlyTransferSave
 		}
 	}
+
+	if !ew.dispatch(q, tr, topic, eventLog.Raw) {
+		return
+	}
+	ew.confirmationsReached(eventLog.Raw, confirmations, tr)
+	if remainder.Sign() != 0 {
+		metrics.AddRoundingLoss(sourceChainId, token, remainder, ew.prometheusService)
+	}
 }
 
 func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queue) {
@@ -587,12 +1640,15 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 		return
 	}
 
-	if len(eventLog.Receiver) == 0 {
-		ew.logger.Errorf("[%s] - Empty receiver account.", eventLog.Raw.TxHash)
+	if !ew.isValidEvent(eventArgs{receiver: eventLog.Receiver, token: eventLog.WrappedToken, targetChain: eventLog.TargetChain}, eventLog.Raw.TxHash) {
 		return
 	}
 
 	sourceChainId := ew.evmClient.GetChainID()
+	if ew.isDeniedAsset(sourceChainId, eventLog.WrappedToken.String(), eventLog.Raw.TxHash) {
+		return
+	}
+
 	nativeAsset := ew.assetsService.WrappedToNative(eventLog.WrappedToken.String(), sourceChainId)
 	if nativeAsset == nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve native asset of [%s].", eventLog.Raw.TxHash, eventLog.WrappedToken)
@@ -642,33 +1698,43 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 		Timestamp:     time.Unix(int64(blockTimestamp), 0).UTC(),
 	}
 
-	ew.logger.Infof("[%s] - New ERC-721Burn ERC-721 Event Log with TokenId [%d], Receiver Address [%s] has been found.",
-		eventLog.Raw.TxHash.String(),
-		eventLog.TokenId.Int64(),
-		recipientAccount)
+	if !ew.logSampler.Skip(nil, nil) {
+		ew.logger.Infof("[%s] - New ERC-721Burn ERC-721 Event Log with TokenId [%d], Receiver Address [%s] has been found.",
+			eventLog.Raw.TxHash.String(),
+			eventLog.TokenId.Int64(),
+			recipientAccount)
+	}
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
 
-	if ew.validator && currentBlockNumber >= ew.targetBlock {
-		if transfer.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: transfer, Topic: constants.HederaNftTransfer})
-		} else {
+	var topic string
+	if ew.shouldProcess(currentBlockNumber, blockTimestamp, transfer.SourceChainId, transfer.TargetChainId) && ew.ownsSubmission(transfer, eventLog.Raw.TxHash) {
+		if transfer.TargetChainId != constants.HederaNetworkId {
 			ew.logger.Errorf("[%s] - NFT Transfer to TargetChain different than [%d]. Not supported.", transfer.TransactionId, constants.HederaNetworkId)
 			return
 		}
+		topic = constants.HederaNftTransfer
 	} else {
+		if !ew.readOnlyFinal(eventLog.Raw, blockTimestamp) {
+			return
+		}
 		transfer.NetworkTimestamp = strconv.FormatUint(blockTimestamp, 10)
-		if transfer.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: transfer, Topic: constants.ReadOnlyHederaUnlockNftTransfer})
-		} else {
+		if transfer.TargetChainId != constants.HederaNetworkId {
 			ew.logger.Errorf("[%s] - Read-only NFT Transfer to TargetChain different than [%d]. Not supported.", transfer.TransactionId, constants.HederaNetworkId)
 			return
 		}
+		topic = constants.ReadOnlyHederaUnlockNftTransfer
+	}
+
+	if ew.dispatch(q, transfer, topic, eventLog.Raw) {
+		ew.confirmationsReached(eventLog.Raw, 0, transfer)
 	}
 }
 
 func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
-	ew.logger.Infof("[%s] - New Unlock Event Log received [%s].", eventLog.TransactionId, eventLog.Raw.TxHash)
+	if !ew.logSampler.Skip(nil, nil) {
+		ew.logger.Infof("[%s] - New Unlock Event Log received [%s].", eventLog.TransactionId, eventLog.Raw.TxHash)
+	}
 
 	if eventLog.Raw.Removed {
 		ew.logger.Errorf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
@@ -677,27 +1743,57 @@ func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
 
 	transactionId := string(eventLog.TransactionId)
 	sourceChainId := eventLog.SourceChain.Uint64()
+	if !ew.isServicedChain(sourceChainId, eventLog.Raw.TxHash) {
+		return
+	}
+
+	// the log is only used to record the transfer as completed
+	if !ew.prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
 	targetChainId := ew.evmClient.GetChainID()
 	oppositeToken := ew.assetsService.OppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
 
 	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, oppositeToken, transactionId, ew.prometheusService, ew.logger)
 }
 
-func (ew *Watcher) convertTargetAmount(sourceChainId, targetChainId uint64, sourceAsset, targetAsset string, amount *big.Int) (*big.Int, error) {
+// roundingPolicy returns the rounding policy of the native asset of a conversion, which is either its source or its target asset
+func (ew *Watcher) roundingPolicy(sourceChainId uint64, sourceAsset string, targetChainId uint64, targetAsset string) string {
+	if rounding, ok := ew.roundingPolicies[sourceChainId][sourceAsset]; ok {
+		return rounding
+	}
+	if rounding, ok := ew.roundingPolicies[targetChainId][targetAsset]; ok {
+		return rounding
+	}
+	return decimal.RoundingTruncate
+}
+
+// convertTargetAmount scales the amount of the given event to the decimals of the target asset, together with the
+// truncated remainder. Amounts, rejected by the rounding policy, are counted once per event, not on every re-scan
+func (ew *Watcher) convertTargetAmount(raw types.Log, sourceChainId, targetChainId uint64, sourceAsset, targetAsset string, amount *big.Int) (*big.Int, uint8, *big.Int, error) {
 	sourceAssetInfo, exists := ew.assetsService.FungibleAssetInfo(sourceChainId, sourceAsset)
 	if !exists {
-		return nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", sourceAsset)
+		return nil, 0, nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", sourceAsset)
 	}
 
 	targetAssetInfo, exists := ew.assetsService.FungibleAssetInfo(targetChainId, targetAsset)
 	if !exists {
-		return nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", targetAsset)
+		return nil, 0, nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", targetAsset)
 	}
 
-	targetAmount := decimal.TargetAmount(sourceAssetInfo.Decimals, targetAssetInfo.Decimals, amount)
-	if targetAmount.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf("insufficient amount provided: Event Amount [%s] and Target Amount [%s]", amount, targetAmount)
+	rounding := ew.roundingPolicy(sourceChainId, sourceAsset, targetChainId, targetAsset)
+	targetAmount, err := decimal.AdjustDecimalsWithRounding(amount, sourceAssetInfo.Decimals, targetAssetInfo.Decimals, rounding)
+	if errors.Is(err, decimal.ErrRoundingRemainder) {
+		if ew.dispatched.drop(fmt.Sprintf("%s-%d", raw.TxHash, raw.Index), raw.BlockNumber) {
+			metrics.IncrementDroppedEvents(ew.dbIdentifier, constants.DropReasonRoundingRemainder, ew.prometheusService)
+		}
+		return nil, 0, nil, fmt.Errorf("amount rejected by the rounding policy of the asset: %w", err)
+	}
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("insufficient amount provided: Event Amount [%s]. Error [%s]", amount, err)
 	}
 
-	return targetAmount, nil
+	remainder := decimal.TruncatedRemainder(amount, sourceAssetInfo.Decimals, targetAssetInfo.Decimals)
+	return targetAmount, targetAssetInfo.Decimals, remainder, nil
 }
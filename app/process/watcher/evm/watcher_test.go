@@ -21,28 +21,40 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gookit/event"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	decimalHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/shard"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/watchers"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 var (
-	tokenAddressString  = "0x0000000000000000000000000000000000000000"
+	tokenAddressString  = "0x0000000000000000000000000000000000000009"
 	tokenAddress        = common.HexToAddress(tokenAddressString)
 	targetChainId       = constants.HederaNetworkId
 	targetChainIdBigInt = big.NewInt(0).SetUint64(targetChainId)
@@ -62,16 +74,17 @@ var (
 		Amount:      big.NewInt(1),
 	}
 
-	hederaAcc, _   = hedera.AccountIDFromString("0.0.123456")
-	hederaBytes    = hederaAcc.ToBytes()
-	dbIdentifier   = "3-0x0000000000000000000000000000000000000001"
-	mintHash       = common.HexToHash("0579df6e9dbf066ba9fbd51ef5241e2b9f9c042a70289e8e5333d714ed4e5787")
-	burnHash       = common.HexToHash("97715804dcd62a721835eaba4356dc90eaf6d442a12fe944f01bbf5f8c0b8992")
-	lockHash       = common.HexToHash("aa3a3bc72b8c754ca6ee8425a5531bafec37569ec012d62d5f682ca909ae06f1")
-	unlockHash     = common.HexToHash("483dd9d090112259cd3c44a9af4b3386be4b4b87145e6bf85bc0964a06062a73")
-	membersHash    = common.HexToHash("30f1d11f11278ba2cc669fd4c95ee8d46ede2c82f6af0b74e4f427369b3522d3")
-	burnERC721Hash = common.HexToHash("eb703661daf51ce0c247ebbf71a8747e6a79f36b2e93a4e5a22f191321e5750e")
-	topics         = [][]common.Hash{
+	hederaAcc, _     = hedera.AccountIDFromString("0.0.123456")
+	hederaBytes      = hederaAcc.ToBytes()
+	dbIdentifier     = "3-0x0000000000000000000000000000000000000001"
+	mintHash         = common.HexToHash("0579df6e9dbf066ba9fbd51ef5241e2b9f9c042a70289e8e5333d714ed4e5787")
+	burnHash         = common.HexToHash("97715804dcd62a721835eaba4356dc90eaf6d442a12fe944f01bbf5f8c0b8992")
+	lockHash         = common.HexToHash("aa3a3bc72b8c754ca6ee8425a5531bafec37569ec012d62d5f682ca909ae06f1")
+	unlockHash       = common.HexToHash("483dd9d090112259cd3c44a9af4b3386be4b4b87145e6bf85bc0964a06062a73")
+	membersHash      = common.HexToHash("30f1d11f11278ba2cc669fd4c95ee8d46ede2c82f6af0b74e4f427369b3522d3")
+	burnERC721Hash   = common.HexToHash("eb703661daf51ce0c247ebbf71a8747e6a79f36b2e93a4e5a22f191321e5750e")
+	tokenUpdatedHash = common.HexToHash("62f51bef49e8a6a5d65e8aef0916ba65fc03e95b3c5c828b6c065f357a24dd34")
+	topics           = [][]common.Hash{
 		{
 			mintHash,
 			burnHash,
@@ -79,13 +92,14 @@ var (
 			unlockHash,
 			membersHash,
 			burnERC721Hash,
+			tokenUpdatedHash,
 		},
 	}
 	filterConfig = FilterConfig{
 		abi:    abi.ABI{},
 		topics: topics,
 		addresses: []common.Address{
-			tokenAddress,
+			{},
 		},
 		mintHash:          mintHash,
 		burnHash:          burnHash,
@@ -121,6 +135,41 @@ func Test_HandleLockLog_EmptyReceiver_Fails(t *testing.T) {
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
+func Test_HandleLockLog_FeeExceedsAmount_Fails(t *testing.T) {
+	setup()
+
+	lockLog.ServiceFee = big.NewInt(2)
+	w.handleLockLog(lockLog, mocks.MQueue)
+	lockLog.ServiceFee = big.NewInt(0)
+
+	mocks.MEVMClient.AssertNotCalled(t, "GetChainID")
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleLockLog_NonPositiveAmount_Fails(t *testing.T) {
+	setup()
+
+	lockLog.ServiceFee = big.NewInt(1)
+	w.handleLockLog(lockLog, mocks.MQueue)
+	lockLog.ServiceFee = big.NewInt(0)
+
+	lockLog.Amount = big.NewInt(-1)
+	lockLog.ServiceFee = big.NewInt(-1)
+	w.handleLockLog(lockLog, mocks.MQueue)
+	lockLog.Amount = big.NewInt(1)
+	lockLog.ServiceFee = big.NewInt(0)
+
+	mocks.MEVMClient.AssertNotCalled(t, "GetChainID")
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Partition(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("GetChainID").Return(uint64(80001))
+
+	assert.Equal(t, "80001", w.Partition())
+}
+
 func Test_HandleLockLog_InvalidReceiver_Fails(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("GetChainID").Return(uint64(1))
@@ -181,7 +230,9 @@ func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
 		assetsService:     mocks.MAssetsService,
 		validator:         false,
 		prometheusService: mocks.MPrometheusService,
+		watchersService:   mocks.MWatchersService,
 	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
 
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 	parsedLockLog := &payload.Transfer{
@@ -218,7 +269,9 @@ func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
 		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
 		assetsService:     mocks.MAssetsService,
 		validator:         false,
+		watchersService:   mocks.MWatchersService,
 	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
 
 	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, lockLog.TargetChain.Uint64()).Return("")
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
@@ -269,6 +322,93 @@ func Test_HandleLockLog_TopicMessageSubmission(t *testing.T) {
 	lockLog.TargetChain = big.NewInt(0)
 }
 
+func Test_Dispatch_IncrementsQueuePushesPerTopic(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MTransferRepository.On("CreateEventLog", mock.Anything).Return(nil)
+	w = &Watcher{
+		transferRepository: mocks.MTransferRepository,
+		prometheusService:  mocks.MPrometheusService,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		dispatched:         newDispatchedTransfers(),
+		watchersService:    mocks.MWatchersService,
+	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
+
+	counters := map[string]prometheus.Counter{}
+	for _, topic := range []string{constants.HederaMintHtsTransfer, constants.TopicMessageSubmission} {
+		opts := prometheus.CounterOpts{
+			Name:        constants.QueuePushesCounterNamePrefix + strings.ToLower(topic),
+			Help:        constants.QueuePushesCounterHelp,
+			ConstLabels: prometheus.Labels{constants.QueueTopicMetricLabelKey: topic},
+		}
+		counters[topic] = prometheus.NewCounter(opts)
+		mocks.MPrometheusService.On("CreateCounterIfNotExists", opts).Return(counters[topic])
+	}
+	mocks.MQueue.On("Push", mock.Anything).Return()
+
+	w.dispatch(mocks.MQueue, &payload.Transfer{TransactionId: "1"}, constants.HederaMintHtsTransfer, types.Log{BlockNumber: 1})
+	w.dispatch(mocks.MQueue, &payload.Transfer{TransactionId: "2"}, constants.HederaMintHtsTransfer, types.Log{BlockNumber: 1})
+	w.dispatch(mocks.MQueue, &payload.Transfer{TransactionId: "3"}, constants.TopicMessageSubmission, types.Log{BlockNumber: 1})
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(counters[constants.HederaMintHtsTransfer]))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counters[constants.TopicMessageSubmission]))
+}
+
+func Test_Dispatch_StoresEventLog(t *testing.T) {
+	setup()
+	raw := types.Log{
+		Address:     common.HexToAddress("0x1"),
+		Topics:      []common.Hash{lockHash},
+		Data:        []byte{1, 2, 3},
+		BlockNumber: 10,
+		TxHash:      common.HexToHash("0x2"),
+		Index:       3,
+	}
+	transfer := &payload.Transfer{TransactionId: "0x2-3", SourceChainId: 80001}
+	mocks.MQueue.On("Push", &queue.Message{Payload: transfer, Topic: constants.HederaMintHtsTransfer}).Return()
+
+	w.dispatch(mocks.MQueue, transfer, constants.HederaMintHtsTransfer, raw)
+
+	mocks.MTransferRepository.AssertCalled(t, "CreateEventLog", entity.NewEventLog(transfer.TransactionId, transfer.SourceChainId, raw))
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: transfer, Topic: constants.HederaMintHtsTransfer})
+}
+
+func Test_Dispatch_SkipsAlreadyDispatched(t *testing.T) {
+	setup()
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x2"), Index: 3}
+	transfer := &payload.Transfer{TransactionId: "0x2-3"}
+	mocks.MQueue.On("Push", &queue.Message{Payload: transfer, Topic: constants.HederaMintHtsTransfer}).Return()
+
+	// The second dispatch is of the same event, re-scanned in the reorg grace window
+	w.dispatch(mocks.MQueue, transfer, constants.HederaMintHtsTransfer, raw)
+	w.dispatch(mocks.MQueue, transfer, constants.HederaMintHtsTransfer, raw)
+
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "CreateEventLog", 1)
+}
+
+func Test_RescanFrom(t *testing.T) {
+	w = &Watcher{reorgGrace: 5}
+
+	assert.Equal(t, int64(95), w.rescanFrom(100))
+	assert.Equal(t, int64(0), w.rescanFrom(3))
+
+	w.reorgGrace = 0
+	assert.Equal(t, int64(100), w.rescanFrom(100))
+}
+
+func Test_DispatchedTransfers_Prune(t *testing.T) {
+	dispatched := newDispatchedTransfers()
+	dispatched.add(&payload.Transfer{TransactionId: "old"}, 5)
+	dispatched.add(&payload.Transfer{TransactionId: "new"}, 10)
+
+	dispatched.prune(10)
+
+	assert.False(t, dispatched.remove("old"))
+	assert.True(t, dispatched.remove("new"))
+}
+
 func Test_HandleBurnLog_HappyPath(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
@@ -358,7 +498,9 @@ func Test_HandleBurnLog_ReadOnlyTransferSave(t *testing.T) {
 		assetsService:     mocks.MAssetsService,
 		pricingService:    mocks.MPricingService,
 		validator:         false,
+		watchersService:   mocks.MWatchersService,
 	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
 
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 
@@ -408,7 +550,9 @@ func Test_HandleBurnLog_ReadOnlyHederaTransfer(t *testing.T) {
 		assetsService:     mocks.MAssetsService,
 		pricingService:    mocks.MPricingService,
 		validator:         false,
+		watchersService:   mocks.MWatchersService,
 	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
 
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 	parsedBurnLog := &payload.Transfer{
@@ -508,22 +652,25 @@ func TestNewWatcher(t *testing.T) {
 	}
 
 	filterCfg := FilterConfig{
-		abi:               abi,
-		topics:            topics,
-		addresses:         addresses,
-		mintHash:          mintHashFromAbi,
-		burnHash:          burnHashFromAbi,
-		lockHash:          lockHashFromAbi,
-		unlockHash:        unlockHashFromAbi,
-		burnERC721Hash:    burnERC721HashAbi,
-		memberUpdatedHash: memberUpdatedHash,
-		maxLogsBlocks:     220,
+		abi:                    abi,
+		topics:                 topics,
+		addresses:              addresses,
+		mintHash:               mintHashFromAbi,
+		burnHash:               burnHashFromAbi,
+		lockHash:               lockHashFromAbi,
+		unlockHash:             unlockHashFromAbi,
+		burnERC721Hash:         burnERC721HashAbi,
+		memberUpdatedHash:      memberUpdatedHash,
+		nativeTokenUpdatedHash: abi.Events[nativeTokenUpdatedEvent].ID,
+		extraEvents:            map[common.Hash]string{},
+		maxLogsBlocks:          220,
 	}
 
 	assets := mocks.MAssetsService
 	blacklist := []string{"0.0.444", "0x0123"}
 	w = &Watcher{
 		repository:          mocks.MStatusRepository,
+		transferRepository:  mocks.MTransferRepository,
 		contracts:           mocks.MBridgeContractService,
 		prometheusService:   mocks.MPrometheusService,
 		pricingService:      mocks.MPricingService,
@@ -536,12 +683,257 @@ func TestNewWatcher(t *testing.T) {
 		sleepDuration:       defaultSleepDuration,
 		filterConfig:        filterCfg,
 		blacklistedAccounts: blacklist,
+		dispatched:          newDispatchedTransfers(),
+		deferred:            newDeferredEvents(),
+		reloadingMembers:    new(atomic.Bool),
+		logsRange:           newLogsRange(220, 0),
+		watchersService:     mocks.MWatchersService,
+		corridors:           newCorridors(nil),
+		maxAmountBits:       defaultMaxAmountBits,
 	}
 
-	actual := NewWatcher(mocks.MStatusRepository, mocks.MBridgeContractService, mocks.MPrometheusService, mocks.MPricingService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220, blacklist)
+	actual, err := NewWatcher(mocks.MStatusRepository, mocks.MTransferRepository, mocks.MBridgeContractService, mocks.MPrometheusService, mocks.MPricingService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220, 0, false, 0, 0, 0, nil, "", nil, nil, nil, blacklist, mocks.MWatchersService)
+	assert.Nil(t, err)
+	assert.NotNil(t, actual.sleep)
+	actual.sleep = nil
+	actual.dispatched.last = w.dispatched.last
 	assert.Equal(t, w, actual)
 }
 
+func validWatcherConfig() WatcherConfig {
+	return WatcherConfig{
+		Repository:         mocks.MStatusRepository,
+		TransferRepository: mocks.MTransferRepository,
+		Contracts:          mocks.MBridgeContractService,
+		PrometheusService:  mocks.MPrometheusService,
+		PricingService:     mocks.MPricingService,
+		EvmClient:          mocks.MEVMClient,
+		AssetsService:      mocks.MAssetsService,
+		WatchersService:    mocks.MWatchersService,
+		DbIdentifier:       dbIdentifier,
+	}
+}
+
+func Test_WatcherConfig_Validate(t *testing.T) {
+	mocks.Setup()
+	assert.Nil(t, validWatcherConfig().Validate())
+
+	invalid := map[string]func(cfg *WatcherConfig){
+		"missing dependency":           func(cfg *WatcherConfig) { cfg.EvmClient = nil },
+		"empty db identifier":          func(cfg *WatcherConfig) { cfg.DbIdentifier = "" },
+		"negative start block":         func(cfg *WatcherConfig) { cfg.StartBlock = -1 },
+		"negative polling interval":    func(cfg *WatcherConfig) { cfg.PollingInterval = -1 },
+		"negative max logs blocks":     func(cfg *WatcherConfig) { cfg.MaxLogsBlocks = -1 },
+		"negative logs ceiling":        func(cfg *WatcherConfig) { cfg.MaxLogsBlocksCeiling = -1 },
+		"negative reorg grace":         func(cfg *WatcherConfig) { cfg.ReorgGrace = -1 },
+		"negative read-only finality":  func(cfg *WatcherConfig) { cfg.ReadOnlyFinality = -1 },
+		"max amount bits too large":    func(cfg *WatcherConfig) { cfg.MaxAmountBits = 257 },
+		"negative max reorg depth":     func(cfg *WatcherConfig) { cfg.MaxReorgDepth = -1 },
+		"negative archive age":         func(cfg *WatcherConfig) { cfg.ArchiveAge = -1 },
+		"negative cold start lookback": func(cfg *WatcherConfig) { cfg.ColdStartLookback = -1 },
+		"reorg grace above max depth":  func(cfg *WatcherConfig) { cfg.ReorgGrace, cfg.MaxReorgDepth = 10, 5 },
+	}
+	for name, invalidate := range invalid {
+		t.Run(name, func(t *testing.T) {
+			cfg := validWatcherConfig()
+			invalidate(&cfg)
+			assert.Error(t, cfg.Validate())
+		})
+	}
+}
+
+func Test_NewWatcherFromConfig_InvalidConfig(t *testing.T) {
+	mocks.Setup()
+	cfg := validWatcherConfig()
+	cfg.DbIdentifier = ""
+
+	actual, err := NewWatcherFromConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryBlockNumber")
+}
+
+func Test_NewWatcherFromConfig_DefaultsMaxLogsBlocks(t *testing.T) {
+	mocks.Setup()
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(0), nil)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+
+	actual, err := NewWatcherFromConfig(validWatcherConfig())
+
+	assert.Nil(t, err)
+	assert.Equal(t, defaultMaxLogsBlocks, actual.logsRange.blocks())
+	assert.Equal(t, defaultSleepDuration, actual.sleepDuration)
+}
+
+func Test_NewWatcher_LatestBlockFails(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(0), errors.New("some-error"))
+
+	actual, err := NewWatcherFromConfig(validWatcherConfig())
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_NewWatcher_InvalidRouterAbi(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	cfg := validWatcherConfig()
+	cfg.RouterAbi = "invalid-abi"
+
+	actual, err := NewWatcherFromConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_NewWatcher_RepositoryGetFails(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(0), errors.New("some-error"))
+
+	actual, err := NewWatcherFromConfig(validWatcherConfig())
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+	mocks.MStatusRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func Test_NewWatcher_RepositoryCreateFails(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(0), gorm.ErrRecordNotFound)
+	mocks.MStatusRepository.On("Create", dbIdentifier, int64(5)).Return(errors.New("some-error"))
+
+	actual, err := NewWatcherFromConfig(validWatcherConfig())
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_NewWatcher_ColdStartLookback(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(100), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(0), gorm.ErrRecordNotFound)
+	mocks.MStatusRepository.On("Create", dbIdentifier, int64(65)).Return(nil)
+	cfg := validWatcherConfig()
+	cfg.ColdStartLookback = 30
+
+	actual, err := NewWatcherFromConfig(cfg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(65), actual.targetBlock)
+	mocks.MStatusRepository.AssertCalled(t, "Create", dbIdentifier, int64(65))
+}
+
+func Test_NewWatcher_ColdStartLookbackIgnoredWithCheckpoint(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(100), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(50), nil)
+	cfg := validWatcherConfig()
+	cfg.ColdStartLookback = 30
+
+	actual, err := NewWatcherFromConfig(cfg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(95), actual.targetBlock)
+	mocks.MStatusRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func Test_coldStartBlock(t *testing.T) {
+	assert.Equal(t, uint64(95), coldStartBlock(95, 0))
+	assert.Equal(t, uint64(65), coldStartBlock(95, 30))
+	assert.Equal(t, uint64(0), coldStartBlock(95, 95))
+	assert.Equal(t, uint64(0), coldStartBlock(95, 50000))
+}
+
+func Test_NewWatcher_RepositoryUpdateFails(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(3)).Return(errors.New("some-error"))
+	cfg := validWatcherConfig()
+	cfg.StartBlock = 3
+
+	actual, err := NewWatcher(cfg.Repository, cfg.TransferRepository, cfg.Contracts, cfg.PrometheusService, cfg.PricingService, cfg.EvmClient, cfg.AssetsService, cfg.DbIdentifier, cfg.StartBlock, false, 0, 0, 0, false, 0, 0, 0, nil, "", nil, nil, nil, nil, cfg.WatchersService)
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_ReadOnlyFinal_Disabled(t *testing.T) {
+	setup()
+
+	assert.True(t, w.readOnlyFinal(types.Log{}, 100))
+
+	mocks.MEVMClient.AssertNotCalled(t, "RetryBlockNumber")
+}
+
+func Test_ReadOnlyFinal_AlreadyFinal(t *testing.T) {
+	setup()
+	w.readOnlyFinality = 60 * time.Second
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(20), nil)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(20)).Return(uint64(160))
+
+	assert.True(t, w.readOnlyFinal(types.Log{}, 100))
+	assert.Equal(t, 0, w.deferred.size())
+}
+
+func Test_ReadOnlyFinal_DefersUntilFinal(t *testing.T) {
+	setup()
+	w.readOnlyFinality = 60 * time.Second
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1")}
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(20), nil)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(20)).Return(uint64(130))
+
+	assert.False(t, w.readOnlyFinal(raw, 100))
+
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+	assert.Equal(t, int64(10), w.deferred.hold(21))
+}
+
+func Test_ReadOnlyFinal_DefersOnHeadFailure(t *testing.T) {
+	setup()
+	w.readOnlyFinality = 60 * time.Second
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1")}
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(0), errors.New("some-error"))
+
+	assert.False(t, w.readOnlyFinal(raw, 100))
+
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+}
+
+func Test_ProcessLogs_RetriesDeferredEvents(t *testing.T) {
+	setup()
+	cfg, err := newFilterConfig(customRouterAbi, []string{"FeeUpdated"}, common.Address{}, 220)
+	assert.Nil(t, err)
+	w.filterConfig = cfg
+	feeUpdatedLog := types.Log{
+		Topics:      []common.Hash{cfg.abi.Events["FeeUpdated"].ID},
+		Data:        common.LeftPadBytes(big.NewInt(10).Bytes(), 32),
+		BlockNumber: 3,
+		TxHash:      common.HexToHash("0x1"),
+	}
+	w.deferred.add(feeUpdatedLog)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{}, nil)
+	mocks.MEVMClient.On("GetChainID").Return(uint64(80001))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(11)).Return(nil)
+
+	err = w.processLogs(5, 10, mocks.MQueue)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertCalled(t, "CreateEventLog", entity.NewEventLog(fmt.Sprintf("%s-%d", feeUpdatedLog.TxHash, feeUpdatedLog.Index), uint64(80001), feeUpdatedLog))
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(11))
+	assert.Equal(t, 0, w.deferred.size())
+}
+
 // TODO: Test_NewWatcher_Fails
 
 func Test_ProcessLogs_ParseBurnLogFails(t *testing.T) {
@@ -606,6 +998,40 @@ func Test_ProcessLogs_ParseLockLogFails(t *testing.T) {
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
+func Test_ProcessLogs_HandlerPanics(t *testing.T) {
+	setup()
+
+	query := &ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetInt64(0),
+		Addresses: []common.Address{
+			common.HexToAddress("0x0000000000000000000000000000000000000000"),
+		},
+		ToBlock: new(big.Int).SetInt64(0),
+		Topics:  topics,
+	}
+
+	mocks.MEVMClient.On("RetryFilterLogs", *query).
+		Return([]types.Log{
+			{
+				Topics: []common.Hash{
+					lockHash,
+				},
+			},
+		}, nil)
+	mocks.MBridgeContractService.On("ParseLockLog", mock.Anything).
+		Run(func(args mock.Arguments) {
+			panic("some-panic")
+		}).
+		Return(lockLog, nil)
+
+	assert.NotPanics(t, func() {
+		err := w.processLogs(0, 0, mocks.MQueue)
+		assert.Error(t, err)
+	})
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", dbIdentifier, int64(1))
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
 func Test_ProcessLogs_FilterLogsFails(t *testing.T) {
 	setup()
 
@@ -649,10 +1075,12 @@ func setup() {
 	mocks.Setup()
 
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
+	mocks.MTransferRepository.On("CreateEventLog", mock.Anything).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	w = &Watcher{
 		repository:          mocks.MStatusRepository,
+		transferRepository:  mocks.MTransferRepository,
 		contracts:           mocks.MBridgeContractService,
 		prometheusService:   mocks.MPrometheusService,
 		pricingService:      mocks.MPricingService,
@@ -664,5 +1092,1283 @@ func setup() {
 		sleepDuration:       defaultSleepDuration,
 		filterConfig:        filterConfig,
 		blacklistedAccounts: []string{"0x0123", "0x4567"},
+		dispatched:          newDispatchedTransfers(),
+		deferred:            newDeferredEvents(),
+		reloadingMembers:    new(atomic.Bool),
+		logsRange:           newLogsRange(filterConfig.maxLogsBlocks, filterConfig.maxLogsBlocks),
+		watchersService:     mocks.MWatchersService,
+		corridors:           newCorridors(nil),
+		maxAmountBits:       defaultMaxAmountBits,
+	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
+}
+
+func Test_ShouldProcess_MaxTransferAge(t *testing.T) {
+	setup()
+	w.maxTransferAge = time.Hour
+	now := time.Now()
+
+	assert.True(t, w.shouldProcess(1, uint64(now.Add(-time.Minute).Unix()), sourceChainId, targetChainId))
+	assert.False(t, w.shouldProcess(1, uint64(now.Add(-2*time.Hour).Unix()), sourceChainId, targetChainId))
+
+	w.maxTransferAge = 0
+	assert.True(t, w.shouldProcess(1, uint64(now.Add(-2*time.Hour).Unix()), sourceChainId, targetChainId))
+}
+
+func Test_ShouldProcess_BeforeTargetBlock(t *testing.T) {
+	setup()
+	w.targetBlock = 10
+
+	assert.False(t, w.shouldProcess(9, uint64(time.Now().Unix()), sourceChainId, targetChainId))
+	assert.True(t, w.shouldProcess(10, uint64(time.Now().Unix()), sourceChainId, targetChainId))
+}
+
+func Test_ShouldProcess_DisabledCorridor(t *testing.T) {
+	setup()
+	w.corridors = newCorridors(map[uint64]map[uint64]bool{sourceChainId: {targetChainId: true}})
+
+	assert.False(t, w.shouldProcess(1, uint64(time.Now().Unix()), sourceChainId, targetChainId))
+	assert.True(t, w.shouldProcess(1, uint64(time.Now().Unix()), targetChainId, sourceChainId))
+	assert.True(t, w.shouldProcess(1, uint64(time.Now().Unix()), sourceChainId, sourceChainId+1))
+}
+
+func Test_OwnsSubmission(t *testing.T) {
+	setup()
+	receiver := "0.0.123456"
+	toHedera := &payload.Transfer{Receiver: receiver, TargetChainId: constants.HederaNetworkId}
+	toEvm := &payload.Transfer{Receiver: "0xaBcDef0123456789abCDef0123456789AbCdEf01", TargetChainId: 80001}
+
+	assert.True(t, w.ownsSubmission(toHedera, common.Hash{}))
+
+	w.shard = config.Shard{Index: shard.Of(receiver, 3), Count: 3}
+	assert.True(t, w.ownsSubmission(toHedera, common.Hash{}))
+
+	w.shard.Index = (w.shard.Index + 1) % 3
+	assert.False(t, w.ownsSubmission(toHedera, common.Hash{}))
+
+	for index := uint64(0); index < 3; index++ {
+		w.shard.Index = index
+		assert.True(t, w.ownsSubmission(toEvm, common.Hash{}))
+	}
+}
+
+func Test_Corridors_BridgeConfigUpdate(t *testing.T) {
+	c := newCorridors(map[uint64]map[uint64]bool{sourceChainId: {targetChainId: true}})
+	e := event.NewBasic(constants.EventBridgeConfigUpdate, event.M{constants.BridgeConfigUpdateEventParamsKey: &bridge_config_event.Params{
+		Bridge: &config.Bridge{DisabledCorridors: map[uint64]map[uint64]bool{targetChainId: {sourceChainId: true}}},
+	}})
+
+	assert.Nil(t, c.bridgeCfgUpdateEventHandler(e))
+
+	assert.True(t, c.enabled(sourceChainId, targetChainId))
+	assert.False(t, c.enabled(targetChainId, sourceChainId))
+}
+
+func Test_Corridors_BridgeConfigUpdate_InvalidParams(t *testing.T) {
+	c := newCorridors(map[uint64]map[uint64]bool{sourceChainId: {targetChainId: true}})
+
+	assert.Error(t, c.bridgeCfgUpdateEventHandler(event.NewBasic(constants.EventBridgeConfigUpdate, event.M{})))
+	assert.False(t, c.enabled(sourceChainId, targetChainId))
+}
+
+func Test_TierConfirmations(t *testing.T) {
+	setup()
+	w.confirmationTiers = map[uint64]uint64{10: 30, 100: 60}
+	minAmount := big.NewInt(1000)
+
+	assert.Equal(t, uint64(0), w.tierConfirmations(big.NewInt(9999), minAmount))
+	assert.Equal(t, uint64(30), w.tierConfirmations(big.NewInt(10000), minAmount))
+	assert.Equal(t, uint64(30), w.tierConfirmations(big.NewInt(99999), minAmount))
+	assert.Equal(t, uint64(60), w.tierConfirmations(big.NewInt(100000), minAmount))
+	assert.Equal(t, uint64(0), w.tierConfirmations(big.NewInt(100000), big.NewInt(0)))
+}
+
+func Test_TierConfirmations_NoTiers(t *testing.T) {
+	setup()
+
+	assert.Equal(t, uint64(0), w.tierConfirmations(big.NewInt(100000), big.NewInt(1000)))
+}
+
+func Test_Confirmed_NotAboveDefault(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+
+	assert.True(t, w.confirmed(types.Log{BlockNumber: 10}, 5))
+	mocks.MEVMClient.AssertNotCalled(t, "RetryBlockNumber")
+}
+
+func Test_Confirmed(t *testing.T) {
+	setup()
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1"), BlockHash: common.HexToHash("0x2")}
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(40), nil)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(&types.Receipt{BlockHash: raw.BlockHash}, nil)
+
+	assert.True(t, w.confirmed(raw, 30))
+	assert.Equal(t, 0, w.deferred.size())
+}
+
+func Test_Confirmed_DefersUntilConfirmed(t *testing.T) {
+	setup()
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1"), BlockHash: common.HexToHash("0x2")}
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(20), nil)
+
+	assert.False(t, w.confirmed(raw, 30))
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+	mocks.MEVMClient.AssertNotCalled(t, "GetClient")
+}
+
+func Test_Confirmed_DefersOnHeadFailure(t *testing.T) {
+	setup()
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1")}
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(0), errors.New("some-error"))
+
+	assert.False(t, w.confirmed(raw, 30))
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+}
+
+func Test_Confirmed_MovedFromBlock(t *testing.T) {
+	setup()
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1"), BlockHash: common.HexToHash("0x2")}
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(40), nil)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(&types.Receipt{BlockHash: common.HexToHash("0x3")}, nil)
+
+	assert.False(t, w.confirmed(raw, 30))
+	assert.Equal(t, 0, w.deferred.size())
+}
+
+func Test_Confirmed_DefersOnReceiptFailure(t *testing.T) {
+	setup()
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1")}
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(40), nil)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(nil, errors.New("some-error"))
+
+	assert.False(t, w.confirmed(raw, 30))
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+}
+
+func Test_ReloadMembers_RetriesWithBackoff(t *testing.T) {
+	setup()
+	var slept []time.Duration
+	w.sleep = func(d time.Duration) { slept = append(slept, d) }
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MBridgeContractService.On("ReloadMembers").Return(errors.New("some-error")).Twice()
+	mocks.MBridgeContractService.On("ReloadMembers").Return(nil).Once()
+
+	w.reloadMembers()
+
+	mocks.MBridgeContractService.AssertNumberOfCalls(t, "ReloadMembers", 3)
+	assert.Equal(t, []time.Duration{minReloadMembersBackoff, 2 * minReloadMembersBackoff}, slept)
+}
+
+func Test_StartMembersReload_SingleFlight(t *testing.T) {
+	setup()
+	release := make(chan struct{})
+	done := make(chan struct{})
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MBridgeContractService.On("ReloadMembers").Return(nil).Run(func(mock.Arguments) {
+		<-release
+		close(done)
+	}).Once()
+
+	w.startMembersReload()
+	w.startMembersReload()
+	close(release)
+	<-done
+
+	mocks.MBridgeContractService.AssertNumberOfCalls(t, "ReloadMembers", 1)
+}
+
+func Test_ReloadMembers_SetsStaleGauge(t *testing.T) {
+	mocks.Setup()
+	w = &Watcher{
+		contracts:         mocks.MBridgeContractService,
+		evmClient:         mocks.MEVMClient,
+		prometheusService: mocks.MPrometheusService,
+		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		sleep:             func(time.Duration) {},
+		watchersService:   mocks.MWatchersService,
+	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
+	opts := prometheus.GaugeOpts{
+		Name:        fmt.Sprintf("%s%d", constants.MembersStaleGaugeNamePrefix, sourceChainId),
+		Help:        constants.MembersStaleGaugeHelp,
+		ConstLabels: prometheus.Labels{constants.NetworkMetricLabelKey: fmt.Sprint(sourceChainId)},
+	}
+	gauge := prometheus.NewGauge(opts)
+	var observed []float64
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", opts).Return(gauge)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MBridgeContractService.On("ReloadMembers").Return(errors.New("some-error")).Once().Run(func(mock.Arguments) {
+		observed = append(observed, testutil.ToFloat64(gauge))
+	})
+	mocks.MBridgeContractService.On("ReloadMembers").Return(nil).Once().Run(func(mock.Arguments) {
+		observed = append(observed, testutil.ToFloat64(gauge))
+	})
+
+	w.reloadMembers()
+
+	assert.Equal(t, []float64{0, 1}, observed)
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauge))
+}
+
+// customRouterAbi is the embedded router ABI, extended with an extra event
+var customRouterAbi = strings.TrimSuffix(router.RouterABI, "]") +
+	`,{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"fee","type":"uint256"}],"name":"FeeUpdated","type":"event"}]`
+
+func Test_NewFilterConfig_DefaultAbi(t *testing.T) {
+	cfg, err := newFilterConfig("", nil, common.Address{}, 220)
+
+	assert.Nil(t, err)
+	assert.Equal(t, topics, cfg.topics)
+	assert.Empty(t, cfg.extraEvents)
+}
+
+func Test_NewFilterConfig_CustomAbiWithExtraEvent(t *testing.T) {
+	cfg, err := newFilterConfig(customRouterAbi, []string{"FeeUpdated"}, common.Address{}, 220)
+
+	assert.Nil(t, err)
+	feeUpdatedHash := cfg.abi.Events["FeeUpdated"].ID
+	assert.Equal(t, map[common.Hash]string{feeUpdatedHash: "FeeUpdated"}, cfg.extraEvents)
+	assert.Equal(t, append(append([]common.Hash{}, topics[0]...), feeUpdatedHash), cfg.topics[0])
+	assert.Equal(t, lockHash, cfg.lockHash)
+}
+
+func Test_NewFilterConfig_UnknownExtraEvent(t *testing.T) {
+	_, err := newFilterConfig(customRouterAbi, []string{"Unknown"}, common.Address{}, 220)
+
+	assert.Error(t, err)
+}
+
+func Test_NewFilterConfig_MissingHandledEvent(t *testing.T) {
+	_, err := newFilterConfig(`[{"anonymous":false,"inputs":[],"name":"FeeUpdated","type":"event"}]`, nil, common.Address{}, 220)
+
+	assert.Error(t, err)
+}
+
+func Test_ProcessLogs_ExtraEvent(t *testing.T) {
+	setup()
+	cfg, err := newFilterConfig(customRouterAbi, []string{"FeeUpdated"}, common.Address{}, 220)
+	assert.Nil(t, err)
+	w.filterConfig = cfg
+
+	feeUpdatedLog := types.Log{
+		Topics: []common.Hash{cfg.abi.Events["FeeUpdated"].ID},
+		Data:   common.LeftPadBytes(big.NewInt(10).Bytes(), 32),
+		TxHash: common.HexToHash("0x1"),
+		Index:  2,
 	}
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{feeUpdatedLog}, nil)
+	mocks.MEVMClient.On("GetChainID").Return(uint64(80001))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
+
+	err = w.processLogs(0, 0, mocks.MQueue)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertCalled(t, "CreateEventLog", entity.NewEventLog(fmt.Sprintf("%s-%d", feeUpdatedLog.TxHash, feeUpdatedLog.Index), uint64(80001), feeUpdatedLog))
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+// stubHistogram records the observed values
+type stubHistogram struct {
+	prometheus.Histogram
+	observations []float64
+}
+
+func (h *stubHistogram) Observe(value float64) {
+	h.observations = append(h.observations, value)
+}
+
+func Test_ProcessLogs_ObservesPhaseDurations(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.ExpectedCalls = nil
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	histograms := map[string]*stubHistogram{}
+	for _, phase := range []string{constants.WatcherPhaseFetch, constants.WatcherPhaseDispatch, constants.WatcherPhaseCheckpoint} {
+		histograms[phase] = &stubHistogram{}
+		mocks.MPrometheusService.On("CreateHistogramIfNotExists", prometheus.HistogramOpts{
+			Name: fmt.Sprintf("%s%s_%s", constants.WatcherPhaseDurationHistogramNamePrefix, phase, metrics.PrepareValueForPrometheusMetricName(dbIdentifier)),
+			Help: constants.WatcherPhaseDurationHistogramHelp,
+			ConstLabels: prometheus.Labels{
+				constants.WatcherMetricLabelKey: dbIdentifier,
+				constants.PhaseMetricLabelKey:   phase,
+			},
+		}).Return(histograms[phase])
+	}
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.Anything).Return(prometheus.NewGauge(prometheus.GaugeOpts{Name: "deferred"}))
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{}, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
+
+	err := w.processLogs(0, 0, mocks.MQueue)
+
+	assert.Nil(t, err)
+	for phase, histogram := range histograms {
+		assert.Len(t, histogram.observations, 1, phase)
+	}
+}
+
+func Test_LockedAmount_StandardToken(t *testing.T) {
+	setup()
+
+	actual, err := w.lockedAmount(lockLog)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lockLog.Amount, actual)
+	mocks.MEVMClient.AssertNotCalled(t, "GetClient")
+}
+
+// tokenTransferLog returns a Transfer log of the test token with the given index, recipient and value
+func tokenTransferLog(index uint, to common.Address, value int64) *types.Log {
+	return &types.Log{
+		Address: tokenAddress,
+		Topics:  []common.Hash{erc20TransferHash, common.BytesToHash(common.HexToAddress("0x1").Bytes()), common.BytesToHash(to.Bytes())},
+		Data:    common.LeftPadBytes(big.NewInt(value).Bytes(), 32),
+		Index:   index,
+	}
+}
+
+func feeOnTransferLock(amount, serviceFee int64) *router.RouterLock {
+	return &router.RouterLock{
+		TargetChain: targetChainIdBigInt,
+		Token:       tokenAddress,
+		Receiver:    hederaBytes,
+		Amount:      big.NewInt(amount),
+		ServiceFee:  big.NewInt(serviceFee),
+		Raw:         types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1"), Index: 3},
+	}
+}
+
+func Test_LockedAmount_FeeOnTransferToken(t *testing.T) {
+	setup()
+	w.feeOnTransferTokens = map[string]bool{tokenAddressString: true}
+	lock := feeOnTransferLock(100, 0)
+	treasury := common.HexToAddress("0x2")
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(&types.Receipt{Logs: []*types.Log{
+		tokenTransferLog(0, mocks.MBridgeContractService.Address(), 500),
+		tokenTransferLog(1, treasury, 2),
+		tokenTransferLog(2, mocks.MBridgeContractService.Address(), 98),
+		tokenTransferLog(4, mocks.MBridgeContractService.Address(), 700),
+	}}, nil)
+
+	actual, err := w.lockedAmount(lock)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(98), actual)
+}
+
+func Test_LockedAmount_FeeOnTransferToken_CappedAtEventAmount(t *testing.T) {
+	setup()
+	w.feeOnTransferTokens = map[string]bool{tokenAddressString: true}
+	lock := feeOnTransferLock(100, 0)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(&types.Receipt{Logs: []*types.Log{
+		tokenTransferLog(2, mocks.MBridgeContractService.Address(), 500),
+	}}, nil)
+
+	actual, err := w.lockedAmount(lock)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lock.Amount, actual)
+}
+
+func Test_LockedAmount_FeeOnTransferToken_NoTransferToRouter(t *testing.T) {
+	setup()
+	w.feeOnTransferTokens = map[string]bool{tokenAddressString: true}
+	lock := feeOnTransferLock(100, 0)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(&types.Receipt{Logs: []*types.Log{
+		tokenTransferLog(2, common.HexToAddress("0x2"), 100),
+	}}, nil)
+
+	actual, err := w.lockedAmount(lock)
+
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_LockedAmount_FeeOnTransferToken_ReceiptFails(t *testing.T) {
+	setup()
+	w.feeOnTransferTokens = map[string]bool{tokenAddressString: true}
+	lock := feeOnTransferLock(100, 0)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(nil, errors.New("some-error"))
+
+	actual, err := w.lockedAmount(lock)
+
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_HandleLockLog_FeeOnTransferToken_DefersOnReceiptFailure(t *testing.T) {
+	setup()
+	w.feeOnTransferTokens = map[string]bool{tokenAddressString: true}
+	lock := feeOnTransferLock(100, 10)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(nil, errors.New("some-error"))
+
+	w.handleLockLog(lock, mocks.MQueue)
+
+	assert.Equal(t, []types.Log{lock.Raw}, w.deferred.pending())
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleLockLog_FeeOnTransferToken_FeeExceedsLockedAmount(t *testing.T) {
+	setup()
+	w.feeOnTransferTokens = map[string]bool{tokenAddressString: true}
+	lock := feeOnTransferLock(100, 10)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, lock.Raw.TxHash).Return(&types.Receipt{Logs: []*types.Log{
+		tokenTransferLog(2, mocks.MBridgeContractService.Address(), 5),
+	}}, nil)
+
+	w.handleLockLog(lock, mocks.MQueue)
+
+	mocks.MEVMClient.AssertNotCalled(t, "GetChainID")
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	assert.Equal(t, 0, w.deferred.size())
+}
+
+func Test_IsServicedChain(t *testing.T) {
+	setup()
+
+	assert.True(t, w.isServicedChain(1, common.Hash{}))
+
+	w.servicedChains = toChainSet([]uint64{sourceChainId, targetChainId})
+	assert.True(t, w.isServicedChain(sourceChainId, common.Hash{}))
+	assert.True(t, w.isServicedChain(targetChainId, common.Hash{}))
+	assert.False(t, w.isServicedChain(1, common.Hash{}))
+}
+
+func Test_HandleLockLog_UnsupportedTargetChain(t *testing.T) {
+	setup()
+	w.servicedChains = toChainSet([]uint64{sourceChainId, targetChainId})
+	unsupportedLog := &router.RouterLock{
+		TargetChain: big.NewInt(1),
+		Token:       tokenAddress,
+		Receiver:    hederaBytes,
+		Amount:      big.NewInt(100),
+		ServiceFee:  big.NewInt(0),
+	}
+
+	w.handleLockLog(unsupportedLog, mocks.MQueue)
+
+	mocks.MEVMClient.AssertNotCalled(t, "GetChainID")
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleBurnLog_UnsupportedTargetChain(t *testing.T) {
+	setup()
+	w.servicedChains = toChainSet([]uint64{sourceChainId, targetChainId})
+	unsupportedLog := &router.RouterBurn{
+		TargetChain: big.NewInt(1),
+		Token:       tokenAddress,
+		Receiver:    hederaBytes,
+		Amount:      big.NewInt(100),
+	}
+
+	w.handleBurnLog(unsupportedLog, mocks.MQueue)
+
+	mocks.MEVMClient.AssertNotCalled(t, "GetChainID")
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleUnlockLog_UnsupportedSourceChain(t *testing.T) {
+	setup()
+	w.servicedChains = toChainSet([]uint64{sourceChainId, targetChainId})
+
+	w.handleUnlockLog(&router.RouterUnlock{SourceChain: big.NewInt(1), Token: tokenAddress})
+
+	mocks.MEVMClient.AssertNotCalled(t, "GetChainID")
+	mocks.MAssetsService.AssertNotCalled(t, "OppositeAsset", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_HandleUnlockLog_MonitoringDisabled(t *testing.T) {
+	setup()
+	w.servicedChains = toChainSet([]uint64{sourceChainId, targetChainId})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+
+	w.handleUnlockLog(&router.RouterUnlock{SourceChain: new(big.Int).SetUint64(sourceChainId), Token: tokenAddress})
+
+	mocks.MAssetsService.AssertNotCalled(t, "OppositeAsset", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateSuccessRateGaugeIfNotExists", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_HandleMintLog_MonitoringDisabled(t *testing.T) {
+	setup()
+	w.servicedChains = toChainSet([]uint64{sourceChainId, targetChainId})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+
+	w.handleMintLog(&router.RouterMint{SourceChain: new(big.Int).SetUint64(sourceChainId), Token: tokenAddress})
+
+	mocks.MAssetsService.AssertNotCalled(t, "OppositeAsset", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateSuccessRateGaugeIfNotExists", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_HandleLockLog_UnsupportedTargetChain_CountsDropped(t *testing.T) {
+	mocks.Setup()
+	w = &Watcher{
+		dbIdentifier:      dbIdentifier,
+		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		prometheusService: mocks.MPrometheusService,
+		servicedChains:    toChainSet([]uint64{sourceChainId}),
+		watchersService:   mocks.MWatchersService,
+	}
+	mocks.MWatchersService.On("IsAssetDenied", mock.Anything, mock.Anything).Return(false)
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "dropped"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == fmt.Sprintf("%s%s_%s", constants.DroppedEventsCounterNamePrefix, constants.DropReasonUnsupportedChain, metrics.PrepareValueForPrometheusMetricName(dbIdentifier))
+	})).Return(counter)
+
+	w.handleLockLog(&router.RouterLock{Token: tokenAddress, TargetChain: big.NewInt(1), Receiver: hederaBytes, Amount: big.NewInt(1), ServiceFee: big.NewInt(0)}, mocks.MQueue)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+// tokenUpdatedLog returns a NativeTokenUpdated event log for the given status of the token
+func tokenUpdatedLog(t *testing.T, cfg FilterConfig, status bool) types.Log {
+	data, err := cfg.abi.Events[nativeTokenUpdatedEvent].Inputs.Pack(tokenAddress, big.NewInt(0), status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{
+		Topics: []common.Hash{tokenUpdatedHash},
+		Data:   data,
+		TxHash: common.HexToHash("0x2"),
+	}
+}
+
+func Test_NewFilterConfig_WithoutNativeTokenUpdated(t *testing.T) {
+	cfg, err := newFilterConfig(strings.Replace(router.RouterABI, `"name":"NativeTokenUpdated"`, `"name":"TokenUpdated"`, 1), nil, common.Address{}, 220)
+
+	assert.Nil(t, err)
+	assert.Equal(t, common.Hash{}, cfg.nativeTokenUpdatedHash)
+	assert.Equal(t, topics[0][:len(topics[0])-1], cfg.topics[0])
+}
+
+// newWatchersService returns a watchers service with an empty persisted deny-list
+func newWatchersService() *watchers.Service {
+	mocks.MDeniedAssetRepository.On("GetAll").Return([]*entity.DeniedAsset{}, nil)
+	mocks.MDeniedAssetRepository.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mocks.MDeniedAssetRepository.On("Delete", mock.Anything, mock.Anything).Return(nil)
+	return watchers.NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+}
+
+func Test_ProcessLogs_NativeTokenUpdated_DenyAndReEnable(t *testing.T) {
+	setup()
+	cfg, err := newFilterConfig("", nil, common.Address{}, 220)
+	assert.Nil(t, err)
+	w.filterConfig = cfg
+	w.watchersService = newWatchersService()
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{tokenUpdatedLog(t, cfg, false)}, nil).Once()
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{tokenUpdatedLog(t, cfg, true)}, nil).Once()
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, lockLog.TargetChain.Uint64()).Return("")
+
+	err = w.processLogs(0, 0, mocks.MQueue)
+	assert.Nil(t, err)
+	assert.True(t, w.watchersService.IsAssetDenied(sourceChainId, tokenAddressString))
+
+	w.handleLockLog(lockLog, mocks.MQueue)
+	mocks.MAssetsService.AssertNotCalled(t, "NativeToWrapped", mock.Anything, mock.Anything, mock.Anything)
+
+	// Enabling the token on-chain does not lift the deny
+	err = w.processLogs(0, 0, mocks.MQueue)
+	assert.Nil(t, err)
+	assert.True(t, w.watchersService.IsAssetDenied(sourceChainId, tokenAddressString))
+
+	assert.True(t, w.watchersService.AllowAsset(sourceChainId, tokenAddressString))
+
+	w.handleLockLog(lockLog, mocks.MQueue)
+
+	mocks.MAssetsService.AssertCalled(t, "NativeToWrapped", tokenAddressString, sourceChainId, lockLog.TargetChain.Uint64())
+}
+
+func Test_HandleBurnLog_DeniedAsset(t *testing.T) {
+	setup()
+	w.watchersService = newWatchersService()
+	w.watchersService.DenyAsset(sourceChainId, tokenAddressString)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+
+	w.handleBurnLog(burnLog, mocks.MQueue)
+
+	mocks.MAssetsService.AssertNotCalled(t, "WrappedToNative", mock.Anything, mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+// mockMidRangePanic returns unordered lock logs from blocks 12, 11 and 14 of the range from 10 to 20,
+// where the handling of the log from block 14 panics, and records the order of the handled blocks
+func mockMidRangePanic(handled *[]uint64) {
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).
+		Return([]types.Log{
+			{Topics: []common.Hash{lockHash}, BlockNumber: 12},
+			{Topics: []common.Hash{lockHash}, BlockNumber: 11},
+			{Topics: []common.Hash{lockHash}, BlockNumber: 14},
+		}, nil)
+	mocks.MBridgeContractService.On("ParseLockLog", mock.Anything).
+		Run(func(args mock.Arguments) {
+			raw := args.Get(0).(types.Log)
+			*handled = append(*handled, raw.BlockNumber)
+			if raw.BlockNumber == 14 {
+				panic("some-panic")
+			}
+		}).
+		Return(lockLog, errors.New("some-error"))
+}
+
+func Test_ProcessLogs_MidRangeFailure_CommitsCompletedBlocks(t *testing.T) {
+	setup()
+	w.partialRangeCommit = true
+	var handled []uint64
+	mockMidRangePanic(&handled)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(14)).Return(nil)
+
+	err := w.processLogs(10, 20, mocks.MQueue)
+
+	assert.Error(t, err)
+	assert.Equal(t, []uint64{11, 12, 14}, handled)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(14))
+	mocks.MStatusRepository.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func Test_ProcessLogs_MidRangeFailure_NeverLowersCheckpoint(t *testing.T) {
+	setup()
+	w.partialRangeCommit = true
+	var handled []uint64
+	mockMidRangePanic(&handled)
+	// The range starts before the checkpoint, as its first blocks are re-scanned for reorgs
+	mocks.MStatusRepository.ExpectedCalls = nil
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(16), nil)
+
+	err := w.processLogs(10, 20, mocks.MQueue)
+
+	assert.Error(t, err)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_ProcessLogs_CheckpointFailure_CommitsCompletedBlocks(t *testing.T) {
+	setup()
+	w.partialRangeCommit = true
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).
+		Return([]types.Log{
+			{Topics: []common.Hash{lockHash}, BlockNumber: 11},
+			{Topics: []common.Hash{lockHash}, BlockNumber: 14},
+		}, nil)
+	mocks.MBridgeContractService.On("ParseLockLog", mock.Anything).Return(lockLog, errors.New("some-error"))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(21)).Return(errors.New("some-error"))
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(14)).Return(nil)
+
+	err := w.processLogs(10, 20, mocks.MQueue)
+
+	assert.Error(t, err)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(14))
+}
+
+func Test_ProcessLogs_MidRangeFailure_PartialRangeCommitDisabled(t *testing.T) {
+	setup()
+	var handled []uint64
+	mockMidRangePanic(&handled)
+
+	err := w.processLogs(10, 20, mocks.MQueue)
+
+	assert.Error(t, err)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_ProcessLogs_FailureInFirstBlock_CommitsNothing(t *testing.T) {
+	setup()
+	w.partialRangeCommit = true
+	var handled []uint64
+	mockMidRangePanic(&handled)
+
+	err := w.processLogs(14, 20, mocks.MQueue)
+
+	assert.Error(t, err)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_LogsRange_GrowsOnEmptyRanges(t *testing.T) {
+	r := newLogsRange(500, 3000)
+
+	r.update(0)
+	assert.Equal(t, int64(1000), r.blocks())
+	r.update(0)
+	assert.Equal(t, int64(2000), r.blocks())
+	r.update(0)
+	assert.Equal(t, int64(3000), r.blocks())
+	r.update(0)
+	assert.Equal(t, int64(3000), r.blocks())
+}
+
+func Test_LogsRange_SnapsBackOnLogs(t *testing.T) {
+	r := newLogsRange(500, 3000)
+	r.update(0)
+	r.update(0)
+
+	r.update(2)
+
+	assert.Equal(t, int64(500), r.blocks())
+}
+
+func Test_LogsRange_WithoutCeiling(t *testing.T) {
+	r := newLogsRange(500, 0)
+
+	r.update(0)
+
+	assert.Equal(t, int64(500), r.blocks())
+}
+
+// drivePollInterval observes a block every blockTime, starting at the given block, and returns the tuned interval
+func drivePollInterval(i *pollInterval, block uint64, blockTime time.Duration, observations int) time.Duration {
+	now := time.Unix(1000, 0)
+	i.now = func() time.Time { return now }
+	for n := 0; n < observations; n++ {
+		i.observe(block + uint64(n))
+		now = now.Add(blockTime)
+	}
+	return i.duration()
+}
+
+func Test_PollInterval_FastBlocks(t *testing.T) {
+	i := newPollInterval(15*time.Second, time.Second, time.Minute)
+
+	assert.Equal(t, 2*time.Second, drivePollInterval(i, 100, 2*time.Second, 5))
+}
+
+func Test_PollInterval_SlowBlocks(t *testing.T) {
+	i := newPollInterval(5*time.Second, time.Second, time.Minute)
+
+	assert.Equal(t, 30*time.Second, drivePollInterval(i, 100, 30*time.Second, 5))
+}
+
+func Test_PollInterval_BoundedByMinAndMax(t *testing.T) {
+	fast := newPollInterval(15*time.Second, time.Second, time.Minute)
+	slow := newPollInterval(15*time.Second, time.Second, time.Minute)
+
+	assert.Equal(t, time.Second, drivePollInterval(fast, 100, 200*time.Millisecond, 5))
+	assert.Equal(t, time.Minute, drivePollInterval(slow, 100, 5*time.Minute, 5))
+}
+
+func Test_PollInterval_AveragesSkippedBlocks(t *testing.T) {
+	i := newPollInterval(15*time.Second, time.Second, time.Minute)
+	now := time.Unix(1000, 0)
+	i.now = func() time.Time { return now }
+
+	i.observe(100)
+	now = now.Add(12 * time.Second)
+	i.observe(104)
+
+	assert.Equal(t, 3*time.Second, i.duration())
+}
+
+func Test_PollInterval_UnchangedWithoutNewBlocks(t *testing.T) {
+	i := newPollInterval(15*time.Second, time.Second, time.Minute)
+
+	assert.Equal(t, 15*time.Second, drivePollInterval(i, 100, 2*time.Second, 1))
+	assert.Equal(t, 15*time.Second, drivePollInterval(i, 100, 2*time.Second, 1))
+}
+
+func Test_PollDuration_WithoutAutoTune(t *testing.T) {
+	setup()
+
+	assert.Equal(t, w.sleepDuration, w.pollDuration())
+}
+
+func Test_PollDuration_WithAutoTune(t *testing.T) {
+	setup()
+	w.pollInterval = newPollInterval(w.sleepDuration, time.Second, time.Minute)
+	drivePollInterval(w.pollInterval, 100, 3*time.Second, 3)
+
+	assert.Equal(t, 3*time.Second, w.pollDuration())
+}
+
+func Test_WatcherConfig_PollInterval(t *testing.T) {
+	cfg := WatcherConfig{PollingInterval: 5}
+	assert.Nil(t, cfg.pollInterval())
+
+	cfg.AutoTunePolling = true
+	i := cfg.pollInterval()
+	assert.Equal(t, 5*time.Second, i.duration())
+	assert.Equal(t, defaultMinPollingInterval, i.min)
+	assert.Equal(t, defaultMaxPollingInterval, i.max)
+
+	cfg.MinPollingInterval = 2
+	cfg.MaxPollingInterval = 30
+	i = cfg.pollInterval()
+	assert.Equal(t, 2*time.Second, i.min)
+	assert.Equal(t, 30*time.Second, i.max)
+}
+
+func Test_ProcessLogs_GrowsRangeOnEmptyLogs(t *testing.T) {
+	setup()
+	w.logsRange = newLogsRange(220, 10000)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{}, nil).Once()
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{}, nil).Once()
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{{}}, nil).Once()
+	mocks.MStatusRepository.On("Update", dbIdentifier, mock.Anything).Return(nil)
+
+	assert.Nil(t, w.processLogs(0, 220, mocks.MQueue))
+	assert.Equal(t, int64(440), w.logsRange.blocks())
+	assert.Nil(t, w.processLogs(221, 661, mocks.MQueue))
+	assert.Equal(t, int64(880), w.logsRange.blocks())
+	assert.Nil(t, w.processLogs(662, 1542, mocks.MQueue))
+	assert.Equal(t, int64(220), w.logsRange.blocks())
+}
+
+func Test_ProcessLogs_ResetsRangeOnError(t *testing.T) {
+	setup()
+	w.logsRange = newLogsRange(220, 10000)
+	w.logsRange.update(0)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return([]types.Log{}, errors.New("some-error"))
+
+	assert.Error(t, w.processLogs(0, 440, mocks.MQueue))
+	assert.Equal(t, int64(220), w.logsRange.blocks())
+}
+
+func Test_CheckCheckpointGap(t *testing.T) {
+	mocks.Setup()
+	w = &Watcher{
+		dbIdentifier:      dbIdentifier,
+		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		prometheusService: mocks.MPrometheusService,
+		logsRange:         newLogsRange(filterConfig.maxLogsBlocks, 0),
+	}
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "gaps"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == constants.CheckpointGapsCounterNamePrefix+metrics.PrepareValueForPrometheusMetricName(dbIdentifier)
+	})).Return(counter)
+
+	// A full range and a step back, e.g. after a partial range commit, are not gaps
+	w.checkCheckpointGap(100, 100+filterConfig.maxLogsBlocks+1)
+	w.checkCheckpointGap(100, 50)
+	// The release of an event, deferred at block 10 while the scan continued from block 100, is not a gap either
+	deferred := newDeferredEvents()
+	deferredLog := types.Log{BlockNumber: 10}
+	deferred.add(deferredLog)
+	deferred.scanned(100)
+	scanFrom := deferred.scanFrom(deferred.hold(100))
+	deferred.remove(deferredLog)
+	w.checkCheckpointGap(scanFrom, deferred.hold(100+filterConfig.maxLogsBlocks+1))
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+
+	w.checkCheckpointGap(100, 100+filterConfig.maxLogsBlocks+2)
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_ResetCheckpoint(t *testing.T) {
+	setup()
+	w.deferred.add(types.Log{BlockNumber: 12})
+	w.deferred.scanned(21)
+
+	w.ResetCheckpoint()
+
+	assert.Equal(t, 0, w.deferred.size())
+	assert.Equal(t, int64(5), w.deferred.scanFrom(5))
+}
+
+// setupDiamondCut watches the upgrades of the router with a counter of the reported upgrades and returns
+// a diamond cut of the router from block 12 of the range from 10 to 20, followed by a lock log from block 14
+func setupDiamondCut(pauseOnUpgrade bool) (prometheus.Counter, []types.Log) {
+	setup()
+	w.upgrades = newUpgrades(pauseOnUpgrade)
+	w.partialRangeCommit = true
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "router_upgrades"})
+	mocks.MPrometheusService.ExpectedCalls = nil
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == constants.RouterUpgradesCounterNamePrefix+metrics.PrepareValueForPrometheusMetricName(dbIdentifier)
+	})).Return(counter)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.Anything).Return(prometheus.NewCounter(prometheus.CounterOpts{Name: "other"}))
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.Anything).Return(prometheus.NewGauge(prometheus.GaugeOpts{Name: "other"}))
+	mocks.MPrometheusService.On("CreateHistogramIfNotExists", mock.Anything).Return(prometheus.NewHistogram(prometheus.HistogramOpts{Name: "other"}))
+	mocks.MBridgeContractService.On("ParseLockLog", mock.Anything).Return(lockLog, errors.New("some-error"))
+
+	return counter, []types.Log{
+		{Address: w.contracts.Address(), Topics: []common.Hash{diamondCutHash}, BlockNumber: 12, TxHash: common.HexToHash("0x12")},
+		{Address: w.contracts.Address(), Topics: []common.Hash{lockHash}, BlockNumber: 14},
+	}
+}
+
+func Test_ProcessLogs_DiamondCut_Reported(t *testing.T) {
+	counter, logs := setupDiamondCut(false)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return(logs, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(21)).Return(nil)
+
+	assert.Nil(t, w.processLogs(10, 20, mocks.MQueue))
+	// The cut is reported once, even if its block is re-scanned
+	assert.Nil(t, w.processLogs(10, 20, mocks.MQueue))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+	mocks.MBridgeContractService.AssertNumberOfCalls(t, "ParseLockLog", 2)
+	mocks.MWatchersService.AssertNotCalled(t, "Pause", mock.Anything)
+}
+
+func Test_ProcessLogs_DiamondCut_PausesUntilResumed(t *testing.T) {
+	counter, logs := setupDiamondCut(true)
+	w.watchersService = newWatchersService()
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything).Return(logs, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(12)).Return(nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(21)).Return(nil)
+
+	err := w.processLogs(10, 20, mocks.MQueue)
+
+	// The logs after the cut are not processed, while the blocks before it are committed
+	assert.ErrorIs(t, err, errPausedOnUpgrade)
+	assert.True(t, w.watchersService.IsPaused(dbIdentifier))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+	mocks.MBridgeContractService.AssertNotCalled(t, "ParseLockLog", mock.Anything)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(12))
+
+	// Resuming acknowledges the upgrade, so the cut is not reported again
+	w.watchersService.Resume(dbIdentifier)
+	assert.Nil(t, w.processLogs(12, 20, mocks.MQueue))
+	assert.False(t, w.watchersService.IsPaused(dbIdentifier))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+	mocks.MBridgeContractService.AssertNumberOfCalls(t, "ParseLockLog", 1)
+}
+
+func Test_IsDiamondCut(t *testing.T) {
+	setup()
+	cut := types.Log{Address: w.contracts.Address(), Topics: []common.Hash{diamondCutHash}}
+	otherEmitter := types.Log{Address: common.HexToAddress("0x1"), Topics: []common.Hash{diamondCutHash}}
+
+	assert.False(t, w.isDiamondCut(cut))
+
+	w.upgrades = newUpgrades(false)
+	assert.True(t, w.isDiamondCut(cut))
+	assert.False(t, w.isDiamondCut(otherEmitter))
+	assert.False(t, w.isDiamondCut(types.Log{Address: w.contracts.Address(), Topics: []common.Hash{lockHash}}))
+}
+
+func Test_NewWatcher_WatchUpgrades(t *testing.T) {
+	mocks.Setup()
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(100), nil)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(50), nil)
+	cfg := validWatcherConfig()
+	cfg.WatchUpgrades = true
+
+	actual, err := NewWatcherFromConfig(cfg)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, actual.upgrades)
+	assert.Contains(t, actual.filterConfig.topics[0], diamondCutHash)
+}
+
+func setupCrossVerification() types.Log {
+	setup()
+	w.verifier = mocks.MEVMCoreClient
+	w.crossVerificationThreshold = 10
+	w.sleep = func(time.Duration) {}
+
+	return types.Log{
+		Address:     common.HexToAddress("0x1"),
+		Topics:      []common.Hash{common.HexToHash("0x2"), common.HexToHash("0x3")},
+		Data:        []byte{1, 2, 3},
+		TxHash:      common.HexToHash("0x4"),
+		BlockHash:   common.HexToHash("0x5"),
+		BlockNumber: 6,
+		Index:       7,
+	}
+}
+
+func verifiedReceipt(logs ...*types.Log) *types.Receipt {
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: common.HexToHash("0x5"), Logs: logs}
+}
+
+func Test_CrossVerify_Matching(t *testing.T) {
+	raw := setupCrossVerification()
+	verified := raw
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(verifiedReceipt(&verified), nil)
+
+	assert.True(t, w.crossVerify(raw, big.NewInt(1000), big.NewInt(100)))
+}
+
+func Test_CrossVerify_FabricatedMismatch(t *testing.T) {
+	raw := setupCrossVerification()
+	fabricated := raw
+	fabricated.Data = []byte{9, 9, 9}
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(verifiedReceipt(&fabricated), nil)
+
+	assert.False(t, w.crossVerify(raw, big.NewInt(1000), big.NewInt(100)))
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+}
+
+func Test_CrossVerify_MissingLog(t *testing.T) {
+	raw := setupCrossVerification()
+	other := raw
+	other.Index = 8
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(verifiedReceipt(&other), nil)
+
+	assert.False(t, w.crossVerify(raw, big.NewInt(1000), big.NewInt(100)))
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+}
+
+func Test_CrossVerify_VerifierFails(t *testing.T) {
+	raw := setupCrossVerification()
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(nil, errors.New("some-error"))
+
+	assert.False(t, w.crossVerify(raw, big.NewInt(1000), big.NewInt(100)))
+	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "TransactionReceipt", crossVerificationAttempts)
+	assert.Equal(t, []types.Log{raw}, w.deferred.pending())
+}
+
+func Test_CrossVerify_VerifiedOnLaterPoll(t *testing.T) {
+	raw := setupCrossVerification()
+	verified := raw
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(nil, errors.New("some-error")).Times(crossVerificationAttempts)
+	mocks.MEVMCoreClient.On("TransactionReceipt", mock.Anything, raw.TxHash).Return(verifiedReceipt(&verified), nil)
+
+	assert.False(t, w.crossVerify(raw, big.NewInt(1000), big.NewInt(100)))
+	assert.Equal(t, int64(raw.BlockNumber), w.deferred.hold(100))
+
+	w.deferred.remove(raw)
+	assert.True(t, w.crossVerify(raw, big.NewInt(1000), big.NewInt(100)))
+	assert.Equal(t, 0, w.deferred.size())
+}
+
+func Test_CrossVerify_BelowThreshold(t *testing.T) {
+	raw := setupCrossVerification()
+
+	assert.True(t, w.crossVerify(raw, big.NewInt(999), big.NewInt(100)))
+	mocks.MEVMCoreClient.AssertNotCalled(t, "TransactionReceipt", mock.Anything, mock.Anything)
+}
+
+// setupDropFilters sets up a watcher with a dust threshold of 100 for the token and dropped self-transfers,
+// and returns the counter of the events, dropped for the given reason
+func setupDropFilters(reason string) prometheus.Counter {
+	mocks.Setup()
+	w = &Watcher{
+		dbIdentifier:      dbIdentifier,
+		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		prometheusService: mocks.MPrometheusService,
+		dustAmounts:       map[uint64]map[string]*big.Int{sourceChainId: {tokenAddressString: big.NewInt(100)}},
+		dropSelfTransfers: true,
+	}
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "dropped"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == fmt.Sprintf("%s%s_%s", constants.DroppedEventsCounterNamePrefix, reason, metrics.PrepareValueForPrometheusMetricName(dbIdentifier))
+	})).Return(counter)
+	return counter
+}
+
+func Test_IsDust_BelowThreshold(t *testing.T) {
+	counter := setupDropFilters(constants.DropReasonDust)
+
+	assert.True(t, w.isDust(sourceChainId, tokenAddressString, big.NewInt(99), common.Hash{}))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_IsDust_ZeroAmount(t *testing.T) {
+	counter := setupDropFilters(constants.DropReasonDust)
+
+	assert.True(t, w.isDust(sourceChainId, "0xwithout-threshold", big.NewInt(0), common.Hash{}))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_IsDust_Legitimate(t *testing.T) {
+	counter := setupDropFilters(constants.DropReasonDust)
+
+	assert.False(t, w.isDust(sourceChainId, tokenAddressString, big.NewInt(100), common.Hash{}))
+	assert.False(t, w.isDust(sourceChainId, "0xwithout-threshold", big.NewInt(1), common.Hash{}))
+	assert.False(t, w.isDust(targetChainId, tokenAddressString, big.NewInt(1), common.Hash{}))
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+// setupRounding sets up a watcher, converting the 18 decimals token to Hbar with 8 decimals, with the given rounding policy of the token
+func setupRounding(rounding string) prometheus.Counter {
+	counter := setupDropFilters(constants.DropReasonRoundingRemainder)
+	w.assetsService = mocks.MAssetsService
+	w.dispatched = newDispatchedTransfers()
+	if rounding != "" {
+		w.roundingPolicies = map[uint64]map[string]string{sourceChainId: {tokenAddressString: rounding}}
+	}
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, constants.Hbar).Return(fungibleAssetInfo, true)
+	roundingLoss = prometheus.NewCounter(prometheus.CounterOpts{Name: "rounding_loss"})
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == fmt.Sprintf("%s%d_%s", constants.RoundingLossCounterNamePrefix, sourceChainId, metrics.PrepareValueForPrometheusMetricName(strings.ToLower(tokenAddressString)))
+	})).Return(roundingLoss)
+	return counter
+}
+
+// roundingLoss is the rounding loss counter of the token, set up by setupRounding
+var roundingLoss prometheus.Counter
+
+func Test_ConvertTargetAmount_TruncatesByDefault(t *testing.T) {
+	counter := setupRounding("")
+
+	amount, decimals, _, err := w.convertTargetAmount(types.Log{}, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_999_999_999_999))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(199), amount)
+	assert.Equal(t, uint8(8), decimals)
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+func Test_ConvertTargetAmount_Truncate(t *testing.T) {
+	counter := setupRounding(decimalHelper.RoundingTruncate)
+
+	amount, _, _, err := w.convertTargetAmount(types.Log{}, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_999_999_999_999))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(199), amount)
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+func Test_ConvertTargetAmount_Reject_Remainder(t *testing.T) {
+	counter := setupRounding(decimalHelper.RoundingReject)
+
+	amount, _, _, err := w.convertTargetAmount(types.Log{}, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_999_999_999_999))
+
+	assert.ErrorIs(t, err, decimalHelper.ErrRoundingRemainder)
+	assert.Nil(t, amount)
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_ConvertTargetAmount_Reject_CleanAmount(t *testing.T) {
+	counter := setupRounding(decimalHelper.RoundingReject)
+
+	amount, _, _, err := w.convertTargetAmount(types.Log{}, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_990_000_000_000))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(199), amount)
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+func Test_ConvertTargetAmount_Remainder(t *testing.T) {
+	setupRounding("")
+
+	_, _, remainder, err := w.convertTargetAmount(types.Log{}, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_999_999_999_999))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(9_999_999_999), remainder)
+
+	_, _, remainder, err = w.convertTargetAmount(types.Log{}, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_990_000_000_000))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, remainder.Sign())
+	assert.Equal(t, float64(0), testutil.ToFloat64(roundingLoss))
+}
+
+func Test_ConvertTargetAmount_Reject_CountedOncePerEvent(t *testing.T) {
+	counter := setupRounding(decimalHelper.RoundingReject)
+	raw := types.Log{TxHash: common.HexToHash("0xrejected"), BlockNumber: 10}
+
+	for i := 0; i < 2; i++ {
+		_, _, _, err := w.convertTargetAmount(raw, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_999_999_999_999))
+		assert.ErrorIs(t, err, decimalHelper.ErrRoundingRemainder)
+	}
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+
+	raw.Index = 1
+	_, _, _, err := w.convertTargetAmount(raw, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_999_999_999_999))
+	assert.ErrorIs(t, err, decimalHelper.ErrRoundingRemainder)
+	assert.Equal(t, float64(2), testutil.ToFloat64(counter))
+	assert.Equal(t, float64(0), testutil.ToFloat64(roundingLoss))
+}
+
+func Test_ConvertTargetAmount_Reject_ByTargetAsset(t *testing.T) {
+	counter := setupRounding("")
+	w.roundingPolicies = map[uint64]map[string]string{targetChainId: {constants.Hbar: decimalHelper.RoundingReject}}
+
+	_, _, _, err := w.convertTargetAmount(types.Log{}, sourceChainId, targetChainId, tokenAddressString, constants.Hbar, big.NewInt(1_999_999_999_999))
+
+	assert.ErrorIs(t, err, decimalHelper.ErrRoundingRemainder)
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_HandleLockLog_RoundingLoss_RecordedOnDispatch(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.ExpectedCalls = nil
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	roundingLoss = prometheus.NewCounter(prometheus.CounterOpts{Name: "rounding_loss"})
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == fmt.Sprintf("%s%d_%s", constants.RoundingLossCounterNamePrefix, sourceChainId, metrics.PrepareValueForPrometheusMetricName(strings.ToLower(tokenAddressString)))
+	})).Return(roundingLoss)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.Anything).Return(prometheus.NewCounter(prometheus.CounterOpts{Name: "other"}))
+	mocks.MPrometheusService.On("CreateSuccessRateGaugeIfNotExists", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(prometheus.NewGauge(prometheus.GaugeOpts{Name: "success_rate"}), nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("BlockConfirmations").Return(uint64(5))
+	mocks.MEVMClient.On("GetBlockTimestamp", mock.Anything).Return(uint64(time.Now().Unix()))
+	mocks.MEVMClient.On("RetryTransactionByHash", mock.Anything).Return(signedTx(t), nil)
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return(constants.Hbar)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, constants.Hbar).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleNativeAsset", sourceChainId, tokenAddressString).Return(&asset.NativeAsset{ChainId: sourceChainId, Asset: tokenAddressString})
+	mocks.MPricingService.On("GetTokenPriceInfo", sourceChainId, tokenAddressString).Return(pricing.TokenPriceInfo{MinAmountWithFee: big.NewInt(0)}, true)
+	mocks.MQueue.On("Push", mock.Anything).Return()
+
+	lock := *lockLog
+	lock.Amount = big.NewInt(1_999_999_999_999)
+	w.handleLockLog(&lock, mocks.MQueue)
+	w.handleLockLog(&lock, mocks.MQueue)
+
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	assert.Equal(t, float64(9_999_999_999), testutil.ToFloat64(roundingLoss))
+}
+
+func Test_IsSelfTransfer(t *testing.T) {
+	counter := setupDropFilters(constants.DropReasonSelfTransfer)
+	sender := "0xb083879B1e10C8476802016CB12cd2F25a896691"
+
+	assert.True(t, w.isSelfTransfer(sender, strings.ToLower(sender), common.Hash{}))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_IsSelfTransfer_Legitimate(t *testing.T) {
+	counter := setupDropFilters(constants.DropReasonSelfTransfer)
+	sender := "0xb083879B1e10C8476802016CB12cd2F25a896691"
+
+	assert.False(t, w.isSelfTransfer(sender, "0x0000000000000000000000000000000000000002", common.Hash{}))
+	w.dropSelfTransfers = false
+	assert.False(t, w.isSelfTransfer(sender, sender, common.Hash{}))
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+func Test_HandleLockLog_Dust(t *testing.T) {
+	setup()
+	w.dustAmounts = map[uint64]map[string]*big.Int{sourceChainId: {tokenAddressString: big.NewInt(100)}}
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, lockLog.TargetChain.Uint64()).Return(constants.Hbar)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", lockLog.TargetChain.Uint64(), constants.Hbar).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleNativeAsset", sourceChainId, tokenAddressString).Return(&asset.NativeAsset{ChainId: sourceChainId, Asset: tokenAddressString})
+	mocks.MPricingService.On("GetTokenPriceInfo", sourceChainId, tokenAddressString).Return(pricing.TokenPriceInfo{MinAmountWithFee: big.NewInt(0)}, true)
+
+	w.handleLockLog(lockLog, mocks.MQueue)
+
+	mocks.MPricingService.AssertCalled(t, "GetTokenPriceInfo", sourceChainId, tokenAddressString)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryTransactionByHash", mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_ReportSinceLastEvent_ResetsOnNewEvent(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.ExpectedCalls = nil
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "since_last_event"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool {
+		return opts.Name == constants.SinceLastEventGaugeNamePrefix+metrics.PrepareValueForPrometheusMetricName(dbIdentifier)
+	})).Return(gauge)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.Anything).Return(prometheus.NewCounter(prometheus.CounterOpts{Name: "pushes"}))
+	mocks.MQueue.On("Push", mock.Anything).Return()
+	w.dispatched.last = time.Now().Add(-time.Hour)
+
+	w.reportSinceLastEvent()
+	assert.GreaterOrEqual(t, testutil.ToFloat64(gauge), time.Hour.Seconds())
+
+	w.dispatch(mocks.MQueue, &payload.Transfer{TransactionId: "0xnew-0"}, constants.TopicMessageSubmission, types.Log{})
+	w.reportSinceLastEvent()
+	assert.Less(t, testutil.ToFloat64(gauge), float64(1))
 }
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mapping_consistency
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/mappings"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	config_mappings "github.com/limechain/hedera-eth-bridge-validator/app/router/config-mappings"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// The default interval, on which the asset mappings are compared with the peers
+const defaultPollingInterval = 10 * time.Minute
+
+// The path of the asset mappings hash in the API of a validator
+var mappingsPath = "/api/v1" + config_mappings.Route
+
+// Watcher compares the hash of the local asset mappings with the hashes of the peer validators,
+// on startup and periodically afterwards, alerting on disagreement
+type Watcher struct {
+	assetsService     service.Assets
+	pricingService    service.Pricing
+	prometheusService service.Prometheus
+	httpClient        client.HttpClient
+	peers             []string
+	pollingInterval   time.Duration
+	logger            *log.Entry
+}
+
+func NewWatcher(assetsService service.Assets, pricingService service.Pricing, prometheusService service.Prometheus, httpClient client.HttpClient, peers []string, pollingInterval time.Duration) *Watcher {
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+
+	return &Watcher{
+		assetsService:     assetsService,
+		pricingService:    pricingService,
+		prometheusService: prometheusService,
+		httpClient:        httpClient,
+		peers:             peers,
+		pollingInterval:   pollingInterval,
+		logger:            config.GetLoggerFor("Mapping Consistency Watcher"),
+	}
+}
+
+func (mcw *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			mcw.watchIteration()
+			time.Sleep(mcw.pollingInterval)
+		}
+	}()
+}
+
+// watchIteration returns the number of peers, which disagree with the local asset mappings
+func (mcw *Watcher) watchIteration() int {
+	local, err := mappings.Hash(mcw.assetsService, mcw.pricingService)
+	if err != nil {
+		mcw.logger.Errorf("Failed to hash the local asset mappings. Error: [%s]", err)
+		return 0
+	}
+
+	mismatches := 0
+	for _, peer := range mcw.peers {
+		remote, err := mcw.peerHash(peer)
+		if err != nil {
+			mcw.logger.Warnf("Failed to fetch the asset mappings hash of peer [%s]. Error: [%s]", peer, err)
+			continue
+		}
+
+		if remote != local {
+			mismatches++
+			mcw.logger.Errorf("Asset mappings of peer [%s] differ from the local ones. Peer hash: [%s], local hash: [%s].", peer, remote, local)
+		}
+	}
+
+	metrics.SetMappingMismatches(mismatches, mcw.prometheusService)
+	return mismatches
+}
+
+func (mcw *Watcher) peerHash(peer string) (string, error) {
+	var response config_mappings.MappingsResponse
+	var statusCode int
+	url := strings.TrimSuffix(peer, "/") + mappingsPath
+	err := httpHelper.Get(mcw.httpClient, url, nil, &response, mcw.logger, &statusCode)
+	if err != nil {
+		return "", err
+	}
+	if statusCode != http.StatusOK || response.Hash == "" {
+		return "", fmt.Errorf("unexpected response with status code [%d]", statusCode)
+	}
+
+	return response.Hash, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mapping_consistency
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/mappings"
+	config_mappings "github.com/limechain/hedera-eth-bridge-validator/app/router/config-mappings"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	watcher   *Watcher
+	peers     = []string{"http://validator-1:5200", "http://validator-2:5200/"}
+	localHash string
+	gauge     prometheus.Gauge
+)
+
+func setup(t *testing.T) {
+	mocks.Setup()
+	mocks.MAssetsService.On("NativeToWrappedAssets").Return(map[uint64]map[string]map[uint64]string{
+		1: {"0xabc": {296: "0.0.1001"}},
+	})
+	mocks.MAssetsService.On("FungibleNetworkAssets").Return(map[uint64][]string{})
+	mocks.MAssetsService.On("NonFungibleNetworkAssets").Return(map[uint64][]string{})
+
+	var err error
+	localHash, err = mappings.Hash(mocks.MAssetsService, mocks.MPricingService)
+	assert.Nil(t, err)
+
+	watcher = &Watcher{
+		assetsService:     mocks.MAssetsService,
+		prometheusService: mocks.MPrometheusService,
+		httpClient:        mocks.MHTTPClient,
+		peers:             peers,
+		pollingInterval:   defaultPollingInterval,
+		logger:            config.GetLoggerFor("Mapping Consistency Watcher"),
+	}
+	gauge = prometheus.NewGauge(prometheus.GaugeOpts{Name: constants.MappingMismatchesGaugeName})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateGaugeIfNotExists", mock.Anything).Return(gauge)
+}
+
+// mockPeer mocks the response of the given peer with the given asset mappings hash
+func mockPeer(t *testing.T, url, hash string) {
+	body, err := httpHelper.EncodeBodyContent(&config_mappings.MappingsResponse{Hash: hash})
+	assert.Nil(t, err)
+	mocks.MHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == url
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: body}, nil)
+}
+
+func Test_NewWatcher(t *testing.T) {
+	setup(t)
+
+	actual := NewWatcher(mocks.MAssetsService, mocks.MPricingService, mocks.MPrometheusService, mocks.MHTTPClient, peers, 0)
+
+	assert.Equal(t, defaultPollingInterval, actual.pollingInterval)
+	assert.Equal(t, peers, actual.peers)
+}
+
+func Test_watchIteration_Matching(t *testing.T) {
+	setup(t)
+	mockPeer(t, "http://validator-1:5200/api/v1/config/mappings", localHash)
+	mockPeer(t, "http://validator-2:5200/api/v1/config/mappings", localHash)
+
+	assert.Equal(t, 0, watcher.watchIteration())
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauge))
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 2)
+}
+
+func Test_watchIteration_Mismatching(t *testing.T) {
+	setup(t)
+	mockPeer(t, "http://validator-1:5200/api/v1/config/mappings", localHash)
+	mockPeer(t, "http://validator-2:5200/api/v1/config/mappings", "other-hash")
+
+	assert.Equal(t, 1, watcher.watchIteration())
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge))
+}
+
+func Test_watchIteration_PeerUnavailable(t *testing.T) {
+	setup(t)
+	mockPeer(t, "http://validator-1:5200/api/v1/config/mappings", "other-hash")
+	mocks.MHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "http://validator-2:5200/api/v1/config/mappings"
+	})).Return((*http.Response)(nil), errors.New("connection refused"))
+
+	assert.Equal(t, 1, watcher.watchIteration())
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge))
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package message_retention
+
+import (
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// The default interval, on which expired signature messages are pruned
+const defaultPruningInterval = time.Hour
+
+// Watcher periodically prunes the signature messages of terminal transfers, which are older than the TTL,
+// bounding the growth of the messages table. The transfer records are kept
+type Watcher struct {
+	messageRepository repository.Message
+	ttl               time.Duration
+	pruningInterval   time.Duration
+	now               func() time.Time
+	logger            *log.Entry
+}
+
+func NewWatcher(messageRepository repository.Message, ttl, pruningInterval time.Duration) *Watcher {
+	if pruningInterval == 0 {
+		pruningInterval = defaultPruningInterval
+	}
+
+	return &Watcher{
+		messageRepository: messageRepository,
+		ttl:               ttl,
+		pruningInterval:   pruningInterval,
+		now:               time.Now,
+		logger:            config.GetLoggerFor("Message Retention Watcher"),
+	}
+}
+
+func (mrw *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			mrw.watchIteration()
+			time.Sleep(mrw.pruningInterval)
+		}
+	}()
+}
+
+// watchIteration prunes the messages, older than the TTL, and returns the amount of pruned messages
+func (mrw *Watcher) watchIteration() int64 {
+	cutoff := mrw.now().Add(-mrw.ttl)
+	pruned, err := mrw.messageRepository.PruneMessagesBefore(cutoff)
+	if err != nil {
+		mrw.logger.Errorf("Failed to prune signature messages before [%s]. Error: [%s]", cutoff, err)
+		return 0
+	}
+
+	if pruned > 0 {
+		mrw.logger.Infof("Pruned [%d] signature messages of terminal transfers before [%s].", pruned, cutoff)
+	}
+	return pruned
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package message_retention
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	ttl = 24 * time.Hour
+	now = time.Unix(1_000_000, 0)
+)
+
+func setup() *Watcher {
+	mocks.Setup()
+	watcher := NewWatcher(mocks.MMessageRepository, ttl, 0)
+	watcher.now = func() time.Time { return now }
+	return watcher
+}
+
+func Test_NewWatcher(t *testing.T) {
+	mocks.Setup()
+
+	actual := NewWatcher(mocks.MMessageRepository, ttl, 0)
+
+	assert.Equal(t, defaultPruningInterval, actual.pruningInterval)
+	assert.Equal(t, ttl, actual.ttl)
+	assert.NotNil(t, actual.now)
+}
+
+func Test_watchIteration(t *testing.T) {
+	watcher := setup()
+	mocks.MMessageRepository.On("PruneMessagesBefore", now.Add(-ttl)).Return(int64(5), nil)
+
+	pruned := watcher.watchIteration()
+
+	assert.Equal(t, int64(5), pruned)
+	mocks.MMessageRepository.AssertCalled(t, "PruneMessagesBefore", now.Add(-ttl))
+}
+
+func Test_watchIteration_Fails(t *testing.T) {
+	watcher := setup()
+	mocks.MMessageRepository.On("PruneMessagesBefore", mock.Anything).Return(int64(0), errors.New("some-error"))
+
+	pruned := watcher.watchIteration()
+
+	assert.Equal(t, int64(0), pruned)
+}
@@ -17,6 +17,7 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -27,7 +28,10 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -36,11 +40,13 @@ import (
 )
 
 type Watcher struct {
-	client           client.MirrorNode
-	topicID          hedera.TopicID
-	statusRepository repository.Status
-	pollingInterval  time.Duration
-	logger           *log.Entry
+	client            client.MirrorNode
+	topicID           hedera.TopicID
+	statusRepository  repository.Status
+	pollingInterval   time.Duration
+	maxMessageSize    int
+	prometheusService service.Prometheus
+	logger            *log.Entry
 }
 
 func NewWatcher(
@@ -48,7 +54,9 @@ func NewWatcher(
 	topicID string,
 	repository repository.Status,
 	pollingInterval time.Duration,
-	startTimestamp int64) *Watcher {
+	startTimestamp int64,
+	maxMessageSize int,
+	prometheusService service.Prometheus) *Watcher {
 	id, err := hedera.TopicIDFromString(topicID)
 	if err != nil {
 		log.Fatalf("Could not start Consensus Topic Watcher for topic [%s] - Error: [%s]", topicID, err)
@@ -79,14 +87,21 @@ func NewWatcher(
 	}
 
 	return &Watcher{
-		client:           client,
-		topicID:          id,
-		statusRepository: repository,
-		pollingInterval:  pollingInterval,
-		logger:           config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
+		client:            client,
+		topicID:           id,
+		statusRepository:  repository,
+		pollingInterval:   pollingInterval,
+		maxMessageSize:    maxMessageSize,
+		prometheusService: prometheusService,
+		logger:            config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
 	}
 }
 
+// Partition returns the queue partition of the watcher, named by its topic id
+func (cmw Watcher) Partition() string {
+	return cmw.topicID.String()
+}
+
 func (cmw Watcher) Watch(q qi.Queue) {
 	if !cmw.client.TopicExists(cmw.topicID) {
 		cmw.logger.Errorf("Could not start monitoring topic [%s] - Topic not found.", cmw.topicID.String())
@@ -139,11 +154,19 @@ func (cmw Watcher) beginWatching(q qi.Queue) {
 func (cmw Watcher) processMessage(topicMsg mirrorNodeMsg.Message, q qi.Queue) {
 	cmw.logger.Debugf("New Message Received")
 
-	msg, err := message.FromString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
+	msg, err := message.FromStringWithMaxSize(topicMsg.Contents, topicMsg.ConsensusTimestamp, cmw.maxMessageSize)
+	if errors.Is(err, message.ErrPayloadTooLarge) {
+		cmw.logger.Warnf("Dropping message with consensus timestamp [%s]. Error: [%s]", topicMsg.ConsensusTimestamp, err)
+		metrics.IncrementTopicDroppedMessages(cmw.topicID.String(), constants.DropReasonOversized, cmw.prometheusService)
+		return
+	}
 	if err != nil {
 		cmw.logger.Errorf("Could not decode incoming message [%s]. Error: [%s]", topicMsg.Contents, err)
 		return
 	}
 
-	q.Push(&queue.Message{Payload: msg, Topic: constants.TopicMessageValidation})
+	ctx, span := tracing.Start(context.Background(), "topic.dispatch", tracing.TransferId(msg))
+	defer span.End()
+
+	q.Push(&queue.Message{Payload: msg, Topic: constants.TopicMessageValidation, Trace: tracing.Inject(ctx)})
 }
@@ -17,6 +17,7 @@
 package message
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -26,7 +27,11 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 	"testing"
@@ -56,24 +61,65 @@ func Test_ProcessMessage_FromString_Fails(t *testing.T) {
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
+// setupMaxMessageSize limits the size of the messages of the watcher and returns the counter of the oversized messages
+func setupMaxMessageSize(maxMessageSize int) prometheus.Counter {
+	setup()
+	w.maxMessageSize = maxMessageSize
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "dropped"})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == constants.TopicDroppedMessagesCounterNamePrefix+constants.DropReasonOversized+"_0_0_1"
+	})).Return(counter)
+	return counter
+}
+
+func Test_ProcessMessage_AtMaxMessageSize(t *testing.T) {
+	data := signatureMessageBytes(t)
+	counter := setupMaxMessageSize(len(data))
+	mocks.MQueue.On("Push", mock.Anything).Return()
+
+	w.processMessage(mirrorNodeMsg.Message{Contents: base64.StdEncoding.EncodeToString(data), ConsensusTimestamp: consensusTimestamp}, mocks.MQueue)
+
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+func Test_ProcessMessage_OverMaxMessageSize(t *testing.T) {
+	data := signatureMessageBytes(t)
+	counter := setupMaxMessageSize(len(data) - 1)
+
+	w.processMessage(mirrorNodeMsg.Message{Contents: base64.StdEncoding.EncodeToString(data), ConsensusTimestamp: consensusTimestamp}, mocks.MQueue)
+
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func signatureMessageBytes(t *testing.T) []byte {
+	data, err := message.NewFungibleSignature(&proto.TopicEthSignatureMessage{TransferID: "0.0.123-1-1", Signature: "signature"}).ToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
 func Test_NewWatcher(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0, 0, mocks.MPrometheusService)
 }
 
 func Test_NewWatcher_Get_Error(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), gorm.ErrRecordNotFound)
 	mocks.MStatusRepository.On("Create", topicID.String(), mock.Anything).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0, 0, mocks.MPrometheusService)
 }
 
 func Test_NewWatcher_WithTS(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(6), nil)
 	mocks.MStatusRepository.On("Update", topicID.String(), int64(6)).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 6)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 6, 0, mocks.MPrometheusService)
 }
 
 func Test_BeginWatch_FailsMessagesRetrieval(t *testing.T) {
@@ -122,10 +168,11 @@ func Test_BeginWatch_SuccessfulExecution(t *testing.T) {
 func setup() {
 	mocks.Setup()
 	w = &Watcher{
-		client:           mocks.MHederaMirrorClient,
-		topicID:          topicID,
-		statusRepository: mocks.MStatusRepository,
-		pollingInterval:  1,
-		logger:           config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
+		client:            mocks.MHederaMirrorClient,
+		topicID:           topicID,
+		statusRepository:  mocks.MStatusRepository,
+		pollingInterval:   1,
+		prometheusService: mocks.MPrometheusService,
+		logger:            config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
 	}
 }
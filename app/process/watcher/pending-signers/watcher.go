@@ -0,0 +1,62 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pending_signers
+
+import (
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// The default time, after which a member's missing signature is reported
+	defaultTimeout = 5 * time.Minute
+	// How often the pending signers are checked
+	pollingInterval = time.Minute
+)
+
+// Watcher periodically reports the members, whose signatures are missing for longer than the timeout
+type Watcher struct {
+	messagesService service.Messages
+	timeout         time.Duration
+	logger          *log.Entry
+}
+
+func NewWatcher(messagesService service.Messages, timeout time.Duration) *Watcher {
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Watcher{
+		messagesService: messagesService,
+		timeout:         timeout,
+		logger:          config.GetLoggerFor("Pending Signers Watcher"),
+	}
+}
+
+func (psw *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			psw.messagesService.ReportPendingSigners(psw.timeout)
+			time.Sleep(pollingInterval)
+		}
+	}()
+}
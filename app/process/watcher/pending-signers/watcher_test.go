@@ -0,0 +1,47 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pending_signers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewWatcher(t *testing.T) {
+	mocks.Setup()
+	expected := &Watcher{
+		messagesService: mocks.MMessageService,
+		timeout:         defaultTimeout,
+		logger:          config.GetLoggerFor("Pending Signers Watcher"),
+	}
+
+	actual := NewWatcher(mocks.MMessageService, 0)
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_NewWatcher_Timeout(t *testing.T) {
+	mocks.Setup()
+
+	actual := NewWatcher(mocks.MMessageService, time.Minute)
+
+	assert.Equal(t, time.Minute, actual.timeout)
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signature_request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
+)
+
+// The default interval, on which the transfers are checked for missing signatures
+const defaultPollingInterval = time.Minute
+
+// transferKey identifies a transfer by its transaction id together with its source chain
+type transferKey struct {
+	sourceChainId uint64
+	transferID    string
+}
+
+// Watcher periodically requests the missing signatures of the transfers, which are short of majority for longer
+// than the wait. The validator, whose signature was recorded first for a transfer, requests its signatures after the wait.
+// Every next signer joins after another wait, so that the requests go on when the earlier signers are gone
+type Watcher struct {
+	transferRepository repository.Transfer
+	messagesService    service.Messages
+	hederaNode         client.HederaNode
+	topicIDs           []hedera.TopicID
+	wait               time.Duration
+	pollingInterval    time.Duration
+	// The time of the last request, per transfer short of majority
+	requested map[transferKey]time.Time
+	now       func() time.Time
+	logger    *log.Entry
+}
+
+func NewWatcher(
+	transferRepository repository.Transfer,
+	messagesService service.Messages,
+	hederaNode client.HederaNode,
+	topicIds []string,
+	wait, pollingInterval time.Duration,
+) *Watcher {
+	topicIDs, err := hederahelper.TopicIDsFromStrings(topicIds)
+	if err != nil || len(topicIDs) == 0 {
+		log.Fatalf("Invalid topic ids: [%v]", topicIds)
+	}
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+
+	return &Watcher{
+		transferRepository: transferRepository,
+		messagesService:    messagesService,
+		hederaNode:         hederaNode,
+		topicIDs:           topicIDs,
+		wait:               wait,
+		pollingInterval:    pollingInterval,
+		requested:          make(map[transferKey]time.Time),
+		now:                time.Now,
+		logger:             config.GetLoggerFor("Signature Request Watcher"),
+	}
+}
+
+func (srw *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			srw.watchIteration()
+			time.Sleep(srw.pollingInterval)
+		}
+	}()
+}
+
+func (srw *Watcher) watchIteration() {
+	now := srw.now()
+	transfers, err := srw.transferRepository.GetInitialBefore(now.Add(-srw.wait))
+	if err != nil {
+		srw.logger.Errorf("Failed to query transfers awaiting signatures. Error: [%s]", err)
+		return
+	}
+
+	awaiting := make(map[transferKey]bool, len(transfers))
+	for _, transfer := range transfers {
+		// Transfers to Hedera are completed by scheduled transactions, not by signatures
+		if transfer.TargetChainID == constants.HederaNetworkId || transfer.IsNft {
+			continue
+		}
+		key := transferKey{sourceChainId: transfer.SourceChainID, transferID: transfer.TransactionID}
+		awaiting[key] = true
+
+		if last, ok := srw.requested[key]; ok && now.Sub(last) < srw.wait {
+			continue
+		}
+
+		if srw.request(transfer.SourceChainID, transfer.TransactionID, now.Sub(transfer.CreatedAt)) {
+			srw.requested[key] = now
+		}
+	}
+
+	// Transfers, which are no longer short of majority, are no longer tracked
+	for key := range srw.requested {
+		if !awaiting[key] {
+			delete(srw.requested, key)
+		}
+	}
+}
+
+// request publishes a request for the missing signatures of the transfer, stored the given time ago, if it is the turn
+// of this validator by its signer rank. Returns whether the request was published
+func (srw *Watcher) request(sourceChainId uint64, transferID string, age time.Duration) bool {
+	rank, err := srw.messagesService.SignerRank(sourceChainId, transferID)
+	if err != nil {
+		srw.logger.Errorf("[%s] - Failed to determine the signer rank. Error: [%s]", transferID, err)
+		return false
+	}
+	if rank < 0 || age < srw.wait*time.Duration(rank+1) {
+		return false
+	}
+
+	pending, err := srw.messagesService.PendingSigners(sourceChainId, transferID)
+	if err != nil {
+		srw.logger.Errorf("[%s] - Failed to get pending signers. Error: [%s]", transferID, err)
+		return false
+	}
+	if len(pending) == 0 {
+		return false
+	}
+
+	bytes, err := message.NewSignatureRequest(sourceChainId, transferID).ToBytes()
+	if err != nil {
+		srw.logger.Errorf("[%s] - Failed to marshal Signature Request Message. Error: [%s]", transferID, err)
+		return false
+	}
+
+	topic := hederahelper.SignatureTopic(transferID, srw.topicIDs)
+	_, err = srw.hederaNode.SubmitTopicConsensusMessage(topic, bytes)
+	if err != nil {
+		srw.logger.Errorf("[%s] - Failed to submit Signature Request Message to Topic [%s]. Error: [%s]", transferID, topic, err)
+		return false
+	}
+
+	srw.logger.Infof("[%s] - Requested the missing signatures of [%s] on Topic [%s].", transferID, strings.Join(pending, ", "), topic)
+	return true
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signature_request
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	watcher         *Watcher
+	now             = time.Unix(1700000000, 0)
+	wait            = 5 * time.Minute
+	transferId      = "0.0.123-1-1"
+	sourceChainId   = constants.HederaNetworkId
+	key             = transferKey{sourceChainId: sourceChainId, transferID: transferId}
+	topicId         = hedera.TopicID{Topic: 1}
+	requestBytes, _ = message.NewSignatureRequest(sourceChainId, transferId).ToBytes()
+)
+
+func setup() {
+	mocks.Setup()
+	watcher = &Watcher{
+		transferRepository: mocks.MTransferRepository,
+		messagesService:    mocks.MMessageService,
+		hederaNode:         mocks.MHederaNodeClient,
+		topicIDs:           []hedera.TopicID{topicId},
+		wait:               wait,
+		pollingInterval:    defaultPollingInterval,
+		requested:          make(map[transferKey]time.Time),
+		now:                func() time.Time { return now },
+		logger:             config.GetLoggerFor("Signature Request Watcher"),
+	}
+}
+
+func initialTransfer(targetChainId uint64) []*entity.Transfer {
+	return []*entity.Transfer{{TransactionID: transferId, SourceChainID: sourceChainId, TargetChainID: targetChainId, CreatedAt: now.Add(-wait)}}
+}
+
+func Test_NewWatcher(t *testing.T) {
+	setup()
+
+	actual := NewWatcher(mocks.MTransferRepository, mocks.MMessageService, mocks.MHederaNodeClient, []string{"0.0.1"}, wait, 0)
+
+	assert.Equal(t, defaultPollingInterval, actual.pollingInterval)
+	assert.Equal(t, wait, actual.wait)
+	assert.Equal(t, []hedera.TopicID{topicId}, actual.topicIDs)
+}
+
+func Test_watchIteration_RequestsMissingSignatures(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-wait)).Return(initialTransfer(80001), nil)
+	mocks.MMessageService.On("SignerRank", sourceChainId, transferId).Return(0, nil)
+	mocks.MMessageService.On("PendingSigners", sourceChainId, transferId).Return([]string{"0xabc2"}, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, requestBytes).Return(&hedera.TransactionID{}, nil)
+
+	watcher.watchIteration()
+
+	mocks.MHederaNodeClient.AssertCalled(t, "SubmitTopicConsensusMessage", topicId, requestBytes)
+	assert.Equal(t, map[transferKey]time.Time{key: now}, watcher.requested)
+}
+
+func Test_watchIteration_NotSigner(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-wait)).Return(initialTransfer(80001), nil)
+	mocks.MMessageService.On("SignerRank", sourceChainId, transferId).Return(-1, nil)
+
+	watcher.watchIteration()
+
+	mocks.MMessageService.AssertNotCalled(t, "PendingSigners", sourceChainId, transferId)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, mock.Anything)
+	assert.Empty(t, watcher.requested)
+}
+
+func Test_watchIteration_NextSignerRequestsAfterAnotherWait(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", mock.Anything).Return(initialTransfer(80001), nil)
+	mocks.MMessageService.On("SignerRank", sourceChainId, transferId).Return(1, nil)
+	mocks.MMessageService.On("PendingSigners", sourceChainId, transferId).Return([]string{"0xabc2"}, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, requestBytes).Return(&hedera.TransactionID{}, nil)
+
+	watcher.watchIteration()
+	mocks.MMessageService.AssertNotCalled(t, "PendingSigners", sourceChainId, transferId)
+	assert.Empty(t, watcher.requested)
+
+	watcher.now = func() time.Time { return now.Add(wait) }
+	watcher.watchIteration()
+	mocks.MHederaNodeClient.AssertCalled(t, "SubmitTopicConsensusMessage", topicId, requestBytes)
+	assert.Equal(t, map[transferKey]time.Time{key: now.Add(wait)}, watcher.requested)
+}
+
+func Test_watchIteration_RequestsAgainAfterWait(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", mock.Anything).Return(initialTransfer(80001), nil)
+	mocks.MMessageService.On("SignerRank", sourceChainId, transferId).Return(0, nil)
+	mocks.MMessageService.On("PendingSigners", sourceChainId, transferId).Return([]string{"0xabc2"}, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, requestBytes).Return(&hedera.TransactionID{}, nil)
+
+	watcher.watchIteration()
+	watcher.now = func() time.Time { return now.Add(wait - time.Second) }
+	watcher.watchIteration()
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 1)
+
+	watcher.now = func() time.Time { return now.Add(wait) }
+	watcher.watchIteration()
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitTopicConsensusMessage", 2)
+}
+
+func Test_watchIteration_Satisfied(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", mock.Anything).Return(initialTransfer(80001), nil).Once()
+	mocks.MTransferRepository.On("GetInitialBefore", mock.Anything).Return([]*entity.Transfer{}, nil).Once()
+	mocks.MMessageService.On("SignerRank", sourceChainId, transferId).Return(0, nil)
+	mocks.MMessageService.On("PendingSigners", sourceChainId, transferId).Return([]string{}, nil)
+
+	watcher.watchIteration()
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, mock.Anything)
+
+	watcher.requested[key] = now
+	watcher.watchIteration()
+	assert.Empty(t, watcher.requested)
+}
+
+func Test_watchIteration_SkipsHederaTargets(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-wait)).Return(initialTransfer(constants.HederaNetworkId), nil)
+
+	watcher.watchIteration()
+
+	mocks.MMessageService.AssertNotCalled(t, "SignerRank", mock.Anything, mock.Anything)
+}
+
+func Test_watchIteration_SubmissionFails(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-wait)).Return(initialTransfer(80001), nil)
+	mocks.MMessageService.On("SignerRank", sourceChainId, transferId).Return(0, nil)
+	mocks.MMessageService.On("PendingSigners", sourceChainId, transferId).Return([]string{"0xabc2"}, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, requestBytes).Return((*hedera.TransactionID)(nil), errors.New("some-error"))
+
+	watcher.watchIteration()
+
+	assert.Empty(t, watcher.requested)
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signature_timeout
+
+import (
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
+)
+
+// The default interval, on which the transfers are checked for a signature timeout
+const defaultPollingInterval = time.Minute
+
+// Watcher periodically fails the transfers, whose signatures have not reached majority within the timeout
+type Watcher struct {
+	transferRepository repository.Transfer
+	prometheusService  service.Prometheus
+	timeout            time.Duration
+	pollingInterval    time.Duration
+	now                func() time.Time
+	logger             *log.Entry
+}
+
+func NewWatcher(transferRepository repository.Transfer, prometheusService service.Prometheus, timeout, pollingInterval time.Duration) *Watcher {
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+
+	return &Watcher{
+		transferRepository: transferRepository,
+		prometheusService:  prometheusService,
+		timeout:            timeout,
+		pollingInterval:    pollingInterval,
+		now:                time.Now,
+		logger:             config.GetLoggerFor("Signature Timeout Watcher"),
+	}
+}
+
+func (stw *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			stw.watchIteration()
+			time.Sleep(stw.pollingInterval)
+		}
+	}()
+}
+
+func (stw *Watcher) watchIteration() {
+	transfers, err := stw.transferRepository.GetInitialBefore(stw.now().Add(-stw.timeout))
+	if err != nil {
+		stw.logger.Errorf("Failed to query transfers awaiting signatures. Error: [%s]", err)
+		return
+	}
+
+	for _, transfer := range transfers {
+		// Transfers to Hedera are completed by scheduled transactions, not by signatures
+		if transfer.TargetChainID == constants.HederaNetworkId {
+			continue
+		}
+
+		failed, err := stw.transferRepository.UpdateStatusFailedIfInitial(transfer.SourceChainID, transfer.TransactionID)
+		if err != nil {
+			stw.logger.Errorf("[%s] - Failed to fail transfer on signature timeout. Error: [%s]", transfer.TransactionID, err)
+			continue
+		}
+		if !failed {
+			// Majority has been reached since the query
+			continue
+		}
+
+		stw.logger.Warnf("[%s] - Signature timeout. Majority was not reached within [%s].", transfer.TransactionID, stw.timeout)
+		metrics.IncrementSignatureTimeouts(stw.prometheusService)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signature_timeout
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	watcher    *Watcher
+	now        = time.Unix(1700000000, 0)
+	timeout    = 30 * time.Minute
+	transferId = "0.0.123-1-1"
+	counter    prometheus.Counter
+)
+
+func setup() {
+	mocks.Setup()
+	watcher = &Watcher{
+		transferRepository: mocks.MTransferRepository,
+		prometheusService:  mocks.MPrometheusService,
+		timeout:            timeout,
+		pollingInterval:    defaultPollingInterval,
+		now:                func() time.Time { return now },
+		logger:             config.GetLoggerFor("Signature Timeout Watcher"),
+	}
+	counter = prometheus.NewCounter(prometheus.CounterOpts{Name: constants.SignatureTimeoutsCounterName})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.Anything).Return(counter)
+}
+
+func initialTransfer(targetChainId uint64) []*entity.Transfer {
+	return []*entity.Transfer{{TransactionID: transferId, SourceChainID: constants.HederaNetworkId, TargetChainID: targetChainId}}
+}
+
+func Test_NewWatcher(t *testing.T) {
+	setup()
+
+	actual := NewWatcher(mocks.MTransferRepository, mocks.MPrometheusService, timeout, 0)
+
+	assert.Equal(t, defaultPollingInterval, actual.pollingInterval)
+	assert.Equal(t, timeout, actual.timeout)
+}
+
+func Test_watchIteration_MajorityNotReached(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-timeout)).Return(initialTransfer(80001), nil)
+	mocks.MTransferRepository.On("UpdateStatusFailedIfInitial", constants.HederaNetworkId, transferId).Return(true, nil)
+
+	watcher.watchIteration()
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailedIfInitial", constants.HederaNetworkId, transferId)
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func Test_watchIteration_MajorityReachedBeforeTimeout(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-timeout)).Return([]*entity.Transfer{}, nil)
+
+	watcher.watchIteration()
+
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailedIfInitial", mock.Anything, mock.Anything)
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+func Test_watchIteration_MajorityReachedDuringCheck(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-timeout)).Return(initialTransfer(80001), nil)
+	mocks.MTransferRepository.On("UpdateStatusFailedIfInitial", constants.HederaNetworkId, transferId).Return(false, nil)
+
+	watcher.watchIteration()
+
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
+
+func Test_watchIteration_SkipsTransfersToHedera(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-timeout)).Return(initialTransfer(constants.HederaNetworkId), nil)
+
+	watcher.watchIteration()
+
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailedIfInitial", mock.Anything, mock.Anything)
+}
+
+func Test_watchIteration_QueryError(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-timeout)).Return(nil, errors.New("some error"))
+
+	watcher.watchIteration()
+
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailedIfInitial", mock.Anything, mock.Anything)
+}
+
+func Test_watchIteration_UpdateError(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitialBefore", now.Add(-timeout)).Return(initialTransfer(80001), nil)
+	mocks.MTransferRepository.On("UpdateStatusFailedIfInitial", constants.HederaNetworkId, transferId).Return(false, errors.New("some error"))
+
+	watcher.watchIteration()
+
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter))
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_status
+
+import (
+	"strings"
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// The default interval, on which the transfer counts are polled
+const defaultPollingInterval = time.Minute
+
+// Watcher periodically publishes the number of transfers per status as Prometheus gauges
+type Watcher struct {
+	transferRepository repository.Transfer
+	prometheusService  service.Prometheus
+	pollingInterval    time.Duration
+	logger             *log.Entry
+}
+
+func NewWatcher(transferRepository repository.Transfer, prometheusService service.Prometheus, pollingInterval time.Duration) *Watcher {
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+
+	return &Watcher{
+		transferRepository: transferRepository,
+		prometheusService:  prometheusService,
+		pollingInterval:    pollingInterval,
+		logger:             config.GetLoggerFor("Transfer Status Watcher"),
+	}
+}
+
+func (tsw *Watcher) Watch(q qi.Queue) {
+	// there will be no handler, so the q is to implement the interface
+	go func() {
+		for {
+			tsw.watchIteration()
+			time.Sleep(tsw.pollingInterval)
+		}
+	}()
+}
+
+func (tsw *Watcher) watchIteration() {
+	counts, err := tsw.transferRepository.CountByStatus()
+	if err != nil {
+		tsw.logger.Errorf("Failed to count transfers by status. Error: [%s]", err)
+		return
+	}
+
+	for status, count := range counts {
+		metrics.SetGauge(prometheus.GaugeOpts{
+			Name: constants.TransfersByStatusGaugeNamePrefix + strings.ToLower(status),
+			Help: constants.TransfersByStatusGaugeHelp,
+		}, float64(count), tsw.prometheusService)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_status
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	watcher *Watcher
+)
+
+func Test_NewWatcher(t *testing.T) {
+	setup()
+
+	actual := NewWatcher(mocks.MTransferRepository, mocks.MPrometheusService, 0)
+
+	assert.Equal(t, watcher, actual)
+}
+
+func Test_watchIteration(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	counts := map[string]int64{
+		status.Initial:   42,
+		status.Completed: 7,
+		status.Failed:    0,
+	}
+	mocks.MTransferRepository.On("CountByStatus").Return(counts, nil)
+
+	gauges := map[string]prometheus.Gauge{}
+	for s := range counts {
+		opts := prometheus.GaugeOpts{
+			Name: constants.TransfersByStatusGaugeNamePrefix + strings.ToLower(s),
+			Help: constants.TransfersByStatusGaugeHelp,
+		}
+		gauges[s] = prometheus.NewGauge(opts)
+		mocks.MPrometheusService.On("CreateGaugeIfNotExists", opts).Return(gauges[s])
+	}
+
+	watcher.watchIteration()
+
+	for s, count := range counts {
+		assert.Equal(t, float64(count), testutil.ToFloat64(gauges[s]))
+	}
+}
+
+func Test_watchIteration_Error(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("CountByStatus").Return(nil, errors.New("some error"))
+
+	watcher.watchIteration()
+
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateGaugeIfNotExists", mock.Anything)
+}
+
+func Test_watchIteration_MonitoringDisabled(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MTransferRepository.On("CountByStatus").Return(map[string]int64{status.Initial: 42}, nil)
+
+	watcher.watchIteration()
+
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateGaugeIfNotExists", mock.Anything)
+}
+
+func setup() {
+	mocks.Setup()
+
+	watcher = &Watcher{
+		transferRepository: mocks.MTransferRepository,
+		prometheusService:  mocks.MPrometheusService,
+		pollingInterval:    time.Minute,
+		logger:             config.GetLoggerFor("Transfer Status Watcher"),
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -35,7 +36,9 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/sampling"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -59,6 +62,8 @@ type Watcher struct {
 	prometheusService   service.Prometheus
 	pricingService      service.Pricing
 	blacklistedAccounts []string
+	maxTransferAge      time.Duration
+	logSampler          *sampling.Sampler
 }
 
 func NewWatcher(
@@ -74,11 +79,16 @@ func NewWatcher(
 	prometheusService service.Prometheus,
 	pricingService service.Pricing,
 	blacklistedAccounts []string,
+	maxTransferAge time.Duration,
+	logSampler *sampling.Sampler,
 ) *Watcher {
 	id, err := hedera.AccountIDFromString(accountID)
 	if err != nil {
 		log.Fatalf("Could not start Crypto Transfer Watcher for account [%s] - Error: [%s]", accountID, err)
 	}
+	// The status is tracked under the normalized account, so that checksum variants share the same progress
+	configuredAccountID := accountID
+	accountID = id.String()
 
 	targetTimestamp := time.Now().UnixNano()
 	timeStamp := startTimestamp
@@ -86,7 +96,7 @@ func NewWatcher(
 		_, err := repository.Get(accountID)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				err := repository.Create(accountID, targetTimestamp)
+				err := repository.Create(accountID, migratedTimestamp(repository, configuredAccountID, accountID, targetTimestamp))
 				if err != nil {
 					log.Fatalf("Failed to create Transfer Watcher timestamp. Error: [%s]", err)
 				}
@@ -117,12 +127,35 @@ func NewWatcher(
 		pricingService:      pricingService,
 		prometheusService:   prometheusService,
 		blacklistedAccounts: blacklistedAccounts,
+		maxTransferAge:      maxTransferAge,
+		logSampler:          logSampler,
 	}
 
 	return instance
 
 }
 
+// Partition returns the queue partition of the watcher, named by the chain id of Hedera
+func (ctw Watcher) Partition() string {
+	return strconv.FormatUint(constants.HederaNetworkId, 10)
+}
+
+// migratedTimestamp returns the timestamp, tracked under the configured account, before the status key was normalized.
+// Returns the given default, if the configured account is already normalized or has no status
+func migratedTimestamp(repository repository.Status, configuredAccountID, accountID string, defaultTimestamp int64) int64 {
+	if configuredAccountID == accountID {
+		return defaultTimestamp
+	}
+
+	migrated, err := repository.Get(configuredAccountID)
+	if err != nil {
+		return defaultTimestamp
+	}
+
+	log.Infof("Migrating Transfer Watcher timestamp [%s] from [%s] to [%s].", timestamp.ToHumanReadable(migrated), configuredAccountID, accountID)
+	return migrated
+}
+
 func (ctw Watcher) Watch(q qi.Queue) {
 	if !ctw.client.AccountExists(ctw.accountID) {
 		ctw.logger.Errorf("Could not start monitoring account [%s] - Account not found.", ctw.accountID.String())
@@ -175,7 +208,9 @@ func (ctw Watcher) beginWatching(q qi.Queue) {
 }
 
 func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
-	ctw.logger.Infof("New Transaction with ID: [%s]", txID)
+	if !ctw.logSampler.Skip(nil, nil) {
+		ctw.logger.Infof("New Transaction with ID: [%s]", txID)
+	}
 
 	// TX like: [HBAR -> WHBAR || HTS -> WHTS || WEVM -> EVM] (Hereda to EVM)
 	tx, err := ctw.client.GetSuccessfulTransaction(txID)
@@ -269,7 +304,7 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 	transferMessage.Originator = originator
 
 	topic := ""
-	if ctw.validator && transactionTimestamp > ctw.targetTimestamp {
+	if ctw.shouldProcess(tx.TransactionID, transactionTimestamp) {
 		if nativeAsset.ChainId == constants.HederaNetworkId {
 			if checkResult.NftId != nil {
 				topic = constants.HederaNativeNftTransfer
@@ -300,7 +335,24 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 		}
 	}
 
-	q.Push(&queue.Message{Payload: transferMessage, Topic: topic})
+	ctx, span := tracing.Start(transferMessage.Context(), "hedera.dispatch", transferMessage.TransactionId)
+	defer span.End()
+
+	q.Push(&queue.Message{Payload: transferMessage, Topic: topic, Trace: tracing.Inject(ctx)})
+}
+
+// shouldProcess checks whether a transfer should be auto-processed, or only routed to the read-only path
+func (ctw Watcher) shouldProcess(transactionID string, transactionTimestamp int64) bool {
+	if !ctw.validator || transactionTimestamp <= ctw.targetTimestamp {
+		return false
+	}
+
+	if timestamp.Expired(timestamp.FromNanos(transactionTimestamp), time.Now(), ctw.maxTransferAge) {
+		ctw.logger.Warnf("[%s] - Transfer is older than the max transfer age [%s]. Routing it for manual review.", transactionID, ctw.maxTransferAge)
+		return false
+	}
+
+	return true
 }
 
 func (ctw Watcher) validateNFTFeeSent(sourceAsset string, tx transaction.Transaction, originator string, nftAssetInfo *asset.NonFungibleAssetInfo, feeSent int64) (int64, bool) {
@@ -386,9 +438,9 @@ func (ctw Watcher) createFungiblePayload(transactionID string, receiver string,
 		return nil, fmt.Errorf("decimals of source asset [%s] and target asset [%s] are not equal", sourceAsset, targetChainAsset)
 	}
 
-	targetAmount := decimal.TargetAmount(sourceAssetInfo.Decimals, targetAssetInfo.Decimals, big.NewInt(amount))
-	if targetAmount.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf("insufficient amount provided: Amount [%d] and Target Amount [%s]", amount, targetAmount)
+	targetAmount, err := decimal.AdjustDecimals(big.NewInt(amount), sourceAssetInfo.Decimals, targetAssetInfo.Decimals)
+	if err != nil {
+		return nil, fmt.Errorf("insufficient amount provided: Amount [%d]. Error [%s]", amount, err)
 	}
 
 	tokenPriceInfo, exist := ctw.pricingService.GetTokenPriceInfo(asset.ChainId, nativeAsset.Asset)
@@ -401,7 +453,15 @@ func (ctw Watcher) createFungiblePayload(transactionID string, receiver string,
 		return nil, fmt.Errorf("[%s] - Transfer Amount [%s] is less than Minimum Amount [%s]", transactionID, targetAmount, tokenPriceInfo.MinAmountWithFee)
 	}
 
-	return payload.New(
+	if !ctw.logSampler.Skip(targetAmount, tokenPriceInfo.MinAmountWithFee) {
+		ctw.logger.Infof("[%s] - New Transfer with Amount [%s], Receiver Address [%s] and Target Chain [%d] has been found.",
+			transactionID,
+			targetAmount,
+			receiver,
+			targetChainId)
+	}
+
+	transfer := payload.New(
 		transactionID,
 		constants.HederaNetworkId,
 		targetChainId,
@@ -410,7 +470,9 @@ func (ctw Watcher) createFungiblePayload(transactionID string, receiver string,
 		sourceAsset,
 		targetChainAsset,
 		nativeAsset.Asset,
-		targetAmount.String()), nil
+		targetAmount.String())
+	transfer.Decimals = targetAssetInfo.Decimals
+	return transfer, nil
 }
 
 func (ctw Watcher) createNonFungiblePayload(
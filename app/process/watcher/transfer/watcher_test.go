@@ -82,6 +82,12 @@ var (
 	}
 )
 
+func Test_Partition(t *testing.T) {
+	w := initializeWatcher()
+
+	assert.Equal(t, strconv.FormatUint(constants.HederaNetworkId, 10), w.Partition())
+}
+
 func Test_NewMemo_MissingWrappedCorrelation(t *testing.T) {
 	w := initializeWatcher()
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
@@ -114,11 +120,40 @@ func Test_NewWatcher_RecordNotFound_Creates(t *testing.T) {
 		mocks.MPrometheusService,
 		mocks.MPricingService,
 		blacklist,
+		0,
+		nil,
 	)
 
 	mocks.MStatusRepository.AssertCalled(t, "Create", txAccountId, mock.Anything)
 }
 
+func Test_NewWatcher_RecordNotFound_MigratesConfiguredAccount(t *testing.T) {
+	setup()
+	configuredAccountId := "0.0.0444444"
+	mocks.MStatusRepository.On("Get", txAccountId).Return(int64(0), gorm.ErrRecordNotFound)
+	mocks.MStatusRepository.On("Get", configuredAccountId).Return(int64(100), nil)
+	mocks.MStatusRepository.On("Create", txAccountId, int64(100)).Return(nil)
+
+	NewWatcher(
+		mocks.MTransferService,
+		mocks.MHederaMirrorClient,
+		configuredAccountId,
+		5,
+		mocks.MStatusRepository,
+		0,
+		map[uint64]iservice.Contracts{3: mocks.MBridgeContractService, 0: mocks.MBridgeContractService},
+		mocks.MAssetsService,
+		true,
+		mocks.MPrometheusService,
+		mocks.MPricingService,
+		[]string{},
+		0,
+		nil,
+	)
+
+	mocks.MStatusRepository.AssertCalled(t, "Create", txAccountId, int64(100))
+}
+
 func Test_NewWatcher_NotNilTS_Works(t *testing.T) {
 	setup()
 	mocks.MStatusRepository.On("Update", txAccountId, mock.Anything).Return(nil)
@@ -137,6 +172,8 @@ func Test_NewWatcher_NotNilTS_Works(t *testing.T) {
 		mocks.MPrometheusService,
 		mocks.MPricingService,
 		blacklist,
+		0,
+		nil,
 	)
 
 	mocks.MStatusRepository.AssertCalled(t, "Update", txAccountId, mock.Anything)
@@ -454,6 +491,7 @@ func Test_createFungiblePayload(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, transactionID, payload.TransactionId)
 	assert.Equal(t, strconv.FormatInt(amount, 10), payload.Amount)
+	assert.Equal(t, uint8(8), payload.Decimals)
 	assert.Equal(t, receiver, payload.Receiver)
 }
 
@@ -548,5 +586,28 @@ func initializeWatcher() *Watcher {
 		mocks.MPrometheusService,
 		mocks.MPricingService,
 		blacklist,
+		0,
+		nil,
 	)
 }
+
+func Test_ShouldProcess_MaxTransferAge(t *testing.T) {
+	w := initializeWatcher()
+	w.targetTimestamp = 0
+	w.maxTransferAge = time.Hour
+	now := time.Now()
+
+	assert.True(t, w.shouldProcess(tx.TransactionID, now.Add(-time.Minute).UnixNano()))
+	assert.False(t, w.shouldProcess(tx.TransactionID, now.Add(-2*time.Hour).UnixNano()))
+
+	w.maxTransferAge = 0
+	assert.True(t, w.shouldProcess(tx.TransactionID, now.Add(-2*time.Hour).UnixNano()))
+}
+
+func Test_ShouldProcess_NotValidator(t *testing.T) {
+	w := initializeWatcher()
+	w.targetTimestamp = 0
+	w.validator = false
+
+	assert.False(t, w.shouldProcess(tx.TransactionID, time.Now().UnixNano()))
+}
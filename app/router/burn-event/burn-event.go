@@ -16,18 +16,25 @@ var (
 	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
 )
 
-func NewRouter(service service.BurnEvent) chi.Router {
+func NewRouter(service service.BurnEvent, transfersService service.Transfers) chi.Router {
 	r := chi.NewRouter()
-	r.Get("/{id}/tx", getTxID(service))
+	r.Get("/{id}/tx", getTxID(service, transfersService))
 	return r
 }
 
-// GET: .../events/:id/tx
-func getTxID(burnService service.BurnEvent) func(w http.ResponseWriter, r *http.Request) {
+// GET: .../events/:id/tx?sourceChainId=
+func getTxID(burnService service.BurnEvent, transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		eventID := chi.URLParam(r, "id")
 
-		txID, err := burnService.TransactionID(eventID)
+		sourceChainId, err := httpHelper.SourceChainId(r, eventID, transfersService.SourceChain)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		txID, err := burnService.TransactionID(sourceChainId, eventID)
 		if err != nil {
 			logger.Errorf("Router resolved with an error. Error [%s].", err)
 			httpHelper.WriteErrorResponse(w, r, err)
@@ -26,7 +26,9 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
+	"net/url"
 	"testing"
 )
 
@@ -34,10 +36,11 @@ var (
 	eventIdUrlParamKey = "id"
 	eventId            = "1"
 	transactionId      = "1"
+	sourceChainId      = uint64(80001)
 )
 
 func Test_NewRouter(t *testing.T) {
-	router := NewRouter(mocks.MBurnService)
+	router := NewRouter(mocks.MBurnService, mocks.MTransferService)
 
 	assert.NotNil(t, router)
 }
@@ -53,13 +56,14 @@ func Test_getTxID(t *testing.T) {
 		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
 	}
 	txIdResponseAsBytes := buf.Bytes()
-	request := prepareRequest()
+	request := prepareRequest("")
+	mocks.MTransferService.On("SourceChain", eventId).Return(sourceChainId, nil)
 
-	mocks.MBurnService.On("TransactionID", eventId).Return(transactionId, err)
+	mocks.MBurnService.On("TransactionID", sourceChainId, eventId).Return(transactionId, err)
 	mocks.MResponseWriter.On("Header").Return(http.Header{})
 	mocks.MResponseWriter.On("Write", txIdResponseAsBytes).Return(len(txIdResponseAsBytes), nil)
 
-	txIdResponseHandler := getTxID(mocks.MBurnService)
+	txIdResponseHandler := getTxID(mocks.MBurnService, mocks.MTransferService)
 	txIdResponseHandler(mocks.MResponseWriter, request)
 
 	assert.Nil(t, err)
@@ -78,19 +82,20 @@ func Test_getTxID_ErrNotFound(t *testing.T) {
 		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
 	}
 	txIdResponseAsBytes := buf.Bytes()
-	request := prepareRequest()
+	request := prepareRequest("")
+	mocks.MTransferService.On("SourceChain", eventId).Return(sourceChainId, nil)
 
-	mocks.MBurnService.On("TransactionID", eventId).Return(transactionId, service.ErrNotFound)
+	mocks.MBurnService.On("TransactionID", sourceChainId, eventId).Return(transactionId, service.ErrNotFound)
 	mocks.MResponseWriter.On("Header").Return(http.Header{})
 	mocks.MResponseWriter.On("Write", txIdResponseAsBytes).Return(len(txIdResponseAsBytes), nil)
 	mocks.MResponseWriter.On("WriteHeader", http.StatusNotFound).Return()
 
-	txIdResponseHandler := getTxID(mocks.MBurnService)
+	txIdResponseHandler := getTxID(mocks.MBurnService, mocks.MTransferService)
 	txIdResponseHandler(mocks.MResponseWriter, request)
 
 	assert.NotNil(t, txIdResponseHandler)
 	assert.NotNil(t, txIdResponseAsBytes)
-	mocks.MBurnService.AssertCalled(t, "TransactionID", eventId)
+	mocks.MBurnService.AssertCalled(t, "TransactionID", sourceChainId, eventId)
 	mocks.MResponseWriter.AssertCalled(t, "Header")
 	mocks.MResponseWriter.AssertCalled(t, "Write", txIdResponseAsBytes)
 	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusNotFound)
@@ -108,26 +113,71 @@ func Test_getTxID_InternalServerErr(t *testing.T) {
 		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
 	}
 	txIdResponseAsBytes := buf.Bytes()
-	request := prepareRequest()
+	request := prepareRequest("")
+	mocks.MTransferService.On("SourceChain", eventId).Return(sourceChainId, nil)
 
-	mocks.MBurnService.On("TransactionID", eventId).Return(transactionId, err)
+	mocks.MBurnService.On("TransactionID", sourceChainId, eventId).Return(transactionId, err)
 	mocks.MResponseWriter.On("Header").Return(http.Header{})
 	mocks.MResponseWriter.On("Write", txIdResponseAsBytes).Return(len(txIdResponseAsBytes), nil)
 	mocks.MResponseWriter.On("WriteHeader", http.StatusInternalServerError).Return()
 
-	txIdResponseHandler := getTxID(mocks.MBurnService)
+	txIdResponseHandler := getTxID(mocks.MBurnService, mocks.MTransferService)
 	txIdResponseHandler(mocks.MResponseWriter, request)
 
 	assert.NotNil(t, txIdResponseHandler)
 	assert.NotNil(t, txIdResponseAsBytes)
-	mocks.MBurnService.AssertCalled(t, "TransactionID", eventId)
+	mocks.MBurnService.AssertCalled(t, "TransactionID", sourceChainId, eventId)
 	mocks.MResponseWriter.AssertCalled(t, "Header")
 	mocks.MResponseWriter.AssertCalled(t, "Write", txIdResponseAsBytes)
 	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusInternalServerError)
 }
 
-func prepareRequest() *http.Request {
+func Test_getTxID_WithSourceChainId(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(transactionId); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	txIdResponseAsBytes := buf.Bytes()
+	request := prepareRequest("sourceChainId=80001")
+
+	mocks.MBurnService.On("TransactionID", sourceChainId, eventId).Return(transactionId, nil)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", txIdResponseAsBytes).Return(len(txIdResponseAsBytes), nil)
+
+	getTxID(mocks.MBurnService, mocks.MTransferService)(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertNotCalled(t, "SourceChain", mock.Anything)
+	mocks.MResponseWriter.AssertCalled(t, "Write", txIdResponseAsBytes)
+}
+
+func Test_getTxID_AmbiguousTransfer(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(response.ErrorResponse(service.ErrAmbiguousTransfer)); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	txIdResponseAsBytes := buf.Bytes()
+	request := prepareRequest("")
+
+	mocks.MTransferService.On("SourceChain", eventId).Return(uint64(0), service.ErrAmbiguousTransfer)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", txIdResponseAsBytes).Return(len(txIdResponseAsBytes), nil)
+	mocks.MResponseWriter.On("WriteHeader", http.StatusBadRequest).Return()
+
+	getTxID(mocks.MBurnService, mocks.MTransferService)(mocks.MResponseWriter, request)
+
+	mocks.MBurnService.AssertNotCalled(t, "TransactionID", mock.Anything, mock.Anything)
+	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusBadRequest)
+}
+
+func prepareRequest(rawQuery string) *http.Request {
 	request := new(http.Request)
+	request.URL = &url.URL{RawQuery: rawQuery}
 	chiCtx := &chi.Context{
 		URLParams: chi.RouteParams{
 			Keys:   []string{eventIdUrlParamKey},
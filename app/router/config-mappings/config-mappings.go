@@ -0,0 +1,60 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config_mappings
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/mappings"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+var (
+	Route  = "/config/mappings"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+// MappingsResponse holds the hash of the effective asset mappings, compared across validators
+type MappingsResponse struct {
+	Hash string `json:"hash"`
+}
+
+// Router for the hash of the asset mappings
+func NewRouter(assetsService service.Assets, pricingService service.Pricing) http.Handler {
+	r := chi.NewRouter()
+	r.Get("/", mappingsResponse(assetsService, pricingService))
+	return r
+}
+
+// GET: .../config/mappings
+func mappingsResponse(assetsService service.Assets, pricingService service.Pricing) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		hash, err := mappings.Hash(assetsService, pricingService)
+		if err != nil {
+			logger.Errorf("Failed to hash the asset mappings. Error: [%s]", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &MappingsResponse{Hash: hash})
+	}
+}
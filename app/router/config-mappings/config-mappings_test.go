@@ -0,0 +1,57 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config_mappings
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/mappings"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MAssetsService, mocks.MPricingService)
+
+	assert.NotNil(t, router)
+}
+
+func Test_mappingsResponse(t *testing.T) {
+	mocks.Setup()
+	mocks.MAssetsService.On("NativeToWrappedAssets").Return(map[uint64]map[string]map[uint64]string{})
+	mocks.MAssetsService.On("FungibleNetworkAssets").Return(map[uint64][]string{})
+	mocks.MAssetsService.On("NonFungibleNetworkAssets").Return(map[uint64][]string{})
+
+	hash, err := mappings.Hash(mocks.MAssetsService, mocks.MPricingService)
+	assert.Nil(t, err)
+
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(&MappingsResponse{Hash: hash}); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	mappingsResponseAsBytes := buf.Bytes()
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", mappingsResponseAsBytes).Return(len(mappingsResponseAsBytes), nil)
+
+	mappingsResponseHandler := mappingsResponse(mocks.MAssetsService, mocks.MPricingService)
+	mappingsResponseHandler(mocks.MResponseWriter, new(http.Request))
+
+	mocks.MResponseWriter.AssertCalled(t, "Write", mappingsResponseAsBytes)
+}
@@ -17,10 +17,13 @@
 package healthcheck
 
 import (
+	"net/http"
+	"sort"
+
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
-	"net/http"
 )
 
 var (
@@ -28,17 +31,30 @@ var (
 )
 
 //Router for health check
-func NewRouter() http.Handler {
+func NewRouter(contractServices map[uint64]service.Contracts) http.Handler {
 	r := chi.NewRouter()
-	r.Get("/", healthResponse())
+	r.Get("/", healthResponse(contractServices))
 	return r
 }
 
 // GET: .../health
-func healthResponse() func(w http.ResponseWriter, r *http.Request) {
+func healthResponse(contractServices map[uint64]service.Contracts) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		render.JSON(w, r, &response.HealthResponse{
-			Status: "OK",
+			Status:       "OK",
+			MembersStale: membersStale(contractServices),
 		})
 	}
 }
+
+func membersStale(contractServices map[uint64]service.Contracts) []uint64 {
+	var stale []uint64
+	for chainId, contractService := range contractServices {
+		if contractService.MembersStale() {
+			stale = append(stale, chainId)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i] < stale[j] })
+
+	return stale
+}
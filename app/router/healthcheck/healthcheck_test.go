@@ -19,6 +19,7 @@ package healthcheck
 import (
 	"bytes"
 	"encoding/json"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -27,7 +28,7 @@ import (
 )
 
 func Test_NewRouter(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(nil)
 
 	assert.NotNil(t, router)
 }
@@ -51,10 +52,32 @@ func Test_configBridgeResponse(t *testing.T) {
 	mocks.MResponseWriter.On("Header").Return(http.Header{})
 	mocks.MResponseWriter.On("Write", healthCheckResponseAsBytes).Return(len(healthCheckResponseAsBytes), nil)
 
-	healthCheckResponseHandler := healthResponse()
+	healthCheckResponseHandler := healthResponse(nil)
 	healthCheckResponseHandler(mocks.MResponseWriter, new(http.Request))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, healthCheckResponseHandler)
 	assert.NotNil(t, healthCheckResponseAsBytes)
 }
+
+func Test_healthResponse_MembersStale(t *testing.T) {
+	mocks.Setup()
+	staleContractService := new(mocks.MockBridgeContract)
+	staleContractService.On("MembersStale").Return(true)
+	mocks.MBridgeContractService.On("MembersStale").Return(false)
+	contractServices := map[uint64]service.Contracts{
+		1: mocks.MBridgeContractService,
+		2: staleContractService,
+	}
+
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(&response.HealthResponse{Status: "OK", MembersStale: []uint64{2}}); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", buf.Bytes()).Return(buf.Len(), nil)
+
+	healthResponse(contractServices)(mocks.MResponseWriter, new(http.Request))
+
+	mocks.MResponseWriter.AssertCalled(t, "Write", buf.Bytes())
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package public_transfers
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+)
+
+// The number of tracked clients or cached responses, after which the expired ones are evicted
+const maxEntries = 10000
+
+// rateLimiter allows up to `limit` requests per client IP in a fixed time window
+type rateLimiter struct {
+	limit   int
+	window  time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	clients map[string]*clientWindow
+}
+
+type clientWindow struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		window:  window,
+		now:     time.Now,
+		clients: make(map[string]*clientWindow),
+	}
+}
+
+// allow registers a request from the given IP and returns the time to wait, if the limit is exceeded
+func (rl *rateLimiter) allow(ip string) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	if len(rl.clients) >= maxEntries {
+		for k, w := range rl.clients {
+			if now.Sub(w.start) >= rl.window {
+				delete(rl.clients, k)
+			}
+		}
+	}
+
+	w, ok := rl.clients[ip]
+	if !ok || now.Sub(w.start) >= rl.window {
+		w = &clientWindow{start: now}
+		rl.clients[ip] = w
+	}
+	if w.count >= rl.limit {
+		return false, w.start.Add(rl.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
+
+func (rl *rateLimiter) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed, retryAfter := rl.allow(clientIP(r))
+		if !allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			render.Status(r, http.StatusTooManyRequests)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("rate limit exceeded")))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// responseCache caches the successful responses by request URI for a fixed TTL
+type responseCache struct {
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+type cachedResponse struct {
+	header    http.Header
+	body      []byte
+	expiresAt time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*cachedResponse),
+	}
+}
+
+func (rc *responseCache) get(key string) *cachedResponse {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[key]
+	if !ok {
+		return nil
+	}
+	if !rc.now().Before(entry.expiresAt) {
+		delete(rc.entries, key)
+		return nil
+	}
+	return entry
+}
+
+func (rc *responseCache) set(key string, header http.Header, body []byte) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	now := rc.now()
+	if len(rc.entries) >= maxEntries {
+		for k, e := range rc.entries {
+			if !now.Before(e.expiresAt) {
+				delete(rc.entries, k)
+			}
+		}
+		if len(rc.entries) >= maxEntries {
+			return
+		}
+	}
+	rc.entries[key] = &cachedResponse{
+		header:    header.Clone(),
+		body:      body,
+		expiresAt: now.Add(rc.ttl),
+	}
+}
+
+func (rc *responseCache) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.RequestURI()
+		if entry := rc.get(key); entry != nil {
+			for k, v := range entry.header {
+				w.Header()[k] = v
+			}
+			w.Header().Set("X-Cache", "HIT")
+			w.WriteHeader(http.StatusOK)
+			w.Write(entry.body)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		if recorder.status == http.StatusOK {
+			rc.set(key, w.Header(), recorder.body.Bytes())
+		}
+	})
+}
+
+// responseRecorder writes through the response, while keeping its status and body
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	rr.status = status
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
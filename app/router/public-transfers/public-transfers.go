@@ -0,0 +1,149 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package public_transfers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+var (
+	Route  = "/public/transfers"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+const (
+	// The default number of requests per minute, allowed for a single client IP
+	defaultRateLimit = 60
+	// The default time, for which successful responses are cached
+	defaultCacheTtl = 10 * time.Second
+	rateLimitWindow = time.Minute
+	// The number of transfers returned per page, when looking up by receiver
+	receiverPageSize = 50
+)
+
+// PublicTransfers is the public, end-user facing response of the receiver lookup
+type PublicTransfers struct {
+	Items      []*transferModel.PublicTransfer `json:"items"`
+	TotalCount int64                           `json:"totalCount"`
+}
+
+// NewRouter creates the public, rate limited and cached, transfer status router
+func NewRouter(transferRepository repository.Transfer, cfg config.PublicApi) chi.Router {
+	rateLimit := cfg.RateLimit
+	if rateLimit == 0 {
+		rateLimit = defaultRateLimit
+	}
+	cacheTtl := cfg.CacheTtl
+	if cacheTtl == 0 {
+		cacheTtl = defaultCacheTtl
+	}
+
+	r := chi.NewRouter()
+	r.Use(newRateLimiter(rateLimit, rateLimitWindow).handler, newResponseCache(cacheTtl).handler)
+	r.Get("/", getByReceiver(transferRepository))
+	r.Get("/{id}", getTransfer(transferRepository))
+	return r
+}
+
+// GET: .../public/transfers/:id?sourceChainId=
+func getTransfer(transferRepository repository.Transfer) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		transferID := chi.URLParam(r, "id")
+
+		var transfer *entity.Transfer
+		var err error
+		if s := r.URL.Query().Get("sourceChainId"); s != "" {
+			sourceChainId, parseErr := strconv.ParseUint(s, 10, 64)
+			if parseErr != nil {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(fmt.Errorf("sourceChainId must be a chain id")))
+				return
+			}
+			transfer, err = transferRepository.GetByTransactionId(sourceChainId, transferID)
+		} else {
+			transfer, err = transferRepository.GetByTransactionIdOnAnyChain(transferID)
+		}
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+		if transfer == nil {
+			httpHelper.WriteErrorResponse(w, r, service.ErrNotFound)
+			return
+		}
+
+		render.JSON(w, r, transfer.ToPublicDto())
+	}
+}
+
+// GET: .../public/transfers?receiver=&page=
+func getByReceiver(transferRepository repository.Transfer) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		receiver := r.URL.Query().Get("receiver")
+		if receiver == "" {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("receiver is required")))
+			return
+		}
+
+		page := uint64(1)
+		if p := r.URL.Query().Get("page"); p != "" {
+			parsed, err := strconv.ParseUint(p, 10, 64)
+			if err != nil || parsed == 0 {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(fmt.Errorf("page must be greater than 0")))
+				return
+			}
+			page = parsed
+		}
+
+		transfers, count, err := transferRepository.Paged(&transferModel.PagedRequest{
+			Page:     page,
+			PageSize: receiverPageSize,
+			Filter:   transferModel.Filter{Receiver: receiver},
+		})
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		items := make([]*transferModel.PublicTransfer, 0, len(transfers))
+		for _, t := range transfers {
+			items = append(items, t.ToPublicDto())
+		}
+
+		render.JSON(w, r, PublicTransfers{
+			Items:      items,
+			TotalCount: count,
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package public_transfers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	transferId = "0.0.1-1-1"
+	receiver   = "0x0000000000000000000000000000000000000001"
+	transfer   = &entity.Transfer{
+		TransactionID: transferId,
+		SourceChainID: 296,
+		TargetChainID: 80001,
+		SourceAsset:   "0.0.2",
+		TargetAsset:   "0x0000000000000000000000000000000000000002",
+		Receiver:      receiver,
+		Amount:        "100",
+		Fee:           "10",
+		Originator:    "0.0.3",
+		Status:        status.Completed,
+	}
+)
+
+func serve(router http.Handler, target string, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.RemoteAddr = remoteAddr
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func Test_getTransfer(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("GetByTransactionIdOnAnyChain", transferId).Return(transfer, nil)
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	w := serve(router, "/"+transferId, "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	actual := new(transferModel.PublicTransfer)
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), actual))
+	assert.Equal(t, transfer.ToPublicDto(), actual)
+	assert.NotContains(t, w.Body.String(), "originator")
+	assert.NotContains(t, w.Body.String(), "fee")
+}
+
+func Test_getTransfer_NotFound(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("GetByTransactionIdOnAnyChain", transferId).Return((*entity.Transfer)(nil), nil)
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	w := serve(router, "/"+transferId, "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func Test_getTransfer_Ambiguous(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("GetByTransactionIdOnAnyChain", transferId).Return((*entity.Transfer)(nil), service.ErrAmbiguousTransfer)
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	w := serve(router, "/"+transferId, "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_getTransfer_BySourceChain(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("GetByTransactionId", uint64(296), transferId).Return(transfer, nil)
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	w := serve(router, "/"+transferId+"?sourceChainId=296", "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionIdOnAnyChain", transferId)
+}
+
+func Test_getTransfer_InvalidSourceChain(t *testing.T) {
+	mocks.Setup()
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	w := serve(router, "/"+transferId+"?sourceChainId=hedera", "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_getByReceiver(t *testing.T) {
+	mocks.Setup()
+	req := &transferModel.PagedRequest{Page: 2, PageSize: receiverPageSize, Filter: transferModel.Filter{Receiver: receiver}}
+	mocks.MTransferRepository.On("Paged", req).Return([]*entity.Transfer{transfer}, int64(51), nil)
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	w := serve(router, "/?receiver="+receiver+"&page=2", "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	actual := new(PublicTransfers)
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), actual))
+	assert.Equal(t, int64(51), actual.TotalCount)
+	assert.Equal(t, []*transferModel.PublicTransfer{transfer.ToPublicDto()}, actual.Items)
+}
+
+func Test_getByReceiver_InvalidRequest(t *testing.T) {
+	mocks.Setup()
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	assert.Equal(t, http.StatusBadRequest, serve(router, "/", "1.1.1.1:1").Code)
+	assert.Equal(t, http.StatusBadRequest, serve(router, "/?receiver="+receiver+"&page=0", "1.1.1.1:1").Code)
+	mocks.MTransferRepository.AssertNotCalled(t, "Paged")
+}
+
+func Test_getByReceiver_Error(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("Paged", &transferModel.PagedRequest{Page: 1, PageSize: receiverPageSize, Filter: transferModel.Filter{Receiver: receiver}}).Return(nil, int64(0), errors.New("some-error"))
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	w := serve(router, "/?receiver="+receiver, "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func Test_RateLimit(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("GetByTransactionIdOnAnyChain", transferId).Return(transfer, nil)
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{RateLimit: 2, CacheTtl: time.Minute})
+
+	assert.Equal(t, http.StatusOK, serve(router, "/"+transferId, "1.1.1.1:1").Code)
+	assert.Equal(t, http.StatusOK, serve(router, "/"+transferId, "1.1.1.1:2").Code)
+	limited := serve(router, "/"+transferId, "1.1.1.1:3")
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.NotEmpty(t, limited.Header().Get("Retry-After"))
+
+	// other clients are limited separately
+	assert.Equal(t, http.StatusOK, serve(router, "/"+transferId, "2.2.2.2:1").Code)
+}
+
+func Test_RateLimit_WindowResets(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(1, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	allowed, _ := limiter.allow("1.1.1.1")
+	assert.True(t, allowed)
+	allowed, retryAfter := limiter.allow("1.1.1.1")
+	assert.False(t, allowed)
+	assert.Equal(t, time.Minute, retryAfter)
+
+	now = now.Add(time.Minute)
+	allowed, _ = limiter.allow("1.1.1.1")
+	assert.True(t, allowed)
+}
+
+func Test_Cache_Hit(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("GetByTransactionIdOnAnyChain", transferId).Return(transfer, nil).Once()
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	first := serve(router, "/"+transferId, "1.1.1.1:1")
+	second := serve(router, "/"+transferId, "1.1.1.1:1")
+
+	assert.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, "HIT", second.Header().Get("X-Cache"))
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "GetByTransactionIdOnAnyChain", 1)
+}
+
+func Test_Cache_SkipsErrors(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("GetByTransactionIdOnAnyChain", transferId).Return((*entity.Transfer)(nil), nil)
+	router := NewRouter(mocks.MTransferRepository, config.PublicApi{})
+
+	serve(router, "/"+transferId, "1.1.1.1:1")
+	serve(router, "/"+transferId, "1.1.1.1:1")
+
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "GetByTransactionIdOnAnyChain", 2)
+}
+
+func Test_Cache_Expires(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache(time.Second)
+	cache.now = func() time.Time { return now }
+
+	cache.set("key", http.Header{}, []byte("body"))
+	assert.NotNil(t, cache.get("key"))
+
+	now = now.Add(time.Second)
+	assert.Nil(t, cache.get("key"))
+}
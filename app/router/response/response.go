@@ -39,4 +39,6 @@ func ErrorResponse(err error) *ErrResponse {
 
 type HealthResponse struct {
 	Status string `json:"status"`
+	// The IDs of the EVM networks, for which the last reload of the router members has failed
+	MembersStale []uint64 `json:"membersStale,omitempty"`
 }
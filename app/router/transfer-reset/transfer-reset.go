@@ -43,7 +43,7 @@ func transferReset(transferService service.Transfers, prometheusService service.
 			return
 		}
 
-		err = transferService.UpdateTransferStatusCompleted(req.TransactionId)
+		err = transferService.UpdateTransferStatusCompleted(req.SourceChainId, req.TransactionId)
 		if err != nil {
 			render.Status(r, http.StatusBadRequest)
 			render.JSON(w, r, response.ErrorResponse(err))
@@ -41,7 +41,7 @@ func Test_GetTransferSuccess(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(body)
 
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
+	mocks.MTransferService.On("UpdateTransferStatusCompleted", uint64(1), transferId).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
@@ -71,7 +71,7 @@ func Test_GetTransferSuccess_FailsWithDB(t *testing.T) {
 	}
 	reqBody, _ := json.Marshal(body)
 
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(errors.New("error"))
+	mocks.MTransferService.On("UpdateTransferStatusCompleted", uint64(1), transferId).Return(errors.New("error"))
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
@@ -98,7 +98,7 @@ func Test_GetTransferSuccess_FailsWithWrongParams(t *testing.T) {
 
 	reqBody, _ := json.Marshal(body)
 
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
+	mocks.MTransferService.On("UpdateTransferStatusCompleted", uint64(1), transferId).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
@@ -124,7 +124,7 @@ func Test_GetTransferSuccess_FailsWithNoPassword(t *testing.T) {
 
 	reqBody, _ := json.Marshal(body)
 
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
+	mocks.MTransferService.On("UpdateTransferStatusCompleted", uint64(1), transferId).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
@@ -151,7 +151,7 @@ func Test_GetTransferSuccess_FailsWithEmptyPassword(t *testing.T) {
 
 	reqBody, _ := json.Marshal(body)
 
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
+	mocks.MTransferService.On("UpdateTransferStatusCompleted", uint64(1), transferId).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
@@ -178,7 +178,7 @@ func Test_GetTransferSuccess_FailsWithWrongPassword(t *testing.T) {
 
 	reqBody, _ := json.Marshal(body)
 
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
+	mocks.MTransferService.On("UpdateTransferStatusCompleted", uint64(1), transferId).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
@@ -33,12 +33,19 @@ func NewRouter(service service.Transfers) chi.Router {
 	return r
 }
 
-// GET: .../transfers/:id
+// GET: .../transfers/:id?sourceChainId=
 func getTransfer(transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		transferID := chi.URLParam(r, "id")
 
-		transferData, err := transfersService.TransferData(transferID)
+		sourceChainId, err := httpHelper.SourceChainId(r, transferID, transfersService.SourceChain)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		transferData, err := transfersService.TransferData(sourceChainId, transferID)
 		if err != nil {
 			logger.Errorf("Router resolved with an error. Error [%s].", err)
 			httpHelper.WriteErrorResponse(w, r, err)
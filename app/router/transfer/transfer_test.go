@@ -26,13 +26,16 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
+	"net/url"
 	"testing"
 )
 
 var (
 	transferIdUrlParamKey = "id"
 	transferId            = "1"
+	sourceChainId         = uint64(80001)
 	transfer              = service.TransferData{}
 )
 
@@ -54,9 +57,10 @@ func Test_getTransfer(t *testing.T) {
 		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
 	}
 	transferResponseAsBytes := buf.Bytes()
-	request := prepareRequest()
+	request := prepareRequest("")
+	mocks.MTransferService.On("SourceChain", transferId).Return(sourceChainId, nil)
 
-	mocks.MTransferService.On("TransferData", transferId).Return(transfer, err)
+	mocks.MTransferService.On("TransferData", sourceChainId, transferId).Return(transfer, err)
 	mocks.MResponseWriter.On("Header").Return(http.Header{})
 	mocks.MResponseWriter.On("Write", transferResponseAsBytes).Return(len(transferResponseAsBytes), nil)
 
@@ -79,9 +83,10 @@ func Test_getTransfer_ErrNotFound(t *testing.T) {
 		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
 	}
 	transferResponseAsBytes := buf.Bytes()
-	request := prepareRequest()
+	request := prepareRequest("")
+	mocks.MTransferService.On("SourceChain", transferId).Return(sourceChainId, nil)
 
-	mocks.MTransferService.On("TransferData", transferId).Return(transfer, service.ErrNotFound)
+	mocks.MTransferService.On("TransferData", sourceChainId, transferId).Return(transfer, service.ErrNotFound)
 	mocks.MResponseWriter.On("Header").Return(http.Header{})
 	mocks.MResponseWriter.On("Write", transferResponseAsBytes).Return(len(transferResponseAsBytes), nil)
 	mocks.MResponseWriter.On("WriteHeader", http.StatusNotFound).Return()
@@ -91,7 +96,7 @@ func Test_getTransfer_ErrNotFound(t *testing.T) {
 
 	assert.NotNil(t, transferResponseHandler)
 	assert.NotNil(t, transferResponseAsBytes)
-	mocks.MTransferService.AssertCalled(t, "TransferData", transferId)
+	mocks.MTransferService.AssertCalled(t, "TransferData", sourceChainId, transferId)
 	mocks.MResponseWriter.AssertCalled(t, "Header")
 	mocks.MResponseWriter.AssertCalled(t, "Write", transferResponseAsBytes)
 	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusNotFound)
@@ -109,9 +114,10 @@ func Test_getTransfer_InternalServerErr(t *testing.T) {
 		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
 	}
 	transferResponseAsBytes := buf.Bytes()
-	request := prepareRequest()
+	request := prepareRequest("")
+	mocks.MTransferService.On("SourceChain", transferId).Return(sourceChainId, nil)
 
-	mocks.MTransferService.On("TransferData", transferId).Return(transfer, err)
+	mocks.MTransferService.On("TransferData", sourceChainId, transferId).Return(transfer, err)
 	mocks.MResponseWriter.On("Header").Return(http.Header{})
 	mocks.MResponseWriter.On("Write", transferResponseAsBytes).Return(len(transferResponseAsBytes), nil)
 	mocks.MResponseWriter.On("WriteHeader", http.StatusInternalServerError).Return()
@@ -121,14 +127,79 @@ func Test_getTransfer_InternalServerErr(t *testing.T) {
 
 	assert.NotNil(t, transferResponseHandler)
 	assert.NotNil(t, transferResponseAsBytes)
-	mocks.MTransferService.AssertCalled(t, "TransferData", transferId)
+	mocks.MTransferService.AssertCalled(t, "TransferData", sourceChainId, transferId)
 	mocks.MResponseWriter.AssertCalled(t, "Header")
 	mocks.MResponseWriter.AssertCalled(t, "Write", transferResponseAsBytes)
 	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusInternalServerError)
 }
 
-func prepareRequest() *http.Request {
+func Test_getTransfer_WithSourceChainId(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(transfer); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	transferResponseAsBytes := buf.Bytes()
+	request := prepareRequest("sourceChainId=80001")
+
+	mocks.MTransferService.On("TransferData", sourceChainId, transferId).Return(transfer, nil)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", transferResponseAsBytes).Return(len(transferResponseAsBytes), nil)
+
+	getTransfer(mocks.MTransferService)(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertNotCalled(t, "SourceChain", mock.Anything)
+	mocks.MResponseWriter.AssertCalled(t, "Write", transferResponseAsBytes)
+}
+
+func Test_getTransfer_InvalidSourceChainId(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(response.ErrorResponse(service.ErrWrongQuery)); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	transferResponseAsBytes := buf.Bytes()
+	request := prepareRequest("sourceChainId=ethereum")
+
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", transferResponseAsBytes).Return(len(transferResponseAsBytes), nil)
+	mocks.MResponseWriter.On("WriteHeader", http.StatusBadRequest).Return()
+
+	getTransfer(mocks.MTransferService)(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertNotCalled(t, "TransferData", mock.Anything, mock.Anything)
+	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusBadRequest)
+}
+
+func Test_getTransfer_AmbiguousTransfer(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(response.ErrorResponse(service.ErrAmbiguousTransfer)); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	transferResponseAsBytes := buf.Bytes()
+	request := prepareRequest("")
+
+	mocks.MTransferService.On("SourceChain", transferId).Return(uint64(0), service.ErrAmbiguousTransfer)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", transferResponseAsBytes).Return(len(transferResponseAsBytes), nil)
+	mocks.MResponseWriter.On("WriteHeader", http.StatusBadRequest).Return()
+
+	getTransfer(mocks.MTransferService)(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertNotCalled(t, "TransferData", mock.Anything, mock.Anything)
+	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusBadRequest)
+}
+
+func prepareRequest(rawQuery string) *http.Request {
 	request := new(http.Request)
+	request.URL = &url.URL{RawQuery: rawQuery}
 	chiCtx := &chi.Context{
 		URLParams: chi.RouteParams{
 			Keys:   []string{transferIdUrlParamKey},
@@ -0,0 +1,298 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watchers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+var (
+	Route  = "/watchers"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+// PasswordHeader is the request header holding the admin password
+const PasswordHeader = "X-Admin-Password"
+
+// MaxSimulateBlocks is the maximum size of the block range of a single simulation
+const MaxSimulateBlocks = int64(1000)
+
+// NewRouter creates the admin router for the EVM watchers, keyed by their database identifier
+func NewRouter(statusRepository repository.Status, evmClients map[string]client.EVM, watchersService service.Watchers, nodeConfig config.Node) chi.Router {
+	r := chi.NewRouter()
+	r.Get("/checkpoints", getCheckpoints(statusRepository, evmClients, nodeConfig))
+	r.Post("/{id}/pause", pause(evmClients, watchersService, nodeConfig))
+	r.Post("/{id}/resume", resume(evmClients, watchersService, nodeConfig))
+	r.Post("/{id}/checkpoint", setCheckpoint(statusRepository, evmClients, watchersService, nodeConfig))
+	r.Get("/{id}/simulate", simulate(evmClients, watchersService, nodeConfig))
+	r.Get("/denied-assets", getDeniedAssets(watchersService, nodeConfig))
+	r.Delete("/denied-assets/{chainId}/{asset}", allowAsset(watchersService, nodeConfig))
+	return r
+}
+
+// GET: .../watchers/checkpoints
+func getCheckpoints(statusRepository repository.Status, evmClients map[string]client.EVM, nodeConfig config.Node) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r, nodeConfig) {
+			render.Status(r, http.StatusUnauthorized)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("Unauthorized")))
+			return
+		}
+
+		checkpoints := make(map[string]*watcher.Checkpoint)
+		for dbIdentifier, evmClient := range evmClients {
+			fromBlock, err := statusRepository.Get(dbIdentifier)
+			if err != nil {
+				logger.Errorf("Router resolved with an error. Failed to get checkpoint for [%s]. Error: [%s]", dbIdentifier, err)
+				render.Status(r, http.StatusInternalServerError)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+				return
+			}
+
+			latestBlock, err := evmClient.RetryBlockNumber()
+			if err != nil {
+				logger.Errorf("Router resolved with an error. Failed to get latest block for [%s]. Error: [%s]", dbIdentifier, err)
+				render.Status(r, http.StatusInternalServerError)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+				return
+			}
+
+			checkpoints[dbIdentifier] = &watcher.Checkpoint{
+				FromBlock:   fromBlock,
+				LatestBlock: latestBlock,
+				Lag:         int64(latestBlock) - fromBlock,
+			}
+		}
+
+		render.JSON(w, r, checkpoints)
+	}
+}
+
+// POST: .../watchers/{id}/pause
+func pause(evmClients map[string]client.EVM, watchersService service.Watchers, nodeConfig config.Node) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := authorizedWatcher(w, r, evmClients, nodeConfig)
+		if !ok {
+			return
+		}
+
+		watchersService.Pause(id)
+		// the checkpoint may still be updated by the iteration in flight
+		watchersService.AwaitIteration(id)
+
+		render.Status(r, http.StatusOK)
+		render.PlainText(w, r, "OK")
+	}
+}
+
+// POST: .../watchers/{id}/resume
+func resume(evmClients map[string]client.EVM, watchersService service.Watchers, nodeConfig config.Node) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := authorizedWatcher(w, r, evmClients, nodeConfig)
+		if !ok {
+			return
+		}
+
+		watchersService.Resume(id)
+
+		render.Status(r, http.StatusOK)
+		render.PlainText(w, r, "OK")
+	}
+}
+
+// POST: .../watchers/{id}/checkpoint
+func setCheckpoint(statusRepository repository.Status, evmClients map[string]client.EVM, watchersService service.Watchers, nodeConfig config.Node) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := authorizedWatcher(w, r, evmClients, nodeConfig)
+		if !ok {
+			return
+		}
+
+		// the watcher would otherwise overwrite the checkpoint with its own progress
+		if !watchersService.IsPaused(id) {
+			render.Status(r, http.StatusConflict)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("watcher [%s] must be paused before updating its checkpoint", id)))
+			return
+		}
+
+		req := new(watcher.CheckpointUpdate)
+		err := json.NewDecoder(r.Body).Decode(req)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(err))
+			return
+		}
+
+		latestBlock, err := evmClients[id].RetryBlockNumber()
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Failed to get latest block for [%s]. Error: [%s]", id, err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		if req.Block < 0 || uint64(req.Block) > latestBlock {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("block [%d] must be between 0 and the latest block [%d]", req.Block, latestBlock)))
+			return
+		}
+
+		// the watcher may have paused itself during an iteration, which is still in flight
+		watchersService.AwaitIteration(id)
+
+		previousBlock, err := statusRepository.Get(id)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Failed to get checkpoint for [%s]. Error: [%s]", id, err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		err = statusRepository.Update(id, req.Block)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Failed to update checkpoint for [%s]. Error: [%s]", id, err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		// the watcher would otherwise continue after the events, deferred ahead of the previous checkpoint
+		watchersService.ResetCheckpoint(id)
+		logger.Warnf("[%s] - MANUAL CHECKPOINT OVERRIDE: Watcher checkpoint changed from [%d] to [%d].", id, previousBlock, req.Block)
+
+		render.Status(r, http.StatusOK)
+		render.PlainText(w, r, "OK")
+	}
+}
+
+// GET: .../watchers/{id}/simulate?from=&to=
+func simulate(evmClients map[string]client.EVM, watchersService service.Watchers, nodeConfig config.Node) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := authorizedWatcher(w, r, evmClients, nodeConfig)
+		if !ok {
+			return
+		}
+
+		from, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("invalid [from] block")))
+			return
+		}
+		to, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("invalid [to] block")))
+			return
+		}
+
+		if from < 0 || to < from || to-from+1 > MaxSimulateBlocks {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("block range must be non-negative, ascending and at most [%d] blocks", MaxSimulateBlocks)))
+			return
+		}
+
+		results, err := watchersService.Simulate(id, from, to)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Failed to simulate [%s] from [%d] to [%d]. Error: [%s]", id, from, to, err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		render.JSON(w, r, results)
+	}
+}
+
+// GET: .../watchers/denied-assets
+func getDeniedAssets(watchersService service.Watchers, nodeConfig config.Node) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r, nodeConfig) {
+			render.Status(r, http.StatusUnauthorized)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("Unauthorized")))
+			return
+		}
+
+		render.JSON(w, r, watchersService.DeniedAssets())
+	}
+}
+
+// DELETE: .../watchers/denied-assets/{chainId}/{asset}
+func allowAsset(watchersService service.Watchers, nodeConfig config.Node) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r, nodeConfig) {
+			render.Status(r, http.StatusUnauthorized)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("Unauthorized")))
+			return
+		}
+
+		chainId, err := strconv.ParseUint(chi.URLParam(r, "chainId"), 10, 64)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("invalid [chainId]")))
+			return
+		}
+
+		asset := chi.URLParam(r, "asset")
+		if !watchersService.AllowAsset(chainId, asset) {
+			render.Status(r, http.StatusNotFound)
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("asset [%s] on chain [%d] is not denied", asset, chainId)))
+			return
+		}
+
+		logger.Warnf("[%d] - MANUAL ASSET RE-ENABLE: Asset [%s] removed from the deny-list.", chainId, asset)
+
+		render.Status(r, http.StatusOK)
+		render.PlainText(w, r, "OK")
+	}
+}
+
+// authorizedWatcher renders the error response and returns false if the request is not authorized
+// or if the requested watcher does not exist
+func authorizedWatcher(w http.ResponseWriter, r *http.Request, evmClients map[string]client.EVM, nodeConfig config.Node) (string, bool) {
+	if !authorized(r, nodeConfig) {
+		render.Status(r, http.StatusUnauthorized)
+		render.JSON(w, r, response.ErrorResponse(fmt.Errorf("Unauthorized")))
+		return "", false
+	}
+
+	id := chi.URLParam(r, "id")
+	if _, ok := evmClients[id]; !ok {
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, response.ErrorResponse(fmt.Errorf("watcher [%s] not found", id)))
+		return "", false
+	}
+
+	return id, true
+}
+
+// authorized returns false if the password is wrong or if password is not set
+func authorized(r *http.Request, nodeConfig config.Node) bool {
+	return nodeConfig.GaugeResetPassword != "" && r.Header.Get(PasswordHeader) == nodeConfig.GaugeResetPassword
+}
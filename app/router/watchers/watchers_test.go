@@ -0,0 +1,333 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watchers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	dbIdentifier = "1-0x0000000000000000000000000000000000000001"
+	node         = config.Node{
+		GaugeResetPassword: "password",
+	}
+)
+
+func setup() map[string]client.EVM {
+	mocks.Setup()
+	return map[string]client.EVM{dbIdentifier: mocks.MEVMClient}
+}
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MStatusRepository, setup(), mocks.MWatchersService, node)
+
+	assert.NotNil(t, router)
+}
+
+func Test_GetCheckpoints(t *testing.T) {
+	evmClients := setup()
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(100), nil)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(150), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/watchers/checkpoints", nil)
+	req.Header.Set(PasswordHeader, "password")
+	w := httptest.NewRecorder()
+	getCheckpoints(mocks.MStatusRepository, evmClients, node)(w, req)
+	res := w.Result()
+	defer res.Body.Close()
+
+	actual := make(map[string]*watcher.Checkpoint)
+	err := json.NewDecoder(res.Body).Decode(&actual)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, map[string]*watcher.Checkpoint{
+		dbIdentifier: {FromBlock: 100, LatestBlock: 150, Lag: 50},
+	}, actual)
+}
+
+func Test_GetCheckpoints_Unauthorized(t *testing.T) {
+	evmClients := setup()
+
+	req := httptest.NewRequest(http.MethodGet, "/watchers/checkpoints", nil)
+	req.Header.Set(PasswordHeader, "wrong")
+	w := httptest.NewRecorder()
+	getCheckpoints(mocks.MStatusRepository, evmClients, node)(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+	mocks.MStatusRepository.AssertNotCalled(t, "Get", dbIdentifier)
+}
+
+func Test_GetCheckpoints_StatusRepositoryFails(t *testing.T) {
+	evmClients := setup()
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(0), errors.New("some-error"))
+
+	req := httptest.NewRequest(http.MethodGet, "/watchers/checkpoints", nil)
+	req.Header.Set(PasswordHeader, "password")
+	w := httptest.NewRecorder()
+	getCheckpoints(mocks.MStatusRepository, evmClients, node)(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+}
+
+func Test_GetCheckpoints_BlockNumberFails(t *testing.T) {
+	evmClients := setup()
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(100), nil)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(0), errors.New("some-error"))
+
+	req := httptest.NewRequest(http.MethodGet, "/watchers/checkpoints", nil)
+	req.Header.Set(PasswordHeader, "password")
+	w := httptest.NewRecorder()
+	getCheckpoints(mocks.MStatusRepository, evmClients, node)(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+}
+
+func serveSetCheckpoint(evmClients map[string]client.EVM, id string, block int64) *http.Response {
+	reqBody, _ := json.Marshal(watcher.CheckpointUpdate{Block: block})
+	req := httptest.NewRequest(http.MethodPost, "/"+id+"/checkpoint", bytes.NewBuffer(reqBody))
+	req.Header.Set(PasswordHeader, "password")
+	w := httptest.NewRecorder()
+	NewRouter(mocks.MStatusRepository, evmClients, mocks.MWatchersService, node).ServeHTTP(w, req)
+	return w.Result()
+}
+
+func Test_SetCheckpoint(t *testing.T) {
+	evmClients := setup()
+	mocks.MWatchersService.On("IsPaused", dbIdentifier).Return(true)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(150), nil)
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(100), nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(80)).Return(nil)
+	mocks.MWatchersService.On("AwaitIteration", dbIdentifier).Return()
+	mocks.MWatchersService.On("ResetCheckpoint", dbIdentifier).Return()
+
+	res := serveSetCheckpoint(evmClients, dbIdentifier, 80)
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	mocks.MWatchersService.AssertCalled(t, "AwaitIteration", dbIdentifier)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(80))
+	mocks.MWatchersService.AssertCalled(t, "ResetCheckpoint", dbIdentifier)
+}
+
+func Test_SetCheckpoint_HeadBound(t *testing.T) {
+	evmClients := setup()
+	mocks.MWatchersService.On("IsPaused", dbIdentifier).Return(true)
+	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(150), nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(150)).Return(nil)
+	mocks.MWatchersService.On("AwaitIteration", dbIdentifier).Return()
+	mocks.MWatchersService.On("ResetCheckpoint", dbIdentifier).Return()
+
+	res := serveSetCheckpoint(evmClients, dbIdentifier, 151)
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", dbIdentifier, int64(151))
+
+	mocks.MStatusRepository.On("Get", dbIdentifier).Return(int64(100), nil)
+	res = serveSetCheckpoint(evmClients, dbIdentifier, 150)
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func Test_SetCheckpoint_RequiresPausedWatcher(t *testing.T) {
+	evmClients := setup()
+	mocks.MWatchersService.On("IsPaused", dbIdentifier).Return(false)
+
+	res := serveSetCheckpoint(evmClients, dbIdentifier, 80)
+
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", dbIdentifier, int64(80))
+}
+
+func Test_SetCheckpoint_UnknownWatcher(t *testing.T) {
+	evmClients := setup()
+
+	res := serveSetCheckpoint(evmClients, "unknown", 80)
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func Test_PauseResume(t *testing.T) {
+	evmClients := setup()
+	mocks.MWatchersService.On("Pause", dbIdentifier).Return()
+	mocks.MWatchersService.On("AwaitIteration", dbIdentifier).Return()
+	mocks.MWatchersService.On("Resume", dbIdentifier).Return()
+	router := NewRouter(mocks.MStatusRepository, evmClients, mocks.MWatchersService, node)
+
+	for _, action := range []string{"pause", "resume"} {
+		req := httptest.NewRequest(http.MethodPost, "/"+dbIdentifier+"/"+action, nil)
+		req.Header.Set(PasswordHeader, "password")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+
+	mocks.MWatchersService.AssertCalled(t, "Pause", dbIdentifier)
+	mocks.MWatchersService.AssertCalled(t, "AwaitIteration", dbIdentifier)
+	mocks.MWatchersService.AssertCalled(t, "Resume", dbIdentifier)
+}
+
+func serveSimulate(evmClients map[string]client.EVM, id, query string) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, "/"+id+"/simulate"+query, nil)
+	req.Header.Set(PasswordHeader, "password")
+	w := httptest.NewRecorder()
+	NewRouter(mocks.MStatusRepository, evmClients, mocks.MWatchersService, node).ServeHTTP(w, req)
+	return w.Result()
+}
+
+func Test_Simulate(t *testing.T) {
+	evmClients := setup()
+	expected := []*watcher.SimResult{
+		{EventType: "Lock", TransactionId: "0x1-0", Decision: watcher.SimDecisionProcess, Topic: "HEDERA_MINT_HTS_TRANSFER", Payload: &payload.Transfer{TransactionId: "0x1-0", Amount: "100"}},
+		{EventType: "Burn", TransactionId: "0x2-1", Decision: watcher.SimDecisionSkip, Reason: "Transfer Amount [1] less than Minimum Amount [10]."},
+	}
+	mocks.MWatchersService.On("Simulate", dbIdentifier, int64(10), int64(20)).Return(expected, nil)
+
+	res := serveSimulate(evmClients, dbIdentifier, "?from=10&to=20")
+	defer res.Body.Close()
+
+	var actual []*watcher.SimResult
+	err := json.NewDecoder(res.Body).Decode(&actual)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Simulate_InvalidRange(t *testing.T) {
+	evmClients := setup()
+
+	for _, query := range []string{"", "?from=10", "?from=a&to=20", "?from=20&to=10", "?from=-1&to=10", "?from=0&to=1000"} {
+		res := serveSimulate(evmClients, dbIdentifier, query)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode, query)
+	}
+	mocks.MWatchersService.AssertNotCalled(t, "Simulate", dbIdentifier, mock.Anything, mock.Anything)
+}
+
+func Test_Simulate_MaxRange(t *testing.T) {
+	evmClients := setup()
+	mocks.MWatchersService.On("Simulate", dbIdentifier, int64(0), int64(999)).Return([]*watcher.SimResult{}, nil)
+
+	res := serveSimulate(evmClients, dbIdentifier, "?from=0&to=999")
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func Test_Simulate_Fails(t *testing.T) {
+	evmClients := setup()
+	mocks.MWatchersService.On("Simulate", dbIdentifier, int64(10), int64(20)).Return(nil, errors.New("some-error"))
+
+	res := serveSimulate(evmClients, dbIdentifier, "?from=10&to=20")
+
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
+
+func Test_Simulate_NotFound(t *testing.T) {
+	evmClients := setup()
+
+	res := serveSimulate(evmClients, "unknown", "?from=10&to=20")
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func Test_Simulate_Unauthorized(t *testing.T) {
+	evmClients := setup()
+	req := httptest.NewRequest(http.MethodGet, "/"+dbIdentifier+"/simulate?from=10&to=20", nil)
+	w := httptest.NewRecorder()
+
+	NewRouter(mocks.MStatusRepository, evmClients, mocks.MWatchersService, node).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+}
+
+func Test_GetDeniedAssets(t *testing.T) {
+	setup()
+	denied := map[uint64][]string{80001: {"0x0000000000000000000000000000000000000002"}}
+	mocks.MWatchersService.On("DeniedAssets").Return(denied)
+
+	req := httptest.NewRequest(http.MethodGet, "/denied-assets", nil)
+	req.Header.Set(PasswordHeader, "password")
+	w := httptest.NewRecorder()
+	NewRouter(mocks.MStatusRepository, nil, mocks.MWatchersService, node).ServeHTTP(w, req)
+	res := w.Result()
+	defer res.Body.Close()
+
+	actual := make(map[uint64][]string)
+	err := json.NewDecoder(res.Body).Decode(&actual)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, denied, actual)
+}
+
+func serveAllowAsset(path, password string) *http.Response {
+	req := httptest.NewRequest(http.MethodDelete, path, nil)
+	req.Header.Set(PasswordHeader, password)
+	w := httptest.NewRecorder()
+	NewRouter(mocks.MStatusRepository, nil, mocks.MWatchersService, node).ServeHTTP(w, req)
+	return w.Result()
+}
+
+func Test_AllowAsset(t *testing.T) {
+	setup()
+	mocks.MWatchersService.On("AllowAsset", uint64(80001), "0x0000000000000000000000000000000000000002").Return(true)
+
+	res := serveAllowAsset("/denied-assets/80001/0x0000000000000000000000000000000000000002", "password")
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	mocks.MWatchersService.AssertCalled(t, "AllowAsset", uint64(80001), "0x0000000000000000000000000000000000000002")
+}
+
+func Test_AllowAsset_NotDenied(t *testing.T) {
+	setup()
+	mocks.MWatchersService.On("AllowAsset", uint64(80001), "0x0000000000000000000000000000000000000002").Return(false)
+
+	res := serveAllowAsset("/denied-assets/80001/0x0000000000000000000000000000000000000002", "password")
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func Test_AllowAsset_InvalidChainId(t *testing.T) {
+	setup()
+
+	res := serveAllowAsset("/denied-assets/abc/0x0000000000000000000000000000000000000002", "password")
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	mocks.MWatchersService.AssertNotCalled(t, "AllowAsset", mock.Anything, mock.Anything)
+}
+
+func Test_AllowAsset_Unauthorized(t *testing.T) {
+	setup()
+
+	res := serveAllowAsset("/denied-assets/80001/0x0000000000000000000000000000000000000002", "wrong")
+
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	mocks.MWatchersService.AssertNotCalled(t, "AllowAsset", mock.Anything, mock.Anything)
+}
@@ -336,6 +336,7 @@ func (a *Service) loadFungibleAssetInfos(
 			if err != nil {
 				a.logger.Fatal(err)
 			}
+			a.applyDecimalsOverride(nativeChainId, nativeAsset, assetInfo, nativeAssetMapping.DecimalsOverrides)
 			a.fungibleAssetInfos[nativeChainId][nativeAsset] = assetInfo
 
 			for wrappedChainId, wrappedAsset := range nativeAssetMapping.Networks {
@@ -346,12 +347,26 @@ func (a *Service) loadFungibleAssetInfos(
 				if err != nil {
 					a.logger.Fatal(err)
 				}
+				a.applyDecimalsOverride(wrappedChainId, wrappedAsset, assetInfo, nativeAssetMapping.DecimalsOverrides)
 				a.fungibleAssetInfos[wrappedChainId][wrappedAsset] = assetInfo
 			}
 		}
 	}
 }
 
+// applyDecimalsOverride replaces the fetched decimals of the asset with the configured override for the given network (if any)
+func (a *Service) applyDecimalsOverride(chainId uint64, asset string, assetInfo *assetModel.FungibleAssetInfo, overrides map[uint64]uint8) {
+	decimals, ok := overrides[chainId]
+	if !ok {
+		return
+	}
+
+	if decimals != assetInfo.Decimals {
+		a.logger.Warnf("Decimals override [%d] for Asset [%s] on network [%d] differs from the on-chain decimals [%d].", decimals, asset, chainId, assetInfo.Decimals)
+	}
+	assetInfo.Decimals = decimals
+}
+
 func (a *Service) getHederaTokenReserveAmount(
 	assetId string,
 	isNative bool,
@@ -650,6 +665,9 @@ func bridgeCfgUpdateEventHandler(e event.Event, mirrorNode client.MirrorNode, in
 		params.EvmFungibleTokenClients,
 		params.EvmNFTClients,
 	)
+	if err := newInstance.ValidateMappings(); err != nil {
+		newInstance.logger.Errorf("Updated asset mappings are inconsistent. Error: [%s]", err)
+	}
 	*instance = *newInstance
 	params.Bridge.LoadStaticMinAmountsForWrappedFungibleTokens(*params.ParsedBridge, instance)
 
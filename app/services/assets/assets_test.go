@@ -23,6 +23,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/token"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	assetModel "github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
@@ -207,6 +208,30 @@ func Test_FungibleAssetInfo(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func Test_ApplyDecimalsOverride_Overridden(t *testing.T) {
+	setup()
+
+	assetInfo := &assetModel.FungibleAssetInfo{Decimals: constants.EvmDefaultDecimals}
+	overrides := map[uint64]uint8{testConstants.EthereumNetworkId: 6}
+
+	serviceInstance.applyDecimalsOverride(testConstants.EthereumNetworkId, testConstants.NetworkEthereumFungibleNativeToken, assetInfo, overrides)
+
+	assert.Equal(t, uint8(6), assetInfo.Decimals)
+}
+
+func Test_ApplyDecimalsOverride_Default(t *testing.T) {
+	setup()
+
+	assetInfo := &assetModel.FungibleAssetInfo{Decimals: constants.EvmDefaultDecimals}
+	overrides := map[uint64]uint8{testConstants.PolygonNetworkId: 6}
+
+	serviceInstance.applyDecimalsOverride(testConstants.EthereumNetworkId, testConstants.NetworkEthereumFungibleNativeToken, assetInfo, overrides)
+	assert.Equal(t, constants.EvmDefaultDecimals, assetInfo.Decimals)
+
+	serviceInstance.applyDecimalsOverride(testConstants.EthereumNetworkId, testConstants.NetworkEthereumFungibleNativeToken, assetInfo, nil)
+	assert.Equal(t, constants.EvmDefaultDecimals, assetInfo.Decimals)
+}
+
 func Test_NonFungibleAssetInfo(t *testing.T) {
 	setup()
 
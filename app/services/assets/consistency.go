@@ -0,0 +1,92 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ValidateMappings checks that every native to wrapped mapping has a matching wrapped to native inverse and vice versa,
+// and that OppositeAsset resolves every mapping in both directions. Handlers resolve lock events through
+// NativeToWrapped and burn events through WrappedToNative, so asymmetric mappings route transfers to the wrong asset.
+// Returns an error, listing every asymmetry
+func (a *Service) ValidateMappings() error {
+	var errs []error
+	for _, nativeChainId := range sortedChainIds(a.nativeToWrapped) {
+		for _, nativeAsset := range sortedAssets(a.nativeToWrapped[nativeChainId]) {
+			wrappedAssets := a.nativeToWrapped[nativeChainId][nativeAsset]
+			for _, wrappedChainId := range sortedChainIds(wrappedAssets) {
+				wrappedAsset := wrappedAssets[wrappedChainId]
+
+				native := a.wrappedToNative[wrappedChainId][wrappedAsset]
+				if native == nil {
+					errs = append(errs, fmt.Errorf("native asset [%s] of chain [%d] maps to wrapped asset [%s] of chain [%d], which has no native mapping",
+						nativeAsset, nativeChainId, wrappedAsset, wrappedChainId))
+					continue
+				}
+				if native.ChainId != nativeChainId || native.Asset != nativeAsset {
+					errs = append(errs, fmt.Errorf("native asset [%s] of chain [%d] maps to wrapped asset [%s] of chain [%d], which maps back to native asset [%s] of chain [%d]",
+						nativeAsset, nativeChainId, wrappedAsset, wrappedChainId, native.Asset, native.ChainId))
+					continue
+				}
+
+				if opposite := a.OppositeAsset(nativeChainId, wrappedChainId, nativeAsset); opposite != wrappedAsset {
+					errs = append(errs, fmt.Errorf("opposite asset of native asset [%s] of chain [%d] on chain [%d] is [%s], instead of wrapped asset [%s]",
+						nativeAsset, nativeChainId, wrappedChainId, opposite, wrappedAsset))
+				}
+				if opposite := a.OppositeAsset(wrappedChainId, nativeChainId, wrappedAsset); opposite != nativeAsset {
+					errs = append(errs, fmt.Errorf("opposite asset of wrapped asset [%s] of chain [%d] on chain [%d] is [%s], instead of native asset [%s]",
+						wrappedAsset, wrappedChainId, nativeChainId, opposite, nativeAsset))
+				}
+			}
+		}
+	}
+
+	for _, wrappedChainId := range sortedChainIds(a.wrappedToNative) {
+		for _, wrappedAsset := range sortedAssets(a.wrappedToNative[wrappedChainId]) {
+			native := a.wrappedToNative[wrappedChainId][wrappedAsset]
+			if a.nativeToWrapped[native.ChainId][native.Asset][wrappedChainId] != wrappedAsset {
+				errs = append(errs, fmt.Errorf("wrapped asset [%s] of chain [%d] maps to native asset [%s] of chain [%d], which does not map to it",
+					wrappedAsset, wrappedChainId, native.Asset, native.ChainId))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sortedChainIds returns the chain ids of the given mapping in ascending order, so that asymmetries are reported deterministically
+func sortedChainIds[V any](mapping map[uint64]V) []uint64 {
+	chainIds := make([]uint64, 0, len(mapping))
+	for chainId := range mapping {
+		chainIds = append(chainIds, chainId)
+	}
+	sort.Slice(chainIds, func(i, j int) bool { return chainIds[i] < chainIds[j] })
+	return chainIds
+}
+
+// sortedAssets returns the assets of the given mapping in ascending order
+func sortedAssets[V any](mapping map[string]V) []string {
+	assets := make([]string, 0, len(mapping))
+	for asset := range mapping {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assets
+
+import (
+	"testing"
+
+	assetModel "github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// mappingsService returns a service with the given mappings only
+func mappingsService(nativeToWrapped map[uint64]map[string]map[uint64]string, wrappedToNative map[uint64]map[string]*assetModel.NativeAsset) *Service {
+	return &Service{
+		nativeToWrapped: nativeToWrapped,
+		wrappedToNative: wrappedToNative,
+		logger:          config.GetLoggerFor("Assets Service"),
+	}
+}
+
+func Test_ValidateMappings_Consistent(t *testing.T) {
+	setup()
+
+	assert.Nil(t, serviceInstance.ValidateMappings())
+}
+
+func Test_ValidateMappings_MissingInverse(t *testing.T) {
+	s := mappingsService(
+		map[uint64]map[string]map[uint64]string{1: {"0xNative": {2: "0xWrapped"}}},
+		map[uint64]map[string]*assetModel.NativeAsset{})
+
+	err := s.ValidateMappings()
+
+	assert.EqualError(t, err, "native asset [0xNative] of chain [1] maps to wrapped asset [0xWrapped] of chain [2], which has no native mapping")
+}
+
+func Test_ValidateMappings_WrappedSharedByNatives(t *testing.T) {
+	second := &assetModel.NativeAsset{ChainId: 1, Asset: "0xSecond"}
+	s := mappingsService(
+		map[uint64]map[string]map[uint64]string{1: {
+			"0xFirst":  {2: "0xWrapped"},
+			"0xSecond": {2: "0xWrapped"},
+		}},
+		map[uint64]map[string]*assetModel.NativeAsset{2: {"0xWrapped": second}})
+
+	err := s.ValidateMappings()
+
+	assert.EqualError(t, err, "native asset [0xFirst] of chain [1] maps to wrapped asset [0xWrapped] of chain [2], which maps back to native asset [0xSecond] of chain [1]")
+}
+
+func Test_ValidateMappings_OrphanedWrapped(t *testing.T) {
+	s := mappingsService(
+		map[uint64]map[string]map[uint64]string{1: {"0xNative": {}}},
+		map[uint64]map[string]*assetModel.NativeAsset{2: {"0xWrapped": {ChainId: 1, Asset: "0xNative"}}})
+
+	err := s.ValidateMappings()
+
+	assert.EqualError(t, err, "wrapped asset [0xWrapped] of chain [2] maps to native asset [0xNative] of chain [1], which does not map to it")
+}
+
+func Test_ValidateMappings_NativeAlsoWrappedOnItsChain(t *testing.T) {
+	native := &assetModel.NativeAsset{ChainId: 1, Asset: "0xNative"}
+	other := &assetModel.NativeAsset{ChainId: 3, Asset: "0xOther"}
+	s := mappingsService(
+		map[uint64]map[string]map[uint64]string{
+			1: {"0xNative": {2: "0xWrapped"}},
+			3: {"0xOther": {1: "0xNative"}},
+		},
+		map[uint64]map[string]*assetModel.NativeAsset{
+			1: {"0xNative": other},
+			2: {"0xWrapped": native},
+		})
+
+	err := s.ValidateMappings()
+
+	assert.EqualError(t, err, "opposite asset of native asset [0xNative] of chain [1] on chain [2] is [0xOther], instead of wrapped asset [0xWrapped]")
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/gookit/event"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -205,7 +206,11 @@ func (s *Service) processFullMsgContent(decodedMsgContent []byte, consensusTimes
 	s.logger.Infof("Successfully processed latest bridge config!")
 
 	s.logger.Infof("Updating config dependencies ...")
-	s.config.Bridge.Update(config.NewBridge(*parsedBridge))
+	bridge := config.NewBridge(*parsedBridge)
+	if !reflect.DeepEqual(bridge.SignatureTopics(), s.config.Bridge.SignatureTopics()) {
+		s.logger.Warnf("Signature topics changed from %v to %v. The change takes effect after a restart.", s.config.Bridge.SignatureTopics(), bridge.SignatureTopics())
+	}
+	s.config.Bridge.Update(bridge)
 	s.parsedBridgeCfg.Update(parsedBridge)
 	event.MustFire(constants.EventBridgeConfigUpdate, event.M{constants.BridgeConfigUpdateEventParamsKey: &bridge_config_event.Params{
 		Bridge:       s.config.Bridge,
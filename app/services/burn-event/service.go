@@ -26,6 +26,7 @@ import (
 	util "github.com/limechain/hedera-eth-bridge-validator/app/helper/fee"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
@@ -81,6 +82,7 @@ func NewService(
 }
 
 func (s Service) ProcessEvent(event payload.Transfer) {
+	s.logger = config.WithContext(s.logger, event.Context())
 	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.TargetAsset)
 
 	amount, err := strconv.ParseInt(event.Amount, 10, 64)
@@ -112,7 +114,7 @@ func (s Service) ProcessEvent(event payload.Transfer) {
 		return
 	}
 
-	err = s.repository.UpdateFee(event.TransactionId, strconv.FormatInt(fee, 10))
+	err = s.repository.UpdateFee(event.SourceChainId, event.TransactionId, strconv.FormatInt(fee, 10))
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to update fee [%d]. Error [%s].", event.TransactionId, fee, err)
 		return
@@ -130,9 +132,10 @@ func (s Service) ProcessEvent(event payload.Transfer) {
 
 	for _, splitTransfer := range splitTransfers {
 		feeAmount, hasReceiver := util.TotalFeeFromTransfers(splitTransfer, receiver)
-		onExecutionSuccess, onExecutionFail := s.scheduledTxExecutionCallbacks(event.TransactionId, feeAmount, hasReceiver)
+		onExecutionSuccess, onExecutionFail := s.scheduledTxExecutionCallbacks(event.SourceChainId, event.TransactionId, feeAmount, hasReceiver)
 
 		onSuccess, onFail := s.scheduledTxMinedCallbacks(
+			event.SourceChainId,
 			event.TransactionId,
 			hasReceiver,
 			splitTransfer,
@@ -152,24 +155,28 @@ func (s Service) startAwaitingFunctionsForMetrics(event payload.Transfer, feeOut
 	}
 
 	// Await Fee Transfer To Set Metrics
-	go hederaHelper.AwaitMultipleScheduledTransactions(
-		feeOutParams.OutParams,
-		event.SourceChainId,
-		event.TargetChainId,
-		event.NativeAsset,
-		event.TransactionId,
-		s.onMinedFeeTransactionsSetMetrics,
-	)
+	syncHelper.Go(func() {
+		hederaHelper.AwaitMultipleScheduledTransactions(
+			feeOutParams.OutParams,
+			event.SourceChainId,
+			event.TargetChainId,
+			event.NativeAsset,
+			event.TransactionId,
+			s.onMinedFeeTransactionsSetMetrics,
+		)
+	})
 
 	// Await User Transfer To Set Metrics
-	go hederaHelper.AwaitMultipleScheduledTransactions(
-		userOutParams.OutParams,
-		event.SourceChainId,
-		event.TargetChainId,
-		event.NativeAsset,
-		event.TransactionId,
-		s.onMinedUserTransactionSetMetrics,
-	)
+	syncHelper.Go(func() {
+		hederaHelper.AwaitMultipleScheduledTransactions(
+			userOutParams.OutParams,
+			event.SourceChainId,
+			event.TargetChainId,
+			event.NativeAsset,
+			event.TransactionId,
+			s.onMinedUserTransactionSetMetrics,
+		)
+	})
 }
 
 func (s Service) initSuccessRatePrometheusMetrics(transactionId string, sourceChainId, targetChainId uint64, asset string) {
@@ -233,8 +240,8 @@ func (s *Service) prepareTransfers(token string, amount int64, receiver hedera.A
 
 // TransactionID returns the corresponding Scheduled Transaction paying out the
 // fees to validators and the amount being bridged to the receiver address
-func (s *Service) TransactionID(id string) (string, error) {
-	event, err := s.scheduleRepository.GetReceiverTransferByTransactionID(id)
+func (s *Service) TransactionID(sourceChainId uint64, id string) (string, error) {
+	event, err := s.scheduleRepository.GetReceiverTransferByTransactionID(sourceChainId, id)
 	if err != nil {
 		s.logger.Errorf("[%s] - failed to get event.", id)
 		return "", err
@@ -247,7 +254,7 @@ func (s *Service) TransactionID(id string) (string, error) {
 	return event.TransactionID, nil
 }
 
-func (s *Service) scheduledTxExecutionCallbacks(id string, feeAmount string, hasReceiver bool) (onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail func(transactionID string)) {
+func (s *Service) scheduledTxExecutionCallbacks(sourceChainId uint64, id string, feeAmount string, hasReceiver bool) (onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail func(transactionID string)) {
 	onExecutionSuccess = func(transactionID, scheduleID string) {
 		s.logger.Debugf("[%s] - Updating db status to Submitted with TransactionID [%s].",
 			id,
@@ -262,6 +269,7 @@ func (s *Service) scheduledTxExecutionCallbacks(id string, feeAmount string, has
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			s.logger.Errorf(
@@ -278,6 +286,7 @@ func (s *Service) scheduledTxExecutionCallbacks(id string, feeAmount string, has
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			s.logger.Errorf(
@@ -296,13 +305,14 @@ func (s *Service) scheduledTxExecutionCallbacks(id string, feeAmount string, has
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", id, err)
 			return
 		}
 
-		err = s.repository.UpdateStatusFailed(id)
+		err = s.repository.UpdateStatusFailed(sourceChainId, id)
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", id, err)
 			return
@@ -316,6 +326,7 @@ func (s *Service) scheduledTxExecutionCallbacks(id string, feeAmount string, has
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			s.logger.Errorf("[%s] Fee - Failed to create failed record. Error [%s].", transactionID, err)
@@ -326,7 +337,7 @@ func (s *Service) scheduledTxExecutionCallbacks(id string, feeAmount string, has
 	return onExecutionSuccess, onExecutionFail
 }
 
-func (s *Service) scheduledTxMinedCallbacks(id string, hasReceiver bool, splitTransfer []transfer.Hedera, feeOutParams *hederaHelper.FeeOutParams, userOutParams *hederaHelper.UserOutParams) (onSuccess, onFail func(transactionID string)) {
+func (s *Service) scheduledTxMinedCallbacks(sourceChainId uint64, id string, hasReceiver bool, splitTransfer []transfer.Hedera, feeOutParams *hederaHelper.FeeOutParams, userOutParams *hederaHelper.UserOutParams) (onSuccess, onFail func(transactionID string)) {
 
 	onSuccess = func(transactionID string) {
 
@@ -337,7 +348,7 @@ func (s *Service) scheduledTxMinedCallbacks(id string, hasReceiver bool, splitTr
 			userOutParams.HandleResultForAwaitedTransfer(&result, hasReceiver)
 		}
 
-		err := s.repository.UpdateStatusCompleted(id)
+		err := s.repository.UpdateStatusCompleted(sourceChainId, id)
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to update status completed. Error [%s].", id, err)
 			return
@@ -369,7 +380,7 @@ func (s *Service) scheduledTxMinedCallbacks(id string, hasReceiver bool, splitTr
 			return
 		}
 
-		err = s.repository.UpdateStatusFailed(id)
+		err = s.repository.UpdateStatusFailed(sourceChainId, id)
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", transactionID, err)
 			return
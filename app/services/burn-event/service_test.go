@@ -102,7 +102,7 @@ func Test_ProcessEvent(t *testing.T) {
 	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, burnEventAmount).Return(mockFee, mockRemainder)
 	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
 	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee).Return([]transfer.Hedera{}, nil)
-	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, strconv.FormatInt(mockValidFee, 10)).Return(nil)
+	mocks.MTransferRepository.On("UpdateFee", tr.SourceChainId, tr.TransactionId, strconv.FormatInt(mockValidFee, 10)).Return(nil)
 	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation).Return()
 
 	s.ProcessEvent(tr)
@@ -183,9 +183,9 @@ func Test_TransactionID(t *testing.T) {
 		TransferID:    sql.NullString{String: expectedTransactionId, Valid: true},
 	}
 
-	mocks.MScheduleRepository.On("GetReceiverTransferByTransactionID", mockBurnEventId).Return(mockBurnEventRecord, nil)
+	mocks.MScheduleRepository.On("GetReceiverTransferByTransactionID", tr.SourceChainId, mockBurnEventId).Return(mockBurnEventRecord, nil)
 
-	actualTransactionId, err := s.TransactionID(mockBurnEventId)
+	actualTransactionId, err := s.TransactionID(tr.SourceChainId, mockBurnEventId)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedTransactionId, actualTransactionId)
 }
@@ -195,9 +195,9 @@ func Test_TransactionIDRepositoryError(t *testing.T) {
 
 	expectedError := errors.New("connection-refused")
 
-	mocks.MScheduleRepository.On("GetReceiverTransferByTransactionID", mockBurnEventId).Return(nil, expectedError)
+	mocks.MScheduleRepository.On("GetReceiverTransferByTransactionID", tr.SourceChainId, mockBurnEventId).Return(nil, expectedError)
 
-	actualTransactionId, err := s.TransactionID(mockBurnEventId)
+	actualTransactionId, err := s.TransactionID(tr.SourceChainId, mockBurnEventId)
 	assert.Error(t, expectedError, err)
 	assert.Empty(t, actualTransactionId)
 }
@@ -206,9 +206,9 @@ func Test_TransactionIDNotFound(t *testing.T) {
 	setup()
 
 	expectedError := errors.New("not-found")
-	mocks.MScheduleRepository.On("GetReceiverTransferByTransactionID", mockBurnEventId).Return(nil, expectedError)
+	mocks.MScheduleRepository.On("GetReceiverTransferByTransactionID", tr.SourceChainId, mockBurnEventId).Return(nil, expectedError)
 
-	actualTransactionId, err := s.TransactionID(mockBurnEventId)
+	actualTransactionId, err := s.TransactionID(tr.SourceChainId, mockBurnEventId)
 	assert.Error(t, expectedError, err)
 	assert.Empty(t, actualTransactionId)
 }
@@ -225,6 +225,7 @@ func Test_ScheduledExecutionSuccessCallback(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 	mockEntitySchedule := &entity.Schedule{
 		TransactionID: txId,
@@ -236,12 +237,13 @@ func Test_ScheduledExecutionSuccessCallback(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 
 	mocks.MScheduleRepository.On("Create", mockEntitySchedule).Return(nil, nil)
 	mocks.MFeeRepository.On("Create", mockEntityFee).Return(nil, nil)
 
-	onSuccess, _ := s.scheduledTxExecutionCallbacks(id, feeAmount, true)
+	onSuccess, _ := s.scheduledTxExecutionCallbacks(tr.SourceChainId, id, feeAmount, true)
 	onSuccess(txId, scheduleId)
 }
 
@@ -257,6 +259,7 @@ func Test_ScheduledExecutionUpdateStatusFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 	mockEntitySchedule := &entity.Schedule{
 		TransactionID: txId,
@@ -268,12 +271,13 @@ func Test_ScheduledExecutionUpdateStatusFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 
 	mocks.MScheduleRepository.On("Create", mockEntitySchedule).Return(errors.New("update-status-failed"))
 	mocks.MFeeRepository.AssertNotCalled(t, "Create", mockEntityFee)
 
-	onSuccess, _ := s.scheduledTxExecutionCallbacks(id, feeAmount, true)
+	onSuccess, _ := s.scheduledTxExecutionCallbacks(tr.SourceChainId, id, feeAmount, true)
 	onSuccess(txId, scheduleId)
 }
 
@@ -289,6 +293,7 @@ func Test_ScheduledExecutionCreateFeeFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 	mockEntitySchedule := &entity.Schedule{
 		TransactionID: txId,
@@ -300,12 +305,13 @@ func Test_ScheduledExecutionCreateFeeFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 
 	mocks.MScheduleRepository.On("Create", mockEntitySchedule).Return(nil)
 	mocks.MFeeRepository.On("Create", mockEntityFee).Return(errors.New("create-failed"))
 
-	onSuccess, _ := s.scheduledTxExecutionCallbacks(id, feeAmount, true)
+	onSuccess, _ := s.scheduledTxExecutionCallbacks(tr.SourceChainId, id, feeAmount, true)
 	onSuccess(txId, scheduleId)
 }
 
@@ -320,6 +326,7 @@ func Test_ScheduledExecutionFailCallback(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 	mockEntitySchedule := &entity.Schedule{
 		TransactionID: txId,
@@ -329,13 +336,14 @@ func Test_ScheduledExecutionFailCallback(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 
 	mocks.MScheduleRepository.On("Create", mockEntitySchedule).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", id).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusFailed", tr.SourceChainId, id).Return(nil)
 	mocks.MFeeRepository.On("Create", mockEntityFee).Return(nil)
 
-	_, onError := s.scheduledTxExecutionCallbacks(id, feeAmount, true)
+	_, onError := s.scheduledTxExecutionCallbacks(tr.SourceChainId, id, feeAmount, true)
 	onError(txId)
 }
 
@@ -351,6 +359,7 @@ func Test_ScheduledExecutionFailedUpdateStatusFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 	mockEntitySchedule := &entity.Schedule{
 		TransactionID: txId,
@@ -360,12 +369,13 @@ func Test_ScheduledExecutionFailedUpdateStatusFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 
 	mocks.MScheduleRepository.On("Create", mockEntitySchedule).Return(errors.New("update-status-failed"))
 	mocks.MFeeRepository.AssertNotCalled(t, "Create", mockEntityFee)
 
-	_, onError := s.scheduledTxExecutionCallbacks(id, feeAmount, true)
+	_, onError := s.scheduledTxExecutionCallbacks(tr.SourceChainId, id, feeAmount, true)
 	onError(txId)
 }
 
@@ -380,6 +390,7 @@ func Test_ScheduledExecutionFailedCreateFeeFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 
 	mockEntitySchedule := &entity.Schedule{
@@ -390,35 +401,36 @@ func Test_ScheduledExecutionFailedCreateFeeFails(t *testing.T) {
 			String: id,
 			Valid:  true,
 		},
+		TransferSourceChainID: tr.SourceChainId,
 	}
 
 	mocks.MScheduleRepository.On("Create", mockEntitySchedule).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", id).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusFailed", tr.SourceChainId, id).Return(nil)
 	mocks.MFeeRepository.On("Create", mockEntityFee).Return(errors.New("create-failed"))
 
-	_, onError := s.scheduledTxExecutionCallbacks(id, feeAmount, true)
+	_, onError := s.scheduledTxExecutionCallbacks(tr.SourceChainId, id, feeAmount, true)
 	onError(txId)
 }
 
 func Test_ScheduledTxMinedExecutionSuccessCallback(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
-	mocks.MTransferRepository.On("UpdateStatusCompleted", id).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", tr.SourceChainId, id).Return(nil)
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
 	mocks.MFeeRepository.On("UpdateStatusCompleted", txId).Return(nil)
 
-	onSuccess, _ := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	onSuccess, _ := s.scheduledTxMinedCallbacks(tr.SourceChainId, id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onSuccess(txId)
 }
 
 func Test_ScheduledTxMinedExecutionSuccessUpdateStatusFails(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
-	mocks.MTransferRepository.On("UpdateStatusCompleted", id).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", tr.SourceChainId, id).Return(nil)
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(errors.New("update-status-fail"))
 	mocks.MFeeRepository.AssertNotCalled(t, "UpdateStatusCompleted", txId)
 
-	onSuccess, _ := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	onSuccess, _ := s.scheduledTxMinedCallbacks(tr.SourceChainId, id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onSuccess(txId)
 }
 
@@ -426,10 +438,10 @@ func Test_ScheduledTxMinedExecutionFailCallback(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
 	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", id).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusFailed", tr.SourceChainId, id).Return(nil)
 	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(nil)
 
-	_, onFail := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	_, onFail := s.scheduledTxMinedCallbacks(tr.SourceChainId, id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onFail(txId)
 }
 
@@ -437,10 +449,10 @@ func Test_ScheduledTxMinedExecutionFailUpdateStatusFailedFails(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
 	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(errors.New("update-status-fail"))
-	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", id)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", tr.SourceChainId, id)
 	mocks.MFeeRepository.AssertNotCalled(t, "UpdateStatusFailed", txId)
 
-	_, onFail := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	_, onFail := s.scheduledTxMinedCallbacks(tr.SourceChainId, id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onFail(txId)
 }
 
@@ -448,21 +460,21 @@ func Test_ScheduledTxMinedExecutionFailFeeUpdateFails(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
 	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", id).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusFailed", tr.SourceChainId, id).Return(nil)
 	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(errors.New("update-fail"))
 
-	_, onFail := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	_, onFail := s.scheduledTxMinedCallbacks(tr.SourceChainId, id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onFail(txId)
 }
 
 func Test_ScheduledTxMinedExecutionSuccessFeeUpdateFails(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
-	mocks.MTransferRepository.On("UpdateStatusCompleted", id).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", tr.SourceChainId, id).Return(nil)
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
 	mocks.MFeeRepository.On("UpdateStatusCompleted", txId).Return(errors.New("update-fail"))
 
-	onSuccess, _ := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	onSuccess, _ := s.scheduledTxMinedCallbacks(tr.SourceChainId, id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onSuccess(txId)
 }
 
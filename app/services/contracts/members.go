@@ -20,7 +20,9 @@ import "sync"
 
 type Members struct {
 	members []string
-	mutex   sync.RWMutex
+	// stale is set when the last reload of the members has failed
+	stale bool
+	mutex sync.RWMutex
 }
 
 func (c *Members) Get() []string {
@@ -34,3 +36,15 @@ func (c *Members) Set(addresses []string) {
 	defer c.mutex.RUnlock()
 	c.members = addresses
 }
+
+func (c *Members) IsStale() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.stale
+}
+
+func (c *Members) SetStale(stale bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.stale = stale
+}
@@ -31,3 +31,14 @@ func TestMembersSet(t *testing.T) {
 		assert.Equal(t, newMembers[i], v, "Members not set correctly")
 	}
 }
+
+func TestMembersStale(t *testing.T) {
+	membersService := Members{}
+	assert.False(t, membersService.IsStale())
+
+	membersService.SetStale(true)
+	assert.True(t, membersService.IsStale())
+
+	membersService.SetStale(false)
+	assert.False(t, membersService.IsStale())
+}
@@ -29,7 +29,6 @@ import (
 	"math/big"
 	"strings"
 	"sync"
-	"time"
 )
 
 type Service struct {
@@ -104,16 +103,24 @@ func (bsc *Service) WatchBurnEventLogs(opts *bind.WatchOpts, sink chan<- *router
 	return bsc.contract.WatchBurn(opts, sink)
 }
 
-func (bsc *Service) ReloadMembers() {
+// ReloadMembers fetches all the members from the Router Contract. On failure, the current
+// members are kept and marked as stale until a subsequent reload succeeds
+func (bsc *Service) ReloadMembers() error {
 	members, err := bsc.getMembers()
 	if err != nil {
-		time.Sleep(10 * time.Second)
-		go bsc.ReloadMembers()
-		return
+		bsc.members.SetStale(true)
+		return err
 	}
 
 	bsc.members.Set(members)
+	bsc.members.SetStale(false)
 	bsc.logger.Infof("Set members list to [%s].", members)
+	return nil
+}
+
+// MembersStale returns whether the last reload of the members has failed
+func (bsc *Service) MembersStale() bool {
+	return bsc.members.IsStale()
 }
 
 func (bsc *Service) getMembers() ([]string, error) {
@@ -150,7 +157,10 @@ func NewService(client client.EVM, address string, contractInstance client.Diamo
 		logger:   config.GetLoggerFor(fmt.Sprintf("Contract Service [%s]", contractAddress.String())),
 	}
 
-	contractService.ReloadMembers()
+	err = contractService.ReloadMembers()
+	if err != nil {
+		contractService.logger.Errorf("Failed to load members. They will be reloaded by the EVM watcher. Error: [%s].", err)
+	}
 
 	return contractService
 }
@@ -70,28 +70,30 @@ func NewService(
 }
 
 func (s *Service) ProcessEvent(event payload.Transfer) {
+	logger := config.WithContext(s.logger, event.Context())
+
 	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.SourceAsset)
 
 	amount, err := strconv.ParseInt(event.Amount, 10, 64)
 	if err != nil {
-		s.logger.Errorf("[%s] - Failed to parse event amount [%s]. Error [%s].", event.TransactionId, event.Amount, err)
+		logger.Errorf("[%s] - Failed to parse event amount [%s]. Error [%s].", event.TransactionId, event.Amount, err)
 	}
 
 	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
 	if err != nil {
-		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
+		logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
 		return
 	}
 
 	if transactionRecord.Status != status.Initial {
-		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
 		return
 	}
 
 	status := make(chan string)
 
 	onTokenMintSuccess, onTokenMintFail := s.scheduledTxMinedCallbacks(event.TransactionId, &status, event, schedule.MINT)
-	onExecutionMintSuccess, onExecutionMintFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.MINT, &status, false)
+	onExecutionMintSuccess, onExecutionMintFail := s.scheduledTxExecutionCallbacks(event.SourceChainId, event.TransactionId, schedule.MINT, &status, false)
 
 	s.scheduledService.ExecuteScheduledMintTransaction(
 		event.TransactionId,
@@ -105,21 +107,21 @@ func (s *Service) ProcessEvent(event payload.Transfer) {
 	)
 
 	// TODO: Figure out Unit Testing on this one
-	s.logger.Debugf("[%s] - Waiting for Mint Transaction Execution.", event.TransactionId)
+	logger.Debugf("[%s] - Waiting for Mint Transaction Execution.", event.TransactionId)
 statusBlocker:
 	for {
 		switch <-status {
 		case syncHelper.DONE:
-			s.logger.Debugf("[%s] - Proceeding to submit the Scheduled Transfer Transaction.", event.TransactionId)
+			logger.Debugf("[%s] - Proceeding to submit the Scheduled Transfer Transaction.", event.TransactionId)
 			break statusBlocker
 		case syncHelper.FAIL:
-			s.logger.Errorf("[%s] - Failed to await the execution of Scheduled Mint Transaction.", event.TransactionId)
+			logger.Errorf("[%s] - Failed to await the execution of Scheduled Mint Transaction.", event.TransactionId)
 			return
 		}
 	}
 	accountID, err := hedera.AccountIDFromString(event.Receiver)
 	if err != nil {
-		s.logger.Errorf("[%s] - Failed to parse receiver [%s]. Error: [%s].", event.TransactionId, event.Receiver, err)
+		logger.Errorf("[%s] - Failed to parse receiver [%s]. Error: [%s].", event.TransactionId, event.Receiver, err)
 		return
 	}
 
@@ -134,7 +136,7 @@ statusBlocker:
 		},
 	}
 
-	onExecutionTransferSuccess, onExecutionTransferFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.TRANSFER, nil, true)
+	onExecutionTransferSuccess, onExecutionTransferFail := s.scheduledTxExecutionCallbacks(event.SourceChainId, event.TransactionId, schedule.TRANSFER, nil, true)
 	onTransferSuccess, onTransferFail := s.scheduledTxMinedCallbacks(event.TransactionId, nil, event, schedule.TRANSFER)
 
 	s.scheduledService.ExecuteScheduledTransferTransaction(
@@ -162,7 +164,7 @@ func (s Service) initSuccessRatePrometheusMetrics(transactionId string, sourceCh
 	}
 }
 
-func (s *Service) scheduledTxExecutionCallbacks(id, operation string, blocker *chan string, hasReceiver bool) (onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail func(transactionID string)) {
+func (s *Service) scheduledTxExecutionCallbacks(sourceChainId uint64, id, operation string, blocker *chan string, hasReceiver bool) (onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail func(transactionID string)) {
 	onExecutionSuccess = func(transactionID, scheduleID string) {
 		s.logger.Debugf("[%s] - Updating db status Submitted with TransactionID [%s].",
 			id,
@@ -177,6 +179,7 @@ func (s *Service) scheduledTxExecutionCallbacks(id, operation string, blocker *c
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			if blocker != nil {
@@ -200,6 +203,7 @@ func (s *Service) scheduledTxExecutionCallbacks(id, operation string, blocker *c
 				String: id,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", id, err)
@@ -226,7 +230,7 @@ func (s *Service) scheduledTxMinedCallbacks(id string, status *chan string, even
 
 		s.logger.Debugf("[%s] - Scheduled [%s] TX execution successful.", id, transactionID)
 
-		err := s.repository.UpdateStatusCompleted(id)
+		err := s.repository.UpdateStatusCompleted(event.SourceChainId, id)
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to update status completed. Error [%s].", id, err)
 			return
@@ -255,15 +259,15 @@ func (s *Service) scheduledTxMinedCallbacks(id string, status *chan string, even
 			*status <- syncHelper.FAIL
 		}
 		s.logger.Debugf("[%s] - Scheduled TX execution has failed.", id)
-		err := s.scheduleRepository.UpdateStatusFailed(id)
+		err := s.scheduleRepository.UpdateStatusFailed(transactionID)
 		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update schedule status failed. Error [%s].", id, err)
+			s.logger.Errorf("[%s] - Failed to update schedule status failed. Error [%s].", transactionID, err)
 			return
 		}
 
-		err = s.repository.UpdateStatusFailed(transactionID)
+		err = s.repository.UpdateStatusFailed(event.SourceChainId, id)
 		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update transfer status failed. Error [%s].", transactionID, err)
+			s.logger.Errorf("[%s] - Failed to update transfer status failed. Error [%s].", id, err)
 			return
 		}
 	}
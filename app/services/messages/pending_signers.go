@@ -0,0 +1,224 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package messages
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+// The period, after which transfers are no longer tracked, even if some members have not signed them
+const pendingSignersRetention = 24 * time.Hour
+
+// transferKey identifies a transfer by its transaction id together with its source chain
+type transferKey struct {
+	sourceChainId uint64
+	transferID    string
+}
+
+// awaitingSigners tracks the transfers, for which signatures have been received,
+// together with the time of the first received signature
+type awaitingSigners struct {
+	mutex     sync.Mutex
+	transfers map[transferKey]time.Time
+}
+
+func newAwaitingSigners() *awaitingSigners {
+	return &awaitingSigners{transfers: make(map[transferKey]time.Time)}
+}
+
+func (a *awaitingSigners) add(sourceChainId uint64, transferID string, at time.Time) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	key := transferKey{sourceChainId: sourceChainId, transferID: transferID}
+	if _, ok := a.transfers[key]; !ok {
+		a.transfers[key] = at
+	}
+}
+
+func (a *awaitingSigners) remove(key transferKey) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.transfers, key)
+}
+
+// since returns the transfers, which have been awaiting signatures since before the given time
+func (a *awaitingSigners) since(before time.Time) map[transferKey]time.Time {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	res := make(map[transferKey]time.Time)
+	for key, at := range a.transfers {
+		if at.Before(before) {
+			res[key] = at
+		}
+	}
+	return res
+}
+
+// PendingSigners returns the members of the target network's router, which have not yet signed the given transfer
+func (ss *Service) PendingSigners(sourceChainId uint64, transferID string) ([]string, error) {
+	t, err := ss.transferRepository.GetByTransactionId(sourceChainId, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, fmt.Errorf("transfer [%s] not found", transferID)
+	}
+
+	contractService, ok := ss.contractServices[t.TargetChainID]
+	if !ok {
+		return nil, fmt.Errorf("no router contract for target network [%d]", t.TargetChainID)
+	}
+
+	messages, err := ss.messageRepository.Get(sourceChainId, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := make(map[string]bool)
+	for _, m := range messages {
+		signed[strings.ToLower(m.Signer)] = true
+	}
+
+	pending := make([]string, 0)
+	for _, member := range contractService.GetMembers() {
+		if !signed[strings.ToLower(member)] {
+			pending = append(pending, member)
+		}
+	}
+
+	return pending, nil
+}
+
+// GetTransfersAwaitingSignatureFrom returns the transfers, which still await the signature of the given member.
+// Transfers to networks, on whose router the address is not a member, are excluded
+func (ss *Service) GetTransfersAwaitingSignatureFrom(member string) ([]*entity.Transfer, error) {
+	transfers, err := ss.transferRepository.GetAwaitingSignatureFrom(member)
+	if err != nil {
+		return nil, err
+	}
+
+	awaiting := make([]*entity.Transfer, 0, len(transfers))
+	for _, t := range transfers {
+		contractService, ok := ss.contractServices[t.TargetChainID]
+		if !ok || !contractService.IsMember(member) {
+			continue
+		}
+		awaiting = append(awaiting, t)
+	}
+
+	return awaiting, nil
+}
+
+// ReportPendingSigners publishes, per member, the number of transfers awaiting its signature for longer than the timeout.
+// Transfers signed by all members, or tracked for longer than the retention period are no longer tracked
+func (ss *Service) ReportPendingSigners(timeout time.Duration) {
+	now := time.Now()
+	pendingPerMember := make(map[string]int)
+	for _, contractService := range ss.contractServices {
+		for _, member := range contractService.GetMembers() {
+			pendingPerMember[strings.ToLower(member)] = 0
+		}
+	}
+
+	for key, at := range ss.awaiting.since(now.Add(-timeout)) {
+		if now.Sub(at) > pendingSignersRetention {
+			ss.awaiting.remove(key)
+			continue
+		}
+
+		pending, err := ss.PendingSigners(key.sourceChainId, key.transferID)
+		if err != nil {
+			ss.logger.Errorf("[%s] - Failed to get pending signers. Error: [%s]", key.transferID, err)
+			continue
+		}
+		if len(pending) == 0 {
+			ss.awaiting.remove(key)
+			continue
+		}
+
+		ss.logger.Warnf("[%s] - Awaiting signatures from [%s] for more than [%s].", key.transferID, strings.Join(pending, ", "), timeout)
+		for _, member := range pending {
+			pendingPerMember[strings.ToLower(member)]++
+		}
+	}
+
+	for member, count := range pendingPerMember {
+		metrics.SetPendingSignatures(member, count, ss.prometheusService)
+	}
+}
+
+// AwaitsOwnSignature returns whether the signature of the validator is still missing for the given transfer
+func (ss *Service) AwaitsOwnSignature(sourceChainId uint64, transferID string) (bool, error) {
+	address, messages, err := ss.ownSignatures(sourceChainId, transferID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, m := range messages {
+		if strings.EqualFold(m.Signer, address) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// SignerRank returns the position of the signature of the validator among the ones, recorded for the given transfer
+// in consensus order, or -1 if it is not recorded. The signers take turns by rank in requesting the missing signatures
+// of transfers short of majority
+func (ss *Service) SignerRank(sourceChainId uint64, transferID string) (int, error) {
+	address, messages, err := ss.ownSignatures(sourceChainId, transferID)
+	if err != nil {
+		return -1, err
+	}
+
+	for i, m := range messages {
+		if strings.EqualFold(m.Signer, address) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// ownSignatures returns the address, with which the validator signs the given transfer, together with its recorded signatures
+func (ss *Service) ownSignatures(sourceChainId uint64, transferID string) (string, []entity.Message, error) {
+	t, err := ss.transferRepository.GetByTransactionId(sourceChainId, transferID)
+	if err != nil {
+		return "", nil, err
+	}
+	if t == nil {
+		return "", nil, fmt.Errorf("transfer [%s] not found", transferID)
+	}
+
+	signer, ok := ss.ethSigners[t.TargetChainID]
+	if !ok {
+		return "", nil, fmt.Errorf("no signer for target network [%d]", t.TargetChainID)
+	}
+
+	messages, err := ss.messageRepository.Get(sourceChainId, transferID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signer.Address(), messages, nil
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package messages
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	pendingSourceChainID = uint64(296)
+	pendingTransferID    = "0.0.1-1"
+	members              = []string{"0xAbC1", "0xaBc2", "0xabC3"}
+)
+
+func Test_PendingSigners_PartialSignatures(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 80001}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: "0xabc1"}, {Signer: "0xABC3"}}, nil)
+
+	pending, err := serviceInstance.PendingSigners(pendingSourceChainID, pendingTransferID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0xaBc2"}, pending)
+}
+
+func Test_PendingSigners_AllSigned(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 80001}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: "0xabc1"}, {Signer: "0xabc2"}, {Signer: "0xabc3"}}, nil)
+
+	pending, err := serviceInstance.PendingSigners(pendingSourceChainID, pendingTransferID)
+
+	assert.Nil(t, err)
+	assert.Empty(t, pending)
+}
+
+func Test_PendingSigners_TransferNotFound(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return((*entity.Transfer)(nil), nil)
+
+	pending, err := serviceInstance.PendingSigners(pendingSourceChainID, pendingTransferID)
+
+	assert.Error(t, err)
+	assert.Nil(t, pending)
+}
+
+func Test_PendingSigners_MessagesFail(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 80001}, nil)
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{}, errors.New("some-error"))
+
+	pending, err := serviceInstance.PendingSigners(pendingSourceChainID, pendingTransferID)
+
+	assert.Error(t, err)
+	assert.Nil(t, pending)
+}
+
+func Test_ReportPendingSigners(t *testing.T) {
+	setup()
+	mocks.MPrometheusService.ExpectedCalls = nil
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 80001}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: "0xabc1"}}, nil)
+	gauges := map[string]prometheus.Gauge{}
+	for _, member := range members {
+		opts := prometheus.GaugeOpts{
+			Name:        constants.PendingSignaturesGaugeNamePrefix + strings.ToLower(member),
+			Help:        constants.PendingSignaturesGaugeHelp,
+			ConstLabels: prometheus.Labels{constants.MemberMetricLabelKey: strings.ToLower(member)},
+		}
+		gauges[member] = prometheus.NewGauge(opts)
+		mocks.MPrometheusService.On("CreateGaugeIfNotExists", opts).Return(gauges[member])
+	}
+	serviceInstance.awaiting.add(pendingSourceChainID, pendingTransferID, time.Now().Add(-time.Hour))
+	serviceInstance.awaiting.add(pendingSourceChainID, "0.0.1-2", time.Now())
+
+	serviceInstance.ReportPendingSigners(time.Minute)
+
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauges[members[0]]))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauges[members[1]]))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauges[members[2]]))
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", pendingSourceChainID, "0.0.1-2")
+}
+
+func Test_ReportPendingSigners_UntracksSignedTransfers(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 80001}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return(members[:1])
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: "0xabc1"}}, nil)
+	serviceInstance.awaiting.add(pendingSourceChainID, pendingTransferID, time.Now().Add(-time.Hour))
+
+	serviceInstance.ReportPendingSigners(time.Minute)
+
+	assert.Empty(t, serviceInstance.awaiting.since(time.Now()))
+}
+
+func Test_ReportPendingSigners_UntracksAfterRetention(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("GetMembers").Return(members)
+	serviceInstance.awaiting.add(pendingSourceChainID, pendingTransferID, time.Now().Add(-pendingSignersRetention-time.Minute))
+
+	serviceInstance.ReportPendingSigners(time.Minute)
+
+	assert.Empty(t, serviceInstance.awaiting.since(time.Now()))
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", pendingSourceChainID, pendingTransferID)
+}
+
+func Test_GetTransfersAwaitingSignatureFrom(t *testing.T) {
+	setup()
+	awaiting := &entity.Transfer{TransactionID: "0.0.1-1", TargetChainID: 80001}
+	unknownNetwork := &entity.Transfer{TransactionID: "0.0.1-2", TargetChainID: 5}
+	mocks.MTransferRepository.On("GetAwaitingSignatureFrom", members[1]).Return([]*entity.Transfer{awaiting, unknownNetwork}, nil)
+	mocks.MBridgeContractService.On("IsMember", members[1]).Return(true)
+
+	transfers, err := serviceInstance.GetTransfersAwaitingSignatureFrom(members[1])
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{awaiting}, transfers)
+}
+
+func Test_GetTransfersAwaitingSignatureFrom_NotMember(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetAwaitingSignatureFrom", "0xdef").Return([]*entity.Transfer{{TransactionID: "0.0.1-1", TargetChainID: 80001}}, nil)
+	mocks.MBridgeContractService.On("IsMember", "0xdef").Return(false)
+
+	transfers, err := serviceInstance.GetTransfersAwaitingSignatureFrom("0xdef")
+
+	assert.Nil(t, err)
+	assert.Empty(t, transfers)
+}
+
+func Test_GetTransfersAwaitingSignatureFrom_Fails(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetAwaitingSignatureFrom", members[1]).Return(nil, errors.New("some-error"))
+
+	transfers, err := serviceInstance.GetTransfersAwaitingSignatureFrom(members[1])
+
+	assert.Error(t, err)
+	assert.Nil(t, transfers)
+}
+
+func Test_AwaitsOwnSignature(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 80001}, nil)
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: "0xabc1"}}, nil).Once()
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: "0xabc1"}, {Signer: strings.ToUpper(signerAddress)}}, nil).Once()
+
+	awaits, err := serviceInstance.AwaitsOwnSignature(pendingSourceChainID, pendingTransferID)
+	assert.Nil(t, err)
+	assert.True(t, awaits)
+
+	awaits, err = serviceInstance.AwaitsOwnSignature(pendingSourceChainID, pendingTransferID)
+	assert.Nil(t, err)
+	assert.False(t, awaits)
+}
+
+func Test_AwaitsOwnSignature_NoSigner(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 296}, nil)
+
+	awaits, err := serviceInstance.AwaitsOwnSignature(pendingSourceChainID, pendingTransferID)
+
+	assert.Error(t, err)
+	assert.False(t, awaits)
+	mocks.MMessageRepository.AssertNotCalled(t, "Get", pendingSourceChainID, pendingTransferID)
+}
+
+func Test_SignerRank(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", pendingSourceChainID, pendingTransferID).Return(&entity.Transfer{TargetChainID: 80001}, nil)
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: strings.ToUpper(signerAddress)}, {Signer: "0xabc1"}}, nil).Once()
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{{Signer: "0xabc1"}, {Signer: signerAddress}}, nil).Once()
+	mocks.MMessageRepository.On("Get", pendingSourceChainID, pendingTransferID).Return([]entity.Message{}, nil).Once()
+
+	rank, err := serviceInstance.SignerRank(pendingSourceChainID, pendingTransferID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rank)
+
+	rank, err = serviceInstance.SignerRank(pendingSourceChainID, pendingTransferID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rank)
+
+	rank, err = serviceInstance.SignerRank(pendingSourceChainID, pendingTransferID)
+	assert.Nil(t, err)
+	assert.Equal(t, -1, rank)
+}
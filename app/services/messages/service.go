@@ -19,7 +19,6 @@ package messages
 import (
 	"encoding/hex"
 	"fmt"
-	"strconv"
 	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
@@ -33,6 +32,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	ethhelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -51,6 +51,9 @@ type Service struct {
 	logger             *log.Entry
 	assetsService      service.Assets
 	retryAttempts      int
+	signatureSchemes   []string
+	prometheusService  service.Prometheus
+	awaiting           *awaitingSigners
 }
 
 func NewService(
@@ -62,12 +65,23 @@ func NewService(
 	ethClients map[uint64]client.EVM,
 	topicID string,
 	assetsService service.Assets,
+	signatureSchemes []string,
+	prometheusService service.Prometheus,
 ) *Service {
 	tID, e := hedera.TopicIDFromString(topicID)
 	if e != nil {
 		log.Fatalf("Invalid monitoring Topic ID [%s] - Error: [%s]", topicID, e)
 	}
 
+	if len(signatureSchemes) == 0 {
+		signatureSchemes = []string{auth_message.SchemeEIP191}
+	}
+	for _, scheme := range signatureSchemes {
+		if scheme != auth_message.SchemeEIP191 && scheme != auth_message.SchemeEIP712 {
+			log.Fatalf("Unsupported signature scheme [%s]", scheme)
+		}
+	}
+
 	return &Service{
 		ethSigners:         ethSigners,
 		contractServices:   contractServices,
@@ -79,6 +93,9 @@ func NewService(
 		ethClients:         ethClients,
 		assetsService:      assetsService,
 		retryAttempts:      30,
+		signatureSchemes:   signatureSchemes,
+		prometheusService:  prometheusService,
+		awaiting:           newAwaitingSigners(),
 	}
 }
 
@@ -86,30 +103,20 @@ func NewService(
 // Validates it against the Transaction Record metadata from DB
 func (ss *Service) SanityCheckFungibleSignature(topicMessage *proto_models.TopicEthSignatureMessage) (bool, error) {
 	// In case a topic message for given transfer is being processed before the actual transfer
-	t, err := ss.awaitTransfer(topicMessage.TransferID)
+	t, err := ss.awaitTransfer(topicMessage.SourceChainId, topicMessage.TransferID)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to await incoming transfer and its fee. Error: [%s]", topicMessage.TransferID, err)
 		return false, err
 	}
 
-	signedAmount := t.Amount
-	if t.NativeChainID == constants.HederaNetworkId {
-		amount, err := strconv.ParseInt(t.Amount, 10, 64)
-		if err != nil {
-			ss.logger.Errorf("[%s] - Failed to parse transfer amount. Error [%s]", topicMessage.TransferID, err)
-			return false, err
-		}
-
-		feeAmount, err := strconv.ParseInt(t.Fee, 10, 64)
-		if err != nil {
-			ss.logger.Errorf("[%s] - Failed to parse fee amount. Error [%s]", topicMessage.TransferID, err)
-			return false, err
-		}
-		signedAmount = strconv.FormatInt(amount-feeAmount, 10)
+	signedAmount, err := t.SignedAmount()
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to subtract the fee from the transfer amount. Error [%s]", topicMessage.TransferID, err)
+		return false, err
 	}
 
 	match :=
-		topicMessage.Recipient == t.Receiver &&
+		ethhelper.SameAddress(topicMessage.Recipient, t.Receiver) &&
 			topicMessage.Amount == signedAmount &&
 			topicMessage.Asset == t.TargetAsset &&
 			topicMessage.TargetChainId == t.TargetChainID &&
@@ -123,14 +130,14 @@ func (ss *Service) SanityCheckFungibleSignature(topicMessage *proto_models.Topic
 // Validates it against the Transaction Record metadata from DB
 func (ss *Service) SanityCheckNftSignature(topicMessage *proto_models.TopicEthNftSignatureMessage) (bool, error) {
 	// In case a topic message for given transfer is being processed before the actual transfer
-	t, err := ss.awaitTransfer(topicMessage.TransferID)
+	t, err := ss.awaitTransfer(topicMessage.SourceChainId, topicMessage.TransferID)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to await incoming transfer and its fee. Error: [%s]", topicMessage.TransferID, err)
 		return false, err
 	}
 
 	match :=
-		topicMessage.Recipient == t.Receiver &&
+		ethhelper.SameAddress(topicMessage.Recipient, t.Receiver) &&
 			int64(topicMessage.TokenId) == t.SerialNumber &&
 			topicMessage.Metadata == t.Metadata &&
 			topicMessage.Asset == t.TargetAsset &&
@@ -147,6 +154,11 @@ func (ss Service) SignFungibleMessage(tm payload.Transfer) ([]byte, error) {
 		return nil, err
 	}
 
+	err = ss.CheckMembership(tm.TransactionId, tm.TargetChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgHash)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to sign the authorisation signature. Error: [%s]", tm.TransactionId, err)
@@ -180,6 +192,11 @@ func (ss Service) SignNftMessage(tm payload.Transfer) ([]byte, error) {
 		return nil, err
 	}
 
+	err = ss.CheckMembership(tm.TransactionId, tm.TargetChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgHash)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to sign the authorisation signature. Error: [%s]", tm.TransactionId, err)
@@ -208,8 +225,22 @@ func (ss Service) SignNftMessage(tm payload.Transfer) ([]byte, error) {
 	return bytes, nil
 }
 
+// CheckMembership returns an error if the validator's key is not in the current member set of the target network.
+// In such case the validator degrades to read-only behaviour for the network - it keeps recording transfers, without signing them.
+func (ss Service) CheckMembership(transferID string, targetChainId uint64) error {
+	address := ss.ethSigners[targetChainId].Address()
+	isMember := ss.contractServices[targetChainId].IsMember(address)
+	metrics.SetNotMember(targetChainId, isMember, ss.prometheusService)
+	if !isMember {
+		ss.logger.Warnf("[%s] - Validator [%s] is not in the member set of network [%d]. Skipping signing.", transferID, address, targetChainId)
+		return service.ErrNotMember
+	}
+
+	return nil
+}
+
 // ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
-func (ss *Service) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error {
+func (ss *Service) ProcessSignature(transferID, signature string, sourceChainId, targetChainId uint64, timestamp int64, authMsg, typedDataMsg []byte) error {
 	// Prepare Signature
 	signatureBytes, signatureHex, err := ethhelper.DecodeSignature(signature)
 	if err != nil {
@@ -230,7 +261,7 @@ func (ss *Service) ProcessSignature(transferID, signature string, targetChainId
 	}
 
 	// Verify Signature
-	address, err := ss.verifySignature(authMsg, signatureBytes, transferID, targetChainId, authMessageStr)
+	address, err := ss.verifySignature(authMsg, typedDataMsg, signatureBytes, transferID, targetChainId, authMessageStr)
 	if err != nil {
 		return err
 	}
@@ -239,52 +270,71 @@ func (ss *Service) ProcessSignature(transferID, signature string, targetChainId
 
 	// Persist in DB
 	err = ss.messageRepository.Create(&entity.Message{
-		TransferID:           transferID,
-		Signature:            signatureHex,
-		Hash:                 authMessageStr,
-		Signer:               address.String(),
-		TransactionTimestamp: timestamp,
+		TransferID:            transferID,
+		TransferSourceChainID: sourceChainId,
+		Signature:             signatureHex,
+		Hash:                  authMessageStr,
+		Signer:                address.String(),
+		TransactionTimestamp:  timestamp,
 	})
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to save Transaction Message in DB with Signature [%s]. Error: [%s]", transferID, signatureHex, err)
 		return err
 	}
 
+	ss.awaiting.add(sourceChainId, transferID, time.Now())
+
 	ss.logger.Infof("[%s] - Successfully processed Signature Message from [%s]", transferID, address.String())
 	return nil
 }
 
-func (ss *Service) verifySignature(authMsgBytes []byte, signatureBytes []byte, transferID string, targetChainId uint64, authMessageStr string) (common.Address, error) {
-	publicKey, err := crypto.Ecrecover(authMsgBytes, signatureBytes)
-	if err != nil {
-		ss.logger.Errorf("[%s] - Failed to recover public key. Hash [%s]. Error: [%s]", transferID, authMessageStr, err)
-		return common.Address{}, err
-	}
-	unmarshalledPublicKey, err := crypto.UnmarshalPubkey(publicKey)
-	if err != nil {
-		ss.logger.Errorf("[%s] - Failed to unmarshall public key. Error: [%s]", transferID, err)
-		return common.Address{}, err
-	}
-	address := crypto.PubkeyToAddress(*unmarshalledPublicKey)
+// verifySignature recovers the signer under each of the configured schemes and
+// returns the first one, which is a member of the bridge
+func (ss *Service) verifySignature(authMsgBytes, typedDataBytes []byte, signatureBytes []byte, transferID string, targetChainId uint64, authMessageStr string) (common.Address, error) {
+	for _, scheme := range ss.signatureSchemes {
+		hash := authMsgBytes
+		if scheme == auth_message.SchemeEIP712 {
+			hash = typedDataBytes
+		}
+		if len(hash) == 0 {
+			continue
+		}
 
-	if !ss.contractServices[targetChainId].IsMember(address.String()) {
-		ss.logger.Errorf("[%s] - Received Signature [%s] is not signed by Bridge member", transferID, authMessageStr)
-		return common.Address{}, fmt.Errorf("signer is not signatures member")
+		publicKey, err := crypto.Ecrecover(hash, signatureBytes)
+		if err != nil {
+			ss.logger.Errorf("[%s] - Failed to recover public key using scheme [%s]. Hash [%s]. Error: [%s]", transferID, scheme, authMessageStr, err)
+			continue
+		}
+		unmarshalledPublicKey, err := crypto.UnmarshalPubkey(publicKey)
+		if err != nil {
+			ss.logger.Errorf("[%s] - Failed to unmarshall public key using scheme [%s]. Error: [%s]", transferID, scheme, err)
+			continue
+		}
+		address := crypto.PubkeyToAddress(*unmarshalledPublicKey)
+
+		if ss.contractServices[targetChainId].IsMember(address.String()) {
+			ss.logger.Debugf("[%s] - Recovered Bridge member [%s] using scheme [%s]", transferID, address.String(), scheme)
+			return address, nil
+		}
 	}
-	return address, nil
+
+	ss.logger.Errorf("[%s] - Received Signature [%s] is not signed by Bridge member", transferID, authMessageStr)
+	return common.Address{}, fmt.Errorf("signer is not signatures member")
 }
 
-// awaitTransfer checks until given transfer is found
-func (ss *Service) awaitTransfer(transferID string) (*entity.Transfer, error) {
+// awaitTransfer checks until given transfer from the given source chain is found
+func (ss *Service) awaitTransfer(sourceChainId uint64, transferID string) (*entity.Transfer, error) {
 	i := 0
+	found := false
 	for i < ss.retryAttempts {
-		t, err := ss.transferRepository.GetByTransactionId(transferID)
+		t, err := ss.transferRepository.GetByTransactionId(sourceChainId, transferID)
 		if err != nil {
 			ss.logger.Errorf("[%s] - Failed to retrieve Transaction Record. Error: [%s]", transferID, err)
 			return nil, err
 		}
 
 		if t != nil {
+			found = true
 			if t.NativeChainID != constants.HederaNetworkId {
 				return t, nil
 			}
@@ -298,6 +348,8 @@ func (ss *Service) awaitTransfer(transferID string) (*entity.Transfer, error) {
 		i++
 	}
 
-	err := fmt.Errorf("[%s] - Failed to retrieve Transaction Record, dropping transaction", transferID)
-	return nil, err
+	if !found {
+		return nil, fmt.Errorf("[%s] - Failed to retrieve Transaction Record: %w", transferID, service.ErrTransferNotFound)
+	}
+	return nil, fmt.Errorf("[%s] - Failed to retrieve the fee of Transaction Record: %w", transferID, service.ErrTransferFeeNotFound)
 }
@@ -17,14 +17,19 @@
 package messages
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -46,6 +51,7 @@ var (
 	sourceChainId = constants.HederaNetworkId
 	targetChainId = uint64(80001)
 	asset         = "0.0.1"
+	signerAddress = "0x0000000000000000000000000000000000000002"
 
 	topicEthFungibleMessage = &proto.TopicEthSignatureMessage{
 		SourceChainId: sourceChainId,
@@ -96,6 +102,8 @@ func Test_NewService(t *testing.T) {
 		ethClients,
 		"0.0.1",
 		mocks.MAssetsService,
+		nil,
+		mocks.MPrometheusService,
 	)
 	actualService.retryAttempts = 1
 
@@ -105,7 +113,7 @@ func Test_NewService(t *testing.T) {
 func Test_SanityCheckFungibleSignature_ShouldReturnError(t *testing.T) {
 	setup()
 
-	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.TransferID).Return(nil, errors.New("some-error"))
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.SourceChainId, topicEthFungibleMessage.TransferID).Return(nil, errors.New("some-error"))
 
 	ok, err := serviceInstance.SanityCheckFungibleSignature(topicFungibleMessage.GetFungibleSignatureMessage())
 	assert.False(t, ok)
@@ -115,9 +123,18 @@ func Test_SanityCheckFungibleSignature_ShouldReturnError(t *testing.T) {
 func Test_awaitTransfer_ShouldDropTransfer(t *testing.T) {
 	setup()
 
-	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.TransferID).Return((*entity.Transfer)(nil), nil)
-	_, err := serviceInstance.awaitTransfer(topicFungibleMessage.GetFungibleSignatureMessage().TransferID)
-	assert.NotNil(t, err)
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.SourceChainId, topicEthFungibleMessage.TransferID).Return((*entity.Transfer)(nil), nil)
+	_, err := serviceInstance.awaitTransfer(topicFungibleMessage.GetFungibleSignatureMessage().SourceChainId, topicFungibleMessage.GetFungibleSignatureMessage().TransferID)
+	assert.ErrorIs(t, err, service.ErrTransferNotFound)
+}
+
+func Test_awaitTransfer_FeeNotStored(t *testing.T) {
+	setup()
+
+	transfer := &entity.Transfer{TransactionID: topicEthFungibleMessage.TransferID, NativeChainID: constants.HederaNetworkId}
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.SourceChainId, topicEthFungibleMessage.TransferID).Return(transfer, nil)
+	_, err := serviceInstance.awaitTransfer(topicEthFungibleMessage.SourceChainId, topicEthFungibleMessage.TransferID)
+	assert.ErrorIs(t, err, service.ErrTransferFeeNotFound)
 }
 
 func Test_SanityCheckFungibleSignature_ShouldReturnTrue(t *testing.T) {
@@ -134,17 +151,47 @@ func Test_SanityCheckFungibleSignature_ShouldReturnTrue(t *testing.T) {
 		Receiver:      topicEthFungibleMessage.Recipient,
 	}
 
-	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.TransferID).Return(transfer, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.SourceChainId, topicEthFungibleMessage.TransferID).Return(transfer, nil)
 
 	ok, err := serviceInstance.SanityCheckFungibleSignature(topicFungibleMessage.GetFungibleSignatureMessage())
 	assert.True(t, ok)
 	assert.Nil(t, err)
 }
 
+func Test_SanityCheckFungibleSignature_ReceiverOfOtherCasing_ShouldReturnTrue(t *testing.T) {
+	setup()
+
+	transfer := &entity.Transfer{
+		TransactionID: topicEthFungibleMessage.TransferID,
+		SourceChainID: topicEthFungibleMessage.SourceChainId,
+		TargetChainID: topicEthFungibleMessage.TargetChainId,
+		NativeChainID: constants.HederaNetworkId,
+		TargetAsset:   topicEthFungibleMessage.Asset,
+		Amount:        "100",
+		Fee:           "5",
+		Receiver:      common.HexToAddress(topicEthFungibleMessage.Recipient).String(),
+	}
+	signatureMessage := &proto.TopicEthSignatureMessage{
+		SourceChainId: topicEthFungibleMessage.SourceChainId,
+		TargetChainId: topicEthFungibleMessage.TargetChainId,
+		TransferID:    topicEthFungibleMessage.TransferID,
+		Asset:         topicEthFungibleMessage.Asset,
+		Recipient:     strings.ToLower(topicEthFungibleMessage.Recipient),
+		Amount:        topicEthFungibleMessage.Amount,
+		Signature:     topicEthFungibleMessage.Signature,
+	}
+
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthFungibleMessage.SourceChainId, topicEthFungibleMessage.TransferID).Return(transfer, nil)
+
+	ok, err := serviceInstance.SanityCheckFungibleSignature(signatureMessage)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
 func Test_SanityCheckNftSignature_ShouldReturnError(t *testing.T) {
 	setup()
 
-	mocks.MTransferRepository.On("GetByTransactionId", topicEthNftMessage.TransferID).Return(nil, errors.New("some-error"))
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthNftMessage.SourceChainId, topicEthNftMessage.TransferID).Return(nil, errors.New("some-error"))
 
 	ok, err := serviceInstance.SanityCheckNftSignature(topicNftMessage.GetNftSignatureMessage())
 	assert.False(t, ok)
@@ -165,7 +212,7 @@ func Test_SanityCheckNftSignature(t *testing.T) {
 		NativeChainID: uint64(296296), // set to not existent chainID in order to not be set to default 0
 	}
 
-	mocks.MTransferRepository.On("GetByTransactionId", topicEthNftMessage.TransferID).Return(transfer, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", topicEthNftMessage.SourceChainId, topicEthNftMessage.TransferID).Return(transfer, nil)
 
 	ok, err := serviceInstance.SanityCheckNftSignature(topicNftMessage.GetNftSignatureMessage())
 	assert.True(t, ok)
@@ -216,6 +263,63 @@ func Test_SignFungibleMessage(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_SignFungibleMessage_NotMember(t *testing.T) {
+	setup()
+	mocks.MSignerService.ExpectedCalls = nil
+	mocks.MBridgeContractService.ExpectedCalls = nil
+	mocks.MSignerService.On("Address").Return(signerAddress)
+	mocks.MBridgeContractService.On("IsMember", signerAddress).Return(false)
+
+	tm := payload.Transfer{
+		SourceChainId: topicEthFungibleMessage.SourceChainId,
+		TargetChainId: topicEthFungibleMessage.TargetChainId,
+		TransactionId: topicEthFungibleMessage.TransferID,
+		TargetAsset:   topicEthFungibleMessage.Asset,
+		Receiver:      topicEthFungibleMessage.Recipient,
+		Amount:        topicEthFungibleMessage.Amount,
+	}
+
+	bytes, err := serviceInstance.SignFungibleMessage(tm)
+	assert.Nil(t, bytes)
+	assert.ErrorIs(t, err, service.ErrNotMember)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
+func Test_CheckMembership(t *testing.T) {
+	setup()
+	mocks.MSignerService.ExpectedCalls = nil
+	mocks.MBridgeContractService.ExpectedCalls = nil
+	mocks.MSignerService.On("Address").Return(signerAddress)
+	mocks.MBridgeContractService.On("IsMember", signerAddress).Return(true).Once()
+	mocks.MBridgeContractService.On("IsMember", signerAddress).Return(false).Once()
+
+	assert.Nil(t, serviceInstance.CheckMembership(topicEthFungibleMessage.TransferID, topicEthFungibleMessage.TargetChainId))
+	assert.ErrorIs(t, serviceInstance.CheckMembership(topicEthFungibleMessage.TransferID, topicEthFungibleMessage.TargetChainId), service.ErrNotMember)
+}
+
+func Test_SignNftMessage_NotMember(t *testing.T) {
+	setup()
+	mocks.MSignerService.ExpectedCalls = nil
+	mocks.MBridgeContractService.ExpectedCalls = nil
+	mocks.MSignerService.On("Address").Return(signerAddress)
+	mocks.MBridgeContractService.On("IsMember", signerAddress).Return(false)
+
+	tm := payload.Transfer{
+		SourceChainId: topicEthNftMessage.SourceChainId,
+		TargetChainId: topicEthNftMessage.TargetChainId,
+		TransactionId: topicEthNftMessage.TransferID,
+		TargetAsset:   topicEthNftMessage.Asset,
+		Receiver:      topicEthNftMessage.Recipient,
+		SerialNum:     int64(topicEthNftMessage.TokenId),
+		IsNft:         true,
+	}
+
+	bytes, err := serviceInstance.SignNftMessage(tm)
+	assert.Nil(t, bytes)
+	assert.ErrorIs(t, err, service.ErrNotMember)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
 func Test_SignNftMessage_ShouldReturnError(t *testing.T) {
 	setup()
 
@@ -262,14 +366,59 @@ func Test_ProcessSignature(t *testing.T) {
 	err := serviceInstance.ProcessSignature(
 		topicEthNftMessage.TransferID,
 		"signature",
+		topicEthNftMessage.SourceChainId,
 		topicEthNftMessage.TargetChainId,
 		time.Now().UnixNano(),
 		[]byte{},
+		[]byte{},
 	)
 
 	assert.NotNil(t, err)
 }
 
+func Test_ProcessSignature_SupportedSchemes(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey).String()
+	tm := topicEthFungibleMessage
+	authMsg, _ := auth_message.EncodeFungibleBytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransferID, tm.Asset, tm.Recipient, tm.Amount)
+	typedDataMsg, _ := auth_message.EncodeFungibleTypedDataFrom(tm.SourceChainId, tm.TargetChainId, tm.TransferID, tm.Asset, tm.Recipient, tm.Amount, mocks.MBridgeContractService.Address().String(), auth_message.TypedDataDomain{Name: "Router", Version: "1"})
+
+	for _, signedMsg := range [][]byte{authMsg, typedDataMsg} {
+		setup()
+		serviceInstance.signatureSchemes = []string{auth_message.SchemeEIP191, auth_message.SchemeEIP712}
+		signature, _ := crypto.Sign(signedMsg, privateKey)
+		mocks.MMessageRepository.On("Exist", tm.TransferID, mock.Anything, hex.EncodeToString(authMsg)).Return(false, nil)
+		mocks.MBridgeContractService.On("IsMember", signer).Return(true)
+		mocks.MBridgeContractService.On("IsMember", mock.Anything).Return(false)
+		mocks.MMessageRepository.On("Create", mock.Anything).Return(nil)
+
+		err := serviceInstance.ProcessSignature(tm.TransferID, hex.EncodeToString(signature), tm.SourceChainId, tm.TargetChainId, time.Now().UnixNano(), authMsg, typedDataMsg)
+
+		assert.Nil(t, err)
+		mocks.MMessageRepository.AssertCalled(t, "Create", mock.MatchedBy(func(m *entity.Message) bool {
+			return m.Signer == signer && m.Hash == hex.EncodeToString(authMsg) && m.TransferSourceChainID == tm.SourceChainId
+		}))
+	}
+}
+
+func Test_ProcessSignature_UnsupportedScheme(t *testing.T) {
+	setup()
+	privateKey, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey).String()
+	tm := topicEthFungibleMessage
+	authMsg, _ := auth_message.EncodeFungibleBytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransferID, tm.Asset, tm.Recipient, tm.Amount)
+	typedDataMsg, _ := auth_message.EncodeFungibleTypedDataFrom(tm.SourceChainId, tm.TargetChainId, tm.TransferID, tm.Asset, tm.Recipient, tm.Amount, mocks.MBridgeContractService.Address().String(), auth_message.TypedDataDomain{Name: "Router", Version: "1"})
+	signature, _ := crypto.Sign(typedDataMsg, privateKey)
+	mocks.MMessageRepository.On("Exist", tm.TransferID, mock.Anything, hex.EncodeToString(authMsg)).Return(false, nil)
+	mocks.MBridgeContractService.On("IsMember", signer).Return(true)
+	mocks.MBridgeContractService.On("IsMember", mock.Anything).Return(false)
+
+	err := serviceInstance.ProcessSignature(tm.TransferID, hex.EncodeToString(signature), tm.SourceChainId, tm.TargetChainId, time.Now().UnixNano(), authMsg, typedDataMsg)
+
+	assert.Error(t, err)
+	mocks.MMessageRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
 func setup() {
 	mocks.Setup()
 
@@ -302,5 +451,11 @@ func setup() {
 		logger:             config.GetLoggerFor(fmt.Sprintf("Messages Service")),
 		assetsService:      mocks.MAssetsService,
 		retryAttempts:      1,
+		signatureSchemes:   []string{auth_message.SchemeEIP191},
+		prometheusService:  mocks.MPrometheusService,
+		awaiting:           newAwaitingSigners(),
 	}
+	mocks.MSignerService.On("Address").Return(signerAddress)
+	mocks.MBridgeContractService.On("IsMember", signerAddress).Return(true)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 }
@@ -33,6 +33,7 @@ type Service struct {
 	logger              *log.Entry
 	gauges              map[string]prometheus.Gauge
 	counters            map[string]prometheus.Counter
+	histograms          map[string]prometheus.Histogram
 	isMonitoringEnabled bool
 	assetsService       service.Assets
 }
@@ -43,6 +44,7 @@ func NewService(assetsService service.Assets, isMonitoringEnabled bool) *Service
 		logger:              config.GetLoggerFor("Prometheus Service"),
 		gauges:              map[string]prometheus.Gauge{},
 		counters:            map[string]prometheus.Counter{},
+		histograms:          map[string]prometheus.Histogram{},
 		isMonitoringEnabled: isMonitoringEnabled,
 		assetsService:       assetsService,
 	}
@@ -189,6 +191,55 @@ func (s *Service) DeleteCounter(name string) {
 	s.logger.Infof("Counter Metric '%v' successfully unregisted!", name)
 }
 
+func (s *Service) CreateHistogramIfNotExists(opts prometheus.HistogramOpts) prometheus.Histogram {
+	if !s.isMonitoringEnabled {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if histogram, exist := s.histograms[opts.Name]; exist {
+		return histogram
+	}
+
+	s.logger.Infof("Creating Histogram Metric '%v' ...", opts.Name)
+	histogram := prometheus.NewHistogram(opts)
+	s.logger.Infof("Histogram Metric '%v' successfully created!", opts.Name)
+
+	s.logger.Infof("Registering Histogram Metric '%v' ...", opts.Name)
+	prometheus.MustRegister(histogram)
+	s.logger.Infof("Histogram Metric '%v' successfully registed!", opts.Name)
+
+	s.histograms[opts.Name] = histogram
+
+	return histogram
+}
+
+func (s *Service) GetHistogram(name string) prometheus.Histogram {
+	if !s.isMonitoringEnabled {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	histogram := s.histograms[name]
+	return histogram
+}
+
+func (s *Service) DeleteHistogram(name string) {
+	if !s.isMonitoringEnabled {
+		return
+	}
+
+	s.logger.Infof("Unregistering Histogram Metric '%v' ...", name)
+	histogram := s.GetHistogram(name)
+	prometheus.Unregister(histogram)
+	delete(s.histograms, name)
+	s.logger.Infof("Histogram Metric '%v' successfully unregisted!", name)
+}
+
 func (s *Service) GetIsMonitoringEnabled() bool {
 	return s.isMonitoringEnabled
 }
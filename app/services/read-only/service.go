@@ -55,6 +55,7 @@ func New(
 }
 
 func (s Service) FindAssetTransfer(
+	sourceChainId uint64,
 	transferID string,
 	asset string,
 	expectedTransfers []model.Hedera,
@@ -102,9 +103,9 @@ func (s Service) FindAssetTransfer(
 					}
 
 					if isSuccessful {
-						err = s.transferRepository.UpdateStatusCompleted(transferID)
+						err = s.transferRepository.UpdateStatusCompleted(sourceChainId, transferID)
 					} else {
-						err = s.transferRepository.UpdateStatusFailed(transferID)
+						err = s.transferRepository.UpdateStatusFailed(sourceChainId, transferID)
 					}
 					if err != nil {
 						s.logger.Errorf("[%s] - Failed to update status. Error: [%s]", transferID, err)
@@ -3,10 +3,15 @@ package scheduled
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -14,27 +19,68 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	initialConfirmationBackoff = 1 * time.Second
+	maxConfirmationBackoff     = 1 * time.Minute
+)
+
 type Service struct {
-	payerAccount     hedera.AccountID
-	hederaNodeClient client.HederaNode
-	mirrorNodeClient client.MirrorNode
-	logger           *log.Entry
+	payerAccount       hedera.AccountID
+	hederaNodeClient   client.HederaNode
+	mirrorNodeClient   client.MirrorNode
+	scheduleRepository repository.Schedule
+	expiryTimeout      time.Duration
+	maxResubmissions   int
+	// The maximum retry attempts for confirming through the mirror node, that it reflects the executed
+	// scheduled transaction, before it is completed. Zero disables the confirmation
+	confirmationRetries int
+	prometheusService   service.Prometheus
+	sleep               func(time.Duration)
+	logger              *log.Entry
+}
+
+// scheduledTransaction holds the submission of a scheduled transaction and the functions,
+// executed along its lifecycle, so that it can be resubmitted once its schedule expires
+type scheduledTransaction struct {
+	id                 string
+	kind               string
+	submit             func() (*hedera.TransactionResponse, error)
+	onExecutionSuccess func(transactionID, scheduleID string)
+	onExecutionFail    func(transactionID string)
+	onSuccess          func(transactionID string)
+	onFail             func(transactionID string)
+	// The asset and the transfers, which the executed transaction must reflect. Nil for non-transfer transactions
+	asset     string
+	transfers []transfer.Hedera
+	// The change of the total supply of the asset, which the executed mint (positive) or burn (negative) must reflect
+	supplyChange int64
 }
 
 func New(
 	payerAccount string,
 	hederaNodeClient client.HederaNode,
-	mirrorNodeClient client.MirrorNode) *Service {
+	mirrorNodeClient client.MirrorNode,
+	scheduleRepository repository.Schedule,
+	expiryTimeout time.Duration,
+	maxResubmissions int,
+	confirmationRetries int,
+	prometheusService service.Prometheus) *Service {
 	payer, err := hedera.AccountIDFromString(payerAccount)
 	if err != nil {
 		log.Fatalf("Invalid payer account: [%s].", payerAccount)
 	}
 
 	return &Service{
-		payerAccount:     payer,
-		hederaNodeClient: hederaNodeClient,
-		mirrorNodeClient: mirrorNodeClient,
-		logger:           config.GetLoggerFor("Scheduled Service"),
+		payerAccount:        payer,
+		hederaNodeClient:    hederaNodeClient,
+		mirrorNodeClient:    mirrorNodeClient,
+		scheduleRepository:  scheduleRepository,
+		expiryTimeout:       expiryTimeout,
+		maxResubmissions:    maxResubmissions,
+		confirmationRetries: confirmationRetries,
+		prometheusService:   prometheusService,
+		sleep:               time.Sleep,
+		logger:              config.GetLoggerFor("Scheduled Service"),
 	}
 }
 
@@ -43,60 +89,52 @@ func (s *Service) ExecuteScheduledTransferTransaction(
 	id, nativeAsset string,
 	transfers []transfer.Hedera,
 	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.executeScheduledTransfersTransaction(id, nativeAsset, transfers)
-	if err != nil {
-		if transactionResponse != nil {
-			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
-			s.logger.Errorf("[%s] - Failed to submit scheduled transfer transaction at Node Account [%s]. Error [%s].", id, transactionResponse.NodeID, err)
-		} else {
-			s.logger.Errorf("[%s] - Failed to submit scheduled transfer transaction. Error [%s].", id, err)
-		}
-		return
-	}
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
-	if err != nil {
-		s.logger.Errorf("[%s] - Failed to create/sign scheduled transfer transaction. Error [%s].", id, err)
-		return
-	}
+	s.execute(scheduledTransaction{
+		id:   id,
+		kind: "transfer",
+		submit: func() (*hedera.TransactionResponse, error) {
+			return s.executeScheduledTransfersTransaction(id, nativeAsset, transfers)
+		},
+		onExecutionSuccess: onExecutionSuccess,
+		onExecutionFail:    onExecutionFail,
+		onSuccess:          onSuccess,
+		onFail:             onFail,
+		asset:              nativeAsset,
+		transfers:          transfers,
+	}, 0)
 }
 
 // ExecuteScheduledNftTransferTransaction submits a scheduled nft transaction and executes provided functions when necessary
 func (s *Service) ExecuteScheduledNftTransferTransaction(
 	id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, approved bool,
 	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftTransferTransaction(nftID, s.payerAccount, sender, receiving, id, approved)
-	if err != nil {
-		if transactionResponse != nil {
-			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
-			s.logger.Errorf("[%s] - Failed to submit scheduled transfer transaction at Node Account [%s]. Error [%s].", id, transactionResponse.NodeID.String(), err)
-		} else {
-			s.logger.Errorf("[%s] - Failed to submit scheduled transfer transaction. Error [%s].", id, err)
-		}
-		return
-	}
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
-	if err != nil {
-		s.logger.Errorf("[%s] - Failed to create/sign scheduled transfer transaction. Error [%s].", id, err)
-		return
-	}
+	s.execute(scheduledTransaction{
+		id:   id,
+		kind: "transfer",
+		submit: func() (*hedera.TransactionResponse, error) {
+			return s.hederaNodeClient.SubmitScheduledNftTransferTransaction(nftID, s.payerAccount, sender, receiving, id, approved)
+		},
+		onExecutionSuccess: onExecutionSuccess,
+		onExecutionFail:    onExecutionFail,
+		onSuccess:          onSuccess,
+		onFail:             onFail,
+	}, 0)
 }
 
 func (s *Service) ExecuteScheduledNftAllowTransaction(
 	id string, nftID hedera.NftID, owner hedera.AccountID, spender hedera.AccountID,
 	onExecutionSuccess func(txId, scheduleId string), onExecutionFail, onSuccess, onFail func(txId string)) {
-	tx, err := s.hederaNodeClient.SubmitScheduledNftApproveTransaction(s.payerAccount, id, nftID, owner, spender)
-	if err != nil {
-		s.logger.Errorf("[%s] - Failed to submit scheduled nft approve transaction. Error [%s].", id, err)
-		if tx != nil {
-			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(tx.TransactionID.String()))
-		}
-		return
-	}
-	err = s.createOrSignScheduledTransaction(tx, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
-	if err != nil {
-		s.logger.Errorf("[%s] - Failed to create/sign scheduled nft approve transaction. Error [%s].", id, err)
-		return
-	}
+	s.execute(scheduledTransaction{
+		id:   id,
+		kind: "nft approve",
+		submit: func() (*hedera.TransactionResponse, error) {
+			return s.hederaNodeClient.SubmitScheduledNftApproveTransaction(s.payerAccount, id, nftID, owner, spender)
+		},
+		onExecutionSuccess: onExecutionSuccess,
+		onExecutionFail:    onExecutionFail,
+		onSuccess:          onSuccess,
+		onFail:             onFail,
+	}, 0)
 }
 
 func (s *Service) executeScheduledTransfersTransaction(id, nativeAsset string, transfers []transfer.Hedera) (*hedera.TransactionResponse, error) {
@@ -120,44 +158,40 @@ func (s *Service) executeScheduledTransfersTransaction(id, nativeAsset string, t
 }
 
 func (s *Service) ExecuteScheduledMintTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.executeScheduledTokenMintTransaction(id, asset, amount)
+	err := s.execute(scheduledTransaction{
+		id:   id,
+		kind: "mint",
+		submit: func() (*hedera.TransactionResponse, error) {
+			return s.executeScheduledTokenMintTransaction(id, asset, amount)
+		},
+		onExecutionSuccess: onExecutionSuccess,
+		onExecutionFail:    onExecutionFail,
+		onSuccess:          onSuccess,
+		onFail:             onFail,
+		asset:              asset,
+		supplyChange:       amount,
+	}, 0)
 	if err != nil {
-		if transactionResponse != nil {
-			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
-			s.logger.Errorf("[%s] - Failed to submit scheduled mint transaction at Node Account [%s]. Error [%s].", id, transactionResponse.NodeID.String(), err)
-		} else {
-			s.logger.Errorf("[%s] - Failed to submit scheduled mint transaction. Error [%s].", id, err)
-		}
 		*status <- sync.FAIL
-		return
-	}
-
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
-	if err != nil {
-		s.logger.Errorf("[%s] - Failed to create/sign scheduled mint transaction. Error [%s].", id, err)
-		*status <- sync.FAIL
-		return
 	}
 }
 
 func (s *Service) ExecuteScheduledBurnTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.executeScheduledTokenBurnTransaction(id, asset, amount)
+	err := s.execute(scheduledTransaction{
+		id:   id,
+		kind: "burn",
+		submit: func() (*hedera.TransactionResponse, error) {
+			return s.executeScheduledTokenBurnTransaction(id, asset, amount)
+		},
+		onExecutionSuccess: onExecutionSuccess,
+		onExecutionFail:    onExecutionFail,
+		onSuccess:          onSuccess,
+		onFail:             onFail,
+		asset:              asset,
+		supplyChange:       -amount,
+	}, 0)
 	if err != nil {
-		if transactionResponse != nil {
-			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
-			s.logger.Errorf("[%s] - Failed to submit scheduled burn transaction at Node Account [%s]. Error [%s].", id, transactionResponse.NodeID.String(), err)
-		} else {
-			s.logger.Errorf("[%s] - Failed to submit scheduled burn transaction. Error [%s].", id, err)
-		}
-		*status <- sync.FAIL
-		return
-	}
-
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
-	if err != nil {
-		s.logger.Errorf("[%s] - Failed to create/sign scheduled burn transaction. Error [%s].", id, err)
 		*status <- sync.FAIL
-		return
 	}
 }
 
@@ -195,7 +229,30 @@ func (s *Service) executeScheduledTokenBurnTransaction(id string, asset string,
 	return transactionResponse, err
 }
 
-func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.TransactionResponse, id string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) error {
+// execute submits the scheduled transaction and creates or signs its schedule.
+// resubmission is the number of times the transaction has been resubmitted after its schedule expired
+func (s *Service) execute(tx scheduledTransaction, resubmission int) error {
+	transactionResponse, err := tx.submit()
+	if err != nil {
+		if transactionResponse != nil {
+			tx.onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
+			s.logger.Errorf("[%s] - Failed to submit scheduled %s transaction at Node Account [%s]. Error [%s].", tx.id, tx.kind, transactionResponse.NodeID.String(), err)
+		} else {
+			s.logger.Errorf("[%s] - Failed to submit scheduled %s transaction. Error [%s].", tx.id, tx.kind, err)
+		}
+		return err
+	}
+
+	err = s.createOrSignScheduledTransaction(transactionResponse, tx, resubmission)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to create/sign scheduled %s transaction. Error [%s].", tx.id, tx.kind, err)
+		return err
+	}
+	return nil
+}
+
+func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.TransactionResponse, tx scheduledTransaction, resubmission int) error {
+	id := tx.id
 	scheduledTxID := hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String())
 	s.logger.Infof("[%s] - Successfully submitted scheduled transaction [%s].",
 		id,
@@ -204,7 +261,7 @@ func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.T
 	txReceipt, err := s.hederaNodeClient.TransactionReceiptQuery(transactionResponse.TransactionID, []hedera.AccountID{transactionResponse.NodeID})
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to get transaction receipt for [%s]. Error: [%s]", id, transactionResponse.TransactionID.String(), err)
-		onExecutionFail(scheduledTxID)
+		tx.onExecutionFail(scheduledTxID)
 		return err
 	}
 
@@ -216,25 +273,139 @@ func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.T
 		txID := hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String())
 		s.logger.Errorf("[%s] - TX [%s] - Scheduled Transaction resolved with [%s].", id, txID, txReceipt.Status)
 
-		onExecutionFail(txID)
+		tx.onExecutionFail(txID)
 		return fmt.Errorf("receipt-status: %s", txReceipt.Status)
 	}
 
 	transactionID := hederahelper.ToMirrorNodeTransactionID(txReceipt.ScheduledTransactionID.String())
-	onExecutionSuccess(transactionID, txReceipt.ScheduleID.String())
+	tx.onExecutionSuccess(transactionID, txReceipt.ScheduleID.String())
 
 	onMinedSuccess := func() {
-		onSuccess(transactionID)
+		if s.confirmationRetries > 0 && !s.confirmExecution(tx, transactionID) {
+			s.logger.Errorf("[%s] - Scheduled %s TX [%s] does not reflect the expected changes on the mirror node.", id, tx.kind, transactionID)
+			tx.onFail(transactionID)
+			return
+		}
+		tx.onSuccess(transactionID)
 	}
 
 	onMinedFail := func() {
-		onFail(transactionID)
+		tx.onFail(transactionID)
 	}
 
-	go s.mirrorNodeClient.WaitForScheduledTransaction(transactionID, onMinedSuccess, onMinedFail)
+	onExpired := func() {
+		s.handleExpiredSchedule(tx, transactionID, resubmission)
+	}
+
+	sync.Go(func() {
+		s.mirrorNodeClient.WaitForScheduledTransactionWithTimeout(transactionID, s.expiryTimeout, onMinedSuccess, onMinedFail, onExpired)
+	})
 	return nil
 }
 
+// confirmExecution waits for the mirror node to reflect the successful execution of the scheduled transaction,
+// together with its transfers or supply change, and returns whether it does. Both are retried with an exponential
+// backoff up to the configured confirmation retries. The executed transaction is known to the consensus nodes,
+// so if the mirror node is still missing it afterwards, it is alerted on and awaited further, leaving the transfer
+// pending instead of failing it
+func (s *Service) confirmExecution(tx scheduledTransaction, transactionID string) bool {
+	backoff := initialConfirmationBackoff
+	alerted := false
+	for attempt := 0; ; attempt++ {
+		executed, err := s.mirrorNodeClient.GetSuccessfulTransaction(transactionID)
+		if err == nil && reflectsTransfers(executed, tx.asset, tx.transfers) && reflectsSupplyChange(executed, tx.asset, tx.supplyChange) {
+			s.logger.Debugf("[%s] - Confirmed scheduled %s TX [%s] through the mirror node.", tx.id, tx.kind, transactionID)
+			return true
+		}
+		if attempt >= s.confirmationRetries {
+			if err == nil {
+				return false
+			}
+			if !alerted {
+				alerted = true
+				s.logger.Errorf("[%s] - Scheduled %s TX [%s] is still missing on the mirror node after [%d] retries. Leaving the transfer pending. Error: [%s]", tx.id, tx.kind, transactionID, s.confirmationRetries, err)
+				metrics.IncrementUnconfirmedScheduledTransactions(s.prometheusService)
+			}
+		}
+
+		if err != nil {
+			s.logger.Warnf("[%s] - Failed to confirm scheduled %s TX [%s], retrying in [%s]. Error: [%s]", tx.id, tx.kind, transactionID, backoff, err)
+		} else {
+			s.logger.Warnf("[%s] - Changes of scheduled %s TX [%s] are not yet reflected, retrying in [%s].", tx.id, tx.kind, transactionID, backoff)
+		}
+		s.sleep(backoff)
+		backoff *= 2
+		if backoff > maxConfirmationBackoff {
+			backoff = maxConfirmationBackoff
+		}
+	}
+}
+
+// reflectsTransfers returns whether the net amounts of the given asset, transferred to each account
+// by the executed transaction, match the expected transfers
+func reflectsTransfers(executed transaction.Transaction, asset string, expected []transfer.Hedera) bool {
+	transfers := executed.TokenTransfers
+	if asset == constants.Hbar {
+		transfers = executed.Transfers
+	}
+
+	for _, e := range expected {
+		var amount int64
+		for _, t := range transfers {
+			if t.Account == e.AccountID.String() && (asset == constants.Hbar || t.Token == asset) {
+				amount += t.Amount
+			}
+		}
+		if amount != e.Amount {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reflectsSupplyChange returns whether the net amount of the given token, transferred by the executed transaction,
+// matches the expected change of its total supply. Minted tokens are credited to and burned tokens are debited from
+// the treasury, so this holds regardless of the treasury account
+func reflectsSupplyChange(executed transaction.Transaction, asset string, expected int64) bool {
+	if expected == 0 {
+		return true
+	}
+
+	var amount int64
+	for _, t := range executed.TokenTransfers {
+		if t.Token == asset {
+			amount += t.Amount
+		}
+	}
+
+	return amount == expected
+}
+
+// handleExpiredSchedule resubmits the scheduled transaction, which has not been executed before its schedule expired.
+// Once the resubmissions are exhausted or the resubmission itself fails, the scheduled transaction is failed.
+func (s *Service) handleExpiredSchedule(tx scheduledTransaction, transactionID string, resubmission int) {
+	if resubmission >= s.maxResubmissions {
+		s.logger.Errorf("[%s] - Scheduled %s TX [%s] was not executed after [%d] resubmissions.", tx.id, tx.kind, transactionID, resubmission)
+		tx.onFail(transactionID)
+		return
+	}
+
+	s.logger.Warnf("[%s] - Scheduled %s TX [%s] expired without being executed. Resubmitting [%d/%d].", tx.id, tx.kind, transactionID, resubmission+1, s.maxResubmissions)
+	err := s.scheduleRepository.UpdateStatusExpired(transactionID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update schedule [%s] status expired. Error [%s].", tx.id, transactionID, err)
+	}
+
+	// The failure of the resubmission is terminal and is reported against the expired scheduled transaction
+	resubmitted := tx
+	resubmitted.onExecutionFail = func(string) {}
+	err = s.execute(resubmitted, resubmission+1)
+	if err != nil {
+		tx.onFail(transactionID)
+	}
+}
+
 func (s *Service) handleScheduleSign(id string, scheduleID hedera.ScheduleID) {
 	s.logger.Debugf("[%s] - Scheduled transaction already created - Executing Scheduled Sign for [%s].", id, scheduleID)
 	txResponse, err := s.hederaNodeClient.SubmitScheduleSign(scheduleID)
@@ -0,0 +1,298 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduled
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	id            = "0.0.123-1-1"
+	payerAccount  = hedera.AccountID{Account: 1}
+	nodeAccount   = hedera.AccountID{Account: 3}
+	tokenID       = hedera.TokenID{Token: 2}
+	amount        = int64(100)
+	expiryTimeout = 30 * time.Minute
+)
+
+func setup(maxResubmissions int) *Service {
+	mocks.Setup()
+	return New(payerAccount.String(), mocks.MHederaNodeClient, mocks.MHederaMirrorClient, mocks.MScheduleRepository, expiryTimeout, maxResubmissions, 0, mocks.MPrometheusService)
+}
+
+// setupWithConfirmation sets up the service to confirm the executed scheduled transactions through the mirror node
+func setupWithConfirmation(confirmationRetries int) *Service {
+	s := setup(0)
+	s.confirmationRetries = confirmationRetries
+	s.sleep = func(time.Duration) {}
+	return s
+}
+
+// mockSubmission mocks a single successful submission of the mint schedule and returns its scheduled transaction ID
+func mockSubmission(submission int64) string {
+	transactionID := hedera.NewTransactionIDWithValidStart(payerAccount, time.Unix(submission, 0))
+	scheduledTransactionID := transactionID.SetScheduled(true)
+	scheduleID := hedera.ScheduleID{Schedule: uint64(submission)}
+
+	mocks.MHederaNodeClient.On("SubmitScheduledTokenMintTransaction", tokenID, amount, payerAccount, id).
+		Return(&hedera.TransactionResponse{TransactionID: transactionID, NodeID: nodeAccount}, nil).Once()
+	mocks.MHederaNodeClient.On("TransactionReceiptQuery", transactionID, []hedera.AccountID{nodeAccount}).
+		Return(hedera.TransactionReceipt{
+			Status:                 hedera.StatusSuccess,
+			ScheduleID:             &scheduleID,
+			ScheduledTransactionID: &scheduledTransactionID,
+		}, nil)
+
+	return hederahelper.ToMirrorNodeTransactionID(scheduledTransactionID.String())
+}
+
+// mockWait resolves the wait for the given scheduled transaction with the callback at the given argument index
+func mockWait(transactionID string, callback int) {
+	mocks.MHederaMirrorClient.On("WaitForScheduledTransactionWithTimeout", transactionID, expiryTimeout, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(callback).(func())()
+		})
+}
+
+const (
+	onMinedSuccess = 2
+	onExpired      = 4
+)
+
+// execute executes the scheduled mint and returns the transaction ID, passed to the terminal callback, and whether it succeeded
+func execute(t *testing.T, s *Service, executed *[]string) (string, bool) {
+	status := make(chan string, 1)
+	result := make(chan string, 1)
+	onExecutionSuccess := func(transactionID, scheduleID string) {
+		*executed = append(*executed, transactionID)
+	}
+	onExecutionFail := func(transactionID string) {
+		t.Fatalf("unexpected execution failure of [%s]", transactionID)
+	}
+	onSuccess := func(transactionID string) {
+		status <- sync.DONE
+		result <- transactionID
+	}
+	onFail := func(transactionID string) {
+		status <- sync.FAIL
+		result <- transactionID
+	}
+
+	s.ExecuteScheduledMintTransaction(id, tokenID.String(), amount, &status, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+
+	select {
+	case transactionID := <-result:
+		return transactionID, <-status == sync.DONE
+	case <-time.After(time.Second):
+		t.Fatal("scheduled transaction was not resolved")
+		return "", false
+	}
+}
+
+func Test_ExecuteScheduledMintTransaction_Executed(t *testing.T) {
+	s := setup(2)
+	transactionID := mockSubmission(1)
+	mockWait(transactionID, onMinedSuccess)
+
+	var executed []string
+	resolved, success := execute(t, s, &executed)
+
+	assert.True(t, success)
+	assert.Equal(t, transactionID, resolved)
+	assert.Equal(t, []string{transactionID}, executed)
+	mocks.MScheduleRepository.AssertNotCalled(t, "UpdateStatusExpired", mock.Anything)
+}
+
+func Test_ExecuteScheduledMintTransaction_ExpiredThenResubmitted(t *testing.T) {
+	s := setup(2)
+	expiredID := mockSubmission(1)
+	mockWait(expiredID, onExpired)
+	resubmittedID := mockSubmission(2)
+	mockWait(resubmittedID, onMinedSuccess)
+	mocks.MScheduleRepository.On("UpdateStatusExpired", expiredID).Return(nil)
+
+	var executed []string
+	resolved, success := execute(t, s, &executed)
+
+	assert.True(t, success)
+	assert.Equal(t, resubmittedID, resolved)
+	assert.Equal(t, []string{expiredID, resubmittedID}, executed)
+	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusExpired", expiredID)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitScheduledTokenMintTransaction", 2)
+}
+
+func Test_ExecuteScheduledMintTransaction_PermanentlyExpired(t *testing.T) {
+	s := setup(1)
+	expiredID := mockSubmission(1)
+	mockWait(expiredID, onExpired)
+	resubmittedID := mockSubmission(2)
+	mockWait(resubmittedID, onExpired)
+	mocks.MScheduleRepository.On("UpdateStatusExpired", expiredID).Return(nil)
+
+	var executed []string
+	resolved, success := execute(t, s, &executed)
+
+	assert.False(t, success)
+	assert.Equal(t, resubmittedID, resolved)
+	assert.Equal(t, []string{expiredID, resubmittedID}, executed)
+	mocks.MScheduleRepository.AssertNotCalled(t, "UpdateStatusExpired", resubmittedID)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "SubmitScheduledTokenMintTransaction", 2)
+}
+
+func Test_ExecuteScheduledMintTransaction_ResubmissionFails(t *testing.T) {
+	s := setup(2)
+	expiredID := mockSubmission(1)
+	mockWait(expiredID, onExpired)
+	mocks.MHederaNodeClient.On("SubmitScheduledTokenMintTransaction", tokenID, amount, payerAccount, id).
+		Return(&hedera.TransactionResponse{TransactionID: hedera.NewTransactionIDWithValidStart(payerAccount, time.Unix(2, 0)), NodeID: nodeAccount}, errors.New("some-error")).Once()
+	mocks.MScheduleRepository.On("UpdateStatusExpired", expiredID).Return(nil)
+
+	var executed []string
+	resolved, success := execute(t, s, &executed)
+
+	assert.False(t, success)
+	assert.Equal(t, expiredID, resolved)
+	assert.Equal(t, []string{expiredID}, executed)
+}
+
+func Test_ExecuteScheduledMintTransaction_MirrorConfirmed(t *testing.T) {
+	s := setupWithConfirmation(2)
+	transactionID := mockSubmission(1)
+	mockWait(transactionID, onMinedSuccess)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", transactionID).Return(transaction.Transaction{}, errors.New("not found")).Once()
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", transactionID).Return(minted(amount), nil)
+
+	var executed []string
+	resolved, success := execute(t, s, &executed)
+
+	assert.True(t, success)
+	assert.Equal(t, transactionID, resolved)
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetSuccessfulTransaction", 2)
+}
+
+func Test_ExecuteScheduledMintTransaction_MirrorLagging_LeftPendingAndAlerted(t *testing.T) {
+	s := setupWithConfirmation(2)
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: constants.UnconfirmedScheduledTransactionsCounterName})
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	mocks.MPrometheusService.On("CreateCounterIfNotExists", mock.Anything).Return(counter)
+	transactionID := mockSubmission(1)
+	mockWait(transactionID, onMinedSuccess)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", transactionID).Return(transaction.Transaction{}, errors.New("not found")).Times(5)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", transactionID).Return(minted(amount), nil)
+
+	var executed []string
+	resolved, success := execute(t, s, &executed)
+
+	assert.True(t, success)
+	assert.Equal(t, transactionID, resolved)
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter))
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetSuccessfulTransaction", 6)
+}
+
+func Test_ExecuteScheduledMintTransaction_MirrorMissingMint(t *testing.T) {
+	s := setupWithConfirmation(2)
+	transactionID := mockSubmission(1)
+	mockWait(transactionID, onMinedSuccess)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", transactionID).Return(minted(amount-1), nil)
+
+	var executed []string
+	resolved, success := execute(t, s, &executed)
+
+	assert.False(t, success)
+	assert.Equal(t, transactionID, resolved)
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetSuccessfulTransaction", 3)
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateCounterIfNotExists", mock.Anything)
+}
+
+// minted returns the mirror node transaction, minting the given amount of the token to the treasury
+func minted(amount int64) transaction.Transaction {
+	return transaction.Transaction{TokenTransfers: []transaction.Transfer{{Account: payerAccount.String(), Amount: amount, Token: tokenID.String()}}}
+}
+
+func Test_ExecuteScheduledTransferTransaction_MirrorReflectsTransfers(t *testing.T) {
+	s := setupWithConfirmation(2)
+	receiver := hedera.AccountID{Account: 4}
+	transfers := []transfer.Hedera{{AccountID: receiver, Amount: amount}, {AccountID: payerAccount, Amount: -amount}}
+	transactionID := hedera.NewTransactionIDWithValidStart(payerAccount, time.Unix(1, 0))
+	scheduledTransactionID := transactionID.SetScheduled(true)
+	mirrorTransactionID := hederahelper.ToMirrorNodeTransactionID(scheduledTransactionID.String())
+	mocks.MHederaNodeClient.On("SubmitScheduledTokenTransferTransaction", tokenID, transfers, payerAccount, id).
+		Return(&hedera.TransactionResponse{TransactionID: transactionID, NodeID: nodeAccount}, nil)
+	mocks.MHederaNodeClient.On("TransactionReceiptQuery", transactionID, []hedera.AccountID{nodeAccount}).
+		Return(hedera.TransactionReceipt{Status: hedera.StatusSuccess, ScheduleID: &hedera.ScheduleID{Schedule: 1}, ScheduledTransactionID: &scheduledTransactionID}, nil)
+	mockWait(mirrorTransactionID, onMinedSuccess)
+	lagging := transaction.Transaction{TokenTransfers: []transaction.Transfer{{Account: payerAccount.String(), Amount: -amount, Token: tokenID.String()}}}
+	reflected := transaction.Transaction{TokenTransfers: []transaction.Transfer{
+		{Account: receiver.String(), Amount: amount, Token: tokenID.String()},
+		{Account: payerAccount.String(), Amount: -amount, Token: tokenID.String()},
+	}}
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", mirrorTransactionID).Return(lagging, nil).Once()
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", mirrorTransactionID).Return(reflected, nil)
+
+	result := make(chan bool, 1)
+	s.ExecuteScheduledTransferTransaction(id, tokenID.String(), transfers,
+		func(transactionID, scheduleID string) {},
+		func(transactionID string) { t.Fatalf("unexpected execution failure of [%s]", transactionID) },
+		func(transactionID string) { result <- true },
+		func(transactionID string) { result <- false })
+
+	select {
+	case success := <-result:
+		assert.True(t, success)
+		mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetSuccessfulTransaction", 2)
+	case <-time.After(time.Second):
+		t.Fatal("scheduled transaction was not resolved")
+	}
+}
+
+func Test_reflectsTransfers(t *testing.T) {
+	receiver := hedera.AccountID{Account: 4}
+	expected := []transfer.Hedera{{AccountID: receiver, Amount: amount}}
+	hbar := transaction.Transaction{Transfers: []transaction.Transfer{{Account: receiver.String(), Amount: amount}}}
+	token := transaction.Transaction{TokenTransfers: []transaction.Transfer{{Account: receiver.String(), Amount: amount, Token: tokenID.String()}}}
+
+	assert.True(t, reflectsTransfers(hbar, constants.Hbar, expected))
+	assert.False(t, reflectsTransfers(hbar, tokenID.String(), expected))
+	assert.True(t, reflectsTransfers(token, tokenID.String(), expected))
+	assert.False(t, reflectsTransfers(token, "0.0.5", expected))
+	assert.True(t, reflectsTransfers(transaction.Transaction{}, tokenID.String(), nil))
+}
+
+func Test_reflectsSupplyChange(t *testing.T) {
+	burned := transaction.Transaction{TokenTransfers: []transaction.Transfer{{Account: payerAccount.String(), Amount: -amount, Token: tokenID.String()}}}
+
+	assert.True(t, reflectsSupplyChange(minted(amount), tokenID.String(), amount))
+	assert.False(t, reflectsSupplyChange(minted(amount), tokenID.String(), -amount))
+	assert.False(t, reflectsSupplyChange(minted(amount), "0.0.5", amount))
+	assert.True(t, reflectsSupplyChange(burned, tokenID.String(), -amount))
+	assert.False(t, reflectsSupplyChange(transaction.Transaction{}, tokenID.String(), -amount))
+	assert.True(t, reflectsSupplyChange(transaction.Transaction{}, tokenID.String(), 0))
+}
@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
@@ -32,9 +33,11 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/events"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/memo"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/shard"
 	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -47,6 +50,11 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	initialSubmissionBackoff = 1 * time.Second
+	maxSubmissionBackoff     = 1 * time.Minute
+)
+
 type Service struct {
 	logger             *log.Entry
 	hederaNode         client.HederaNode
@@ -61,8 +69,13 @@ type Service struct {
 	messageService     service.Messages
 	prometheusService  service.Prometheus
 	assetsService      service.Assets
-	topicID            hedera.TopicID
+	topicIDs           []hedera.TopicID
 	bridgeAccountID    hedera.AccountID
+	// The maximum retry attempts for submitting a signature message to the topic
+	submissionRetries int
+	sleep             func(time.Duration)
+	// The shard of receivers, whose fee transfers are submitted by the node
+	shard config.Shard
 }
 
 func NewService(
@@ -74,16 +87,18 @@ func NewService(
 	feeRepository repository.Fee,
 	feeService service.Fee,
 	distributor service.Distributor,
-	topicID string,
+	topicIDs []string,
 	bridgeAccount string,
 	scheduledService service.Scheduled,
 	messageService service.Messages,
 	prometheusService service.Prometheus,
 	assetsService service.Assets,
+	submissionRetries int,
+	shard config.Shard,
 ) *Service {
-	tID, e := hedera.TopicIDFromString(topicID)
-	if e != nil {
-		log.Fatalf("Invalid monitoring Topic ID [%s] - Error: [%s]", topicID, e)
+	tIDs, e := hederaHelper.TopicIDsFromStrings(topicIDs)
+	if e != nil || len(tIDs) == 0 {
+		log.Fatalf("Invalid monitoring Topic IDs [%v] - Error: [%v]", topicIDs, e)
 	}
 	bridgeAccountID, e := hedera.AccountIDFromString(bridgeAccount)
 	if e != nil {
@@ -98,7 +113,7 @@ func NewService(
 		transferRepository: transferRepository,
 		scheduleRepository: scheduleRepository,
 		feeRepository:      feeRepository,
-		topicID:            tID,
+		topicIDs:           tIDs,
 		feeService:         feeService,
 		distributor:        distributor,
 		bridgeAccountID:    bridgeAccountID,
@@ -106,6 +121,9 @@ func NewService(
 		messageService:     messageService,
 		prometheusService:  prometheusService,
 		assetsService:      assetsService,
+		submissionRetries:  submissionRetries,
+		sleep:              time.Sleep,
+		shard:              shard,
 	}
 
 	return instance
@@ -139,7 +157,7 @@ func (ts *Service) SanityCheckTransfer(tx mirrorNodeTransaction.Transaction) mod
 
 // InitiateNewTransfer Stores the incoming transfer message into the Database aware of already processed transfers
 func (ts *Service) InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, error) {
-	dbTransaction, err := ts.transferRepository.GetByTransactionId(tm.TransactionId)
+	dbTransaction, err := ts.transferRepository.GetByTransactionId(tm.SourceChainId, tm.TransactionId)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to get db record. Error [%s]", tm.TransactionId, err)
 		return nil, err
@@ -159,31 +177,31 @@ func (ts *Service) InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, e
 	return tx, nil
 }
 
-func (ts *Service) authMessageSubmissionCallbacks(txId string) (onSuccess, onRevert func()) {
+func (ts *Service) authMessageSubmissionCallbacks(sourceChainId uint64, txId string) (onSuccess, onRevert func()) {
 	onSuccess = func() {
 		ts.logger.Debugf("Authorisation Signature TX successfully executed for TX [%s]", txId)
 	}
 
 	onRevert = func() {
 		ts.logger.Debugf("Authorisation Signature TX failed for TX ID [%s]", txId)
+		ts.updateSignatureMsgStatus(sourceChainId, txId, status.SignatureFailed)
 	}
 	return onSuccess, onRevert
 }
 
 func (ts *Service) ProcessNativeTransfer(tm payload.Transfer) error {
-	intAmount, err := strconv.ParseInt(tm.Amount, 10, 64)
-	if err != nil {
-		ts.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", tm.TransactionId, err)
+	if err := ts.messageService.CheckMembership(tm.TransactionId, tm.TargetChainId); err != nil {
 		return err
 	}
 
-	fee, remainder := ts.feeService.CalculateFee(tm.NativeAsset, intAmount)
-	validFee := ts.distributor.ValidAmount(fee)
-	if validFee != fee {
-		remainder += fee - validFee
+	validFee, remainder, err := ts.splitNativeAmount(tm.TransactionId, tm.NativeAsset, tm.Amount)
+	if err != nil {
+		return err
 	}
 
-	go ts.processFeeTransfer(validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset)
+	syncHelper.Go(func() {
+		ts.processFeeTransfer(validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset, ts.ownsReceiver(tm))
+	})
 
 	wrappedAmount := strconv.FormatInt(remainder, 10)
 
@@ -193,10 +211,32 @@ func (ts *Service) ProcessNativeTransfer(tm payload.Transfer) error {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(tm.SourceChainId, tm.TransactionId, signatureMessage)
+}
+
+// splitNativeAmount splits the amount of a Hedera-native fungible transfer into the fee, distributed
+// to the validators, and the remainder, which is signed for the receiver
+func (ts *Service) splitNativeAmount(transferID, nativeAsset, amount string) (validFee, remainder int64, err error) {
+	intAmount, err := strconv.ParseInt(amount, 10, 64)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferID, err)
+		return 0, 0, err
+	}
+
+	fee, remainder := ts.feeService.CalculateFee(nativeAsset, intAmount)
+	validFee = ts.distributor.ValidAmount(fee)
+	if validFee != fee {
+		remainder += fee - validFee
+	}
+
+	return validFee, remainder, nil
 }
 
 func (ts *Service) ProcessNativeNftTransfer(tm payload.Transfer) error {
+	if err := ts.messageService.CheckMembership(tm.TransactionId, tm.TargetChainId); err != nil {
+		return err
+	}
+
 	ts.logger.Infof("[%s] - Sending NFT to bridge account.", tm.TransactionId)
 	status, wg, err := ts.transferNftToBridgeAccount(tm)
 	if err != nil {
@@ -212,22 +252,24 @@ func (ts *Service) ProcessNativeNftTransfer(tm payload.Transfer) error {
 	}
 
 	feePerValidator := ts.distributor.ValidAmount(tm.Fee)
-	go ts.processFeeTransfer(feePerValidator, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar)
+	syncHelper.Go(func() {
+		ts.processFeeTransfer(feePerValidator, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar, ts.ownsReceiver(tm))
+	})
 
 	signatureMessage, err := ts.messageService.SignNftMessage(tm)
 	if err != nil {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(tm.SourceChainId, tm.TransactionId, signatureMessage)
 }
 
 func (ts *Service) transferNftToBridgeAccount(tm payload.Transfer) (status *string, wg *sync.WaitGroup, err error) {
 	status = new(string)
 	wg = new(sync.WaitGroup)
 	wg.Add(1)
-	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(ts.transferRepository, ts.scheduleRepository, ts.logger, tm.TransactionId, true, status, schedule.TRANSFER, wg)
-	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(ts.transferRepository, ts.scheduleRepository, ts.logger, tm.TransactionId, status, wg)
+	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(ts.transferRepository, ts.scheduleRepository, ts.logger, tm.SourceChainId, tm.TransactionId, true, status, schedule.TRANSFER, wg)
+	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(ts.transferRepository, ts.scheduleRepository, ts.logger, tm.SourceChainId, tm.TransactionId, status, wg)
 
 	token, err := hedera.TokenIDFromString(tm.SourceAsset)
 	if err != nil {
@@ -251,6 +293,10 @@ func (ts *Service) transferNftToBridgeAccount(tm payload.Transfer) (status *stri
 }
 
 func (ts *Service) ProcessWrappedTransfer(tm payload.Transfer) error {
+	if err := ts.messageService.CheckMembership(tm.TransactionId, tm.TargetChainId); err != nil {
+		return err
+	}
+
 	amount, err := big_numbers.ToBigInt(tm.Amount)
 	if err != nil {
 		return err
@@ -267,13 +313,13 @@ func (ts *Service) ProcessWrappedTransfer(tm payload.Transfer) error {
 	}
 
 	// Convert the amount to the initial, so that the correct amount is being burned.
-	targetAmount := decimal.TargetAmount(targetAssetInfo.Decimals, sourceAssetInfo.Decimals, amount)
-	if targetAmount.Cmp(big.NewInt(0)) == 0 {
-		return fmt.Errorf("Insufficient amount provided: Amount [%s] and Target Amount [%s].", amount, targetAmount)
+	targetAmount, err := decimal.AdjustDecimals(amount, targetAssetInfo.Decimals, sourceAssetInfo.Decimals)
+	if err != nil {
+		return fmt.Errorf("Insufficient amount provided: Amount [%s]. Error [%s].", amount, err)
 	}
 
 	status := make(chan string)
-	onExecutionBurnSuccess, onExecutionBurnFail := ts.scheduledBurnTxExecutionCallbacks(tm.TransactionId, &status)
+	onExecutionBurnSuccess, onExecutionBurnFail := ts.scheduledBurnTxExecutionCallbacks(tm.SourceChainId, tm.TransactionId, &status)
 	onTokenBurnSuccess, onTokenBurnFail := ts.scheduledBurnTxMinedCallbacks(&status)
 	ts.scheduledService.ExecuteScheduledBurnTransaction(tm.TransactionId, tm.SourceAsset, targetAmount.Int64(), &status, onExecutionBurnSuccess, onExecutionBurnFail, onTokenBurnSuccess, onTokenBurnFail)
 
@@ -294,13 +340,13 @@ statusBlocker:
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(tm.SourceChainId, tm.TransactionId, signatureMessage)
 }
 
 // TransferData returns from the database the given transfer, its signatures and
 // calculates if its messages have reached super majority
-func (ts *Service) TransferData(txId string) (interface{}, error) {
-	t, err := ts.transferRepository.GetWithPreloads(txId)
+func (ts *Service) TransferData(sourceChainId uint64, txId string) (interface{}, error) {
+	t, err := ts.transferRepository.GetWithPreloads(sourceChainId, txId)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to query Transfer with messages. Error: [%s].", txId, err)
 		return nil, err
@@ -346,20 +392,10 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 	transferData.Majority = reachedMajority
 
 	if !t.IsNft {
-		signedAmount := t.Amount
-		if t.NativeChainID == constants.HederaNetworkId {
-			amount, err := strconv.ParseInt(t.Amount, 10, 64)
-			if err != nil {
-				ts.logger.Errorf("[%s] - Failed to parse transfer amount. Error [%s]", t.TransactionID, err)
-				return nil, err
-			}
-
-			feeAmount, err := strconv.ParseInt(t.Fee, 10, 64)
-			if err != nil {
-				ts.logger.Errorf("[%s] - Failed to parse fee amount. Error [%s]", t.TransactionID, err)
-				return nil, err
-			}
-			signedAmount = strconv.FormatInt(amount-feeAmount, 10)
+		signedAmount, err := t.SignedAmount()
+		if err != nil {
+			ts.logger.Errorf("[%s] - Failed to subtract the fee from the transfer amount. Error [%s]", t.TransactionID, err)
+			return nil, err
 		}
 		return service.FungibleTransferData{
 			TransferData: transferData,
@@ -392,23 +428,117 @@ func (ts *Service) Paged(req *model.PagedRequest) (*model.Paged, error) {
 	}, nil
 }
 
-func (ts *Service) submitTopicMessageAndWaitForTransaction(transferID string, signatureMessageBytes []byte) error {
-	messageTxId, err := ts.hederaNode.SubmitTopicConsensusMessage(
-		ts.topicID,
-		signatureMessageBytes)
+// ResumePendingSubmissions re-submits the signatures of the Hedera-originated transfers, which were not
+// successfully submitted to the topic before the last shutdown of the node. Their fee, NFT and burn
+// transactions are not executed again, since the signature submission starts only after them
+func (ts *Service) ResumePendingSubmissions() {
+	transfers, err := ts.transferRepository.GetPendingSignatureSubmissions()
+	if err != nil {
+		ts.logger.Errorf("Failed to get pending signature submissions. Error: [%s]", err)
+		return
+	}
+
+	for _, t := range transfers {
+		if t.SourceChainID != constants.HederaNetworkId {
+			continue
+		}
+
+		ts.logger.Infof("[%s] - Resuming signature submission with status [%s].", t.TransactionID, t.SignatureMsgStatus)
+		err = ts.resumeSubmission(t)
+		if err != nil {
+			ts.logger.Errorf("[%s] - Resuming signature submission failed. Error: [%s]", t.TransactionID, err)
+		}
+	}
+}
+
+// resumeSubmission signs the given transfer the same way as its Process function and submits the signature
+func (ts *Service) resumeSubmission(t *entity.Transfer) error {
+	var (
+		signatureMessage []byte
+		err              error
+	)
+	if t.IsNft {
+		tm := payload.NewNft(t.TransactionID, t.SourceChainID, t.TargetChainID, t.NativeChainID, t.Receiver, t.SourceAsset, t.TargetAsset, t.NativeAsset, t.SerialNumber, t.Metadata, 0)
+		signatureMessage, err = ts.messageService.SignNftMessage(*tm)
+	} else {
+		tm := payload.New(t.TransactionID, t.SourceChainID, t.TargetChainID, t.NativeChainID, t.Receiver, t.SourceAsset, t.TargetAsset, t.NativeAsset, t.Amount)
+		if t.NativeChainID == constants.HederaNetworkId {
+			_, remainder, err := ts.splitNativeAmount(t.TransactionID, t.NativeAsset, t.Amount)
+			if err != nil {
+				return err
+			}
+			tm.Amount = strconv.FormatInt(remainder, 10)
+		}
+		signatureMessage, err = ts.messageService.SignFungibleMessage(*tm)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ts.submitTopicMessageAndWaitForTransaction(t.SourceChainID, t.TransactionID, signatureMessage)
+}
+
+func (ts *Service) submitTopicMessageAndWaitForTransaction(sourceChainId uint64, transferID string, signatureMessageBytes []byte) error {
+	topicID := hederaHelper.SignatureTopic(transferID, ts.topicIDs)
+	ts.updateSignatureMsgStatus(sourceChainId, transferID, status.SignaturePending)
+	messageTxId, err := ts.submitWithRetry(transferID, topicID, signatureMessageBytes)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to submit Signature Message to Topic. Error: [%s]", transferID, err)
+		ts.updateSignatureMsgStatus(sourceChainId, transferID, status.SignatureFailed)
 		return err
 	}
+	ts.updateSignatureMsgStatus(sourceChainId, transferID, status.SignatureSubmitted)
 
 	// Attach update callbacks on Signature HCS Message
-	ts.logger.Infof("[%s] - Submitted signature on Topic [%s]", transferID, ts.topicID)
-	onSuccessfulAuthMessage, onFailedAuthMessage := ts.authMessageSubmissionCallbacks(transferID)
+	ts.logger.Infof("[%s] - Submitted signature on Topic [%s]", transferID, topicID)
+	events.FireTransferEvent(constants.EventTransferSubmitted, sourceChainId, transferID, "")
+	onSuccessfulAuthMessage, onFailedAuthMessage := ts.authMessageSubmissionCallbacks(sourceChainId, transferID)
 	ts.mirrorNode.WaitForTransaction(hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
-func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChainId uint64, transferID string, nativeAsset string) {
+// submitWithRetry submits the signature message to the topic, retrying with an exponential backoff
+// up to the configured maximum retries
+func (ts *Service) submitWithRetry(transferID string, topicID hedera.TopicID, message []byte) (*hedera.TransactionID, error) {
+	backoff := initialSubmissionBackoff
+	for attempt := 0; ; attempt++ {
+		messageTxId, err := ts.hederaNode.SubmitTopicConsensusMessage(topicID, message)
+		if err == nil {
+			return messageTxId, nil
+		}
+		if attempt >= ts.submissionRetries {
+			return nil, err
+		}
+
+		ts.logger.Warnf("[%s] - Failed to submit Signature Message to Topic, retrying in [%s]. Error: [%s]", transferID, backoff, err)
+		ts.sleep(backoff)
+		backoff *= 2
+		if backoff > maxSubmissionBackoff {
+			backoff = maxSubmissionBackoff
+		}
+	}
+}
+
+func (ts *Service) updateSignatureMsgStatus(sourceChainId uint64, transferID, s string) {
+	err := ts.transferRepository.UpdateSignatureMsgStatus(sourceChainId, transferID, s)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to update Signature Message status to [%s]. Error: [%s]", transferID, s, err)
+	}
+}
+
+// ownsReceiver checks whether the receiver of a transfer falls in the shard of the node. The fee transfers of other
+// shards are submitted by the other instances of the validator. Signatures are never sharded
+func (ts *Service) ownsReceiver(tm payload.Transfer) bool {
+	if shard.Owns(tm.Receiver, ts.shard.Index, ts.shard.Count) {
+		return true
+	}
+
+	ts.logger.Debugf("[%s] - Receiver [%s] is outside of shard [%d/%d]. Leaving the fee transfer to its instance.", tm.TransactionId, tm.Receiver, ts.shard.Index, ts.shard.Count)
+	return false
+}
+
+// processFeeTransfer records the fee of the transfer and, if submit is set, distributes it to the validators
+func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChainId uint64, transferID string, nativeAsset string, submit bool) {
 
 	transfers, err := ts.distributor.CalculateMemberDistribution(totalFee)
 	if err != nil {
@@ -421,12 +551,16 @@ func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChain
 		Amount:    -totalFee,
 	})
 
-	err = ts.transferRepository.UpdateFee(transferID, strconv.FormatInt(totalFee, 10))
+	err = ts.transferRepository.UpdateFee(sourceChainId, transferID, strconv.FormatInt(totalFee, 10))
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to update fee [%d]. Error [%s].", transferID, totalFee, err)
 		return
 	}
 
+	if !submit {
+		return
+	}
+
 	var (
 		feeOutParams *hederaHelper.FeeOutParams
 	)
@@ -437,21 +571,23 @@ func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChain
 
 	for _, splitTransfer := range splitTransfers {
 		fee := -splitTransfer[len(splitTransfer)-1].Amount
-		onExecutionSuccess, onExecutionFail := ts.scheduledFeeTxExecutionCallbacks(transferID, strconv.FormatInt(fee, 10))
+		onExecutionSuccess, onExecutionFail := ts.scheduledFeeTxExecutionCallbacks(sourceChainId, transferID, strconv.FormatInt(fee, 10))
 		onSuccess, onFail := ts.scheduledFeeTxMinedCallbacks(feeOutParams, splitTransfer)
 
 		ts.scheduledService.ExecuteScheduledTransferTransaction(transferID, nativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	}
 
 	if ts.prometheusService.GetIsMonitoringEnabled() {
-		go hederaHelper.AwaitMultipleScheduledTransactions(
-			feeOutParams.OutParams,
-			sourceChainId,
-			targetChainId,
-			nativeAsset,
-			transferID,
-			ts.onMinedFeeTransactionsSetMetrics,
-		)
+		syncHelper.Go(func() {
+			hederaHelper.AwaitMultipleScheduledTransactions(
+				feeOutParams.OutParams,
+				sourceChainId,
+				targetChainId,
+				nativeAsset,
+				transferID,
+				ts.onMinedFeeTransactionsSetMetrics,
+			)
+		})
 	}
 }
 
@@ -463,7 +599,7 @@ func (ts *Service) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainId
 	metrics.SetFeeTransferred(sourceChainId, targetChainId, nativeAsset, transferID, ts.prometheusService, ts.logger)
 }
 
-func (ts *Service) scheduledBurnTxExecutionCallbacks(transferID string, blocker *chan string) (onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail func(transactionID string)) {
+func (ts *Service) scheduledBurnTxExecutionCallbacks(sourceChainId uint64, transferID string, blocker *chan string) (onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail func(transactionID string)) {
 	onExecutionSuccess = func(transactionID, scheduleID string) {
 		ts.logger.Debugf("[%s] - Updating db status to Submitted with TransactionID [%s].",
 			transferID,
@@ -477,6 +613,7 @@ func (ts *Service) scheduledBurnTxExecutionCallbacks(transferID string, blocker
 				String: transferID,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			*blocker <- syncHelper.FAIL
@@ -497,6 +634,7 @@ func (ts *Service) scheduledBurnTxExecutionCallbacks(transferID string, blocker
 				String: transferID,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			ts.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", transferID, err)
@@ -542,7 +680,7 @@ func (ts *Service) scheduledBurnTxMinedCallbacks(status *chan string) (onSuccess
 	return onSuccess, onFail
 }
 
-func (ts *Service) scheduledFeeTxExecutionCallbacks(transferID, feeAmount string) (onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail func(transactionID string)) {
+func (ts *Service) scheduledFeeTxExecutionCallbacks(sourceChainId uint64, transferID, feeAmount string) (onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail func(transactionID string)) {
 	onExecutionSuccess = func(transactionID, scheduleID string) {
 		err := ts.scheduleRepository.Create(&entity.Schedule{
 			TransactionID: transactionID,
@@ -553,6 +691,7 @@ func (ts *Service) scheduledFeeTxExecutionCallbacks(transferID, feeAmount string
 				String: transferID,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			ts.logger.Errorf(
@@ -569,6 +708,7 @@ func (ts *Service) scheduledFeeTxExecutionCallbacks(transferID, feeAmount string
 				String: transferID,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			ts.logger.Errorf(
@@ -587,6 +727,7 @@ func (ts *Service) scheduledFeeTxExecutionCallbacks(transferID, feeAmount string
 				String: transferID,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			ts.logger.Errorf(
@@ -602,6 +743,7 @@ func (ts *Service) scheduledFeeTxExecutionCallbacks(transferID, feeAmount string
 				String: transferID,
 				Valid:  true,
 			},
+			TransferSourceChainID: sourceChainId,
 		})
 		if err != nil {
 			ts.logger.Errorf("[%s] Fee - Failed to create failed record. Error [%s].", transferID, err)
@@ -656,6 +798,20 @@ func (ts *Service) scheduledFeeTxMinedCallbacks(feeOutParams *hederaHelper.FeeOu
 	return onSuccess, onFail
 }
 
-func (ts *Service) UpdateTransferStatusCompleted(transferID string) error {
-	return ts.transferRepository.UpdateStatusCompleted(transferID)
+func (ts *Service) UpdateTransferStatusCompleted(sourceChainId uint64, transferID string) error {
+	return ts.transferRepository.UpdateStatusCompleted(sourceChainId, transferID)
+}
+
+// SourceChain returns the source chain of the transfer with the given ID, failing if
+// the ID is not unique across the source chains
+func (ts *Service) SourceChain(txId string) (uint64, error) {
+	t, err := ts.transferRepository.GetByTransactionIdOnAnyChain(txId)
+	if err != nil {
+		return 0, err
+	}
+	if t == nil {
+		return 0, service.ErrNotFound
+	}
+
+	return t.SourceChainID, nil
 }
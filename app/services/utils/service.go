@@ -81,7 +81,7 @@ finish:
 		return nil, service.ErrNotFound
 	}
 
-	hederaTx, err := s.burnEvt.TransactionID(txIdWithLogIndex)
+	hederaTx, err := s.burnEvt.TransactionID(chainId, txIdWithLogIndex)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +115,7 @@ func Test_ConvertEvmHashToBridgeTxId_LockEvent(t *testing.T) {
 	setup()
 	mockReceipt.Logs[3].Topics[0] = lockHash
 	mocks.MEVMClient.On("WaitForTransactionReceipt", evmTxHash).Return(mockReceipt, nil)
-	mocks.MBurnService.On("TransactionID", fmt.Sprintf("%s-4", evmTx)).Return(expectedBridgeTx, nil)
+	mocks.MBurnService.On("TransactionID", uint64(80001), fmt.Sprintf("%s-4", evmTx)).Return(expectedBridgeTx, nil)
 
 	actual, err := svc.ConvertEvmHashToBridgeTxId(evmTx, 80001)
 
@@ -127,7 +127,7 @@ func Test_ConvertEvmHashToBridgeTxId_BurnEvent(t *testing.T) {
 	setup()
 	mockReceipt.Logs[3].Topics[0] = burnHash
 	mocks.MEVMClient.On("WaitForTransactionReceipt", evmTxHash).Return(mockReceipt, nil)
-	mocks.MBurnService.On("TransactionID", fmt.Sprintf("%s-4", evmTx)).Return(expectedBridgeTx, nil)
+	mocks.MBurnService.On("TransactionID", uint64(80001), fmt.Sprintf("%s-4", evmTx)).Return(expectedBridgeTx, nil)
 
 	actual, err := svc.ConvertEvmHashToBridgeTxId(evmTx, 80001)
 
@@ -139,7 +139,7 @@ func Test_ConvertEvmHashToBridgeTxId_BurnErc721Event(t *testing.T) {
 	setup()
 	mockReceipt.Logs[3].Topics[0] = burnErc721
 	mocks.MEVMClient.On("WaitForTransactionReceipt", evmTxHash).Return(mockReceipt, nil)
-	mocks.MBurnService.On("TransactionID", fmt.Sprintf("%s-4", evmTx)).Return(expectedBridgeTx, nil)
+	mocks.MBurnService.On("TransactionID", uint64(80001), fmt.Sprintf("%s-4", evmTx)).Return(expectedBridgeTx, nil)
 
 	actual, err := svc.ConvertEvmHashToBridgeTxId(evmTx, 80001)
 
@@ -160,7 +160,7 @@ func Test_ConvertEvmHashToBridgeTxId_WithErrorFromWaitForTransactionReceipt(t *t
 func Test_ConvertEvmHashToBridgeTxId_WithErrorFromTransactionID(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("WaitForTransactionReceipt", evmTxHash).Return(mockReceipt, nil)
-	mocks.MBurnService.On("TransactionID", fmt.Sprintf("%s-4", evmTx)).Return(nil, mockErr)
+	mocks.MBurnService.On("TransactionID", uint64(80001), fmt.Sprintf("%s-4", evmTx)).Return(nil, mockErr)
 
 	actual, err := svc.ConvertEvmHashToBridgeTxId(evmTx, 80001)
 
@@ -0,0 +1,243 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watchers
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	mutex  sync.RWMutex
+	paused map[string]bool
+	// Held by the watchers for the duration of every iteration, so that the operators await the iteration in flight
+	iterations          map[string]*sync.Mutex
+	simulators          map[string]service.Simulator
+	checkpointResetters map[string]service.CheckpointResetter
+	deniedAssets        map[uint64]map[string]bool
+	// Persists the deny-list, so that denied assets remain denied across restarts
+	deniedAssetRepository repository.DeniedAsset
+	prometheusService     service.Prometheus
+	logger                *log.Entry
+}
+
+// NewService creates the watchers service and loads the persisted deny-list of assets
+func NewService(deniedAssetRepository repository.DeniedAsset, prometheusService service.Prometheus) *Service {
+	s := &Service{
+		paused:                make(map[string]bool),
+		iterations:            make(map[string]*sync.Mutex),
+		simulators:            make(map[string]service.Simulator),
+		checkpointResetters:   make(map[string]service.CheckpointResetter),
+		deniedAssets:          make(map[uint64]map[string]bool),
+		deniedAssetRepository: deniedAssetRepository,
+		prometheusService:     prometheusService,
+		logger:                config.GetLoggerFor("Watchers Service"),
+	}
+
+	denied, err := deniedAssetRepository.GetAll()
+	if err != nil {
+		log.Fatalf("Failed to load the denied assets. Error: [%s]", err)
+	}
+	for _, d := range denied {
+		s.deny(d.ChainID, d.Asset)
+		s.logger.Warnf("[%d] - Asset [%s] is denied.", d.ChainID, d.Asset)
+	}
+
+	return s
+}
+
+func (s *Service) Pause(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.paused[id] = true
+	s.logger.Infof("[%s] - Watcher paused.", id)
+}
+
+func (s *Service) Resume(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.paused, id)
+	s.logger.Infof("[%s] - Watcher resumed.", id)
+}
+
+func (s *Service) IsPaused(id string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.paused[id]
+}
+
+// Iterate runs the given iteration of the given watcher, unless it is paused. Returns whether the iteration was run
+func (s *Service) Iterate(id string, iteration func()) bool {
+	lock := s.iteration(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if s.IsPaused(id) {
+		return false
+	}
+	iteration()
+	return true
+}
+
+// AwaitIteration blocks until the iteration of the given watcher in flight, if any, is finished
+func (s *Service) AwaitIteration(id string) {
+	lock := s.iteration(id)
+	lock.Lock()
+	defer lock.Unlock()
+}
+
+func (s *Service) iteration(id string) *sync.Mutex {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	lock, ok := s.iterations[id]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.iterations[id] = lock
+	}
+	return lock
+}
+
+func (s *Service) RegisterSimulator(id string, simulator service.Simulator) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.simulators[id] = simulator
+}
+
+func (s *Service) Simulate(id string, from, to int64) ([]*watcher.SimResult, error) {
+	s.mutex.RLock()
+	simulator, ok := s.simulators[id]
+	s.mutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no simulator registered for watcher [%s]", id)
+	}
+
+	return simulator.Simulate(from, to)
+}
+
+func (s *Service) RegisterCheckpointResetter(id string, resetter service.CheckpointResetter) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.checkpointResetters[id] = resetter
+}
+
+// ResetCheckpoint lets the given watcher drop its progress ahead of the overridden checkpoint,
+// once its iteration in flight, if any, is finished
+func (s *Service) ResetCheckpoint(id string) {
+	lock := s.iteration(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	s.mutex.RLock()
+	resetter, ok := s.checkpointResetters[id]
+	s.mutex.RUnlock()
+	if ok {
+		resetter.ResetCheckpoint()
+	}
+}
+
+func (s *Service) DenyAsset(chainId uint64, asset string) {
+	asset = normalizeAsset(asset)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := s.deniedAssetRepository.Create(chainId, asset)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to persist denied asset [%s]. It is denied until restart only. Error: [%s]", chainId, asset, err)
+	}
+	s.deny(chainId, asset)
+	s.logger.Warnf("[%d] - Asset [%s] denied.", chainId, asset)
+}
+
+func (s *Service) deny(chainId uint64, asset string) {
+	if s.deniedAssets[chainId] == nil {
+		s.deniedAssets[chainId] = make(map[string]bool)
+	}
+	s.deniedAssets[chainId][asset] = true
+	metrics.SetAssetDenied(chainId, asset, true, s.prometheusService)
+}
+
+func (s *Service) AllowAsset(chainId uint64, asset string) bool {
+	asset = normalizeAsset(asset)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.deniedAssets[chainId][asset] {
+		return false
+	}
+
+	err := s.deniedAssetRepository.Delete(chainId, asset)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to delete denied asset [%s]. It is denied again after restart. Error: [%s]", chainId, asset, err)
+	}
+	delete(s.deniedAssets[chainId], asset)
+	if len(s.deniedAssets[chainId]) == 0 {
+		delete(s.deniedAssets, chainId)
+	}
+	metrics.SetAssetDenied(chainId, asset, false, s.prometheusService)
+	s.logger.Infof("[%d] - Asset [%s] re-enabled.", chainId, asset)
+
+	return true
+}
+
+func (s *Service) IsAssetDenied(chainId uint64, asset string) bool {
+	asset = normalizeAsset(asset)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.deniedAssets[chainId][asset]
+}
+
+func (s *Service) DeniedAssets() map[uint64][]string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result := make(map[uint64][]string, len(s.deniedAssets))
+	for chainId, assets := range s.deniedAssets {
+		for asset := range assets {
+			result[chainId] = append(result[chainId], asset)
+		}
+		sort.Strings(result[chainId])
+	}
+
+	return result
+}
+
+// normalizeAsset brings EVM addresses to their checksum form, so that the same asset always has the same key
+func normalizeAsset(asset string) string {
+	if common.IsHexAddress(asset) {
+		return common.HexToAddress(asset).String()
+	}
+	return asset
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watchers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var id = "1-0x0000000000000000000000000000000000000001"
+
+func setup() {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MDeniedAssetRepository.On("GetAll").Return([]*entity.DeniedAsset{}, nil)
+	mocks.MDeniedAssetRepository.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mocks.MDeniedAssetRepository.On("Delete", mock.Anything, mock.Anything).Return(nil)
+}
+
+func Test_New(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+
+	assert.NotNil(t, s)
+	assert.False(t, s.IsPaused(id))
+}
+
+func Test_PauseResume(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+
+	s.Pause(id)
+	assert.True(t, s.IsPaused(id))
+	assert.False(t, s.IsPaused("other"))
+
+	s.Resume(id)
+	assert.False(t, s.IsPaused(id))
+}
+
+func Test_Iterate(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+	iterations := 0
+
+	assert.True(t, s.Iterate(id, func() { iterations++ }))
+	s.Pause(id)
+	assert.False(t, s.Iterate(id, func() { iterations++ }))
+	assert.Equal(t, 1, iterations)
+}
+
+func Test_AwaitIteration(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	finished := false
+	go s.Iterate(id, func() {
+		close(started)
+		<-finish
+		finished = true
+	})
+	<-started
+
+	// The watcher pauses itself during its iteration, which is awaited by the operator
+	s.Pause(id)
+	time.AfterFunc(10*time.Millisecond, func() { close(finish) })
+	s.AwaitIteration(id)
+
+	assert.True(t, finished)
+	assert.False(t, s.Iterate(id, func() {}))
+}
+
+type stubCheckpointResetter struct {
+	resets int
+}
+
+func (r *stubCheckpointResetter) ResetCheckpoint() {
+	r.resets++
+}
+
+func Test_ResetCheckpoint(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+	resetter := &stubCheckpointResetter{}
+	s.RegisterCheckpointResetter(id, resetter)
+
+	s.ResetCheckpoint(id)
+	s.ResetCheckpoint("other")
+
+	assert.Equal(t, 1, resetter.resets)
+}
+
+type stubSimulator struct {
+	from, to int64
+}
+
+func (s *stubSimulator) Simulate(from, to int64) ([]*watcher.SimResult, error) {
+	s.from, s.to = from, to
+	return []*watcher.SimResult{{EventType: "Lock", Decision: watcher.SimDecisionProcess}}, nil
+}
+
+func Test_Simulate(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+	simulator := &stubSimulator{}
+	s.RegisterSimulator(id, simulator)
+
+	results, err := s.Simulate(id, 10, 20)
+
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, int64(10), simulator.from)
+	assert.Equal(t, int64(20), simulator.to)
+}
+
+func Test_Simulate_NotRegistered(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+
+	results, err := s.Simulate(id, 10, 20)
+
+	assert.Error(t, err)
+	assert.Nil(t, results)
+}
+
+func Test_DenyAllowAsset(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+
+	s.DenyAsset(1, "0x00000000000000000000000000000000000000aa")
+	assert.True(t, s.IsAssetDenied(1, "0x00000000000000000000000000000000000000AA"))
+	assert.False(t, s.IsAssetDenied(2, "0x00000000000000000000000000000000000000aa"))
+	assert.Equal(t, map[uint64][]string{1: {"0x00000000000000000000000000000000000000AA"}}, s.DeniedAssets())
+
+	mocks.MDeniedAssetRepository.AssertCalled(t, "Create", uint64(1), "0x00000000000000000000000000000000000000AA")
+
+	assert.True(t, s.AllowAsset(1, "0x00000000000000000000000000000000000000aa"))
+	assert.False(t, s.IsAssetDenied(1, "0x00000000000000000000000000000000000000aa"))
+	assert.Empty(t, s.DeniedAssets())
+	mocks.MDeniedAssetRepository.AssertCalled(t, "Delete", uint64(1), "0x00000000000000000000000000000000000000AA")
+}
+
+func Test_New_LoadsDeniedAssets(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MDeniedAssetRepository.On("GetAll").Return([]*entity.DeniedAsset{{ChainID: 1, Asset: "0x00000000000000000000000000000000000000AA"}}, nil)
+
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+
+	assert.True(t, s.IsAssetDenied(1, "0x00000000000000000000000000000000000000aa"))
+	mocks.MDeniedAssetRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func Test_DenyAsset_PersistFails(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MDeniedAssetRepository.On("GetAll").Return([]*entity.DeniedAsset{}, nil)
+	mocks.MDeniedAssetRepository.On("Create", uint64(1), "0.0.1").Return(errors.New("some-error"))
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+
+	s.DenyAsset(1, "0.0.1")
+
+	assert.True(t, s.IsAssetDenied(1, "0.0.1"))
+}
+
+func Test_AllowAsset_NotDenied(t *testing.T) {
+	setup()
+	s := NewService(mocks.MDeniedAssetRepository, mocks.MPrometheusService)
+
+	assert.False(t, s.AllowAsset(1, "0.0.1"))
+}
@@ -18,9 +18,11 @@ package bootstrap
 
 import (
 	"context"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gookit/event"
+	hederaSDK "github.com/hashgraph/hedera-sdk-go/v2"
 	coin_gecko "github.com/limechain/hedera-eth-bridge-validator/app/clients/coin-gecko"
 	coin_market_cap "github.com/limechain/hedera-eth-bridge-validator/app/clients/coin-market-cap"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm"
@@ -72,6 +74,57 @@ func PrepareClients(clientsCfg config.Clients, bridgeEvmsCfgs map[uint64]config.
 	return instance
 }
 
+// VerifyWrappedSupplyKeys checks that the key of the validator is part of the supply key of every wrapped token on Hedera,
+// so that its mint submissions do not fail. A failed check is logged as a warning or stops the validator, depending on the configured mode
+func VerifyWrappedSupplyKeys(hederaCfg config.Hedera, bridgeCfg *config.Bridge, node client.HederaNode) {
+	if hederaCfg.SupplyKeyCheck == "" {
+		return
+	}
+
+	privateKey, err := hederaSDK.PrivateKeyFromString(hederaCfg.Operator.PrivateKey)
+	if err != nil {
+		log.Fatalf("Failed to parse the Hedera operator private key. Error: [%s]", err)
+	}
+
+	err = hedera.VerifySupplyKeys(node, privateKey.PublicKey(), wrappedHederaTokens(bridgeCfg))
+	if err == nil {
+		log.Infof("Verified the supply keys of the wrapped Hedera tokens.")
+		return
+	}
+	if hederaCfg.SupplyKeyCheck == config.SupplyKeyCheckFail {
+		log.Fatalf("Failed to verify the supply keys of the wrapped Hedera tokens. Error: [%s]", err)
+	}
+	log.Warnf("Failed to verify the supply keys of the wrapped Hedera tokens. Error: [%s]", err)
+}
+
+// wrappedHederaTokens returns the Hedera tokens, which wrap the native EVM fungible tokens, sorted by their id
+func wrappedHederaTokens(bridgeCfg *config.Bridge) []hederaSDK.TokenID {
+	unique := make(map[string]hederaSDK.TokenID)
+	for _, evm := range bridgeCfg.EVMs {
+		for nativeAsset, token := range evm.Tokens {
+			wrapped, ok := token.Networks[constants.HederaNetworkId]
+			if !ok {
+				continue
+			}
+			tokenID, err := hederaSDK.TokenIDFromString(wrapped)
+			if err != nil {
+				log.Errorf("Failed to parse the wrapped Hedera token [%s] of [%s]. Error: [%s]", wrapped, nativeAsset, err)
+				continue
+			}
+			unique[tokenID.String()] = tokenID
+		}
+	}
+
+	tokens := make([]hederaSDK.TokenID, 0, len(unique))
+	for _, tokenID := range unique {
+		tokens = append(tokens, tokenID)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].String() < tokens[j].String()
+	})
+	return tokens
+}
+
 func bridgeCfgEventHandler(e event.Event, instance *Clients) error {
 	params, err := eventHelper.GetBridgeCfgUpdateEventParams(e)
 	if err != nil {
@@ -123,15 +176,11 @@ func InitEVMClients(clientsCfg config.Clients, networks map[uint64]*parser.Netwo
 		if e != nil {
 			log.Fatalf("[%d] - Failed to initialize EVM Client. Error: [%s]", configChainId, e)
 		}
-		EVMClients[configChainId] = evmClient
-		clientChainId, e := EVMClients[configChainId].ChainID(context.Background())
-		if e != nil {
-			log.Fatalf("[%d] - Failed to retrieve chain ID on client prepare. Error: [%s]", configChainId, e)
+		if e := evmClient.VerifyChainID(context.Background(), configChainId); e != nil {
+			log.Fatalf("[%d] - Chain ID verification failed. Error: [%s]", configChainId, e)
 		}
-		if configChainId != clientChainId.Uint64() {
-			log.Fatalf("Chain IDs mismatch [%d] config, [%d] actual.", configChainId, clientChainId)
-		}
-		EVMClients[configChainId].SetChainID(clientChainId.Uint64())
+		evmClient.SetChainID(configChainId)
+		EVMClients[configChainId] = evmClient
 	}
 	return EVMClients
 }
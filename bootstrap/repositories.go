@@ -17,13 +17,18 @@
 package bootstrap
 
 import (
+	"database/sql"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	deniedAsset "github.com/limechain/hedera-eth-bridge-validator/app/persistence/denied-asset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/fee"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/message"
+	queueMessage "github.com/limechain/hedera-eth-bridge-validator/app/persistence/queue-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/transfer"
+	log "github.com/sirupsen/logrus"
 )
 
 // Repositories struct holding the referenced repositories
@@ -34,11 +39,19 @@ type Repositories struct {
 	Message        repository.Message
 	Fee            repository.Fee
 	Schedule       repository.Schedule
+	QueueMessage   repository.QueueMessage
+	DeniedAsset    repository.DeniedAsset
+	DatabasePool   *sql.DB
 }
 
 // PrepareRepositories initialises connection to the Database and instantiates the repositories
 func PrepareRepositories(db database.Database) *Repositories {
 	connection := db.Connection()
+	pool, err := connection.DB()
+	if err != nil {
+		log.Fatalf("Failed to get the database connection pool. Error: [%s]", err)
+	}
+
 	return &Repositories{
 		TransferStatus: status.NewRepositoryForStatus(connection, status.Transfer),
 		MessageStatus:  status.NewRepositoryForStatus(connection, status.Message),
@@ -46,5 +59,8 @@ func PrepareRepositories(db database.Database) *Repositories {
 		Message:        message.NewRepository(connection),
 		Fee:            fee.NewRepository(connection),
 		Schedule:       schedule.NewRepository(connection),
+		QueueMessage:   queueMessage.NewRepository(connection),
+		DeniedAsset:    deniedAsset.NewRepository(connection),
+		DatabasePool:   pool,
 	}
 }
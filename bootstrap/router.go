@@ -21,31 +21,37 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/assets"
 	burn_event "github.com/limechain/hedera-eth-bridge-validator/app/router/burn-event"
 	config_bridge "github.com/limechain/hedera-eth-bridge-validator/app/router/config-bridge"
+	config_mappings "github.com/limechain/hedera-eth-bridge-validator/app/router/config-mappings"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/fees"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/healthcheck"
 	min_amounts "github.com/limechain/hedera-eth-bridge-validator/app/router/min-amounts"
+	public_transfers "github.com/limechain/hedera-eth-bridge-validator/app/router/public-transfers"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/transfer-reset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/utils"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/validator-version"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/watchers"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func InitializeAPIRouter(services *Services, bridgeConfig *parser.Bridge, nodeConfig config.Node) *apirouter.APIRouter {
+func InitializeAPIRouter(services *Services, repositories *Repositories, clients *Clients, bridgeConfig *parser.Bridge, nodeConfig config.Node) *apirouter.APIRouter {
 	apiRouter := apirouter.NewAPIRouter()
-	apiRouter.AddV1Router(healthcheck.Route, healthcheck.NewRouter())
+	apiRouter.AddV1Router(healthcheck.Route, healthcheck.NewRouter(services.ContractServices))
 	apiRouter.AddV1Router(transfer.Route, transfer.NewRouter(services.transfers))
-	apiRouter.AddV1Router(burn_event.Route, burn_event.NewRouter(services.BurnEvents))
+	apiRouter.AddV1Router(burn_event.Route, burn_event.NewRouter(services.BurnEvents, services.transfers))
 	apiRouter.AddV1Router(constants.PrometheusMetricsEndpoint, promhttp.Handler())
 	apiRouter.AddV1Router(config_bridge.Route, config_bridge.NewRouter(bridgeConfig))
+	apiRouter.AddV1Router(config_mappings.Route, config_mappings.NewRouter(services.Assets, services.Pricing))
 	apiRouter.AddV1Router(min_amounts.Route, min_amounts.NewRouter(services.Pricing))
 	apiRouter.AddV1Router(assets.Route, assets.NewRouter(bridgeConfig, services.Assets, services.Pricing))
 	apiRouter.AddV1Router(utils.Route, utils.NewRouter(services.Utils))
 	apiRouter.AddV1Router(fees.Route, fees.NewRouter(services.Pricing))
 	apiRouter.AddV1Router(transfer_reset.Route, transfer_reset.NewRouter(services.transfers, services.Prometheus, nodeConfig))
 	apiRouter.AddV1Router(validator_version.Route, validator_version.NewRouter())
+	apiRouter.AddV1Router(public_transfers.Route, public_transfers.NewRouter(repositories.Transfer, nodeConfig.PublicApi))
+	apiRouter.AddV1Router(watchers.Route, watchers.NewRouter(repositories.TransferStatus, evmWatcherClients(services, clients), services.Watchers, nodeConfig))
 	return apiRouter
 }
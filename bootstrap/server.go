@@ -18,10 +18,27 @@ package bootstrap
 
 import (
 	"fmt"
+	"net/http"
+	"os"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	evmclient "github.com/limechain/hedera-eth-bridge-validator/app/clients/evm"
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/bounded"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/partitioned"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/persistent"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue/priority"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/events"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/mappings"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
+	transfer_event "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer-event"
 	burn_message "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/burn-message"
 	fee_message "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/fee-message"
 	fee_transfer "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/fee-transfer"
@@ -38,14 +55,59 @@ import (
 	rnth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/transfer"
 	rthh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/transfer"
 	bridge_config "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/bridge-config"
+	database_pool "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/database-pool"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/evm"
+	evm_endpoints "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/evm-endpoints"
+	mapping_consistency "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/mapping-consistency"
+	message_retention "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/message-retention"
+	pending_signers "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/pending-signers"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/price"
+	signature_request "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/signature-request"
+	signature_timeout "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/signature-timeout"
+	transfer_status "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/transfer-status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
 
+// The timeout of the requests for the asset mappings hash of the peer validators
+const mappingConsistencyRequestTimeout = 30 * time.Second
+
+// PrepareQueue instantiates the queue, used between the watchers and handlers, based on the `queue` node configuration.
+// The queue is partitioned per watcher, weighted by the `queue_weights` node configuration
+func PrepareQueue(nodeConfig config.Node, repositories *Repositories, prometheusService service.Prometheus, pricingService service.Pricing, assetsService service.Assets) queue.Queue {
+	var target queue.Queue
+	switch nodeConfig.Queue {
+	case "", q.TypeMemory:
+		if nodeConfig.QueueCapacity == 0 {
+			target = q.NewQueue()
+			break
+		}
+		boundedQueue, err := bounded.NewQueue(nodeConfig.QueueCapacity, nodeConfig.QueueOverflowPolicy, repositories.QueueMessage, prometheusService)
+		if err != nil {
+			log.Fatalf("Failed to create in-memory queue. Error: [%s]", err)
+		}
+		target = boundedQueue
+	case q.TypePriority:
+		prioritizer := priority.NewPrioritizer(nodeConfig.QueuePriority, pricingService, assetsService)
+		target = priority.NewQueue(nodeConfig.QueueCapacity, prioritizer, nodeConfig.QueuePriority.AgingInterval, prometheusService)
+	case q.TypePersistent:
+		target = persistent.NewQueue(repositories.QueueMessage)
+	default:
+		log.Fatalf("Unsupported queue type [%s]", nodeConfig.Queue)
+		return nil
+	}
+
+	// The partitions hold the events in memory, before they reach the target queue,
+	// so they are opt-in and never in front of the persistent queue
+	if len(nodeConfig.QueueWeights) == 0 || nodeConfig.Queue == q.TypePersistent {
+		return target
+	}
+
+	return partitioned.NewQueue(target, nodeConfig.QueueWeights, prometheusService)
+}
+
 func InitializeServerPairs(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config, parsedBridge *parser.Bridge, bridgeCfgTopicId hedera.TopicID) {
 	// Transfer Message Watcher
 	registerTransferWatcher(server, services, repositories, clients, configuration)
@@ -72,15 +134,88 @@ func InitializeServerPairs(server *server.Server, services *Services, repositori
 	registerAssetsWatcher(server, services, configuration, clients)
 
 	// Prometheus Watcher
-	registerPrometheusWatcher(server, services, configuration, clients)
+	registerPrometheusWatcher(server, services, repositories, configuration, clients)
 
 	// Pricing Watcher
 	server.AddWatcher(price.NewWatcher(services.Pricing))
 
+	// Signature Timeout Watcher
+	registerSignatureTimeoutWatcher(server, services, repositories, configuration)
+
+	// Signature Request Watcher
+	registerSignatureRequestWatcher(server, services, repositories, clients, configuration)
+
+	// Mapping Consistency Watcher
+	registerMappingConsistencyWatcher(server, services, configuration)
+
+	// Message Retention Watcher
+	registerMessageRetentionWatcher(server, repositories, configuration)
+
 	// Bridge Config Watcher
 	registerBridgeConfigWatcher(server, services, parsedBridge.UseLocalConfig, bridgeCfgTopicId, parsedBridge.PollingInterval)
 }
 
+func registerSignatureTimeoutWatcher(server *server.Server, services *Services, repositories *Repositories, configuration *config.Config) {
+	if configuration.Node.SignatureTimeout == 0 {
+		log.Infoln("Signature timeout is disabled. Skipping initialization of SignatureTimeoutWatcher ...")
+		return
+	}
+
+	server.AddWatcher(signature_timeout.NewWatcher(
+		repositories.Transfer,
+		services.Prometheus,
+		configuration.Node.SignatureTimeout,
+		0))
+}
+
+func registerSignatureRequestWatcher(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
+	if !configuration.Node.Validator || configuration.Node.SignatureRequest.Wait == 0 {
+		log.Infoln("Signature requests are disabled. Skipping initialization of SignatureRequestWatcher ...")
+		return
+	}
+
+	server.AddWatcher(signature_request.NewWatcher(
+		repositories.Transfer,
+		services.Messages,
+		clients.HederaNode,
+		configuration.Bridge.SignatureTopics(),
+		configuration.Node.SignatureRequest.Wait,
+		configuration.Node.SignatureRequest.PollingInterval))
+}
+
+func registerMessageRetentionWatcher(server *server.Server, repositories *Repositories, configuration *config.Config) {
+	if configuration.Node.MessageRetention.Ttl == 0 {
+		log.Infoln("Message retention is disabled. Skipping initialization of MessageRetentionWatcher ...")
+		return
+	}
+
+	server.AddWatcher(message_retention.NewWatcher(
+		repositories.Message,
+		configuration.Node.MessageRetention.Ttl,
+		configuration.Node.MessageRetention.PruningInterval))
+}
+
+func registerMappingConsistencyWatcher(server *server.Server, services *Services, configuration *config.Config) {
+	hash, err := mappings.Hash(services.Assets, services.Pricing)
+	if err != nil {
+		log.Fatalf("Failed to hash the asset mappings. Error: [%s]", err)
+	}
+	log.Infof("Asset mappings hash: [%s]", hash)
+
+	if len(configuration.Node.MappingConsistency.Peers) == 0 {
+		log.Infoln("No mapping consistency peers are configured. Skipping initialization of MappingConsistencyWatcher ...")
+		return
+	}
+
+	server.AddWatcher(mapping_consistency.NewWatcher(
+		services.Assets,
+		services.Pricing,
+		services.Prometheus,
+		&http.Client{Timeout: mappingConsistencyRequestTimeout},
+		configuration.Node.MappingConsistency.Peers,
+		configuration.Node.MappingConsistency.PollingInterval))
+}
+
 func registerBridgeConfigWatcher(s *server.Server, services *Services, useLocalConfig bool, bridgeCfgTopicId hedera.TopicID, pollingInterval time.Duration) {
 	if useLocalConfig {
 		log.Infoln("Using local bridge config. Skipping initialization of BridgeConfigWatcher ...")
@@ -102,34 +237,62 @@ func registerTransferWatcher(server *server.Server, services *Services, reposito
 }
 
 func registerValidationServerPairs(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
-	// Watcher - ConsensusTopic
-	server.AddWatcher(
-		createConsensusTopicWatcher(
-			configuration,
-			clients.MirrorNode,
-			repositories.MessageStatus))
+	// Watchers - ConsensusTopic, one per signature topic. Their messages are aggregated by a single handler
+	for _, topic := range configuration.Bridge.SignatureTopics() {
+		server.AddWatcher(
+			createConsensusTopicWatcher(
+				configuration,
+				topic,
+				clients.MirrorNode,
+				repositories.MessageStatus,
+				services.Prometheus))
+	}
 
 	// Handler - TopicMessageValidation
-	server.AddHandler(constants.TopicMessageValidation, mh.NewHandler(
-		configuration.Bridge.TopicId,
+	messageHandler := mh.NewHandler(
+		configuration.Bridge.SignatureTopics(),
 		repositories.Transfer,
 		repositories.Message,
 		services.ContractServices,
 		services.Messages,
 		services.Prometheus,
-		services.Assets))
+		services.Assets,
+		TypedDataDomain(configuration.Node),
+		configuration.Node.OrphanedSignatures.Ttl,
+		configuration.Node.OrphanedSignatures.Max)
+	server.AddHandler(constants.TopicMessageValidation, messageHandler)
+	// Handle the signatures, received before their transfer or its fee, without blocking the storing of either
+	resolveOrphans := func(params *transfer_event.Params) error {
+		syncHelper.Go(func() {
+			messageHandler.ResolveOrphans(params.SourceChainID, params.TransactionID)
+		})
+		return nil
+	}
+	events.OnTransferEvent(constants.EventTransferCreated, resolveOrphans)
+	events.OnTransferEvent(constants.EventTransferFeeUpdated, resolveOrphans)
 }
 
 func registerTransferMessageHandlers(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
 	// TopicMessageSubmission
-	server.AddHandler(constants.TopicMessageSubmission,
-		message_submission.NewHandler(
-			clients.HederaNode,
-			clients.MirrorNode,
-			services.transfers,
-			repositories.Transfer,
-			services.Messages,
-			configuration.Bridge.TopicId))
+	messageSubmissionHandler := message_submission.NewHandler(
+		clients.HederaNode,
+		clients.MirrorNode,
+		services.transfers,
+		repositories.Transfer,
+		services.Messages,
+		configuration.Bridge.SignatureTopics(),
+		configuration.Node.Clients.Hedera.SignatureSubmissionRetries,
+		configuration.Node.Clients.Hedera.SignatureConfirmationRetries)
+	server.AddHandler(constants.TopicMessageSubmission, messageSubmissionHandler)
+	if configuration.Node.Validator {
+		go messageSubmissionHandler.ResumePendingSubmissions()
+		go services.transfers.ResumePendingSubmissions()
+		// Re-submit the signatures, requested by other validators, without blocking the handling of the topic messages
+		events.OnTransferEvent(constants.EventTransferSignatureRequested, func(params *transfer_event.Params) error {
+			go messageSubmissionHandler.ResubmitSignature(params.SourceChainID, params.TransactionID)
+			return nil
+		})
+	}
 
 	// HederaMintHtsTransfer
 	server.AddHandler(constants.HederaMintHtsTransfer, mint_hts.NewHandler(services.LockEvents))
@@ -145,31 +308,188 @@ func registerTransferMessageHandlers(server *server.Server, services *Services,
 }
 
 func registerEvmClients(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
+	for _, evmClient := range clients.EvmClients {
+		server.AddWatcher(newEvmWatcher(evmClient, services, repositories, clients, configuration))
+	}
+}
+
+// PrepareEvmSimulators creates the EVM watchers only as simulators, without running them.
+// Returns the database identifiers of the watchers, keyed by chain id
+func PrepareEvmSimulators(services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) map[uint64]string {
+	ids := make(map[uint64]string)
 	for _, evmClient := range clients.EvmClients {
 		chain := evmClient.GetChainID()
-		contractService := services.ContractServices[chain]
-		// Given that addresses between different
-		// EVM networks might be the same, a concatenation between
-		// <chain-id>-<contract-address> removes possible duplication.
-		dbIdentifier := fmt.Sprintf("%d-%s", chain, contractService.Address().String())
-		blacklisted := configuration.Bridge.BlacklistedAccounts
+		newEvmWatcher(evmClient, services, repositories, clients, configuration)
+		ids[chain] = evmWatcherDbIdentifier(chain, services.ContractServices[chain])
+	}
+	return ids
+}
 
-		server.AddWatcher(
-			evm.NewWatcher(
-				repositories.TransferStatus,
-				contractService,
-				services.Prometheus,
-				services.Pricing,
-				evmClient,
-				services.Assets,
-				dbIdentifier,
-				configuration.Node.Clients.EvmPool[chain].StartBlock,
-				configuration.Node.Validator,
-				configuration.Node.Clients.EvmPool[chain].PollingInterval,
-				configuration.Node.Clients.EvmPool[chain].MaxLogsBlocks,
-				blacklisted,
-			))
+// newEvmWatcher creates the watcher of the router on the network of the given client and registers it as a simulator
+func newEvmWatcher(evmClient client.EVM, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) *evm.Watcher {
+	chain := evmClient.GetChainID()
+	contractService := services.ContractServices[chain]
+	dbIdentifier := evmWatcherDbIdentifier(chain, contractService)
+	blacklisted := configuration.Bridge.BlacklistedAccounts
+
+	evmPool := configuration.Node.Clients.EvmPool[chain]
+
+	watcher, err := evm.NewWatcherFromConfig(evm.WatcherConfig{
+		Repository:                   repositories.TransferStatus,
+		TransferRepository:           repositories.Transfer,
+		Contracts:                    contractService,
+		PrometheusService:            services.Prometheus,
+		PricingService:               services.Pricing,
+		EvmClient:                    evmClient,
+		AssetsService:                services.Assets,
+		WatchersService:              services.Watchers,
+		DbIdentifier:                 dbIdentifier,
+		StartBlock:                   evmPool.StartBlock,
+		Validator:                    configuration.Node.Validator,
+		PollingInterval:              evmPool.PollingInterval,
+		AutoTunePolling:              evmPool.AutoTunePolling,
+		MinPollingInterval:           evmPool.MinPollingInterval,
+		MaxPollingInterval:           evmPool.MaxPollingInterval,
+		MaxLogsBlocks:                evmPool.MaxLogsBlocks,
+		MaxLogsBlocksCeiling:         evmPool.MaxLogsBlocksCeiling,
+		PartialRangeCommit:           evmPool.PartialRangeCommit,
+		ReorgGrace:                   evmPool.ReorgGrace,
+		WatchUpgrades:                evmPool.WatchUpgrades,
+		PauseOnUpgrade:               evmPool.PauseOnUpgrade,
+		Verifier:                     evmCrossVerifier(chain, evmPool),
+		CrossVerificationThreshold:   evmPool.CrossVerificationThreshold,
+		ReadOnlyFinality:             evmPool.ReadOnlyFinality,
+		MaxTransferAge:               configuration.Node.MaxTransferAge,
+		ConfirmationTiers:            evmPool.ConfirmationTiers,
+		RouterAbi:                    readAbi(evmPool.RouterAbi),
+		ExtraEvents:                  evmPool.ExtraEvents,
+		FeeOnTransferTokens:          evmFeeOnTransferTokens(chain, configuration),
+		ServicedChains:               evmServicedChains(chain, configuration),
+		BlacklistedAccounts:          blacklisted,
+		DustAmounts:                  configuration.Bridge.DustAmounts,
+		RoundingPolicies:             configuration.Bridge.RoundingPolicies,
+		DisabledCorridors:            configuration.Bridge.DisabledCorridors,
+		DropSelfTransfers:            evmPool.DropSelfTransfers,
+		MaxAmountBits:                evmPool.MaxAmountBits,
+		MaxReorgDepth:                evmPool.MaxReorgDepth,
+		Shard:                        configuration.Node.Shard,
+		Archive:                      evmArchive(chain, evmPool),
+		ArchiveAge:                   evmPool.ArchiveAge,
+		Emitters:                     evmEmitters(chain, evmPool),
+		ConfirmationsCallbackUrl:     evmPool.ConfirmationsCallbackUrl,
+		ConfirmationsCallbackRetries: evmPool.ConfirmationsCallbackRetries,
+		LogSampler:                   logSampler(configuration.Node.LogSampling),
+		ColdStartLookback:            evmPool.ColdStartLookback,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create EVM watcher for chain [%d]. Error: [%s]", chain, err)
+	}
+	services.Watchers.RegisterSimulator(dbIdentifier, watcher)
+	services.Watchers.RegisterCheckpointResetter(dbIdentifier, watcher)
+	return watcher
+}
+
+// evmCrossVerifier dials the secondary endpoint of the given chain, against which the logs of high-value transfers are verified.
+// Returns nil if cross-verification is not configured
+func evmCrossVerifier(chain uint64, evmPool config.EvmPool) client.Core {
+	if evmPool.CrossVerificationUrl == "" || evmPool.CrossVerificationThreshold == 0 {
+		return nil
+	}
+
+	verifier, err := evmclient.Dial(evmPool.CrossVerificationUrl, evmPool.NodeHeaders[evmPool.CrossVerificationUrl])
+	if err != nil {
+		log.Fatalf("Failed to dial cross-verification endpoint for chain [%d]. Error: [%s]", chain, err)
+	}
+	return verifier
+}
+
+// evmArchive dials the archive endpoint of the given chain, from which old ranges without logs are re-queried.
+// Returns nil if the archive fallback is not configured
+func evmArchive(chain uint64, evmPool config.EvmPool) client.Core {
+	if evmPool.ArchiveNodeUrl == "" {
+		return nil
+	}
+
+	archive, err := evmclient.Dial(evmPool.ArchiveNodeUrl, evmPool.NodeHeaders[evmPool.ArchiveNodeUrl])
+	if err != nil {
+		log.Fatalf("Failed to dial archive endpoint for chain [%d]. Error: [%s]", chain, err)
+	}
+	return archive
+}
+
+// evmFeeOnTransferTokens returns the native tokens on the given chain, which are flagged as fee-on-transfer
+func evmFeeOnTransferTokens(chain uint64, configuration *config.Config) map[string]bool {
+	tokens := make(map[string]bool)
+	for address, token := range configuration.Bridge.EVMs[chain].Tokens {
+		if token.FeeOnTransfer {
+			tokens[address] = true
+		}
+	}
+	return tokens
+}
+
+// evmServicedChains returns the configured serviced chains of the EVM watcher on the given chain.
+// Defaults to every network of the bridge configuration.
+func evmServicedChains(chain uint64, configuration *config.Config) []uint64 {
+	if len(configuration.Node.Clients.EvmPool[chain].ServicedChains) > 0 {
+		return configuration.Node.Clients.EvmPool[chain].ServicedChains
+	}
+
+	chains := []uint64{constants.HederaNetworkId}
+	for evmChain := range configuration.Bridge.EVMs {
+		chains = append(chains, evmChain)
+	}
+	return chains
+}
+
+// readAbi returns the content of the ABI file at the given path.
+// Returns an empty string if no path is configured, so that the embedded router ABI is used.
+func readAbi(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read ABI from [%s]. Error: [%s]", path, err)
+	}
+	return string(content)
+}
+
+// evmEmitters returns the auxiliary contracts, whose events are watched next to the ones of the router of the given chain
+func evmEmitters(chain uint64, evmPool config.EvmPool) []evm.Emitter {
+	emitters := make([]evm.Emitter, 0, len(evmPool.Emitters))
+	for _, emitter := range evmPool.Emitters {
+		if !common.IsHexAddress(emitter.Address) {
+			log.Fatalf("Invalid address [%s] of emitter on chain [%d]", emitter.Address, chain)
+		}
+		if emitter.Abi == "" {
+			log.Fatalf("Missing ABI of emitter [%s] on chain [%d]", emitter.Address, chain)
+		}
+		emitters = append(emitters, evm.Emitter{
+			Address: common.HexToAddress(emitter.Address),
+			Abi:     readAbi(emitter.Abi),
+			Events:  emitter.Events,
+		})
+	}
+	return emitters
+}
+
+// evmWatcherDbIdentifier returns the identifier under which the EVM watcher for the given chain stores its progress.
+// Given that addresses between different EVM networks might be the same, a concatenation between
+// <chain-id>-<contract-address> removes possible duplication.
+func evmWatcherDbIdentifier(chain uint64, contractService service.Contracts) string {
+	return fmt.Sprintf("%d-%s", chain, contractService.Address().String())
+}
+
+// evmWatcherClients returns the EVM clients of the watchers, keyed by the watchers' database identifiers
+func evmWatcherClients(services *Services, clients *Clients) map[string]client.EVM {
+	evmClients := make(map[string]client.EVM)
+	for _, evmClient := range clients.EvmClients {
+		chain := evmClient.GetChainID()
+		evmClients[evmWatcherDbIdentifier(chain, services.ContractServices[chain])] = evmClient
 	}
+	return evmClients
 }
 
 func registerAssetsWatcher(server *server.Server, services *Services, configuration *config.Config, clients *Clients) {
@@ -181,7 +501,7 @@ func registerAssetsWatcher(server *server.Server, services *Services, configurat
 		services.Assets))
 }
 
-func registerPrometheusWatcher(server *server.Server, services *Services, configuration *config.Config, clients *Clients) {
+func registerPrometheusWatcher(server *server.Server, services *Services, repositories *Repositories, configuration *config.Config, clients *Clients) {
 	if configuration.Node.Monitoring.Enable {
 		dashboardPolling := configuration.Node.Monitoring.DashboardPolling * time.Minute
 		log.Infoln("Dashboard Polling interval: ", dashboardPolling)
@@ -193,11 +513,39 @@ func registerPrometheusWatcher(server *server.Server, services *Services, config
 			clients.EvmFungibleTokenClients,
 			clients.EvmNFTClients,
 			services.Assets))
+		server.AddWatcher(transfer_status.NewWatcher(
+			repositories.Transfer,
+			services.Prometheus,
+			configuration.Node.Monitoring.TransfersByStatusPolling))
+		server.AddWatcher(database_pool.NewWatcher(
+			repositories.DatabasePool,
+			services.Prometheus,
+			configuration.Node.Monitoring.DatabasePoolPolling))
+		server.AddWatcher(evm_endpoints.NewWatcher(
+			evmEndpoints(clients),
+			services.Prometheus,
+			0))
+		if configuration.Node.Validator {
+			server.AddWatcher(pending_signers.NewWatcher(
+				services.Messages,
+				configuration.Node.Monitoring.PendingSignersTimeout))
+		}
 	} else {
 		log.Infoln("Monitoring is disabled. No metrics will be added.")
 	}
 }
 
+// evmEndpoints returns the EVM clients, which fail over across multiple RPC endpoints
+func evmEndpoints(clients *Clients) map[uint64]client.EvmEndpoints {
+	endpoints := make(map[uint64]client.EvmEndpoints)
+	for chainId, evmClient := range clients.EvmClients {
+		if pool, ok := evmClient.(client.EvmEndpoints); ok {
+			endpoints[chainId] = pool
+		}
+	}
+	return endpoints
+}
+
 func registerHederaNativeUnlockNftHandlers(server *server.Server, services *Services, repositories *Repositories, configuration *config.Config) {
 	// HederaNftTransfer
 	server.AddHandler(constants.HederaNftTransfer, nth.NewHandler(
@@ -283,3 +631,15 @@ func registerReadOnlyHandlers(server *server.Server, services *Services, reposit
 	//ReadOnlyTransferSave
 	server.AddHandler(constants.ReadOnlyTransferSave, rthh.NewHandler(services.transfers))
 }
+
+// TypedDataDomain returns the EIP-712 domain of the authorisation signatures, if the eip712 scheme is configured
+func TypedDataDomain(node config.Node) *auth_message.TypedDataDomain {
+	if !node.VerifiesTypedData() {
+		return nil
+	}
+
+	return &auth_message.TypedDataDomain{
+		Name:    node.SignatureTypedData.Name,
+		Version: node.SignatureTypedData.Version,
+	}
+}
@@ -19,6 +19,7 @@ package bootstrap
 import (
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	hederaClient "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/assets"
 	bridge_config "github.com/limechain/hedera-eth-bridge-validator/app/services/bridge-config"
@@ -35,6 +36,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/transfers"
 	utilsSvc "github.com/limechain/hedera-eth-bridge-validator/app/services/utils"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/watchers"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -56,6 +58,7 @@ type Services struct {
 	Assets           service.Assets
 	Utils            service.Utils
 	BridgeConfig     service.BridgeConfig
+	Watchers         service.Watchers
 }
 
 // PrepareServices instantiates all the necessary services with their required context and parameters
@@ -82,6 +85,9 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		clients.EvmFungibleTokenClients,
 		clients.EvmNFTClients,
 	)
+	if err := assetsService.ValidateMappings(); err != nil {
+		panic(fmt.Sprintf("inconsistent asset mappings. Err: [%s]", err))
+	}
 	c.Bridge.LoadStaticMinAmountsForWrappedFungibleTokens(*parsedBridge, assetsService)
 
 	for _, client := range clients.EvmClients {
@@ -93,11 +99,29 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		}
 	}
 
+	prometheus := prometheusServices.NewService(assetsService, c.Node.Monitoring.Enable)
+	if c.Node.Clients.Hedera.MinOperatorBalance > 0 {
+		clients.HederaNode = hederaClient.NewBalanceGuard(
+			clients.HederaNode,
+			clients.MirrorNode,
+			c.Node.Clients.Hedera.Operator.AccountId,
+			c.Node.Clients.Hedera.MinOperatorBalance,
+			c.Node.Clients.Hedera.OperatorBalanceCheckInterval,
+			prometheus)
+	}
+
 	fees := calculator.New(c.Bridge.Hedera.FeePercentages)
 	distributor := distributor.New(c.Bridge.Hedera.Members)
-	scheduled := scheduled.New(c.Bridge.Hedera.PayerAccount, clients.HederaNode, clients.MirrorNode)
+	scheduled := scheduled.New(
+		c.Bridge.Hedera.PayerAccount,
+		clients.HederaNode,
+		clients.MirrorNode,
+		repositories.Schedule,
+		c.Node.Clients.Hedera.ScheduleExpiryTimeout,
+		c.Node.Clients.Hedera.ScheduleResubmissions,
+		c.Node.Clients.Hedera.MirrorConfirmationRetries,
+		prometheus)
 
-	prometheus := prometheusServices.NewService(assetsService, c.Node.Monitoring.Enable)
 	messages := messages.NewService(
 		evmSigners,
 		contractServices,
@@ -106,7 +130,9 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		clients.MirrorNode,
 		clients.EvmClients,
 		c.Bridge.TopicId,
-		assetsService)
+		assetsService,
+		c.Node.SignatureSchemes,
+		prometheus)
 
 	transfers := transfers.NewService(
 		clients.HederaNode,
@@ -117,12 +143,14 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		repositories.Fee,
 		fees,
 		distributor,
-		c.Bridge.TopicId,
+		c.Bridge.SignatureTopics(),
 		c.Bridge.Hedera.BridgeAccount,
 		scheduled,
 		messages,
 		prometheus,
-		assetsService)
+		assetsService,
+		c.Node.Clients.Hedera.SignatureSubmissionRetries,
+		c.Node.Shard)
 
 	burnEvent := burn_event.NewService(
 		c.Bridge.Hedera.BridgeAccount,
@@ -171,5 +199,6 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		Assets:           assetsService,
 		Utils:            utilsService,
 		BridgeConfig:     bridgeCfgService,
+		Watchers:         watchers.NewService(repositories.DeniedAsset, prometheus),
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/sampling"
 	aw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/assets"
 	cmw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/message"
 	pw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/prometheus"
@@ -57,20 +58,25 @@ func createTransferWatcher(
 		prometheusService,
 		pricingService,
 		blacklisted_accounts,
+		configuration.Node.MaxTransferAge,
+		logSampler(configuration.Node.LogSampling),
 	)
 }
 
 func createConsensusTopicWatcher(configuration *config.Config,
+	topic string,
 	client client.MirrorNode,
 	repository repository.Status,
+	prometheusService service.Prometheus,
 ) *cmw.Watcher {
-	topic := configuration.Bridge.TopicId
 	log.Debugf("Added Topic Watcher for topic [%s]\n", topic)
 	return cmw.NewWatcher(client,
 		topic,
 		repository,
 		configuration.Node.Clients.MirrorNode.PollingInterval,
-		configuration.Node.Clients.Hedera.StartTimestamp)
+		configuration.Node.Clients.Hedera.StartTimestamp,
+		configuration.Node.Clients.MirrorNode.MaxMessageSize,
+		prometheusService)
 }
 
 func createAssetsWatcher(
@@ -110,3 +116,8 @@ func createPrometheusWatcher(
 		evmNonFungibleTokenClients,
 		assetsService)
 }
+
+// logSampler creates the sampler of the routine per-transfer log lines of a watcher
+func logSampler(cfg config.LogSampling) *sampling.Sampler {
+	return sampling.New(cfg.Rate, cfg.HighValueMultiplier)
+}
@@ -17,15 +17,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/recovery"
 	"github.com/limechain/hedera-eth-bridge-validator/bootstrap"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 	_ "net/http/pprof"
 )
@@ -38,13 +42,19 @@ func main() {
 	}
 
 	config.InitLogger(configuration.Node.LogLevel, configuration.Node.LogFormat)
+	// Spans are exported only when an OTLP endpoint is configured through the environment
+	shutdownTracing := tracing.Init()
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), configuration.Node.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Errorf("Failed to flush the exported spans. Error: [%s]", err)
+		}
+	}()
 
 	// Prepare Clients
 	clients := bootstrap.PrepareClients(configuration.Node.Clients, configuration.Bridge.EVMs, parsedBridge.Networks)
 
-	// Prepare Node
-	server := server.NewServer()
-
 	var services *bootstrap.Services = nil
 	conn := persistence.NewPgConnector(configuration.Node.Database)
 	db := persistence.NewDatabase(conn)
@@ -63,9 +73,18 @@ func main() {
 		}
 	}
 	services = bootstrap.PrepareServices(configuration, parsedBridge, clients, *repositories, parsedBridgeConfigTopicId)
+	// The Hedera network id is known only after the bridge config has been loaded
+	migrateLegacyTransfers(repositories.Transfer)
+	migrateTransferDecimals(repositories.Transfer, services.Assets)
+	if configuration.Node.Validator {
+		bootstrap.VerifyWrappedSupplyKeys(configuration.Node.Clients.Hedera, configuration.Bridge, clients.HederaNode)
+	}
+
+	// Prepare Node
+	server := server.NewServer(bootstrap.PrepareQueue(configuration.Node, repositories, services.Prometheus, services.Pricing, services.Assets), configuration.Node.HandlerWorkers, configuration.Node.MaxInFlight, configuration.Node.ShutdownTimeout, services.Prometheus)
 	bootstrap.InitializeServerPairs(server, services, repositories, clients, configuration, parsedBridge, parsedBridgeConfigTopicId)
 
-	apiRouter := bootstrap.InitializeAPIRouter(services, parsedBridge, configuration.Node)
+	apiRouter := bootstrap.InitializeAPIRouter(services, repositories, clients, parsedBridge, configuration.Node)
 
 	executeRecovery(repositories.Fee, repositories.Schedule, clients.MirrorNode)
 
@@ -73,6 +92,78 @@ func main() {
 	server.Run(apiRouter.Router, fmt.Sprintf(":%s", configuration.Node.Port))
 }
 
+// migrateLegacyTransfers makes the source chain id part of the identity of transfers, stored before it was,
+// backfills the chain ids of transfers, stored before the introduction of the Hedera network id,
+// backfills the source chain of the rows, which reference transfers by their transaction id alone,
+// references transfers by their full identity from those rows
+// and backfills the creation times of transfers, stored before they were recorded.
+// The chain ids are migrated ahead of the source chains, which are copied from the migrated transfers
+func migrateLegacyTransfers(transferRepository repository.Transfer) {
+	migrated, err := transferRepository.MigrateCompositeIdentity()
+	if err != nil {
+		log.Fatalf("Failed to migrate the identity of transfers. Error: [%s]", err)
+	}
+	if migrated {
+		log.Infof("Migrated the identity of transfers to the transaction id and the source chain id")
+	}
+
+	updated, err := transferRepository.MigrateLegacyChainIds(constants.HederaNetworkId)
+	if err != nil {
+		log.Fatalf("Failed to migrate legacy transfers. Error: [%s]", err)
+	}
+	if updated > 0 {
+		log.Infof("Migrated the chain ids of [%d] legacy transfer rows", updated)
+	}
+
+	backfilled, err := transferRepository.MigrateTransferSourceChains()
+	if err != nil {
+		log.Fatalf("Failed to migrate the source chains of messages, fees and schedules. Error: [%s]", err)
+	}
+	if backfilled > 0 {
+		log.Infof("Backfilled the source chain of [%d] rows, which reference transfers", backfilled)
+	}
+
+	referenced, err := transferRepository.MigrateCompositeForeignKeys()
+	if err != nil {
+		log.Fatalf("Failed to migrate the foreign keys of messages, fees and schedules. Error: [%s]", err)
+	}
+	if referenced {
+		log.Infof("Referenced transfers by the transaction id and the source chain id from messages, fees and schedules")
+	}
+
+	stamped, err := transferRepository.MigrateCreationTimes()
+	if err != nil {
+		log.Fatalf("Failed to migrate the creation times of transfers. Error: [%s]", err)
+	}
+	if stamped > 0 {
+		log.Infof("Stamped [%d] legacy transfers with their creation time", stamped)
+	}
+}
+
+// migrateTransferDecimals backfills the decimals of the fungible transfers, stored before the decimals of their amount
+// were recorded, with the decimals of their target asset
+func migrateTransferDecimals(transferRepository repository.Transfer, assetsService service.Assets) {
+	targets, err := transferRepository.GetTargetAssetsWithoutDecimals()
+	if err != nil {
+		log.Fatalf("Failed to get the target assets of transfers without decimals. Error: [%s]", err)
+	}
+
+	for _, t := range targets {
+		info, exists := assetsService.FungibleAssetInfo(t.TargetChainID, t.TargetAsset)
+		if !exists || info.Decimals == 0 {
+			continue
+		}
+
+		updated, err := transferRepository.MigrateDecimals(t.TargetChainID, t.TargetAsset, info.Decimals)
+		if err != nil {
+			log.Fatalf("Failed to migrate the decimals of transfers to [%d]-[%s]. Error: [%s]", t.TargetChainID, t.TargetAsset, err)
+		}
+		if updated > 0 {
+			log.Infof("Migrated the decimals of [%d] transfers to [%d]-[%s]", updated, t.TargetChainID, t.TargetAsset)
+		}
+	}
+}
+
 func executeRecovery(feeRepository repository.Fee, scheduleRepository repository.Schedule, client client.MirrorNode) {
 	r := recovery.New(feeRepository, scheduleRepository, client)
 
@@ -31,26 +31,47 @@ import (
 
 type Bridge struct {
 	TopicId             string
+	TopicIds            []string
 	Hedera              *BridgeHedera
 	EVMs                map[uint64]BridgeEvm
 	CoinMarketCapIds    map[uint64]map[string]string
 	CoinGeckoIds        map[uint64]map[string]string
 	MinAmounts          map[uint64]map[string]*big.Int
+	DustAmounts         map[uint64]map[string]*big.Int
+	RoundingPolicies    map[uint64]map[string]string
+	DisabledCorridors   map[uint64]map[uint64]bool
 	MonitoredAccounts   map[string]string
 	BlacklistedAccounts []string
 }
 
+// Update applies the given bridge config, fetched at runtime. The signature topics are kept, as the topic watchers
+// and the topic assignment of transfers are set up at startup, so changing them requires a restart
 func (b *Bridge) Update(from *Bridge) {
-	b.TopicId = from.TopicId
 	b.Hedera = from.Hedera
 	b.EVMs = from.EVMs
 	b.CoinMarketCapIds = from.CoinMarketCapIds
 	b.CoinGeckoIds = from.CoinGeckoIds
 	b.MinAmounts = from.MinAmounts
+	b.DustAmounts = from.DustAmounts
+	b.RoundingPolicies = from.RoundingPolicies
+	b.DisabledCorridors = from.DisabledCorridors
 	b.MonitoredAccounts = from.MonitoredAccounts
 	b.BlacklistedAccounts = from.BlacklistedAccounts
 }
 
+// SignatureTopics returns the topics, across which the signatures of transfers are sharded -
+// the bridge topic, followed by the additional topics
+func (b *Bridge) SignatureTopics() []string {
+	topics := []string{b.TopicId}
+	for _, topicId := range b.TopicIds {
+		if topicId != b.TopicId {
+			topics = append(topics, topicId)
+		}
+	}
+
+	return topics
+}
+
 type BridgeHedera struct {
 	BridgeAccount   string
 	PayerAccount    string
@@ -85,6 +106,7 @@ type Token struct {
 	MinFeeAmountInUsd *big.Int
 	Networks          map[uint64]string
 	ReleaseTimestamp  uint64
+	FeeOnTransfer     bool
 }
 
 type BridgeEvm struct {
@@ -95,6 +117,7 @@ type BridgeEvm struct {
 func NewBridge(bridge parser.Bridge) *Bridge {
 	config := Bridge{
 		TopicId:             bridge.TopicId,
+		TopicIds:            bridge.TopicIds,
 		Hedera:              nil,
 		EVMs:                make(map[uint64]BridgeEvm),
 		MonitoredAccounts:   bridge.MonitoredAccounts,
@@ -104,6 +127,9 @@ func NewBridge(bridge parser.Bridge) *Bridge {
 	config.CoinGeckoIds = make(map[uint64]map[string]string)
 	config.CoinMarketCapIds = make(map[uint64]map[string]string)
 	config.MinAmounts = make(map[uint64]map[string]*big.Int)
+	config.DustAmounts = make(map[uint64]map[string]*big.Int)
+	config.RoundingPolicies = make(map[uint64]map[string]string)
+	config.DisabledCorridors = make(map[uint64]map[uint64]bool)
 	for networkId, networkInfo := range bridge.Networks {
 		if networkInfo.Name == constants.HederaName {
 			constants.HederaNetworkId = networkId
@@ -113,6 +139,12 @@ func NewBridge(bridge parser.Bridge) *Bridge {
 		config.CoinGeckoIds[networkId] = make(map[string]string)
 		config.CoinMarketCapIds[networkId] = make(map[string]string)
 		config.MinAmounts[networkId] = make(map[string]*big.Int)
+		config.DustAmounts[networkId] = make(map[string]*big.Int)
+		config.RoundingPolicies[networkId] = make(map[string]string)
+		config.DisabledCorridors[networkId] = make(map[uint64]bool)
+		for _, targetNetworkId := range networkInfo.DisabledCorridors {
+			config.DisabledCorridors[networkId][targetNetworkId] = true
+		}
 
 		if networkId == constants.HederaNetworkId { // Hedera
 			config.Hedera = &BridgeHedera{
@@ -139,6 +171,7 @@ func NewBridge(bridge parser.Bridge) *Bridge {
 				config.EVMs[networkId].Tokens[name] = Token{
 					Networks:         tokenInfo.Networks,
 					ReleaseTimestamp: tokenInfo.ReleaseTimestamp,
+					FeeOnTransfer:    tokenInfo.FeeOnTransfer,
 				}
 			}
 		}
@@ -156,6 +189,15 @@ func NewBridge(bridge parser.Bridge) *Bridge {
 			if tokenInfo.MinAmount != nil {
 				config.MinAmounts[networkId][tokenAddress] = tokenInfo.MinAmount
 			}
+			if tokenInfo.DustAmount != nil {
+				config.DustAmounts[networkId][tokenAddress] = tokenInfo.DustAmount
+			}
+			if !decimalHelper.IsValidRounding(tokenInfo.RoundingPolicy) {
+				log.Fatalf("[%s] - Invalid rounding policy [%s].", tokenAddress, tokenInfo.RoundingPolicy)
+			}
+			if tokenInfo.RoundingPolicy != "" {
+				config.RoundingPolicies[networkId][tokenAddress] = tokenInfo.RoundingPolicy
+			}
 			for wrappedNetworkId, wrappedAddress := range tokenInfo.Networks {
 				if config.MinAmounts[wrappedNetworkId] == nil {
 					config.MinAmounts[wrappedNetworkId] = make(map[string]*big.Int)
@@ -180,8 +222,10 @@ func (b Bridge) LoadStaticMinAmountsForWrappedFungibleTokens(parsedBridge parser
 				b.MinAmounts[wrappedNetworkId][wrappedAddress] = big.NewInt(0)
 				if tokenInfo.MinAmount != nil {
 					wrappedFungibleAssetsInfo, _ := assetsService.FungibleAssetInfo(wrappedNetworkId, wrappedAddress)
-					targetAmount := decimalHelper.TargetAmount(nativeFungibleAssetsInfo.Decimals, wrappedFungibleAssetsInfo.Decimals, tokenInfo.MinAmount)
-					b.MinAmounts[wrappedNetworkId][wrappedAddress] = targetAmount
+					targetAmount, err := decimalHelper.AdjustDecimals(tokenInfo.MinAmount, nativeFungibleAssetsInfo.Decimals, wrappedFungibleAssetsInfo.Decimals)
+					if err == nil {
+						b.MinAmounts[wrappedNetworkId][wrappedAddress] = targetAmount
+					}
 				}
 			}
 		}
@@ -145,6 +145,23 @@ func Test_NewBridge(t *testing.T) {
 	assert.NotNil(t, bridge)
 }
 
+func Test_SignatureTopics(t *testing.T) {
+	bridge := Bridge{TopicId: "0.0.1"}
+	assert.Equal(t, []string{"0.0.1"}, bridge.SignatureTopics())
+
+	bridge.TopicIds = []string{"0.0.2", "0.0.1", "0.0.3"}
+	assert.Equal(t, []string{"0.0.1", "0.0.2", "0.0.3"}, bridge.SignatureTopics())
+}
+
+func Test_Update_KeepsSignatureTopics(t *testing.T) {
+	bridge := Bridge{TopicId: "0.0.1", TopicIds: []string{"0.0.2"}}
+
+	bridge.Update(&Bridge{TopicId: "0.0.3", TopicIds: []string{"0.0.4"}, BlacklistedAccounts: []string{"0.0.5"}})
+
+	assert.Equal(t, []string{"0.0.1", "0.0.2"}, bridge.SignatureTopics())
+	assert.Equal(t, []string{"0.0.5"}, bridge.BlacklistedAccounts)
+}
+
 func Test_LoadStaticMinAmountsForWrappedFungibleTokens(t *testing.T) {
 	mocks.Setup()
 	bridge := NewBridge(parserBridge)
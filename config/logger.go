@@ -17,18 +17,32 @@
 package config
 
 import (
+	"context"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/correlation"
 	log "github.com/sirupsen/logrus"
 )
 
+// CorrelationIdField is the log field, correlating the log lines of a single transfer
+const CorrelationIdField = "correlation_id"
+
 // GetLoggerFor returns a logger defined with a context
 func GetLoggerFor(ctx string) *log.Entry {
 	return log.WithField("context", ctx)
 }
 
+// WithContext adds the correlation id, carried by the given context, to the fields of the logger
+func WithContext(logger *log.Entry, ctx context.Context) *log.Entry {
+	id := correlation.FromContext(ctx)
+	if id == "" {
+		return logger
+	}
+	return logger.WithField(CorrelationIdField, id)
+}
+
 // InitLogger sets the initial configuration of the used logger
 func InitLogger(level string, format string) {
 
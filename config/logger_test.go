@@ -17,6 +17,8 @@
 package config
 
 import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/correlation"
 	log "github.com/sirupsen/logrus"
 	"testing"
 )
@@ -30,6 +32,26 @@ func Test_GetLoggerFor(t *testing.T) {
 	}
 }
 
+func Test_WithContext(t *testing.T) {
+	ctx := correlation.NewContext(context.Background(), "some-correlation-id")
+	logEntry := WithContext(GetLoggerFor("testContext"), ctx)
+
+	if logEntry.Data[CorrelationIdField] != "some-correlation-id" {
+		t.Fatalf(`Expected to return logger with correlation id: [%s]`, "some-correlation-id")
+	}
+	if logEntry.Data["context"] != "testContext" {
+		t.Fatalf(`Expected to return logger with context: [%s]`, "testContext")
+	}
+}
+
+func Test_WithContext_WithoutCorrelationId(t *testing.T) {
+	logEntry := WithContext(GetLoggerFor("testContext"), context.Background())
+
+	if _, ok := logEntry.Data[CorrelationIdField]; ok {
+		t.Fatalf(`Expected to return logger without correlation id`)
+	}
+}
+
 func Test_LevelsWorkCorrectly(t *testing.T) {
 	ctx := "testContext"
 	logEntry := GetLoggerFor(ctx)
@@ -25,22 +25,75 @@ import (
 )
 
 type Node struct {
-	Database           Database
-	Clients            Clients
-	LogLevel           string
-	LogFormat          string
-	Port               string
-	Validator          bool
-	Monitoring         Monitoring
-	GaugeResetPassword string
+	Database            Database
+	Clients             Clients
+	LogLevel            string
+	LogFormat           string
+	Port                string
+	Validator           bool
+	Monitoring          Monitoring
+	GaugeResetPassword  string
+	SignatureSchemes    []string
+	SignatureTypedData  SignatureTypedData
+	MaxTransferAge      time.Duration
+	Queue               string
+	QueueWeights        map[string]int
+	QueueCapacity       int
+	QueueOverflowPolicy string
+	QueuePriority       QueuePriority
+	SignatureTimeout    time.Duration
+	PublicApi           PublicApi
+	MappingConsistency  MappingConsistency
+	Shard               Shard
+	HandlerWorkers      map[string]int
+	MaxInFlight         int
+	ShutdownTimeout     time.Duration
+	MessageRetention    MessageRetention
+	SignatureRequest    SignatureRequest
+	LogSampling         LogSampling
+	OrphanedSignatures  OrphanedSignatures
+}
+
+// in seconds
+const defaultShutdownTimeout = 30
+
+// in seconds
+const defaultOrphanedSignaturesTtl = 86400
+
+const defaultMaxOrphanedSignatures = 10000
+
+// The messages, handled at a time with the priority queue, when not configured, so that the backlog
+// is held by the queue and dispatched by priority
+const defaultPriorityMaxInFlight = 100
+
+// The signature scheme, verifying the authorisation signatures as EIP-712 typed data
+const signatureSchemeEIP712 = "eip712"
+
+// The queue type, which stores the events in the database
+const queuePersistent = "persistent"
+
+// The queue type, which dispatches the events of the highest priority first
+const queuePriority = "priority"
+
+// VerifiesTypedData returns whether the authorisation signatures are verified as EIP-712 typed data
+func (n Node) VerifiesTypedData() bool {
+	for _, scheme := range n.SignatureSchemes {
+		if scheme == signatureSchemeEIP712 {
+			return true
+		}
+	}
+	return false
 }
 
 type Database struct {
-	Host     string
-	Name     string
-	Password string
-	Port     string
-	Username string
+	Host            string
+	Name            string
+	Password        string
+	Port            string
+	Username        string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
 }
 
 type Clients struct {
@@ -52,29 +105,76 @@ type Clients struct {
 }
 
 type Evm struct {
-	BlockConfirmations uint64        `yaml:"block_confirmations"`
-	NodeUrl            string        `yaml:"node_url"`
-	PrivateKey         string        `yaml:"private_key"`
-	StartBlock         int64         `yaml:"start_block"`
-	PollingInterval    time.Duration `yaml:"polling_interval"`
-	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	BlockConfirmations uint64            `yaml:"block_confirmations"`
+	NodeUrl            string            `yaml:"node_url"`
+	PrivateKey         string            `yaml:"private_key"`
+	StartBlock         int64             `yaml:"start_block"`
+	PollingInterval    time.Duration     `yaml:"polling_interval"`
+	MaxLogsBlocks      int64             `yaml:"max_logs_blocks"`
+	LogsProvider       string            `yaml:"logs_provider"`
+	ReadOnlyFinality   time.Duration     `yaml:"read_only_finality"`
+	NodeHeaders        map[string]string `yaml:"node_headers"`
+	MaxConcurrentCalls int               `yaml:"max_concurrent_calls"`
 }
 
 type EvmPool struct {
-	BlockConfirmations uint64
-	NodeUrls           []string
-	PrivateKey         string
-	StartBlock         int64
-	PollingInterval    time.Duration
-	MaxLogsBlocks      int64
+	BlockConfirmations           uint64
+	NodeUrls                     []string
+	PrivateKey                   string
+	StartBlock                   int64
+	PollingInterval              time.Duration
+	MaxLogsBlocks                int64
+	MaxLogsBlocksCeiling         int64
+	LogsProvider                 string
+	ReadOnlyFinality             time.Duration
+	ConfirmationTiers            map[uint64]uint64
+	RouterAbi                    string
+	ExtraEvents                  []string
+	ServicedChains               []uint64
+	PartialRangeCommit           bool
+	ReorgGrace                   int64
+	WatchUpgrades                bool
+	PauseOnUpgrade               bool
+	CrossVerificationUrl         string
+	CrossVerificationThreshold   uint64
+	AutoTunePolling              bool
+	MinPollingInterval           time.Duration
+	MaxPollingInterval           time.Duration
+	DropSelfTransfers            bool
+	MaxAmountBits                int
+	NodeHeaders                  map[string]map[string]string
+	MaxReorgDepth                int64
+	ArchiveNodeUrl               string
+	ArchiveAge                   int64
+	Emitters                     []Emitter
+	ConfirmationsCallbackUrl     string
+	ConfirmationsCallbackRetries int
+	// The maximum in-flight HTTP requests per RPC endpoint. The rest are queued. Zero disables the limit
+	MaxConcurrentCalls int
+	// The amount of blocks before the latest confirmed block, from which a watcher without a checkpoint starts
+	ColdStartLookback int64
 }
 
+// Emitter is an auxiliary contract, whose events are watched next to the ones of the router.
+// An alias, so that the EVM pool configuration converts directly from its parsed form
+type Emitter = parser.Emitter
+
 type Hedera struct {
-	Operator       Operator
-	Network        string
-	Rpc            map[string]hedera.AccountID
-	StartTimestamp int64
-	MaxRetry       int
+	Operator                     Operator
+	Network                      string
+	Rpc                          map[string]hedera.AccountID
+	StartTimestamp               int64
+	MaxRetry                     int
+	SignatureSubmissionRetries   int
+	SignatureConfirmationRetries int
+	ScheduleExpiryTimeout        time.Duration
+	ScheduleResubmissions        int
+	MirrorConfirmationRetries    int
+	MinOperatorBalance           int64
+	OperatorBalanceCheckInterval time.Duration
+	// Whether a missing validator key in the supply key of a wrapped token is reported as a warning or fails the startup.
+	// Empty disables the check
+	SupplyKeyCheck string
 }
 
 type Operator struct {
@@ -83,8 +183,20 @@ type Operator struct {
 }
 
 const (
-	defaultMaxRetry       = 20
-	defaultStartTimestamp = 0
+	defaultMaxRetry                   = 20
+	defaultStartTimestamp             = 0
+	defaultSignatureSubmissionRetries = 5
+	// in seconds, matching the default schedule expiry of the Hedera network
+	defaultScheduleExpiryTimeout = 1800
+	defaultScheduleResubmissions = 2
+	// in seconds
+	defaultOperatorBalanceCheckInterval = 60
+)
+
+// Supply key check modes
+const (
+	SupplyKeyCheckWarn = "warn"
+	SupplyKeyCheckFail = "fail"
 )
 
 func (h *Hedera) DefaultOrConfig(cfg *parser.Hedera) *Hedera {
@@ -106,6 +218,28 @@ func (h *Hedera) DefaultOrConfig(cfg *parser.Hedera) *Hedera {
 	if h.MaxRetry = cfg.MaxRetry; h.MaxRetry == 0 {
 		h.MaxRetry = defaultMaxRetry
 	}
+	if h.SignatureSubmissionRetries = cfg.SignatureSubmissionRetries; h.SignatureSubmissionRetries == 0 {
+		h.SignatureSubmissionRetries = defaultSignatureSubmissionRetries
+	}
+	h.SignatureConfirmationRetries = cfg.SignatureConfirmationRetries
+	if h.ScheduleExpiryTimeout = cfg.ScheduleExpiryTimeout; h.ScheduleExpiryTimeout == 0 {
+		h.ScheduleExpiryTimeout = defaultScheduleExpiryTimeout
+	}
+	h.ScheduleExpiryTimeout = h.ScheduleExpiryTimeout * time.Second
+	if h.ScheduleResubmissions = cfg.ScheduleResubmissions; h.ScheduleResubmissions == 0 {
+		h.ScheduleResubmissions = defaultScheduleResubmissions
+	}
+	if h.MirrorConfirmationRetries = cfg.MirrorConfirmationRetries; h.MirrorConfirmationRetries < 0 {
+		log.Fatalf("node configuration: Hedera mirror confirmation retries must not be negative")
+	}
+	h.MinOperatorBalance = cfg.MinOperatorBalance
+	if h.OperatorBalanceCheckInterval = cfg.OperatorBalanceCheckInterval; h.OperatorBalanceCheckInterval == 0 {
+		h.OperatorBalanceCheckInterval = defaultOperatorBalanceCheckInterval
+	}
+	h.OperatorBalanceCheckInterval = h.OperatorBalanceCheckInterval * time.Second
+	if h.SupplyKeyCheck = cfg.SupplyKeyCheck; h.SupplyKeyCheck != "" && h.SupplyKeyCheck != SupplyKeyCheckWarn && h.SupplyKeyCheck != SupplyKeyCheckFail {
+		log.Fatalf("node configuration: invalid Hedera supply key check [%s]", h.SupplyKeyCheck)
+	}
 
 	return h
 }
@@ -133,6 +267,7 @@ type MirrorNode struct {
 	QueryDefaultLimit int64
 	RetryPolicy       RetryPolicy
 	RequestTimeout    int
+	MaxMessageSize    int
 }
 
 const (
@@ -171,6 +306,10 @@ func (m *MirrorNode) DefaultOrConfig(cfg *parser.MirrorNode) *MirrorNode {
 	if cfg.RequestTimeout != 0 {
 		m.RequestTimeout = cfg.RequestTimeout
 	}
+	if cfg.MaxMessageSize < 0 {
+		log.Fatalf("node configuration: MirrorNode MaxMessageSize must not be negative")
+	}
+	m.MaxMessageSize = cfg.MaxMessageSize
 
 	m.RetryPolicy = *m.RetryPolicy.DefaultOrConfig(&cfg.RetryPolicy)
 
@@ -213,9 +352,82 @@ func (r *RetryPolicy) DefaultOrConfig(cfg *parser.RetryPolicy) *RetryPolicy {
 	return r
 }
 
+// PublicApi configures the public, rate limited transfer status API
+type PublicApi struct {
+	RateLimit int
+	CacheTtl  time.Duration
+}
+
+// MappingConsistency configures the comparison of the asset mappings hash with the peer validators
+type MappingConsistency struct {
+	// Base URLs of the peer validators' APIs
+	Peers           []string
+	PollingInterval time.Duration
+}
+
+// MessageRetention configures the pruning of the signature messages of terminal transfers
+type MessageRetention struct {
+	// The age, after which the messages are pruned. Zero disables the pruning
+	Ttl             time.Duration
+	PruningInterval time.Duration
+}
+
+// OrphanedSignatures bounds the signature messages, stored until the transfer they sign is created
+type OrphanedSignatures struct {
+	// The age, after which the orphaned signature messages are pruned
+	Ttl time.Duration
+	// The maximum number of stored orphaned signature messages. Further ones are dropped
+	Max int
+}
+
+// SignatureRequest configures the request for the missing signatures of transfers, short of majority
+type SignatureRequest struct {
+	// The time, after which the missing signatures of a transfer are requested. Zero disables the requests
+	Wait            time.Duration
+	PollingInterval time.Duration
+}
+
+// QueuePriority configures the priorities of the transfers, dispatched by the `priority` queue
+type QueuePriority struct {
+	// The priorities of the transfers per corridor, keyed by "<source chain id>-<target chain id>"
+	Corridors map[string]int
+	// Transfers with an amount of at least minimum amount * HighValueMultiplier get at least HighValuePriority. Zero disables it
+	HighValueMultiplier uint64
+	HighValuePriority   int
+	// Every AgingInterval a message waits, its priority is raised by one, so that low-priority messages are not starved
+	AgingInterval time.Duration
+}
+
+// SignatureTypedData is the name and version of the EIP-712 domain of the authorisation signatures,
+// verified under the eip712 signature scheme. It must match the domain of the router contracts
+type SignatureTypedData struct {
+	Name    string
+	Version string
+}
+
+// LogSampling configures the sampling of the routine per-transfer log lines of the watchers
+type LogSampling struct {
+	// 1 in every Rate routine log lines is logged. Zero and one log every line
+	Rate uint64
+	// Transfers with an amount of at least minimum amount * HighValueMultiplier are always logged. Zero disables the exception
+	HighValueMultiplier uint64
+}
+
+// Shard configures the share of the transfers, processed by the node, when the processing
+// is split by receiver across several instances of the same validator
+type Shard struct {
+	// Index of the shard, processed by the node
+	Index uint64
+	// Total number of shards. Zero or one disables sharding
+	Count uint64
+}
+
 type Monitoring struct {
-	Enable           bool
-	DashboardPolling time.Duration
+	Enable                   bool
+	DashboardPolling         time.Duration
+	TransfersByStatusPolling time.Duration
+	PendingSignersTimeout    time.Duration
+	DatabasePoolPolling      time.Duration
 }
 
 type Recovery struct {
@@ -243,14 +455,105 @@ func New(node parser.Node) Node {
 		Port:      node.Port,
 		Validator: node.Validator,
 		Monitoring: Monitoring{
-			Enable:           node.Monitoring.Enable,
-			DashboardPolling: node.Monitoring.DashboardPolling,
+			Enable:                   node.Monitoring.Enable,
+			DashboardPolling:         node.Monitoring.DashboardPolling,
+			TransfersByStatusPolling: node.Monitoring.TransfersByStatusPolling * time.Second,
+			PendingSignersTimeout:    node.Monitoring.PendingSignersTimeout * time.Second,
+			DatabasePoolPolling:      node.Monitoring.DatabasePoolPolling * time.Second,
+		},
+		GaugeResetPassword:  node.GaugeResetPassword,
+		SignatureSchemes:    node.SignatureSchemes,
+		SignatureTypedData:  SignatureTypedData(node.SignatureTypedData),
+		MaxTransferAge:      node.MaxTransferAge * time.Second,
+		Queue:               node.Queue,
+		QueueWeights:        node.QueueWeights,
+		QueueCapacity:       node.QueueCapacity,
+		QueueOverflowPolicy: node.QueueOverflowPolicy,
+		QueuePriority: QueuePriority{
+			Corridors:           node.QueuePriority.Corridors,
+			HighValueMultiplier: node.QueuePriority.HighValueMultiplier,
+			HighValuePriority:   node.QueuePriority.HighValuePriority,
+			AgingInterval:       node.QueuePriority.AgingInterval * time.Second,
 		},
-		GaugeResetPassword: node.GaugeResetPassword,
+		SignatureTimeout: node.SignatureTimeout * time.Second,
+		PublicApi: PublicApi{
+			RateLimit: node.PublicApi.RateLimit,
+			CacheTtl:  node.PublicApi.CacheTtl * time.Second,
+		},
+		MappingConsistency: MappingConsistency{
+			Peers:           node.MappingConsistency.Peers,
+			PollingInterval: node.MappingConsistency.PollingInterval * time.Second,
+		},
+		Shard:           Shard(node.Shard),
+		HandlerWorkers:  node.HandlerWorkers,
+		MaxInFlight:     node.MaxInFlight,
+		ShutdownTimeout: defaultShutdownTimeout * time.Second,
+		MessageRetention: MessageRetention{
+			Ttl:             node.MessageRetention.Ttl * time.Second,
+			PruningInterval: node.MessageRetention.PruningInterval * time.Second,
+		},
+		SignatureRequest: SignatureRequest{
+			Wait:            node.SignatureRequest.Wait * time.Second,
+			PollingInterval: node.SignatureRequest.PollingInterval * time.Second,
+		},
+		LogSampling: LogSampling(node.LogSampling),
+		OrphanedSignatures: OrphanedSignatures{
+			Ttl: defaultOrphanedSignaturesTtl * time.Second,
+			Max: defaultMaxOrphanedSignatures,
+		},
+	}
+	config.Database.ConnMaxLifetime = node.Database.ConnMaxLifetime * time.Second
+	if node.ShutdownTimeout != 0 {
+		config.ShutdownTimeout = node.ShutdownTimeout * time.Second
+	}
+	if node.MaxInFlight == 0 && node.Queue == queuePriority {
+		config.MaxInFlight = defaultPriorityMaxInFlight
+	}
+	if node.OrphanedSignatures.Ttl != 0 {
+		config.OrphanedSignatures.Ttl = node.OrphanedSignatures.Ttl * time.Second
+	}
+	if node.OrphanedSignatures.Max != 0 {
+		config.OrphanedSignatures.Max = node.OrphanedSignatures.Max
+	}
+
+	if config.VerifiesTypedData() && node.SignatureTypedData.Name == "" {
+		log.Fatalf("node configuration: the name of the signature typed data domain is required by the [%s] signature scheme", signatureSchemeEIP712)
+	}
+
+	if node.Shard.Count > 1 && node.Shard.Index >= node.Shard.Count {
+		log.Fatalf("node configuration: shard index [%d] must be less than the shard count [%d]", node.Shard.Index, node.Shard.Count)
+	}
+
+	if len(node.QueueWeights) > 0 && node.Queue == queuePersistent {
+		log.Fatalf("node configuration: queue weights are not supported by the [%s] queue, as the partitions hold the events in memory", queuePersistent)
+	}
+
+	for corridor, priority := range node.QueuePriority.Corridors {
+		if priority < 0 {
+			log.Fatalf("node configuration: queue priority of corridor [%s] must not be negative", corridor)
+		}
+	}
+	if node.QueuePriority.HighValuePriority < 0 {
+		log.Fatalf("node configuration: queue high value priority must not be negative")
+	}
+
+	for topic, workers := range node.HandlerWorkers {
+		if workers < 0 {
+			log.Fatalf("node configuration: handler workers of topic [%s] must not be negative", topic)
+		}
+	}
+
+	if node.MaxInFlight < 0 {
+		log.Fatalf("node configuration: max in flight must not be negative")
 	}
 
 	for key, value := range node.Clients.EvmPool {
-		config.Clients.EvmPool[key] = EvmPool(value)
+		if value.MaxConcurrentCalls < 0 {
+			log.Fatalf("node configuration: max concurrent calls of EVM pool [%d] must not be negative", key)
+		}
+		pool := EvmPool(value)
+		pool.ReadOnlyFinality = value.ReadOnlyFinality * time.Second
+		config.Clients.EvmPool[key] = pool
 	}
 
 	return config
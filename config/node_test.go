@@ -18,6 +18,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
@@ -92,10 +93,14 @@ func Test_New(t *testing.T) {
 					AccountId:  "account-id",
 					PrivateKey: "private-key",
 				},
-				Network:        "network",
-				StartTimestamp: 0,
-				Rpc:            map[string]hedera.AccountID{},
-				MaxRetry:       20,
+				Network:                      "network",
+				StartTimestamp:               0,
+				Rpc:                          map[string]hedera.AccountID{},
+				MaxRetry:                     20,
+				SignatureSubmissionRetries:   defaultSignatureSubmissionRetries,
+				ScheduleExpiryTimeout:        defaultScheduleExpiryTimeout * time.Second,
+				ScheduleResubmissions:        defaultScheduleResubmissions,
+				OperatorBalanceCheckInterval: defaultOperatorBalanceCheckInterval * time.Second,
 			},
 			MirrorNode: MirrorNode{
 				ClientAddress:     "client-address",
@@ -119,12 +124,51 @@ func Test_New(t *testing.T) {
 			Enable:           false,
 			DashboardPolling: 0,
 		},
+		ShutdownTimeout: defaultShutdownTimeout * time.Second,
+		OrphanedSignatures: OrphanedSignatures{
+			Ttl: defaultOrphanedSignaturesTtl * time.Second,
+			Max: defaultMaxOrphanedSignatures,
+		},
 	}
 
 	actual := New(in)
 	assert.Equal(t, actual, expected)
 }
 
+func Test_New_MaxInFlight(t *testing.T) {
+	node := func(queue string, maxInFlight int) parser.Node {
+		return parser.Node{
+			Clients: parser.Clients{
+				Hedera:     parser.Hedera{Operator: parser.Operator{AccountId: "account-id", PrivateKey: "private-key"}},
+				MirrorNode: parser.MirrorNode{ClientAddress: "client-address", ApiAddress: "api-address"},
+			},
+			Queue:       queue,
+			MaxInFlight: maxInFlight,
+		}
+	}
+
+	assert.Equal(t, 0, New(node("", 0)).MaxInFlight)
+	assert.Equal(t, defaultPriorityMaxInFlight, New(node(queuePriority, 0)).MaxInFlight)
+	assert.Equal(t, 20, New(node(queuePriority, 20)).MaxInFlight)
+	assert.Equal(t, 20, New(node("", 20)).MaxInFlight)
+}
+
+func Test_New_Seconds(t *testing.T) {
+	in := parser.Node{
+		Clients: parser.Clients{
+			EvmPool:    map[uint64]parser.EvmPool{80001: {ReadOnlyFinality: 30}},
+			Hedera:     parser.Hedera{Operator: parser.Operator{AccountId: "account-id", PrivateKey: "private-key"}},
+			MirrorNode: parser.MirrorNode{ClientAddress: "client-address", ApiAddress: "api-address"},
+		},
+		MaxTransferAge: 3600,
+	}
+
+	actual := New(in)
+
+	assert.Equal(t, 30*time.Second, actual.Clients.EvmPool[80001].ReadOnlyFinality)
+	assert.Equal(t, time.Hour, actual.MaxTransferAge)
+}
+
 func Test_parseRpc(t *testing.T) {
 	acc1, _ := hedera.AccountIDFromString("0.0.1")
 	acc2, _ := hedera.AccountIDFromString("0.0.2")
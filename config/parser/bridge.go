@@ -29,16 +29,17 @@ type Bridge struct {
 	ConfigTopicId       string              `yaml:"config_topic_id,omitempty" json:"configTopicId,omitempty"`
 	PollingInterval     time.Duration       `yaml:"polling_interval,omitempty" json:"pollingInterval,omitempty"`
 	TopicId             string              `yaml:"topic_id,omitempty" json:"topicId,omitempty"`
+	TopicIds            []string            `yaml:"topic_ids,omitempty" json:"topicIds,omitempty"` // Additional topics, across which signatures are sharded next to topic_id
 	Networks            map[uint64]*Network `yaml:"networks,omitempty" json:"networks,omitempty"`
 	MonitoredAccounts   map[string]string   `yaml:"monitored_accounts,omitempty" json:"monitoredAccounts,omitempty"`
 	BlacklistedAccounts []string            `yaml:"blacklist,omitempty" json:"blacklistedAccounts,omitempty"`
 }
 
+// Update applies the given bridge config, fetched at runtime. The signature topics are kept, as they require a restart
 func (b *Bridge) Update(from *Bridge) {
 	b.UseLocalConfig = from.UseLocalConfig
 	b.ConfigTopicId = from.ConfigTopicId
 	b.PollingInterval = from.PollingInterval
-	b.TopicId = from.TopicId
 	b.Networks = from.Networks
 	b.MonitoredAccounts = from.MonitoredAccounts
 	b.BlacklistedAccounts = from.BlacklistedAccounts
@@ -51,6 +52,7 @@ type Network struct {
 	RouterContractAddress string   `yaml:"router_contract_address,omitempty" json:"routerContractAddress,omitempty"`
 	Members               []string `yaml:"members,omitempty" json:"members,omitempty"`
 	Tokens                Tokens   `yaml:"tokens,omitempty" json:"tokens,omitempty"`
+	DisabledCorridors     []uint64 `yaml:"disabled_corridors,omitempty" json:"disabledCorridors,omitempty"` // Target network ids, to which transfers from the network are not auto-processed, but routed to the read-only path
 }
 
 type Tokens struct {
@@ -68,4 +70,8 @@ type Token struct {
 	CoinGeckoId       string            `yaml:"coin_gecko_id,omitempty" json:"coinGeckoId,omitempty"`
 	CoinMarketCapId   string            `yaml:"coin_market_cap_id,omitempty" json:"coinMarketCapId,omitempty"`
 	ReleaseTimestamp  uint64            `yaml:"release_timestamp,omitempty" json:"releaseTimestamp,omitempty"`
+	DecimalsOverrides map[uint64]uint8  `yaml:"decimals_overrides,omitempty" json:"decimalsOverrides,omitempty"` // Overrides the on-chain decimals of the asset per network id (native or wrapped). Applies only for Fungible tokens
+	FeeOnTransfer     bool              `yaml:"fee_on_transfer,omitempty" json:"feeOnTransfer,omitempty"`        // Flags tokens, which deduct a fee on transfer. The locked amount is determined by the token Transfer to the router instead of the event amount. Applies only for EVM native Fungible tokens
+	DustAmount        *big.Int          `yaml:"dust_amount,omitempty" json:"dustAmount,omitempty"`               // Transfers of a smaller amount, in the lowest denomination of the native asset, are dropped as dust. Applies only for Fungible tokens
+	RoundingPolicy    string            `yaml:"rounding_policy,omitempty" json:"roundingPolicy,omitempty"`       // How amounts are scaled down to fewer decimals between networks - "truncate" (default) or "reject" on any remainder. Applies only for Fungible tokens
 }
@@ -22,23 +22,45 @@ import "time"
 Structs used to parse the node YAML configuration
 */
 type Node struct {
-	Database            Database   `yaml:"database"`
-	Clients             Clients    `yaml:"clients"`
-	LogLevel            string     `yaml:"log_level"`
-	LogFormat           string     `yaml:"log_format"`
-	Port                string     `yaml:"port"`
-	Validator           bool       `yaml:"validator"`
-	Monitoring          Monitoring `yaml:"monitoring"`
-	BridgeConfigTopicId Monitoring `yaml:"bridge_config_topic_id"`
-	GaugeResetPassword  string     `yaml:"gauge_reset_pass"`
+	Database            Database           `yaml:"database"`
+	Clients             Clients            `yaml:"clients"`
+	LogLevel            string             `yaml:"log_level"`
+	LogFormat           string             `yaml:"log_format"`
+	Port                string             `yaml:"port"`
+	Validator           bool               `yaml:"validator"`
+	Monitoring          Monitoring         `yaml:"monitoring"`
+	BridgeConfigTopicId Monitoring         `yaml:"bridge_config_topic_id"`
+	GaugeResetPassword  string             `yaml:"gauge_reset_pass"`
+	SignatureSchemes    []string           `yaml:"signature_schemes"`
+	SignatureTypedData  SignatureTypedData `yaml:"signature_typed_data"`
+	MaxTransferAge      time.Duration      `yaml:"max_transfer_age"`
+	Queue               string             `yaml:"queue"`
+	QueueWeights        map[string]int     `yaml:"queue_weights"`
+	QueueCapacity       int                `yaml:"queue_capacity"`
+	QueueOverflowPolicy string             `yaml:"queue_overflow_policy"`
+	QueuePriority       QueuePriority      `yaml:"queue_priority"`
+	SignatureTimeout    time.Duration      `yaml:"signature_timeout"`
+	PublicApi           PublicApi          `yaml:"public_api"`
+	MappingConsistency  MappingConsistency `yaml:"mapping_consistency"`
+	Shard               Shard              `yaml:"shard"`
+	HandlerWorkers      map[string]int     `yaml:"handler_workers"`
+	MaxInFlight         int                `yaml:"max_in_flight"`
+	ShutdownTimeout     time.Duration      `yaml:"shutdown_timeout"`
+	MessageRetention    MessageRetention   `yaml:"message_retention"`
+	SignatureRequest    SignatureRequest   `yaml:"signature_request"`
+	LogSampling         LogSampling        `yaml:"log_sampling"`
+	OrphanedSignatures  OrphanedSignatures `yaml:"orphaned_signatures"`
 }
 
 type Database struct {
-	Host     string `yaml:"host" env:"VALIDATOR_DATABASE_HOST"`
-	Name     string `yaml:"name"`
-	Password string `yaml:"password"`
-	Port     string `yaml:"port"`
-	Username string `yaml:"username"`
+	Host            string        `yaml:"host" env:"VALIDATOR_DATABASE_HOST"`
+	Name            string        `yaml:"name"`
+	Password        string        `yaml:"password"`
+	Port            string        `yaml:"port"`
+	Username        string        `yaml:"username"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 }
 
 type Clients struct {
@@ -52,31 +74,79 @@ type Clients struct {
 // Evm //
 
 type Evm struct {
-	BlockConfirmations uint64        `yaml:"block_confirmations"`
-	NodeUrl            string        `yaml:"node_url"`
-	PrivateKey         string        `yaml:"private_key"`
-	StartBlock         int64         `yaml:"start_block"`
-	PollingInterval    time.Duration `yaml:"polling_interval"`
-	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	BlockConfirmations uint64            `yaml:"block_confirmations"`
+	NodeUrl            string            `yaml:"node_url"`
+	PrivateKey         string            `yaml:"private_key"`
+	StartBlock         int64             `yaml:"start_block"`
+	PollingInterval    time.Duration     `yaml:"polling_interval"`
+	MaxLogsBlocks      int64             `yaml:"max_logs_blocks"`
+	LogsProvider       string            `yaml:"logs_provider"`
+	ReadOnlyFinality   time.Duration     `yaml:"read_only_finality"`
+	NodeHeaders        map[string]string `yaml:"node_headers"`
+	MaxConcurrentCalls int               `yaml:"max_concurrent_calls"`
 }
 
 type EvmPool struct {
-	BlockConfirmations uint64        `yaml:"block_confirmations"`
-	NodeUrls           []string      `yaml:"node_url"`
-	PrivateKey         string        `yaml:"private_key"`
-	StartBlock         int64         `yaml:"start_block"`
-	PollingInterval    time.Duration `yaml:"polling_interval"`
-	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	BlockConfirmations           uint64                       `yaml:"block_confirmations"`
+	NodeUrls                     []string                     `yaml:"node_url"`
+	PrivateKey                   string                       `yaml:"private_key"`
+	StartBlock                   int64                        `yaml:"start_block"`
+	PollingInterval              time.Duration                `yaml:"polling_interval"`
+	MaxLogsBlocks                int64                        `yaml:"max_logs_blocks"`
+	MaxLogsBlocksCeiling         int64                        `yaml:"max_logs_blocks_ceiling"`
+	LogsProvider                 string                       `yaml:"logs_provider"`
+	ReadOnlyFinality             time.Duration                `yaml:"read_only_finality"`
+	ConfirmationTiers            map[uint64]uint64            `yaml:"confirmation_tiers"`
+	RouterAbi                    string                       `yaml:"router_abi"`
+	ExtraEvents                  []string                     `yaml:"extra_events"`
+	ServicedChains               []uint64                     `yaml:"serviced_chains"`
+	PartialRangeCommit           bool                         `yaml:"partial_range_commit"`
+	ReorgGrace                   int64                        `yaml:"reorg_grace"`
+	WatchUpgrades                bool                         `yaml:"watch_upgrades"`
+	PauseOnUpgrade               bool                         `yaml:"pause_on_upgrade"`
+	CrossVerificationUrl         string                       `yaml:"cross_verification_url"`
+	CrossVerificationThreshold   uint64                       `yaml:"cross_verification_threshold"`
+	AutoTunePolling              bool                         `yaml:"auto_tune_polling"`
+	MinPollingInterval           time.Duration                `yaml:"min_polling_interval"`
+	MaxPollingInterval           time.Duration                `yaml:"max_polling_interval"`
+	DropSelfTransfers            bool                         `yaml:"drop_self_transfers"`
+	MaxAmountBits                int                          `yaml:"max_amount_bits"`
+	NodeHeaders                  map[string]map[string]string `yaml:"node_headers"`
+	MaxReorgDepth                int64                        `yaml:"max_reorg_depth"`
+	ArchiveNodeUrl               string                       `yaml:"archive_node_url"`
+	ArchiveAge                   int64                        `yaml:"archive_age"`
+	Emitters                     []Emitter                    `yaml:"emitters"`
+	ConfirmationsCallbackUrl     string                       `yaml:"confirmations_callback_url"`
+	ConfirmationsCallbackRetries int                          `yaml:"confirmations_callback_retries"`
+	MaxConcurrentCalls           int                          `yaml:"max_concurrent_calls"`
+	ColdStartLookback            int64                        `yaml:"cold_start_lookback"`
+}
+
+// Emitter is an auxiliary contract, whose events are watched next to the ones of the router
+type Emitter struct {
+	Address string `yaml:"address"`
+	// Path to a JSON file with the contract ABI
+	Abi string `yaml:"abi"`
+	// The handlers of the watched events, keyed by event name
+	Events map[string]string `yaml:"events"`
 }
 
 // Hedera //
 
 type Hedera struct {
-	Operator       Operator          `yaml:"operator"`
-	Network        string            `yaml:"network"`
-	Rpc            map[string]string `yaml:"rpc"`
-	StartTimestamp int64             `yaml:"start_timestamp"`
-	MaxRetry       int               `yaml:"max_retry" default:"20"`
+	Operator                     Operator          `yaml:"operator"`
+	Network                      string            `yaml:"network"`
+	Rpc                          map[string]string `yaml:"rpc"`
+	StartTimestamp               int64             `yaml:"start_timestamp"`
+	MaxRetry                     int               `yaml:"max_retry" default:"20"`
+	SignatureSubmissionRetries   int               `yaml:"signature_submission_retries"`
+	SignatureConfirmationRetries int               `yaml:"signature_confirmation_retries"`
+	ScheduleExpiryTimeout        time.Duration     `yaml:"schedule_expiry_timeout"`
+	ScheduleResubmissions        int               `yaml:"schedule_resubmissions"`
+	MirrorConfirmationRetries    int               `yaml:"mirror_confirmation_retries"`
+	MinOperatorBalance           int64             `yaml:"min_operator_balance"`
+	OperatorBalanceCheckInterval time.Duration     `yaml:"operator_balance_check_interval"`
+	SupplyKeyCheck               string            `yaml:"supply_key_check"`
 }
 
 type Operator struct {
@@ -94,6 +164,7 @@ type MirrorNode struct {
 	QueryDefaultLimit int64         `yaml:"query_default_limit"`
 	RetryPolicy       RetryPolicy   `yaml:"retry_policy"`
 	RequestTimeout    int           `yaml:"request_timeout" default:"15"`
+	MaxMessageSize    int           `yaml:"max_message_size"`
 }
 
 type RetryPolicy struct {
@@ -116,7 +187,57 @@ type CoinMarketCap struct {
 	ApiAddress string `yaml:"api_address" json:"apiAddress,omitempty"`
 }
 
+type PublicApi struct {
+	RateLimit int           `yaml:"rate_limit"`
+	CacheTtl  time.Duration `yaml:"cache_ttl"`
+}
+
+type MappingConsistency struct {
+	Peers           []string      `yaml:"peers"`
+	PollingInterval time.Duration `yaml:"polling_interval"`
+}
+
+type MessageRetention struct {
+	Ttl             time.Duration `yaml:"ttl"`
+	PruningInterval time.Duration `yaml:"pruning_interval"`
+}
+
+type OrphanedSignatures struct {
+	Ttl time.Duration `yaml:"ttl"`
+	Max int           `yaml:"max"`
+}
+
+type SignatureRequest struct {
+	Wait            time.Duration `yaml:"wait"`
+	PollingInterval time.Duration `yaml:"polling_interval"`
+}
+
+type QueuePriority struct {
+	Corridors           map[string]int `yaml:"corridors"`
+	HighValueMultiplier uint64         `yaml:"high_value_multiplier"`
+	HighValuePriority   int            `yaml:"high_value_priority"`
+	AgingInterval       time.Duration  `yaml:"aging_interval"`
+}
+
+type LogSampling struct {
+	Rate                uint64 `yaml:"rate"`
+	HighValueMultiplier uint64 `yaml:"high_value_multiplier"`
+}
+
+type SignatureTypedData struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+type Shard struct {
+	Index uint64 `yaml:"index"`
+	Count uint64 `yaml:"count"`
+}
+
 type Monitoring struct {
-	Enable           bool          `yaml:"enable"`
-	DashboardPolling time.Duration `yaml:"dashboard_polling"`
+	Enable                   bool          `yaml:"enable"`
+	DashboardPolling         time.Duration `yaml:"dashboard_polling"`
+	TransfersByStatusPolling time.Duration `yaml:"transfers_by_status_polling"`
+	PendingSignersTimeout    time.Duration `yaml:"pending_signers_timeout"`
+	DatabasePoolPolling      time.Duration `yaml:"database_pool_polling"`
 }
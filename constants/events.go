@@ -21,6 +21,14 @@ import "github.com/gookit/event"
 const (
 	EventBridgeConfigUpdate          = "config.bridge.update"
 	BridgeConfigUpdateEventParamsKey = "params"
+	EventTransferCreated             = "transfer.created"
+	EventTransferFeeUpdated          = "transfer.fee-updated"
+	EventTransferSignaturesReached   = "transfer.signatures-reached"
+	EventTransferSignatureRequested  = "transfer.signature-requested"
+	EventTransferSubmitted           = "transfer.submitted"
+	EventTransferCompleted           = "transfer.completed"
+	EventTransferFailed              = "transfer.failed"
+	TransferEventParamsKey           = "params"
 	ClientsEventPriority             = event.Max
 	AssetServicePriority             = event.High
 	ServiceEventPriority             = event.AboveNormal
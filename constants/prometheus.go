@@ -62,6 +62,133 @@ const (
 	FeeTransferredHelp         = "Fee transferred to the bridge account."
 	UserGetHisTokensNameSuffix = "user_get_his_tokens"
 	UserGetHisTokensHelp       = "The user get his tokens after bridging."
+
+	// Queue Metrics //
+
+	QueuePushesCounterNamePrefix = "queue_pushes_"
+	QueuePushesCounterHelp       = "Number of messages pushed to the processing queue for the given topic."
+	QueueTopicMetricLabelKey     = "topic"
+
+	QueuePartitionDepthGaugeNamePrefix = "queue_partition_depth_"
+	QueuePartitionDepthGaugeHelp       = "Number of messages of the given queue partition, awaiting dispatch to the handlers."
+	QueuePartitionMetricLabelKey       = "partition"
+
+	QueueFullEventsCounterName = "queue_full_events"
+	QueueFullEventsCounterHelp = "Number of messages pushed to the in-memory queue while it was full."
+	QueuePolicyMetricLabelKey  = "policy"
+
+	QueuePriorityDepthGaugeNamePrefix = "queue_priority_depth_"
+	QueuePriorityDepthGaugeHelp       = "Number of messages of the given priority, held by the priority queue."
+	QueuePriorityMetricLabelKey       = "priority"
+
+	TopicDroppedMessagesCounterNamePrefix = "topic_watcher_dropped_messages_"
+	TopicDroppedMessagesCounterHelp       = "Number of messages of the given topic, dropped by the topic watcher for the given reason."
+	TopicMetricLabelKey                   = "topic_id"
+	DropReasonOversized                   = "oversized"
+
+	HandlerActiveWorkersGaugeNamePrefix = "handler_active_workers_"
+	HandlerActiveWorkersGaugeHelp       = "Number of workers of the given topic, currently handling a message."
+
+	SignatureTimeoutsCounterName = "signature_timeouts"
+	SignatureTimeoutsCounterHelp = "Number of transfers failed for not reaching signature majority within the signature timeout."
+
+	UnconfirmedScheduledTransactionsCounterName = "unconfirmed_scheduled_transactions"
+	UnconfirmedScheduledTransactionsCounterHelp = "Number of executed scheduled transactions, still missing on the mirror node after the confirmation retries."
+
+	SignatureToMajorityHistogramNamePrefix = "signature_to_majority_seconds_"
+	SignatureToMajorityHistogramHelp       = "Duration in seconds from the first signature of a transfer to reaching signature majority on the given corridor."
+	SourceNetworkMetricLabelKey            = "source_network"
+	TargetNetworkMetricLabelKey            = "target_network"
+
+	MappingMismatchesGaugeName = "mapping_mismatches"
+	MappingMismatchesGaugeHelp = "Number of peer validators, whose hash of the asset mappings differs from the local one."
+
+	// Membership Metrics //
+
+	NotMemberGaugeNamePrefix = "validator_not_member_"
+	NotMemberGaugeHelp       = "Set to 1 when the validator's key is not in the member set of the router on the given network."
+	NetworkMetricLabelKey    = "network"
+
+	MembersStaleGaugeNamePrefix = "members_stale_"
+	MembersStaleGaugeHelp       = "Set to 1 when the last reload of the router members on the given network has failed."
+
+	PendingSignaturesGaugeNamePrefix = "pending_signatures_"
+	PendingSignaturesGaugeHelp       = "Number of transfers awaiting the signature of the given member for longer than the timeout."
+	MemberMetricLabelKey             = "member"
+
+	// Watcher Processing Metrics //
+
+	WatcherPhaseDurationHistogramNamePrefix = "evm_watcher_duration_seconds_"
+	WatcherPhaseDurationHistogramHelp       = "Duration in seconds of the given processing phase of the EVM watcher."
+	WatcherMetricLabelKey                   = "watcher"
+	PhaseMetricLabelKey                     = "phase"
+	WatcherPhaseFetch                       = "fetch"
+	WatcherPhaseDispatch                    = "dispatch"
+	WatcherPhaseCheckpoint                  = "checkpoint"
+
+	DroppedEventsCounterNamePrefix = "evm_watcher_dropped_events_"
+	DroppedEventsCounterHelp       = "Number of events dropped by the EVM watcher for the given reason."
+	ReasonMetricLabelKey           = "reason"
+	DropReasonUnsupportedChain     = "unsupported_chain"
+	DropReasonDeniedAsset          = "denied_asset"
+	DropReasonDust                 = "dust"
+	DropReasonSelfTransfer         = "self_transfer"
+	DropReasonEmptyReceiver        = "empty_receiver"
+	DropReasonZeroToken            = "zero_token"
+	DropReasonInvalidAmount        = "invalid_amount"
+	DropReasonRoundingRemainder    = "rounding_remainder"
+
+	CheckpointGapsCounterNamePrefix = "evm_watcher_checkpoint_gaps_"
+	CheckpointGapsCounterHelp       = "Number of times the checkpoint of the EVM watcher jumped forward by more than a single range of blocks."
+
+	CrossVerificationFailuresCounterNamePrefix = "evm_watcher_cross_verification_failures_"
+	CrossVerificationFailuresCounterHelp       = "Number of times a high-value transfer could not be confirmed by the cross-verification endpoint and was deferred."
+
+	RouterUpgradesCounterNamePrefix = "evm_watcher_router_upgrades_"
+	RouterUpgradesCounterHelp       = "Number of diamond cuts, which changed the facets of the router contract."
+
+	ReorgsCounterNamePrefix     = "evm_watcher_reorgs_"
+	ReorgsCounterHelp           = "Number of reorgs, after which the EVM watcher rewound its checkpoint."
+	ReorgHaltsCounterNamePrefix = "evm_watcher_reorg_halts_"
+	ReorgHaltsCounterHelp       = "Number of reorgs deeper than the maximum reorg depth, on which the EVM watcher was paused."
+
+	SinceLastEventGaugeNamePrefix = "evm_watcher_seconds_since_last_event_"
+	SinceLastEventGaugeHelp       = "Seconds since the EVM watcher last dispatched an event."
+
+	DeferredEventsGaugeNamePrefix = "evm_watcher_deferred_events_"
+	DeferredEventsGaugeHelp       = "Number of events, whose dispatch the EVM watcher defers to a later poll, holding its checkpoint."
+
+	OperatorBalanceLowGaugeName = "hedera_operator_balance_low"
+	OperatorBalanceLowGaugeHelp = "Whether Hedera submissions are held back, because the operator balance is below the configured minimum."
+
+	AssetDeniedGaugeNamePrefix = "asset_denied_"
+	AssetDeniedGaugeHelp       = "Set to 1 while the given asset is on the runtime deny-list, after being disabled by the router."
+	AssetAddressMetricLabelKey = "asset"
+
+	RoundingLossCounterNamePrefix = "rounding_loss_"
+	RoundingLossCounterHelp       = "Total remainder in the lowest denomination of the given asset, truncated when scaling its amounts down to fewer decimals."
+
+	// Transfer Status Metrics //
+
+	TransfersByStatusGaugeNamePrefix = "transfers_by_status_"
+	TransfersByStatusGaugeHelp       = "Number of transfers in the given status."
+
+	// Database Pool Metrics //
+
+	DatabasePoolInUseGaugeName     = "db_pool_in_use"
+	DatabasePoolInUseGaugeHelp     = "Number of database connections currently in use."
+	DatabasePoolIdleGaugeName      = "db_pool_idle"
+	DatabasePoolIdleGaugeHelp      = "Number of idle database connections."
+	DatabasePoolWaitCountGaugeName = "db_pool_wait_count"
+	DatabasePoolWaitCountGaugeHelp = "Total number of connections waited for."
+
+	// EVM Endpoint Metrics //
+
+	EvmEndpointHealthyGaugeNamePrefix   = "evm_endpoint_healthy_"
+	EvmEndpointHealthyGaugeHelp         = "Set to 1 when the last request to the RPC endpoint succeeded, 0 otherwise."
+	EvmEndpointQueueWaitGaugeNamePrefix = "evm_endpoint_queue_wait_seconds_"
+	EvmEndpointQueueWaitGaugeHelp       = "The average time the requests to the RPC endpoint spent queued because of its concurrency limit, since the previous poll."
+	EndpointMetricLabelKey              = "endpoint"
 )
 
 var (
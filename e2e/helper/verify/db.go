@@ -229,7 +229,7 @@ func (s *Service) getMessageListByTransactionId(expectedTransferRecord *entity.T
 	for currentCount < s.DatabaseRetryCount {
 		currentCount++
 
-		result, err = verifier.messages.Get(expectedTransferRecord.TransactionID)
+		result, err = verifier.messages.Get(expectedTransferRecord.SourceChainID, expectedTransferRecord.TransactionID)
 
 		// if result == nil && err == nil - the record is not found in the database - retry
 		if (result != nil && len(result) == s.ExpectedValidatorsCount) || err != nil {
@@ -254,7 +254,7 @@ func (s *Service) getTransactionById(verifier dbVerifier, expectedTransferRecord
 	for currentCount < s.DatabaseRetryCount {
 		currentCount++
 
-		result, err = verifier.transactions.GetByTransactionId(expectedTransferRecord.TransactionID)
+		result, err = verifier.transactions.GetByTransactionId(expectedTransferRecord.SourceChainID, expectedTransferRecord.TransactionID)
 
 		// if result == nil && err == nil - the record is not found in the database - retry
 		// if status != COMPLETED - the record processing is not finished - retry
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
+	verify_signature "github.com/limechain/hedera-eth-bridge-validator/scripts/common/verify-signature"
+)
+
+func main() {
+	signature := flag.String("signature", "", "The signature to verify, hex encoded")
+	expectedAddress := flag.String("expectedAddress", "", "The EVM address of the member, expected to have signed the message")
+	scheme := flag.String("scheme", auth_message.SchemeEIP191, "The signature scheme: eip191 or eip712")
+
+	transactionId := flag.String("transactionId", "", "The unique identifier of the transfer")
+	sourceChainId := flag.Uint64("sourceChainId", 0, "The identifier of the source blockchain network")
+	targetChainId := flag.Uint64("targetChainId", 0, "The identifier of the target blockchain network")
+	targetAsset := flag.String("targetAsset", "", "The asset at the target chain")
+	receiver := flag.String("receiver", "", "The recipient account on the target chain")
+	amount := flag.String("amount", "", "The amount of the asset being transferred")
+	isNft := flag.Bool("isNft", false, "Whether the transfer is of an NFT")
+	serialNum := flag.Int64("serialNum", 0, "The serial number of the NFT")
+	metadata := flag.String("metadata", "", "The metadata of the NFT")
+	routerAddress := flag.String("routerAddress", "", "The router contract at the target chain. Required by the eip712 scheme")
+	domainName := flag.String("domainName", "", "The name of the EIP-712 domain, verified by the router. Required by the eip712 scheme")
+	domainVersion := flag.String("domainVersion", "", "The version of the EIP-712 domain, verified by the router")
+
+	flag.Parse()
+	if *signature == "" {
+		panic("no signature provided")
+	}
+	if *expectedAddress == "" {
+		panic("no expectedAddress provided")
+	}
+	if *transactionId == "" {
+		panic("no transactionId provided")
+	}
+	if *targetChainId == 0 {
+		panic("no targetChainId provided")
+	}
+	if *targetAsset == "" {
+		panic("no targetAsset provided")
+	}
+	if *receiver == "" {
+		panic("no receiver provided")
+	}
+	if !*isNft && *amount == "" {
+		panic("no amount provided")
+	}
+
+	result, err := verify_signature.Verify(verify_signature.Transfer{
+		TransactionId: *transactionId,
+		SourceChainId: *sourceChainId,
+		TargetChainId: *targetChainId,
+		TargetAsset:   *targetAsset,
+		Receiver:      *receiver,
+		Amount:        *amount,
+		IsNft:         *isNft,
+		SerialNum:     *serialNum,
+		Metadata:      *metadata,
+		RouterAddress: *routerAddress,
+		Domain:        auth_message.TypedDataDomain{Name: *domainName, Version: *domainVersion},
+	}, *scheme, *signature, *expectedAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Scheme: %s\n", result.Scheme)
+	fmt.Printf("Transfer hash: 0x%s\n", result.Hash)
+	fmt.Printf("Recovered signer: %s\n", result.Signer)
+	if !result.Matches {
+		fmt.Printf("Signer does NOT match the expected address [%s]\n", *expectedAddress)
+		os.Exit(1)
+	}
+	fmt.Println("Signer matches the expected address")
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package verify_signature
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmhelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
+)
+
+// Transfer holds the parameters of the transfer, which are part of the authorisation message
+type Transfer struct {
+	TransactionId string
+	SourceChainId uint64
+	TargetChainId uint64
+	TargetAsset   string
+	Receiver      string
+	Amount        string
+	IsNft         bool
+	SerialNum     int64
+	Metadata      string
+	// RouterAddress is the router at the target chain. Required by the EIP-712 scheme only
+	RouterAddress string
+	// Domain is the EIP-712 domain, verified by the router. Required by the EIP-712 scheme only
+	Domain auth_message.TypedDataDomain
+}
+
+// Result is the outcome of the verification of a single signature
+type Result struct {
+	Scheme  string
+	Hash    string
+	Signer  string
+	Matches bool
+}
+
+// Verify computes the authorisation hash of the transfer under the given scheme, the same
+// way the messages service does, recovers the signer of the signature and checks whether it
+// is the expected address
+func Verify(transfer Transfer, scheme, signature, expectedAddress string) (*Result, error) {
+	hash, err := authMessageHash(transfer, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, _, err := evmhelper.RecoverSignerFromStr(strings.TrimPrefix(signature, "0x"), hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover signer: %w", err)
+	}
+
+	return &Result{
+		Scheme:  scheme,
+		Hash:    hex.EncodeToString(hash),
+		Signer:  signer,
+		Matches: common.HexToAddress(expectedAddress) == common.HexToAddress(signer),
+	}, nil
+}
+
+func authMessageHash(t Transfer, scheme string) ([]byte, error) {
+	switch scheme {
+	case "", auth_message.SchemeEIP191:
+		if t.IsNft {
+			return auth_message.EncodeNftBytesFrom(t.SourceChainId, t.TargetChainId, t.TransactionId, t.TargetAsset, t.SerialNum, t.Metadata, t.Receiver)
+		}
+		return auth_message.EncodeFungibleBytesFrom(t.SourceChainId, t.TargetChainId, t.TransactionId, t.TargetAsset, t.Receiver, t.Amount)
+	case auth_message.SchemeEIP712:
+		if t.RouterAddress == "" {
+			return nil, fmt.Errorf("scheme [%s] requires the router address", scheme)
+		}
+		if t.Domain.Name == "" {
+			return nil, fmt.Errorf("scheme [%s] requires the typed data domain name", scheme)
+		}
+		if t.IsNft {
+			return auth_message.EncodeNftTypedDataFrom(t.SourceChainId, t.TargetChainId, t.TransactionId, t.TargetAsset, t.SerialNum, t.Metadata, t.Receiver, t.RouterAddress, t.Domain)
+		}
+		return auth_message.EncodeFungibleTypedDataFrom(t.SourceChainId, t.TargetChainId, t.TransactionId, t.TargetAsset, t.Receiver, t.Amount, t.RouterAddress, t.Domain)
+	default:
+		return nil, fmt.Errorf("unsupported signature scheme [%s]", scheme)
+	}
+}
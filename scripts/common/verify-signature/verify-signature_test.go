@@ -0,0 +1,119 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package verify_signature
+
+import (
+	"encoding/hex"
+	"testing"
+
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	signer   = evm.NewEVMSigner("1b3a1b4d55df3d2cf9eaa9d6a6d52b5a8b9d1f4f7a3c1e2d0b9f8e7d6c5b4a39")
+	other    = evm.NewEVMSigner("2c4b2c5e66ef4e3dfafbbae7b7e63c6b9cae2f5f8b4d2f3e1cafaf8e7d6c5b4a")
+	transfer = Transfer{
+		TransactionId: "0.0.123-1658399049-123456789",
+		SourceChainId: 296,
+		TargetChainId: 80001,
+		TargetAsset:   "0x0000000000000000000000000000000000000001",
+		Receiver:      "0x0000000000000000000000000000000000000002",
+		Amount:        "100",
+		RouterAddress: "0x0000000000000000000000000000000000000003",
+		Domain:        auth_message.TypedDataDomain{Name: "Router", Version: "1"},
+	}
+)
+
+func sign(t *testing.T, s *evm.Signer, hash []byte) string {
+	signature, err := s.Sign(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(signature)
+}
+
+func Test_Verify_Matches(t *testing.T) {
+	hash, _ := auth_message.EncodeFungibleBytesFrom(transfer.SourceChainId, transfer.TargetChainId, transfer.TransactionId, transfer.TargetAsset, transfer.Receiver, transfer.Amount)
+
+	result, err := Verify(transfer, auth_message.SchemeEIP191, sign(t, signer, hash), signer.Address())
+
+	assert.Nil(t, err)
+	assert.True(t, result.Matches)
+	assert.Equal(t, signer.Address(), result.Signer)
+	assert.Equal(t, hex.EncodeToString(hash), result.Hash)
+}
+
+func Test_Verify_TypedData_Matches(t *testing.T) {
+	hash, _ := auth_message.EncodeFungibleTypedDataFrom(transfer.SourceChainId, transfer.TargetChainId, transfer.TransactionId, transfer.TargetAsset, transfer.Receiver, transfer.Amount, transfer.RouterAddress, transfer.Domain)
+
+	result, err := Verify(transfer, auth_message.SchemeEIP712, "0x"+sign(t, signer, hash), signer.Address())
+
+	assert.Nil(t, err)
+	assert.True(t, result.Matches)
+}
+
+func Test_Verify_Nft_Matches(t *testing.T) {
+	nft := transfer
+	nft.IsNft = true
+	nft.SerialNum = 5
+	nft.Metadata = "ipfs://metadata"
+	hash, _ := auth_message.EncodeNftBytesFrom(nft.SourceChainId, nft.TargetChainId, nft.TransactionId, nft.TargetAsset, nft.SerialNum, nft.Metadata, nft.Receiver)
+
+	result, err := Verify(nft, auth_message.SchemeEIP191, sign(t, signer, hash), signer.Address())
+
+	assert.Nil(t, err)
+	assert.True(t, result.Matches)
+}
+
+func Test_Verify_OtherSigner_Mismatches(t *testing.T) {
+	hash, _ := auth_message.EncodeFungibleBytesFrom(transfer.SourceChainId, transfer.TargetChainId, transfer.TransactionId, transfer.TargetAsset, transfer.Receiver, transfer.Amount)
+
+	result, err := Verify(transfer, auth_message.SchemeEIP191, sign(t, other, hash), signer.Address())
+
+	assert.Nil(t, err)
+	assert.False(t, result.Matches)
+	assert.Equal(t, other.Address(), result.Signer)
+}
+
+func Test_Verify_OtherTransfer_Mismatches(t *testing.T) {
+	hash, _ := auth_message.EncodeFungibleBytesFrom(transfer.SourceChainId, transfer.TargetChainId, transfer.TransactionId, transfer.TargetAsset, transfer.Receiver, "101")
+
+	result, err := Verify(transfer, auth_message.SchemeEIP191, sign(t, signer, hash), signer.Address())
+
+	assert.Nil(t, err)
+	assert.False(t, result.Matches)
+}
+
+func Test_Verify_Fails(t *testing.T) {
+	_, err := Verify(transfer, auth_message.SchemeEIP191, "0x01", signer.Address())
+	assert.Error(t, err)
+
+	_, err = Verify(transfer, "unknown", "0x01", signer.Address())
+	assert.Error(t, err)
+
+	noRouter := transfer
+	noRouter.RouterAddress = ""
+	_, err = Verify(noRouter, auth_message.SchemeEIP712, "0x01", signer.Address())
+	assert.Error(t, err)
+
+	noDomain := transfer
+	noDomain.Domain = auth_message.TypedDataDomain{}
+	_, err = Verify(noDomain, auth_message.SchemeEIP712, "0x01", signer.Address())
+	assert.Error(t, err)
+}
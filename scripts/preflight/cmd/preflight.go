@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm"
+	mirrornode "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/scripts/preflight"
+)
+
+// Exit codes of the command
+const (
+	exitPassed = 0
+	exitFailed = 1
+	exitConfig = 2
+)
+
+func main() {
+	nodeConfigPath := flag.String("nodeConfig", "config/node.yml", "The path to the node configuration")
+	bridgeConfigPath := flag.String("bridgeConfig", "config/bridge.yml", "The path to the bridge configuration")
+	flag.Parse()
+
+	var parsed parser.Config
+	if err := config.GetConfig(&parsed, *bridgeConfigPath); err != nil {
+		fmt.Printf("Failed to load bridge configuration [%s]. Error: [%s]\n", *bridgeConfigPath, err)
+		os.Exit(exitConfig)
+	}
+	if err := config.GetConfig(&parsed, *nodeConfigPath); err != nil {
+		fmt.Printf("Failed to load node configuration [%s]. Error: [%s]\n", *nodeConfigPath, err)
+		os.Exit(exitConfig)
+	}
+	node := config.New(parsed.Node)
+
+	newEvmClient := func(cfg config.EvmPool, chainId uint64) (client.EVM, error) {
+		return evm.NewClientPool(cfg, chainId)
+	}
+	checker := preflight.NewChecker(node, &parsed.Bridge, newEvmClient, mirrornode.NewClient(node.Clients.MirrorNode))
+
+	failed := 0
+	for _, result := range checker.Run() {
+		if result.Passed() {
+			fmt.Printf("PASS %s\n", result.Check)
+			continue
+		}
+		failed++
+		fmt.Printf("FAIL %s: %s\n", result.Check, result.Err)
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d check(s) failed\n", failed)
+		os.Exit(exitFailed)
+	}
+	fmt.Println("All checks passed")
+	os.Exit(exitPassed)
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+)
+
+// Result is the outcome of a single preflight check
+type Result struct {
+	Check string
+	Err   error
+}
+
+func (r Result) Passed() bool {
+	return r.Err == nil
+}
+
+// EvmClientFactory creates an EVM client for the given pool configuration and chain id
+type EvmClientFactory func(cfg config.EvmPool, chainId uint64) (client.EVM, error)
+
+// Checker validates the node and bridge configuration against the live chains
+type Checker struct {
+	node         config.Node
+	bridge       *parser.Bridge
+	newEvmClient EvmClientFactory
+	mirrorNode   client.MirrorNode
+}
+
+func NewChecker(node config.Node, bridge *parser.Bridge, newEvmClient EvmClientFactory, mirrorNode client.MirrorNode) *Checker {
+	return &Checker{
+		node:         node,
+		bridge:       bridge,
+		newEvmClient: newEvmClient,
+		mirrorNode:   mirrorNode,
+	}
+}
+
+// Run runs every check and returns their results in a stable order
+func (c *Checker) Run() []Result {
+	var results []Result
+	evmClients := make(map[uint64]client.EVM)
+
+	for _, chainId := range c.chainIds() {
+		evmClient, endpointResults := c.checkEvmEndpoints(chainId)
+		results = append(results, endpointResults...)
+		if evmClient == nil {
+			continue
+		}
+		evmClients[chainId] = evmClient
+
+		if network, ok := c.bridge.Networks[chainId]; ok && network.RouterContractAddress != "" {
+			results = append(results, c.checkContract(fmt.Sprintf("[%d] router [%s] has code", chainId, network.RouterContractAddress), evmClient, network.RouterContractAddress))
+		}
+	}
+
+	results = append(results, c.checkOperator())
+	results = append(results, c.checkTokens(evmClients)...)
+
+	return results
+}
+
+// checkEvmEndpoints checks that every endpoint of the chain responds with the configured chain id.
+// Returns a client of the responding endpoints, if any
+func (c *Checker) checkEvmEndpoints(chainId uint64) (client.EVM, []Result) {
+	pool := c.node.Clients.EvmPool[chainId]
+	var results []Result
+	var healthy []string
+
+	for _, url := range pool.NodeUrls {
+		check := fmt.Sprintf("[%d] endpoint [%s] responds with the configured chain id", chainId, url)
+		endpoint := pool
+		endpoint.NodeUrls = []string{url}
+		err := c.checkChainId(endpoint, chainId)
+		if err == nil {
+			healthy = append(healthy, url)
+		}
+		results = append(results, Result{Check: check, Err: err})
+	}
+
+	if len(healthy) == 0 {
+		return nil, results
+	}
+
+	pool.NodeUrls = healthy
+	evmClient, err := c.newEvmClient(pool, chainId)
+	if err != nil {
+		return nil, append(results, Result{Check: fmt.Sprintf("[%d] client is created", chainId), Err: err})
+	}
+	return evmClient, results
+}
+
+func (c *Checker) checkChainId(cfg config.EvmPool, chainId uint64) error {
+	evmClient, err := c.newEvmClient(cfg, chainId)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	actual, err := evmClient.ChainID(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve chain id: %w", err)
+	}
+	if actual.Uint64() != chainId {
+		return fmt.Errorf("chain id mismatch: [%d] configured, [%d] actual", chainId, actual.Uint64())
+	}
+
+	return nil
+}
+
+func (c *Checker) checkContract(check string, evmClient client.EVM, address string) Result {
+	_, err := evmClient.ValidateContractDeployedAt(address)
+	return Result{Check: check, Err: err}
+}
+
+func (c *Checker) checkOperator() Result {
+	accountId := c.node.Clients.Hedera.Operator.AccountId
+	check := fmt.Sprintf("Hedera operator account [%s] exists", accountId)
+
+	id, err := hedera.AccountIDFromString(accountId)
+	if err != nil {
+		return Result{Check: check, Err: fmt.Errorf("invalid account id: %w", err)}
+	}
+	if !c.mirrorNode.AccountExists(id) {
+		return Result{Check: check, Err: fmt.Errorf("account not found")}
+	}
+
+	return Result{Check: check}
+}
+
+// checkTokens checks that every native token and every asset, it is mapped to, resolves on its network
+func (c *Checker) checkTokens(evmClients map[uint64]client.EVM) []Result {
+	var results []Result
+	for _, chainId := range sortedNetworkIds(c.bridge.Networks) {
+		tokens := c.bridge.Networks[chainId].Tokens
+		for _, mapping := range []map[string]parser.Token{tokens.Fungible, tokens.Nft} {
+			for _, native := range sortedKeys(mapping) {
+				results = append(results, c.checkToken(chainId, native, evmClients))
+				for _, wrappedChainId := range sortedWrappedIds(mapping[native].Networks) {
+					results = append(results, c.checkToken(wrappedChainId, mapping[native].Networks[wrappedChainId], evmClients))
+				}
+			}
+		}
+	}
+	return results
+}
+
+func (c *Checker) checkToken(chainId uint64, asset string, evmClients map[uint64]client.EVM) Result {
+	check := fmt.Sprintf("[%d] token [%s] resolves", chainId, asset)
+
+	if chainId == constants.HederaNetworkId {
+		if asset == constants.Hbar {
+			return Result{Check: check}
+		}
+		_, err := c.mirrorNode.GetToken(asset)
+		return Result{Check: check, Err: err}
+	}
+
+	evmClient, ok := evmClients[chainId]
+	if !ok {
+		return Result{Check: check, Err: fmt.Errorf("no responding endpoint for chain [%d]", chainId)}
+	}
+	return c.checkContract(check, evmClient, asset)
+}
+
+// chainIds returns the EVM chains, configured in the node configuration
+func (c *Checker) chainIds() []uint64 {
+	ids := make([]uint64, 0, len(c.node.Clients.EvmPool))
+	for id := range c.node.Clients.EvmPool {
+		ids = append(ids, id)
+	}
+	return sortIds(ids)
+}
+
+func sortedNetworkIds(networks map[uint64]*parser.Network) []uint64 {
+	ids := make([]uint64, 0, len(networks))
+	for id := range networks {
+		ids = append(ids, id)
+	}
+	return sortIds(ids)
+}
+
+func sortedWrappedIds(wrapped map[uint64]string) []uint64 {
+	ids := make([]uint64, 0, len(wrapped))
+	for id := range wrapped {
+		ids = append(ids, id)
+	}
+	return sortIds(ids)
+}
+
+func sortIds(ids []uint64) []uint64 {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func sortedKeys(tokens map[string]parser.Token) []string {
+	keys := make([]string, 0, len(tokens))
+	for key := range tokens {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package preflight
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/token"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	mockclient "github.com/limechain/hedera-eth-bridge-validator/test/mocks/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	chainId     = uint64(80001)
+	router      = "0x0000000000000000000000000000000000000001"
+	evmToken    = "0x0000000000000000000000000000000000000002"
+	hederaToken = "0.0.2"
+	operator    = hedera.AccountID{Account: 100}
+)
+
+// stubs returns a checker with an EVM client stub per endpoint url and a mirror node stub
+func stubs() (*Checker, map[string]*mockclient.MockEVM, *mockclient.MockHederaMirror) {
+	node := config.Node{
+		Clients: config.Clients{
+			EvmPool: map[uint64]config.EvmPool{
+				chainId: {NodeUrls: []string{"url-1", "url-2"}},
+			},
+			Hedera: config.Hedera{Operator: config.Operator{AccountId: operator.String()}},
+		},
+	}
+	bridge := &parser.Bridge{
+		Networks: map[uint64]*parser.Network{
+			constants.HederaNetworkId: {
+				Tokens: parser.Tokens{
+					Fungible: map[string]parser.Token{
+						hederaToken: {Networks: map[uint64]string{chainId: evmToken}},
+					},
+				},
+			},
+			chainId: {RouterContractAddress: router},
+		},
+	}
+
+	clients := map[string]*mockclient.MockEVM{"url-1": {}, "url-2": {}}
+	for _, c := range clients {
+		c.On("ChainID", mock.Anything).Return(big.NewInt(int64(chainId)), nil)
+		c.On("ValidateContractDeployedAt", mock.Anything).Return(&common.Address{}, nil)
+	}
+	newEvmClient := func(cfg config.EvmPool, _ uint64) (client.EVM, error) {
+		return clients[cfg.NodeUrls[0]], nil
+	}
+
+	mirrorNode := &mockclient.MockHederaMirror{}
+	mirrorNode.On("AccountExists", operator).Return(true)
+	mirrorNode.On("GetToken", hederaToken).Return(&token.TokenResponse{}, nil)
+
+	return NewChecker(node, bridge, newEvmClient, mirrorNode), clients, mirrorNode
+}
+
+func failed(results []Result) []string {
+	var res []string
+	for _, r := range results {
+		if !r.Passed() {
+			res = append(res, r.Check)
+		}
+	}
+	return res
+}
+
+func Test_Run_AllPass(t *testing.T) {
+	checker, _, _ := stubs()
+
+	results := checker.Run()
+
+	assert.Len(t, results, 6)
+	assert.Empty(t, failed(results))
+}
+
+func Test_Run_EndpointDown(t *testing.T) {
+	checker, clients, _ := stubs()
+	clients["url-2"].ExpectedCalls = nil
+	clients["url-2"].On("ChainID", mock.Anything).Return(nil, errors.New("connection refused"))
+
+	results := checker.Run()
+
+	assert.Equal(t, []string{"[80001] endpoint [url-2] responds with the configured chain id"}, failed(results))
+}
+
+func Test_Run_ChainIdMismatch(t *testing.T) {
+	checker, clients, _ := stubs()
+	for _, c := range clients {
+		c.ExpectedCalls = nil
+		c.On("ChainID", mock.Anything).Return(big.NewInt(1), nil)
+	}
+
+	results := checker.Run()
+
+	assert.Equal(t, []string{
+		"[80001] endpoint [url-1] responds with the configured chain id",
+		"[80001] endpoint [url-2] responds with the configured chain id",
+		"[80001] token [0x0000000000000000000000000000000000000002] resolves",
+	}, failed(results))
+}
+
+func Test_Run_RouterWithoutCode(t *testing.T) {
+	checker, clients, _ := stubs()
+	clients["url-1"].ExpectedCalls = nil
+	clients["url-1"].On("ChainID", mock.Anything).Return(big.NewInt(int64(chainId)), nil)
+	clients["url-1"].On("ValidateContractDeployedAt", router).Return(&common.Address{}, errors.New("no code"))
+	clients["url-1"].On("ValidateContractDeployedAt", evmToken).Return(&common.Address{}, nil)
+
+	results := checker.Run()
+
+	assert.Equal(t, []string{"[80001] router [0x0000000000000000000000000000000000000001] has code"}, failed(results))
+}
+
+func Test_Run_MissingOperatorAndToken(t *testing.T) {
+	checker, _, mirrorNode := stubs()
+	mirrorNode.ExpectedCalls = nil
+	mirrorNode.On("AccountExists", operator).Return(false)
+	mirrorNode.On("GetToken", hederaToken).Return(&token.TokenResponse{}, errors.New("not found"))
+
+	results := checker.Run()
+
+	assert.Equal(t, []string{
+		"Hedera operator account [0.0.100] exists",
+		"[0] token [0.0.2] resolves",
+	}, failed(results))
+}
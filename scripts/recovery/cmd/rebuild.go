@@ -0,0 +1,111 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence"
+	mh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/recovery"
+	"github.com/limechain/hedera-eth-bridge-validator/bootstrap"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+func main() {
+	fromBlock := flag.Int64("fromBlock", 0, "The block, from which the router events of every network are replayed. Defaults to genesis")
+	fromTimestamp := flag.Int64("fromTimestamp", 0, "The consensus timestamp (in nanoseconds), after which the topic messages are replayed")
+	batchBlocks := flag.Int64("batchBlocks", 500, "The number of blocks, replayed in a single query")
+	flag.Parse()
+
+	configuration, parsedBridge, err := config.LoadConfig()
+	if err != nil {
+		fmt.Printf("Failed to load config. Error: [%s]\n", err)
+		os.Exit(1)
+	}
+	config.InitLogger(configuration.Node.LogLevel, configuration.Node.LogFormat)
+
+	topicIDs, err := hederahelper.TopicIDsFromStrings(configuration.Bridge.SignatureTopics())
+	if err != nil {
+		fmt.Printf("Failed to parse topic ids [%v]. Error: [%s]\n", configuration.Bridge.SignatureTopics(), err)
+		os.Exit(1)
+	}
+	var bridgeConfigTopicId hedera.TopicID
+	if !parsedBridge.UseLocalConfig {
+		bridgeConfigTopicId, err = hedera.TopicIDFromString(parsedBridge.ConfigTopicId)
+		if err != nil {
+			fmt.Printf("Failed to parse bridge config topic id [%s]. Error: [%s]\n", parsedBridge.ConfigTopicId, err)
+			os.Exit(1)
+		}
+	}
+
+	clients := bootstrap.PrepareClients(configuration.Node.Clients, configuration.Bridge.EVMs, parsedBridge.Networks)
+	db := persistence.NewDatabase(persistence.NewPgConnector(configuration.Node.Database))
+	db.Migrate()
+	repositories := bootstrap.PrepareRepositories(db)
+	if _, err := repositories.Transfer.MigrateCompositeIdentity(); err != nil {
+		fmt.Printf("Failed to migrate the identity of transfers. Error: [%s]\n", err)
+		os.Exit(1)
+	}
+	services := bootstrap.PrepareServices(configuration, parsedBridge, clients, *repositories, bridgeConfigTopicId)
+
+	var chains []recovery.ChainHistory
+	for chain, watcherId := range bootstrap.PrepareEvmSimulators(services, repositories, clients, configuration) {
+		evmClient := clients.EvmClients[chain]
+		latest, err := evmClient.RetryBlockNumber()
+		if err != nil {
+			fmt.Printf("Failed to retrieve latest block of chain [%d]. Error: [%s]\n", chain, err)
+			os.Exit(1)
+		}
+		chains = append(chains, recovery.ChainHistory{
+			WatcherId: watcherId,
+			FromBlock: *fromBlock,
+			ToBlock:   int64(latest - evmClient.BlockConfirmations()),
+		})
+	}
+	sort.Slice(chains, func(i, j int) bool {
+		return chains[i].WatcherId < chains[j].WatcherId
+	})
+
+	messageHandler := mh.NewHandler(
+		configuration.Bridge.SignatureTopics(),
+		repositories.Transfer,
+		repositories.Message,
+		services.ContractServices,
+		services.Messages,
+		services.Prometheus,
+		services.Assets,
+		bootstrap.TypedDataDomain(configuration.Node),
+		configuration.Node.OrphanedSignatures.Ttl,
+		configuration.Node.OrphanedSignatures.Max)
+	rebuild := recovery.NewRebuild(repositories.Transfer, services.Watchers, clients.MirrorNode, topicIDs, messageHandler, *batchBlocks)
+
+	result, err := rebuild.Execute(chains, *fromTimestamp)
+	if result != nil {
+		fmt.Printf("Created [%d] transfers, kept [%d] existing, skipped [%d] events and replayed [%d] messages\n",
+			result.Created, result.Existing, result.Skipped, result.Messages)
+	}
+	if err != nil {
+		fmt.Printf("Rebuild failed. Error: [%s]\n", err)
+		os.Exit(1)
+	}
+}
@@ -92,7 +92,8 @@ func (m *MockBridgeContract) ParseBurnERC721Log(log types.Log) (*router.RouterBu
 }
 
 func (m *MockBridgeContract) IsMember(address string) bool {
-	panic("implement me")
+	args := m.Called(address)
+	return args.Bool(0)
 }
 
 func (m *MockBridgeContract) HasValidSignaturesLength(signaturesLength *big.Int) (bool, error) {
@@ -137,6 +138,15 @@ func (m *MockBridgeContract) GetMembers() []string {
 	return args.Get(0).([]string)
 }
 
-func (m *MockBridgeContract) ReloadMembers() {
-	m.Called()
+func (m *MockBridgeContract) ReloadMembers() error {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockBridgeContract) MembersStale() bool {
+	args := m.Called()
+	return args.Bool(0)
 }
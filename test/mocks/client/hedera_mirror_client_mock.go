@@ -17,6 +17,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
@@ -226,6 +228,10 @@ func (m *MockHederaMirror) WaitForScheduledTransaction(txId string, onSuccess, o
 	m.Called(txId /*, onSuccess, onFailure*/)
 }
 
+func (m *MockHederaMirror) WaitForScheduledTransactionWithTimeout(txId string, timeout time.Duration, onSuccess, onFailure, onTimeout func()) {
+	m.Called(txId, timeout, onSuccess, onFailure, onTimeout)
+}
+
 func (m *MockHederaMirror) GetHBARUsdPrice() (price decimal.Decimal, err error) {
 	args := m.Called()
 	return args.Get(0).(decimal.Decimal), args.Error(1)
@@ -108,6 +108,14 @@ func (m *MockHederaNode) TransactionReceiptQuery(transactionID hedera.Transactio
 	return args.Get(0).(hedera.TransactionReceipt), args.Get(1).(error)
 }
 
+func (m *MockHederaNode) TokenInfoQuery(tokenID hedera.TokenID) (hedera.TokenInfo, error) {
+	args := m.Called(tokenID)
+	if args.Get(1) == nil {
+		return args.Get(0).(hedera.TokenInfo), nil
+	}
+	return args.Get(0).(hedera.TokenInfo), args.Get(1).(error)
+}
+
 func (m *MockHederaNode) SubmitScheduledNftApproveTransaction(payer hedera.AccountID, memo string, nftId hedera.NftID, owner, spender hedera.AccountID) (*hedera.TransactionResponse, error) {
 	args := m.Called(payer, memo, nftId, owner, spender)
 	if args.Get(1) == nil {
@@ -33,3 +33,7 @@ func (m *MockQueue) Channel() chan *queue.Message {
 func (m *MockQueue) Push(message *queue.Message) {
 	m.Called(message)
 }
+
+func (m *MockQueue) Ack(message *queue.Message) {
+	m.Called(message)
+}
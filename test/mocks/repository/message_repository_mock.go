@@ -17,6 +17,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/stretchr/testify/mock"
 )
@@ -35,14 +37,14 @@ func (m *MockMessageRepository) Create(message *entity.Message) error {
 
 func (m *MockMessageRepository) Exist(transferID, signature, hash string) (bool, error) {
 	args := m.Called(transferID, signature, hash)
-	if args[0] == nil {
+	if args[1] == nil {
 		return args[0].(bool), nil
 	}
-	return args[0].(bool), args[0].(error)
+	return args[0].(bool), args[1].(error)
 }
 
-func (m *MockMessageRepository) Get(transferID string) ([]entity.Message, error) {
-	args := m.Called(transferID)
+func (m *MockMessageRepository) Get(sourceChainId uint64, transferID string) ([]entity.Message, error) {
+	args := m.Called(sourceChainId, transferID)
 	if args[1] == nil {
 		return args[0].([]entity.Message), nil
 	}
@@ -56,3 +58,43 @@ func (m *MockMessageRepository) GetMessageWith(transferID, signature, hash strin
 	}
 	return args[0].(*entity.Message), args[0].(error)
 }
+
+func (m *MockMessageRepository) PruneMessagesBefore(cutoff time.Time) (int64, error) {
+	args := m.Called(cutoff)
+	if args[1] == nil {
+		return args[0].(int64), nil
+	}
+	return args[0].(int64), args[1].(error)
+}
+
+func (m *MockMessageRepository) CreateOrphan(orphan *entity.OrphanedSignature) error {
+	args := m.Called(orphan)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
+}
+
+func (m *MockMessageRepository) ResolveOrphans(sourceChainId uint64, transferID string) ([]entity.OrphanedSignature, error) {
+	args := m.Called(sourceChainId, transferID)
+	if args[1] == nil {
+		return args[0].([]entity.OrphanedSignature), nil
+	}
+	return args[0].([]entity.OrphanedSignature), args[1].(error)
+}
+
+func (m *MockMessageRepository) PruneOrphansBefore(cutoff time.Time) (int64, error) {
+	args := m.Called(cutoff)
+	if args[1] == nil {
+		return args[0].(int64), nil
+	}
+	return args[0].(int64), args[1].(error)
+}
+
+func (m *MockMessageRepository) CountOrphans() (int64, error) {
+	args := m.Called()
+	if args[1] == nil {
+		return args[0].(int64), nil
+	}
+	return args[0].(int64), args[1].(error)
+}
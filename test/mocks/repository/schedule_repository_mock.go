@@ -51,6 +51,14 @@ func (m *MockScheduleRepository) Create(entity *entity.Schedule) error {
 	return args.Get(0).(error)
 }
 
+func (m *MockScheduleRepository) UpdateStatusExpired(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
 func (m *MockScheduleRepository) UpdateStatusCompleted(txId string) error {
 	args := m.Called(txId)
 	if args.Get(0) == nil {
@@ -67,8 +75,8 @@ func (m *MockScheduleRepository) UpdateStatusFailed(txId string) error {
 	return args.Get(0).(error)
 }
 
-func (m *MockScheduleRepository) GetReceiverTransferByTransactionID(id string) (*entity.Schedule, error) {
-	args := m.Called(id)
+func (m *MockScheduleRepository) GetReceiverTransferByTransactionID(sourceChainId uint64, id string) (*entity.Schedule, error) {
+	args := m.Called(sourceChainId, id)
 	if args.Get(1) == nil {
 		return args.Get(0).(*entity.Schedule), nil
 	}
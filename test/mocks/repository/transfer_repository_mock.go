@@ -17,6 +17,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -27,23 +29,23 @@ type MockTransferRepository struct {
 	mock.Mock
 }
 
-func (m *MockTransferRepository) UpdateStatusFailed(txId string) error {
-	args := m.Called(txId)
+func (m *MockTransferRepository) UpdateStatusFailed(sourceChainId uint64, txId string) error {
+	args := m.Called(sourceChainId, txId)
 	if args.Get(0) == nil {
 		return nil
 	}
 	return args.Get(0).(error)
 }
 
-func (m *MockTransferRepository) GetByTransactionId(txId string) (*entity.Transfer, error) {
-	args := m.Called(txId)
+func (m *MockTransferRepository) GetByTransactionId(sourceChainId uint64, txId string) (*entity.Transfer, error) {
+	args := m.Called(sourceChainId, txId)
 	if args.Get(1) == nil {
 		return args.Get(0).(*entity.Transfer), nil
 	}
 	return nil, args.Get(1).(error)
 }
 
-func (m *MockTransferRepository) GetWithFee(txId string) (*entity.Transfer, error) {
+func (m *MockTransferRepository) GetByTransactionIdOnAnyChain(txId string) (*entity.Transfer, error) {
 	args := m.Called(txId)
 	if args.Get(1) == nil {
 		return args.Get(0).(*entity.Transfer), nil
@@ -51,8 +53,16 @@ func (m *MockTransferRepository) GetWithFee(txId string) (*entity.Transfer, erro
 	return nil, args.Get(1).(error)
 }
 
-func (m *MockTransferRepository) GetWithPreloads(txId string) (*entity.Transfer, error) {
-	args := m.Called(txId)
+func (m *MockTransferRepository) GetWithFee(sourceChainId uint64, txId string) (*entity.Transfer, error) {
+	args := m.Called(sourceChainId, txId)
+	if args.Get(1) == nil {
+		return args.Get(0).(*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) GetWithPreloads(sourceChainId uint64, txId string) (*entity.Transfer, error) {
+	args := m.Called(sourceChainId, txId)
 	if args.Get(1) == nil {
 		return args.Get(0).(*entity.Transfer), nil
 	}
@@ -67,17 +77,145 @@ func (m *MockTransferRepository) Create(ct *payload.Transfer) (*entity.Transfer,
 	return nil, args.Get(1).(error)
 }
 
-func (m *MockTransferRepository) UpdateFee(txId, fee string) error {
-	args := m.Called(txId, fee)
+func (m *MockTransferRepository) CreateFailedIfAbsent(ct *payload.Transfer) (bool, error) {
+	args := m.Called(ct)
+	if args.Get(1) == nil {
+		return args.Get(0).(bool), nil
+	}
+	return false, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) CreateEventLog(eventLog *entity.EventLog) error {
+	args := m.Called(eventLog)
 	if args.Get(0) == nil {
 		return nil
 	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) GetEventLog(sourceChainId uint64, txId string) (*entity.EventLog, error) {
+	args := m.Called(sourceChainId, txId)
+	if args.Get(1) == nil {
+		return args.Get(0).(*entity.EventLog), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) CountByStatus() (map[string]int64, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(map[string]int64), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) MigrateLegacyChainIds(hederaNetworkId uint64) (int64, error) {
+	args := m.Called(hederaNetworkId)
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil
+	}
+	return 0, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) MigrateCreationTimes() (int64, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil
+	}
+	return 0, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) MigrateTransferSourceChains() (int64, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil
+	}
+	return 0, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) GetTargetAssetsWithoutDecimals() ([]*entity.Transfer, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
 
+func (m *MockTransferRepository) MigrateDecimals(targetChainId uint64, targetAsset string, decimals uint8) (int64, error) {
+	args := m.Called(targetChainId, targetAsset, decimals)
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil
+	}
+	return 0, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) MigrateCompositeIdentity() (bool, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(bool), nil
+	}
+	return false, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) MigrateCompositeForeignKeys() (bool, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(bool), nil
+	}
+	return false, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateSignatureMsgStatus(sourceChainId uint64, txId string, status string) error {
+	args := m.Called(sourceChainId, txId, status)
+	if args.Get(0) == nil {
+		return nil
+	}
 	return args.Get(0).(error)
 }
 
-func (m *MockTransferRepository) UpdateStatusCompleted(txId string) error {
-	args := m.Called(txId)
+func (m *MockTransferRepository) GetPendingSignatureSubmissions() ([]*entity.Transfer, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) GetAwaitingSignatureFrom(signer string) ([]*entity.Transfer, error) {
+	args := m.Called(signer)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) GetInitialBefore(before time.Time) ([]*entity.Transfer, error) {
+	args := m.Called(before)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusFailedIfInitial(sourceChainId uint64, txId string) (bool, error) {
+	args := m.Called(sourceChainId, txId)
+	if args.Get(1) == nil {
+		return args.Bool(0), nil
+	}
+	return args.Bool(0), args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateFee(sourceChainId uint64, txId, fee string) error {
+	args := m.Called(sourceChainId, txId, fee)
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusCompleted(sourceChainId uint64, txId string) error {
+	args := m.Called(sourceChainId, txId)
 	if args.Get(0) == nil {
 		return nil
 	}
@@ -85,5 +223,9 @@ func (m *MockTransferRepository) UpdateStatusCompleted(txId string) error {
 }
 
 func (m *MockTransferRepository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error) {
-	panic("implement me")
+	args := m.Called(req)
+	if args.Get(2) == nil {
+		return args.Get(0).([]*entity.Transfer), args.Get(1).(int64), nil
+	}
+	return nil, 0, args.Get(2).(error)
 }
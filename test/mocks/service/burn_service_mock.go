@@ -25,8 +25,8 @@ type MockBurnService struct {
 	mock.Mock
 }
 
-func (m *MockBurnService) TransactionID(id string) (string, error) {
-	args := m.Called(id)
+func (m *MockBurnService) TransactionID(sourceChainId uint64, id string) (string, error) {
+	args := m.Called(sourceChainId, id)
 	if err, ok := args.Get(1).(error); ok {
 		return "", err
 	}
@@ -17,6 +17,9 @@
 package service
 
 import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/stretchr/testify/mock"
@@ -63,10 +66,54 @@ func (m *MockMessageService) SanityCheckNftSignature(tm *proto.TopicEthNftSignat
 }
 
 // ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
-func (m *MockMessageService) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error {
-	args := m.Called(transferID, signature, targetChainId, timestamp, authMsg)
+func (m *MockMessageService) ProcessSignature(transferID, signature string, sourceChainId, targetChainId uint64, timestamp int64, authMsg, typedDataMsg []byte) error {
+	args := m.Called(transferID, signature, sourceChainId, targetChainId, timestamp, authMsg, typedDataMsg)
 	if args[0] == nil {
 		return nil
 	}
 	return args[0].(error)
 }
+
+func (m *MockMessageService) PendingSigners(sourceChainId uint64, transferID string) ([]string, error) {
+	args := m.Called(sourceChainId, transferID)
+	if args[1] == nil {
+		return args[0].([]string), nil
+	}
+	return nil, args[1].(error)
+}
+
+func (m *MockMessageService) GetTransfersAwaitingSignatureFrom(member string) ([]*entity.Transfer, error) {
+	args := m.Called(member)
+	if args[1] == nil {
+		return args[0].([]*entity.Transfer), nil
+	}
+	return nil, args[1].(error)
+}
+
+func (m *MockMessageService) AwaitsOwnSignature(sourceChainId uint64, transferID string) (bool, error) {
+	args := m.Called(sourceChainId, transferID)
+	if args[1] == nil {
+		return args.Bool(0), nil
+	}
+	return false, args[1].(error)
+}
+
+func (m *MockMessageService) CheckMembership(transferID string, targetChainId uint64) error {
+	args := m.Called(transferID, targetChainId)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
+}
+
+func (m *MockMessageService) SignerRank(sourceChainId uint64, transferID string) (int, error) {
+	args := m.Called(sourceChainId, transferID)
+	if args[1] == nil {
+		return args.Int(0), nil
+	}
+	return -1, args[1].(error)
+}
+
+func (m *MockMessageService) ReportPendingSigners(timeout time.Duration) {
+	m.Called(timeout)
+}
@@ -36,8 +36,8 @@ func (m *MockReadOnlyService) FindTransfer(transferID string, fetch func() (*mir
 	m.Called(transferID, fetch, save)
 }
 
-func (m *MockReadOnlyService) FindAssetTransfer(transferID string, asset string, transfers []transfer.Hedera, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
-	m.Called(transferID, asset, transfers, fetch, save)
+func (m *MockReadOnlyService) FindAssetTransfer(sourceChainId uint64, transferID string, asset string, transfers []transfer.Hedera, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
+	m.Called(sourceChainId, transferID, asset, transfers, fetch, save)
 }
 
 func (m *MockReadOnlyService) FindScheduledNftAllowanceApprove(t *payload.Transfer, sender hedera.AccountID, save func(transactionID string, scheduleID string, status string) error) {
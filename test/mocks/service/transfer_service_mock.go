@@ -31,11 +31,11 @@ type MockTransferService struct {
 	mock.Mock
 }
 
-func (mts *MockTransferService) GetByTransactionId(txId string) (*entity.Transfer, error) {
+func (mts *MockTransferService) GetByTransactionId(sourceChainId uint64, txId string) (*entity.Transfer, error) {
 	panic("implement me")
 }
 
-func (mts *MockTransferService) GetWithFee(txId string) (*entity.Transfer, error) {
+func (mts *MockTransferService) GetWithFee(sourceChainId uint64, txId string) (*entity.Transfer, error) {
 	panic("implement me")
 }
 
@@ -99,8 +99,8 @@ func (mts *MockTransferService) InitiateNewTransfer(tm payload.Transfer) (*entit
 	return args.Get(0).(*entity.Transfer), args.Get(1).(error)
 }
 
-func (mts *MockTransferService) TransferData(txId string) (interface{}, error) {
-	args := mts.Called(txId)
+func (mts *MockTransferService) TransferData(sourceChainId uint64, txId string) (interface{}, error) {
+	args := mts.Called(sourceChainId, txId)
 	if args.Get(0) == nil {
 		return service.TransferData{}, args.Get(1).(error)
 	}
@@ -112,11 +112,20 @@ func (mts *MockTransferService) Paged(filter *transfer.PagedRequest) (*transfer.
 	panic("implement me")
 }
 
-func (mts *MockTransferService) UpdateTransferStatusCompleted(txId string) error {
-	args := mts.Called(txId)
+func (mts *MockTransferService) UpdateTransferStatusCompleted(sourceChainId uint64, txId string) error {
+	args := mts.Called(sourceChainId, txId)
 	if args.Get(0) == nil {
 		return nil
 	}
 
 	return fmt.Errorf("error")
 }
+
+func (mts *MockTransferService) SourceChain(txId string) (uint64, error) {
+	args := mts.Called(txId)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (mts *MockTransferService) ResumePendingSubmissions() {
+	mts.Called()
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/watcher"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWatchersService struct {
+	mock.Mock
+}
+
+func (m *MockWatchersService) Pause(id string) {
+	m.Called(id)
+}
+
+func (m *MockWatchersService) Resume(id string) {
+	m.Called(id)
+}
+
+func (m *MockWatchersService) IsPaused(id string) bool {
+	args := m.Called(id)
+	return args.Bool(0)
+}
+
+func (m *MockWatchersService) Iterate(id string, iteration func()) bool {
+	args := m.Called(id, iteration)
+	if args.Bool(0) {
+		iteration()
+	}
+	return args.Bool(0)
+}
+
+func (m *MockWatchersService) AwaitIteration(id string) {
+	m.Called(id)
+}
+
+func (m *MockWatchersService) RegisterCheckpointResetter(id string, resetter service.CheckpointResetter) {
+	m.Called(id, resetter)
+}
+
+func (m *MockWatchersService) ResetCheckpoint(id string) {
+	m.Called(id)
+}
+
+func (m *MockWatchersService) RegisterSimulator(id string, simulator service.Simulator) {
+	m.Called(id, simulator)
+}
+
+func (m *MockWatchersService) Simulate(id string, from, to int64) ([]*watcher.SimResult, error) {
+	args := m.Called(id, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*watcher.SimResult), args.Error(1)
+}
+
+func (m *MockWatchersService) DenyAsset(chainId uint64, asset string) {
+	m.Called(chainId, asset)
+}
+
+func (m *MockWatchersService) AllowAsset(chainId uint64, asset string) bool {
+	args := m.Called(chainId, asset)
+	return args.Bool(0)
+}
+
+func (m *MockWatchersService) IsAssetDenied(chainId uint64, asset string) bool {
+	args := m.Called(chainId, asset)
+	return args.Bool(0)
+}
+
+func (m *MockWatchersService) DeniedAssets() map[uint64][]string {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(map[uint64][]string)
+}
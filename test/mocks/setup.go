@@ -42,6 +42,7 @@ var MMessageRepository *repository.MockMessageRepository
 var MFeeRepository *repository.MockFeeRepository
 var MScheduleRepository *repository.MockScheduleRepository
 var MStatusRepository *repository.MockStatusRepository
+var MDeniedAssetRepository *repository.MockDeniedAssetRepository
 var MHederaMirrorClient *client.MockHederaMirror
 var MHederaNodeClient *client.MockHederaNode
 var MEVMCoreClient *client.MockEVMCore
@@ -65,6 +66,7 @@ var MHandler *handlers.MockHandler
 var MHttpHandler *http.MockHandler
 var MUtilsService *service.MockUtilsService
 var MBridgeConfigService *service.MockBridgeConfigService
+var MWatchersService *service.MockWatchersService
 
 func Setup() {
 	MDatabase = &database.MockDatabase{}
@@ -82,6 +84,7 @@ func Setup() {
 	MMessageRepository = &repository.MockMessageRepository{}
 	MScheduleRepository = &repository.MockScheduleRepository{}
 	MStatusRepository = &repository.MockStatusRepository{}
+	MDeniedAssetRepository = &repository.MockDeniedAssetRepository{}
 	MDistributorService = &service.MockDistrubutorService{}
 	MReadOnlyService = &service.MockReadOnlyService{}
 	MMessageService = &service.MockMessageService{}
@@ -104,4 +107,5 @@ func Setup() {
 	MHttpHandler = &http.MockHandler{}
 	MUtilsService = &service.MockUtilsService{}
 	MBridgeConfigService = &service.MockBridgeConfigService{}
+	MWatchersService = &service.MockWatchersService{}
 }